@@ -100,8 +100,15 @@ func configImplForSettings(settings Settings) config.Interface {
 	}
 
 	impl = remote.New(settings.ConfigServiceURL+"/_ah/api/config/v1/", authenticatedClient)
-	if settings.CacheExpirationSec != 0 {
+	switch {
+	case settings.CacheExpirationSec == 0:
+		// No caching.
+	case settings.CacheMaxStalenessSec == 0:
 		impl = WrapWithCache(impl, time.Duration(settings.CacheExpirationSec)*time.Second)
+	default:
+		impl = WrapWithTieredCache(impl,
+			time.Duration(settings.CacheExpirationSec)*time.Second,
+			time.Duration(settings.CacheMaxStalenessSec)*time.Second)
 	}
 
 	if implCache.cache == nil {
@@ -0,0 +1,101 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package gaeconfig
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/config"
+	"github.com/luci/luci-go/common/config/filters/caching"
+	log "github.com/luci/luci-go/common/logging"
+	"golang.org/x/net/context"
+)
+
+// tieredCacheLRUSize is how many configs the in-process tier of
+// WrapWithTieredCache holds.
+const tieredCacheLRUSize = 1000
+
+// WrapWithTieredCache wraps config client with in-process LRU, memcache and
+// datastore caching layers.
+//
+// Configs are refreshed after expire, but are kept for maxStaleness more and
+// used if the config service is unavailable. The datastore layer keeps them
+// across memcache flushes and instance restarts.
+func WrapWithTieredCache(cfg config.Interface, expire, maxStaleness time.Duration) config.Interface {
+	return caching.Wrap(cfg, caching.Options{
+		Cache:        caching.Tiered{caching.NewLRUCache(tieredCacheLRUSize), &cache{}, &dsCache{}},
+		Expiration:   expire,
+		MaxStaleness: maxStaleness,
+	})
+}
+
+// dsCache is a caching.Cache that stores values in the datastore.
+type dsCache struct{}
+
+// dsCacheEntry is a cached value in the datastore.
+//
+// The ID is a hash of the cache key, since keys may be longer than datastore
+// allows.
+type dsCacheEntry struct {
+	_kind  string    `gae:"$kind,gaeconfig.CacheEntry"`
+	ID     string    `gae:"$id"`
+	Key    string    `gae:",noindex"`
+	Value  []byte    `gae:",noindex"`
+	Expiry time.Time `gae:",noindex"` // zero if never expires
+
+	// Disable dscache, values are in memcache already.
+	_ datastore.Toggle `gae:"$dscache.enable,false"`
+}
+
+func (c *dsCache) Store(ctx context.Context, baseKey string, expire time.Duration, value []byte) {
+	ent := dsCacheEntry{ID: dsCacheID(baseKey)}
+
+	ds := datastore.Get(ctx)
+	var err error
+	if value == nil {
+		err = ds.Delete(ds.KeyForObj(&ent))
+	} else {
+		ent.Key = baseKey
+		ent.Value = value
+		if expire > 0 {
+			ent.Expiry = clock.Now(ctx).UTC().Add(expire)
+		}
+		err = ds.Put(&ent)
+	}
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"key":        baseKey,
+		}.Warningf(ctx, "Failed to store datastore cache value.")
+	}
+}
+
+func (c *dsCache) Retrieve(ctx context.Context, baseKey string) []byte {
+	ent := dsCacheEntry{ID: dsCacheID(baseKey)}
+	switch err := datastore.Get(ctx).Get(&ent); {
+	case err == datastore.ErrNoSuchEntity:
+		return nil
+	case err != nil:
+		log.Fields{
+			log.ErrorKey: err,
+			"key":        baseKey,
+		}.Warningf(ctx, "Failed to retrieve datastore cache value.")
+		return nil
+	case ent.Key != baseKey:
+		return nil // hash collision
+	case !ent.Expiry.IsZero() && !clock.Now(ctx).Before(ent.Expiry):
+		return nil
+	}
+	return ent.Value
+}
+
+func dsCacheID(baseKey string) string {
+	h := sha256.Sum256([]byte(baseKey))
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,52 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package gaeconfig
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/gae/impl/memory"
+	"github.com/luci/luci-go/common/clock/testclock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDSCache(t *testing.T) {
+	t.Parallel()
+
+	Convey("Test datastore cache", t, func() {
+		c := memory.Use(context.Background())
+		c, clk := testclock.UseTime(c, testclock.TestTimeUTC)
+
+		cache := &dsCache{}
+
+		Convey("Should be able to store stuff", func() {
+			cache.Store(c, "item", time.Second, []byte("foobar"))
+			So(cache.Retrieve(c, "item"), ShouldResemble, []byte("foobar"))
+
+			Convey("unless it's expired", func() {
+				clk.Add(time.Second * 2)
+				So(cache.Retrieve(c, "item"), ShouldBeNil)
+			})
+
+			Convey("or invalidated", func() {
+				cache.Store(c, "item", time.Second, nil)
+				So(cache.Retrieve(c, "item"), ShouldBeNil)
+			})
+		})
+
+		Convey("Should keep stuff without expiration", func() {
+			cache.Store(c, "item", 0, []byte("foobar"))
+			clk.Add(time.Hour * 24 * 365)
+			So(cache.Retrieve(c, "item"), ShouldResemble, []byte("foobar"))
+		})
+
+		Convey("Should miss unknown keys", func() {
+			So(cache.Retrieve(c, "missing"), ShouldBeNil)
+		})
+	})
+}
@@ -19,6 +19,10 @@ import (
 // DefaultExpire is a reasonable default expiration value.
 const DefaultExpire = 10 * time.Minute
 
+// DefaultMaxStaleness is a reasonable default for how long to use cached
+// configs when the config service is unavailable.
+const DefaultMaxStaleness = 24 * time.Hour
+
 // Settings are stored in the datastore via appengine/gaesettings package.
 type Settings struct {
 	// ConfigServiceURL is URL of luci-config service to fetch configs from.
@@ -26,6 +30,10 @@ type Settings struct {
 
 	// CacheExpirationSec is how long to hold configs in local cache.
 	CacheExpirationSec int `json:"cache_expiration_sec"`
+
+	// CacheMaxStalenessSec is how long to keep using cached configs past their
+	// expiration if the config service is unavailable.
+	CacheMaxStalenessSec int `json:"cache_max_staleness_sec"`
 }
 
 // FetchCachedSettings fetches Settings from the settings store.
@@ -52,7 +60,8 @@ func FetchCachedSettings(c context.Context) (Settings, error) {
 // DefaultSettings returns Settings to use if setting store is empty.
 func DefaultSettings() Settings {
 	return Settings{
-		CacheExpirationSec: int(DefaultExpire.Seconds()),
+		CacheExpirationSec:   int(DefaultExpire.Seconds()),
+		CacheMaxStalenessSec: int(DefaultMaxStaleness.Seconds()),
 	}
 }
 
@@ -111,6 +120,21 @@ probably don't use it and can keep this setting blank.</p>`,
 service are cached in memcache for specified amount of time. Set it to 0 to
 disable local cache.</p>`,
 		},
+		{
+			ID:    "CacheMaxStalenessSec",
+			Title: "Cache max staleness, sec",
+			Type:  settings.UIFieldText,
+			Validator: func(v string) error {
+				if i, err := strconv.Atoi(v); err != nil || i < 0 {
+					return errors.New("expecting a non-negative integer")
+				}
+				return nil
+			},
+			Help: `<p>If the configuration service is unavailable, cached
+configuration files are used for this long past their expiration. They are also
+stored in the datastore to survive memcache flushes. Set it to 0 to fail when the
+configuration service is unavailable.</p>`,
+		},
 	}, nil
 }
 
@@ -121,8 +145,9 @@ func (settingsUIPage) ReadSettings(c context.Context) (map[string]string, error)
 		return nil, err
 	}
 	return map[string]string{
-		"ConfigServiceURL":   s.ConfigServiceURL,
-		"CacheExpirationSec": strconv.Itoa(s.CacheExpirationSec),
+		"ConfigServiceURL":     s.ConfigServiceURL,
+		"CacheExpirationSec":   strconv.Itoa(s.CacheExpirationSec),
+		"CacheMaxStalenessSec": strconv.Itoa(s.CacheMaxStalenessSec),
 	}, nil
 }
 
@@ -135,6 +160,10 @@ func (settingsUIPage) WriteSettings(c context.Context, values map[string]string,
 	if err != nil {
 		return err
 	}
+	modified.CacheMaxStalenessSec, err = strconv.Atoi(values["CacheMaxStalenessSec"])
+	if err != nil {
+		return err
+	}
 
 	return settings.SetIfChanged(c, settingsKey, &modified, who, why)
 }
@@ -11,7 +11,6 @@
 // callers should prefer using cli.Application for hosting subcommands and
 // making the context:
 //
-//
 //	import (
 //	  "github.com/luci/luci-go/client/authcli"
 //	  "github.com/luci/luci-go/common/cli"
@@ -53,6 +52,7 @@ import (
 
 	"github.com/luci/luci-go/common/auth"
 	"github.com/luci/luci-go/common/cli"
+	"github.com/luci/luci-go/common/flag/stringlistflag"
 )
 
 // CommandParams specifies various parameters for a subcommand.
@@ -69,16 +69,22 @@ type CommandParams struct {
 
 // Flags defines command line flags related to authentication.
 type Flags struct {
-	defaults           auth.Options
-	serviceAccountJSON string
-	scopes             string
-	registerScopesFlag bool
+	defaults             auth.Options
+	serviceAccountJSON   string
+	credentialHelper     string
+	credentialHelperArgs stringlistflag.Flag
+	tokenServerSocket    string
+	scopes               string
+	registerScopesFlag   bool
 }
 
 // Register adds auth related flags to a FlagSet.
 func (fl *Flags) Register(f *flag.FlagSet, defaults auth.Options) {
 	fl.defaults = defaults
 	f.StringVar(&fl.serviceAccountJSON, "service-account-json", "", "Path to JSON file with service account credentials to use.")
+	f.StringVar(&fl.credentialHelper, "credential-helper", "", "Path to a credential helper that prints access tokens.")
+	f.Var(&fl.credentialHelperArgs, "credential-helper-arg", "An argument to pass to the credential helper. May be repeated.")
+	f.StringVar(&fl.tokenServerSocket, "token-server-socket", "", "Path to a unix socket of a local token server to get access tokens from.")
 	if fl.registerScopesFlag {
 		defaultScopes := strings.Join(defaults.Scopes, " ")
 		if defaultScopes == "" {
@@ -92,9 +98,26 @@ func (fl *Flags) Register(f *flag.FlagSet, defaults auth.Options) {
 // parsed command line flags.
 func (fl *Flags) Options() (auth.Options, error) {
 	opts := fl.defaults
+	count := 0
 	if fl.serviceAccountJSON != "" {
 		opts.Method = auth.ServiceAccountMethod
 		opts.ServiceAccountJSONPath = fl.serviceAccountJSON
+		count++
+	}
+	if fl.credentialHelper != "" {
+		opts.Method = auth.CredentialHelperMethod
+		opts.CredentialHelper = append([]string{fl.credentialHelper}, fl.credentialHelperArgs...)
+		count++
+	} else if len(fl.credentialHelperArgs) != 0 {
+		return opts, fmt.Errorf("-credential-helper-arg requires -credential-helper")
+	}
+	if fl.tokenServerSocket != "" {
+		opts.Method = auth.TokenServerMethod
+		opts.TokenServerSocket = fl.tokenServerSocket
+		count++
+	}
+	if count > 1 {
+		return opts, fmt.Errorf("-service-account-json, -credential-helper and -token-server-socket are mutually exclusive")
 	}
 
 	if fl.registerScopesFlag {
@@ -75,10 +75,11 @@ type Method string
 // Supported authentication methods.
 const (
 	// AutoSelectMethod can be used to allow the library to pick a method most
-	// appropriate for current execution environment. It will search for a private
-	// key for a service account, then (if running on GCE) will try to query GCE
-	// metadata server, and only then pick UserCredentialsMethod that requires
-	// interaction with a user.
+	// appropriate for current execution environment. It will use a credential
+	// helper or a token server if they are configured in Options, then search
+	// for a private key for a service account, then (if running on GCE) will try
+	// to query GCE metadata server, and only then pick UserCredentialsMethod that
+	// requires interaction with a user.
 	AutoSelectMethod Method = ""
 
 	// UserCredentialsMethod is used for interactive OAuth 3-legged login flow.
@@ -91,6 +92,14 @@ const (
 	// GCEMetadataMethod is used on Compute Engine to use tokens provided by
 	// Metadata server. See https://cloud.google.com/compute/docs/authentication
 	GCEMetadataMethod Method = "GCEMetadataMethod"
+
+	// CredentialHelperMethod is used to get tokens from an external command.
+	// See Options.CredentialHelper.
+	CredentialHelperMethod Method = "CredentialHelperMethod"
+
+	// TokenServerMethod is used to get tokens from a local token server
+	// listening on a unix domain socket. See Options.TokenServerSocket.
+	TokenServerMethod Method = "TokenServerMethod"
 )
 
 // LoginMode is used as enum in NewAuthenticator function.
@@ -168,6 +177,26 @@ type Options struct {
 	// Default: "default" account.
 	GCEAccountName string
 
+	// CredentialHelper is a command line of an external credential helper.
+	//
+	// The helper receives {"scopes": [...]} JSON on stdin and must print
+	// {"access_token": "...", "token_type": "...", "expires_at": <unix sec>}
+	// JSON to stdout. It is called each time a new token is needed and is
+	// killed if it runs longer than a minute. Tokens without "expires_at" are
+	// considered valid for 5 minutes.
+	//
+	// Used only with CredentialHelperMethod.
+	CredentialHelper []string
+
+	// TokenServerSocket is a path to a unix domain socket of a local token
+	// server.
+	//
+	// The server must reply to "POST /token" requests with the same JSON
+	// messages as CredentialHelper uses.
+	//
+	// Used only with TokenServerMethod.
+	TokenServerSocket string
+
 	// TokenCacheFactory is a factory method to use to grab TokenCache object.
 	//
 	// If not set, a file system cache will be used.
@@ -767,6 +796,12 @@ func pickServiceAccount(opts *Options) string {
 // It looks at the options and the environment and picks the most appropriate
 // authentication method.
 func selectDefaultMethod(opts *Options) Method {
+	if len(opts.CredentialHelper) != 0 {
+		return CredentialHelperMethod
+	}
+	if opts.TokenServerSocket != "" {
+		return TokenServerMethod
+	}
 	if len(opts.ServiceAccountJSON) != 0 {
 		return ServiceAccountMethod
 	}
@@ -814,6 +849,10 @@ func makeTokenProvider(ctx context.Context, opts *Options) (internal.TokenProvid
 			opts.Scopes)
 	case GCEMetadataMethod:
 		return internal.NewGCETokenProvider(ctx, opts.GCEAccountName, opts.Scopes)
+	case CredentialHelperMethod:
+		return internal.NewCredentialHelperTokenProvider(ctx, opts.CredentialHelper, opts.Scopes)
+	case TokenServerMethod:
+		return internal.NewTokenServerTokenProvider(ctx, opts.TokenServerSocket, opts.Scopes)
 	default:
 		return nil, fmt.Errorf("auth: unrecognized authentication method: %s", opts.Method)
 	}
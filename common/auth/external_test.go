@@ -0,0 +1,193 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/auth/internal"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/errors"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestExternalMethods(t *testing.T) {
+	Convey("With temp dir", t, func() {
+		tempDir, err := ioutil.TempDir("", "auth_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tempDir)
+
+		expiry := time.Now().Add(time.Hour).Unix()
+
+		getToken := func(opts Options) (string, error) {
+			opts.SecretsDir = tempDir
+			opts.Scopes = []string{"scope1", "scope2"}
+			opts.TokenCacheFactory = func(string) (TokenCache, error) {
+				return &fakeTokenCache{}, nil
+			}
+			tok, err := NewAuthenticator(context.Background(), SilentLogin, opts).GetAccessToken(time.Minute)
+			if err != nil {
+				return "", err
+			}
+			return tok.AccessToken, nil
+		}
+
+		Convey("Credential helper works", func() {
+			// The helper echoes requested scopes back in the token.
+			script := filepath.Join(tempDir, "helper.sh")
+			So(ioutil.WriteFile(script, []byte(fmt.Sprintf(`#!/bin/sh
+req=$(cat)
+case "$req" in
+  *scope1*scope2*) echo '{"access_token": "helper-token", "expires_at": %d}' ;;
+  *) echo "bad request: $req" >&2; exit 1 ;;
+esac
+`, expiry)), 0700), ShouldBeNil)
+
+			tok, err := getToken(Options{
+				Method:           CredentialHelperMethod,
+				CredentialHelper: []string{script},
+			})
+			So(err, ShouldBeNil)
+			So(tok, ShouldEqual, "helper-token")
+		})
+
+		Convey("Credential helper is picked automatically", func() {
+			So(selectDefaultMethod(&Options{CredentialHelper: []string{"helper"}}), ShouldEqual, CredentialHelperMethod)
+			So(selectDefaultMethod(&Options{TokenServerSocket: "socket"}), ShouldEqual, TokenServerMethod)
+		})
+
+		Convey("Credential helper failures", func() {
+			_, err := getToken(Options{
+				Method:           CredentialHelperMethod,
+				CredentialHelper: []string{"sh", "-c", "echo 'no creds' >&2; exit 1"},
+			})
+			So(err, ShouldErrLike, "no creds")
+
+			_, err = getToken(Options{
+				Method:           CredentialHelperMethod,
+				CredentialHelper: []string{"sh", "-c", "echo '{}'"},
+			})
+			So(err, ShouldErrLike, "no access_token")
+		})
+
+		Convey("Credential helper tokens without expiry expire soon", func() {
+			ctx, _ := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+			p, err := internal.NewCredentialHelperTokenProvider(ctx,
+				[]string{"sh", "-c", `echo '{"access_token": "helper-token"}'`}, nil)
+			So(err, ShouldBeNil)
+			tok, err := p.MintToken()
+			So(err, ShouldBeNil)
+			So(tok.Expiry, ShouldResemble, testclock.TestTimeUTC.Add(internal.DefaultExternalTokenLifetime))
+		})
+
+		Convey("Credential helper is killed on timeout", func() {
+			ctx, tc := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+			tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+				// Let the helper start, then time out.
+				go func() {
+					time.Sleep(50 * time.Millisecond)
+					tc.Add(d)
+				}()
+			})
+			p, err := internal.NewCredentialHelperTokenProvider(ctx, []string{"sleep", "60"}, nil)
+			So(err, ShouldBeNil)
+			_, err = p.MintToken()
+			So(err, ShouldErrLike, "timed out")
+			So(errors.IsTransient(err), ShouldBeTrue)
+		})
+
+		Convey("Token server works", func() {
+			socket := filepath.Join(tempDir, "token.sock")
+			l, err := net.Listen("unix", socket)
+			So(err, ShouldBeNil)
+			defer l.Close()
+
+			var seen internal.ExternalTokenRequest
+			go http.Serve(l, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "POST" || r.URL.Path != "/token" {
+					http.Error(w, "unexpected request", http.StatusNotFound)
+					return
+				}
+				if err := json.NewDecoder(r.Body).Decode(&seen); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				if len(seen.Scopes) == 0 {
+					http.Error(w, "overloaded", http.StatusServiceUnavailable)
+					return
+				}
+				json.NewEncoder(w).Encode(&internal.ExternalTokenResponse{
+					AccessToken:  "server-token",
+					TokenType:    "Bearer",
+					ExpiresAtSec: expiry,
+				})
+			}))
+
+			tok, err := getToken(Options{
+				Method:            TokenServerMethod,
+				TokenServerSocket: socket,
+			})
+			So(err, ShouldBeNil)
+			So(tok, ShouldEqual, "server-token")
+			So(seen.Scopes, ShouldResemble, []string{"scope1", "scope2"})
+
+			Convey("HTTP 5xx are transient", func() {
+				p, err := internal.NewTokenServerTokenProvider(context.Background(), socket, nil)
+				So(err, ShouldBeNil)
+				_, err = p.MintToken()
+				So(err, ShouldErrLike, "HTTP 503")
+				So(errors.IsTransient(err), ShouldBeTrue)
+			})
+		})
+
+		Convey("Unresponsive token server times out", func() {
+			socket := filepath.Join(tempDir, "stuck.sock")
+			l, err := net.Listen("unix", socket)
+			So(err, ShouldBeNil)
+			defer l.Close()
+
+			// Accept connections, but never reply.
+			stuck := make(chan struct{})
+			defer close(stuck)
+			go http.Serve(l, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				<-stuck
+			}))
+
+			ctx, tc := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+			tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+				// Let the request reach the server, then time out.
+				go func() {
+					time.Sleep(50 * time.Millisecond)
+					tc.Add(d)
+				}()
+			})
+			p, err := internal.NewTokenServerTokenProvider(ctx, socket, nil)
+			So(err, ShouldBeNil)
+			_, err = p.MintToken()
+			So(err, ShouldErrLike, "timed out")
+			So(errors.IsTransient(err), ShouldBeTrue)
+		})
+
+		Convey("Missing token server is a transient error", func() {
+			p, err := internal.NewTokenServerTokenProvider(context.Background(), filepath.Join(tempDir, "missing"), nil)
+			So(err, ShouldBeNil)
+			_, err = p.MintToken()
+			So(errors.IsTransient(err), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,247 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package internal
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	"golang.org/x/net/context/ctxhttp"
+	"golang.org/x/oauth2"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/system/ctxcmd"
+)
+
+const (
+	// CredentialHelperTimeout is how long a credential helper may run before
+	// it is killed.
+	CredentialHelperTimeout = time.Minute
+
+	// TokenServerTimeout is how long to wait for a reply from a token server.
+	TokenServerTimeout = time.Minute
+
+	// DefaultExternalTokenLifetime is how long tokens without "expires_at" are
+	// assumed to be valid.
+	DefaultExternalTokenLifetime = 5 * time.Minute
+)
+
+// ExternalTokenRequest is sent to credential helpers and token servers.
+type ExternalTokenRequest struct {
+	Scopes []string `json:"scopes"`
+}
+
+// ExternalTokenResponse is expected from credential helpers and token servers.
+type ExternalTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type,omitempty"`
+	ExpiresAtSec int64  `json:"expires_at,omitempty"`
+}
+
+// token validates the response and converts it to oauth2.Token.
+//
+// Tokens without expiration time get DefaultExternalTokenLifetime, starting
+// from now, so they are eventually refreshed.
+func (r *ExternalTokenResponse) token(now time.Time) (*oauth2.Token, error) {
+	if r.AccessToken == "" {
+		return nil, fmt.Errorf("no access_token in the response")
+	}
+	tok := &oauth2.Token{
+		AccessToken: r.AccessToken,
+		TokenType:   r.TokenType,
+	}
+	if r.ExpiresAtSec != 0 {
+		tok.Expiry = time.Unix(r.ExpiresAtSec, 0)
+	} else {
+		tok.Expiry = now.Add(DefaultExternalTokenLifetime)
+	}
+	return tok, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Credential helper.
+
+type credentialHelperTokenProvider struct {
+	ctx     context.Context
+	command []string
+	scopes  []string
+}
+
+// NewCredentialHelperTokenProvider returns TokenProvider that runs an external
+// command to get access tokens.
+//
+// The command receives ExternalTokenRequest as JSON on stdin and must print
+// ExternalTokenResponse as JSON to stdout and exit with code 0 within
+// CredentialHelperTimeout.
+func NewCredentialHelperTokenProvider(ctx context.Context, command []string, scopes []string) (TokenProvider, error) {
+	if len(command) == 0 {
+		return nil, fmt.Errorf("credential helper command is not set")
+	}
+	return &credentialHelperTokenProvider{
+		ctx:     ctx,
+		command: command,
+		scopes:  scopes,
+	}, nil
+}
+
+func (p *credentialHelperTokenProvider) RequiresInteraction() bool {
+	return false
+}
+
+func (p *credentialHelperTokenProvider) CacheSeed() []byte {
+	seed := sha1.New()
+	for _, arg := range p.command {
+		seed.Write([]byte(arg))
+		seed.Write([]byte{0})
+	}
+	return seed.Sum(nil)
+}
+
+func (p *credentialHelperTokenProvider) MintToken() (*oauth2.Token, error) {
+	req, err := json.Marshal(&ExternalTokenRequest{Scopes: p.scopes})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := clock.WithTimeout(p.ctx, CredentialHelperTimeout)
+	defer cancel()
+
+	logging.Debugf(ctx, "Running credential helper %q", p.command[0])
+	cmd := ctxcmd.CtxCmd{Cmd: exec.Command(p.command[0], p.command[1:]...)}
+	cmd.Stdin = bytes.NewReader(req)
+	stdout := bytes.Buffer{}
+	stderr := bytes.Buffer{}
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(ctx); err != nil {
+		if err == context.DeadlineExceeded {
+			return nil, errors.WrapTransient(fmt.Errorf("credential helper %q timed out after %s",
+				p.command[0], CredentialHelperTimeout))
+		}
+		return nil, fmt.Errorf("credential helper %q failed - %s: %s",
+			p.command[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	resp := ExternalTokenResponse{}
+	if err := json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+		return nil, fmt.Errorf("credential helper %q returned bad JSON - %s", p.command[0], err)
+	}
+	tok, err := resp.token(clock.Now(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("credential helper %q returned bad token - %s", p.command[0], err)
+	}
+	return tok, nil
+}
+
+func (p *credentialHelperTokenProvider) RefreshToken(*oauth2.Token) (*oauth2.Token, error) {
+	// The helper knows how to refresh tokens itself, just ask for a new one.
+	return p.MintToken()
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Local token server.
+
+type tokenServerTokenProvider struct {
+	ctx    context.Context
+	socket string
+	scopes []string
+	client *http.Client
+}
+
+// NewTokenServerTokenProvider returns TokenProvider that gets access tokens
+// from a local token server listening on a unix domain socket.
+//
+// The server is expected to reply to "POST /token" requests with
+// ExternalTokenRequest body with ExternalTokenResponse within
+// TokenServerTimeout.
+func NewTokenServerTokenProvider(ctx context.Context, socket string, scopes []string) (TokenProvider, error) {
+	if socket == "" {
+		return nil, fmt.Errorf("token server socket is not set")
+	}
+	return &tokenServerTokenProvider{
+		ctx:    ctx,
+		socket: socket,
+		scopes: scopes,
+		client: &http.Client{
+			Transport: &http.Transport{
+				Dial: func(string, string) (net.Conn, error) {
+					return net.Dial("unix", socket)
+				},
+			},
+		},
+	}, nil
+}
+
+func (p *tokenServerTokenProvider) RequiresInteraction() bool {
+	return false
+}
+
+func (p *tokenServerTokenProvider) CacheSeed() []byte {
+	return []byte(p.socket)
+}
+
+func (p *tokenServerTokenProvider) MintToken() (*oauth2.Token, error) {
+	req, err := json.Marshal(&ExternalTokenRequest{Scopes: p.scopes})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := clock.WithTimeout(p.ctx, TokenServerTimeout)
+	defer cancel()
+
+	logging.Debugf(ctx, "Requesting a token from the token server at %s", p.socket)
+	// The host is ignored, the connection goes to the socket.
+	resp, err := ctxhttp.Post(ctx, p.client, "http://localhost/token", "application/json", bytes.NewReader(req))
+	if err != nil {
+		return nil, p.transportError(ctx, err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, p.transportError(ctx, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("token server replied with HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+		if resp.StatusCode >= 500 {
+			return nil, errors.WrapTransient(err)
+		}
+		return nil, err
+	}
+
+	out := ExternalTokenResponse{}
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil, fmt.Errorf("token server returned bad JSON - %s", err)
+	}
+	tok, err := out.token(clock.Now(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("token server returned bad token - %s", err)
+	}
+	return tok, nil
+}
+
+// transportError converts an error of a call to the token server to a
+// transient error, naming timeouts explicitly.
+func (p *tokenServerTokenProvider) transportError(ctx context.Context, err error) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		err = fmt.Errorf("token server at %s timed out after %s", p.socket, TokenServerTimeout)
+	}
+	return errors.WrapTransient(err)
+}
+
+func (p *tokenServerTokenProvider) RefreshToken(*oauth2.Token) (*oauth2.Token, error) {
+	// Minting and refreshing is the same thing: a call to the token server.
+	return p.MintToken()
+}
@@ -7,22 +7,27 @@ package caching
 import (
 	"bytes"
 	"compress/zlib"
+	"encoding/binary"
 	"encoding/json"
-	"errors"
 	"io/ioutil"
 	"net/url"
+	"reflect"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/luci/luci-go/common/clock"
 	"github.com/luci/luci-go/common/config"
+	"github.com/luci/luci-go/common/logging"
 	"golang.org/x/net/context"
 )
 
 const (
-	version = "v2"
-)
+	version = "v3"
 
-var errCacheMiss = errors.New("cache miss")
+	// backgroundRefreshTimeout limits refreshes done by RefreshInBackground.
+	backgroundRefreshTimeout = time.Minute
+)
 
 type contentKey byte
 
@@ -54,14 +59,35 @@ type Options struct {
 	//
 	// Due to implementation details of the cache layer, the configuration may be
 	// retained for less time if necessary.
+	//
+	// If zero, cached configuration is never refreshed and entries live as long
+	// as Cache keeps them.
 	Expiration time.Duration
+
+	// MaxStaleness is how long to keep configuration past its Expiration.
+	//
+	// Stale configuration is refreshed when accessed. If the refresh fails, the
+	// stale configuration is returned instead of the error, so that services
+	// continue to work when the config service is down.
+	//
+	// Ignored if Expiration is zero.
+	MaxStaleness time.Duration
+
+	// RefreshInBackground, if true, makes stale configuration be returned right
+	// away while it is refreshed in a goroutine (stale-while-revalidate).
+	//
+	// The goroutine uses the values of the context of the call that found the
+	// stale entry, but not its deadline or cancellation: it may keep running
+	// after the call returns, for at most a minute.
+	RefreshInBackground bool
 }
 
 // Wrap returns Interface object that adds caching layer on top of given one.
 func Wrap(cc config.Interface, o Options) config.Interface {
 	return &cacheConfig{
-		opts:  o,
-		inner: cc,
+		opts:       o,
+		inner:      cc,
+		refreshing: map[string]struct{}{},
 	}
 }
 
@@ -69,6 +95,10 @@ func Wrap(cc config.Interface, o Options) config.Interface {
 type cacheConfig struct {
 	opts  Options
 	inner config.Interface
+
+	// refreshing is a set of keys being refreshed in background.
+	refreshingLock sync.Mutex
+	refreshing     map[string]struct{}
 }
 
 func (cc *cacheConfig) ServiceURL(ctx context.Context) url.URL {
@@ -81,69 +111,39 @@ func (cc *cacheConfig) GetConfig(ctx context.Context, configSet, path string, ha
 	// a hash-only cache bucket.
 	c := config.Config{}
 	key := cc.cacheKey("configs", "full", configSet, path)
-	switch err := cc.retrieve(ctx, key, &c); err {
-	case nil:
-		// Cache hit.
-		return &c, nil
-	case errCacheMiss:
-		// Cache miss, load from service.
-		break
-	default:
-		// Return cached error.
-		return nil, err
-	}
-
 	if hashOnly {
-		key = cc.cacheKey("configs", "hashOnly", configSet, path)
-		switch err := cc.retrieve(ctx, key, &c); err {
-		case nil:
-			// Cache hit.
+		if res, _, err := cc.retrieve(ctx, key, &c); res == resultHit {
+			cc.record(ctx, "configs", res, 0)
+			if err != nil {
+				return nil, err
+			}
 			return &c, nil
-		case errCacheMiss:
-			// Cache miss, load from service.
-			break
-		default:
-			// Return cached error.
-			return nil, err
 		}
+		key = cc.cacheKey("configs", "hashOnly", configSet, path)
 	}
 
-	ic, err := cc.inner.GetConfig(ctx, configSet, path, hashOnly)
+	err := cc.cached(ctx, "configs", key, &c, func(ctx context.Context) (interface{}, error) {
+		ic, err := cc.inner.GetConfig(ctx, configSet, path, hashOnly)
+		if err != nil {
+			return nil, err
+		}
+		if !hashOnly {
+			cc.store(ctx, cc.configByHashCacheKey(ic.ContentHash), ic.Content)
+		}
+		return *ic, nil
+	})
 	if err != nil {
-		cc.storeErr(ctx, key, err)
 		return nil, err
 	}
-
-	cc.store(ctx, key, ic)
-	if !hashOnly {
-		cc.store(ctx, cc.configByHashCacheKey(ic.ContentHash), ic.Content)
-	}
-	return ic, nil
+	return &c, nil
 }
 
 func (cc *cacheConfig) GetConfigByHash(ctx context.Context, contentHash string) (string, error) {
 	c := ""
-	key := cc.configByHashCacheKey(contentHash)
-	switch err := cc.retrieve(ctx, key, &c); err {
-	case nil:
-		// Cache hit.
-		return c, nil
-	case errCacheMiss:
-		// Cache miss, load from service.
-		break
-	default:
-		// Return cached error.
-		return "", err
-	}
-
-	c, err := cc.inner.GetConfigByHash(ctx, contentHash)
-	if err != nil {
-		cc.storeErr(ctx, key, err)
-		return "", err
-	}
-
-	cc.store(ctx, key, c)
-	return c, nil
+	err := cc.cached(ctx, "configsByHash", cc.configByHashCacheKey(contentHash), &c, func(ctx context.Context) (interface{}, error) {
+		return cc.inner.GetConfigByHash(ctx, contentHash)
+	})
+	return c, err
 }
 
 func (cc *cacheConfig) configByHashCacheKey(contentHash string) string {
@@ -153,183 +153,235 @@ func (cc *cacheConfig) configByHashCacheKey(contentHash string) string {
 func (cc *cacheConfig) GetConfigSetLocation(ctx context.Context, configSet string) (*url.URL, error) {
 	v := ""
 	key := cc.cacheKey("configSet", "location", configSet)
-	switch err := cc.retrieve(ctx, key, &v); err {
-	case nil:
-		// Cache hit.
-		u, err := url.Parse(v)
+	err := cc.cached(ctx, "configSet", key, &v, func(ctx context.Context) (interface{}, error) {
+		u, err := cc.inner.GetConfigSetLocation(ctx, configSet)
 		if err != nil {
-			return u, nil
+			return nil, err
 		}
-
-	case errCacheMiss:
-		// Cache miss, load from service.
-		break
-	default:
-		// Return cached error.
-		return nil, err
-	}
-
-	u, err := cc.inner.GetConfigSetLocation(ctx, configSet)
+		return u.String(), nil
+	})
 	if err != nil {
-		cc.storeErr(ctx, key, err)
 		return nil, err
 	}
-
-	cc.store(ctx, key, u.String())
-	return u, nil
+	return url.Parse(v)
 }
 
 func (cc *cacheConfig) GetProjectConfigs(ctx context.Context, path string, hashesOnly bool) ([]config.Config, error) {
 	var c []config.Config
 	key := cc.cacheKey("projectConfigs", "full", path)
-	switch err := cc.retrieve(ctx, key, &c); err {
-	case nil:
-		// Cache hit.
-		return c, nil
-	case errCacheMiss:
-		// Cache miss, load from service.
-		break
-	default:
-		// Return cached error.
-		return nil, err
+	if res, _, err := cc.retrieve(ctx, key, &c); res == resultHit {
+		cc.record(ctx, "projectConfigs", res, 0)
+		return c, err
 	}
 
 	if hashesOnly {
 		key = cc.cacheKey("projectConfigs", "hashesOnly", path)
 	}
 
-	c, err := cc.inner.GetProjectConfigs(ctx, path, hashesOnly)
-	if err != nil {
-		cc.storeErr(ctx, key, err)
-		return nil, err
-	}
-
-	cc.store(ctx, key, c)
-	return c, nil
+	err := cc.cached(ctx, "projectConfigs", key, &c, func(ctx context.Context) (interface{}, error) {
+		return cc.inner.GetProjectConfigs(ctx, path, hashesOnly)
+	})
+	return c, err
 }
 
 func (cc *cacheConfig) GetProjects(ctx context.Context) ([]config.Project, error) {
 	p := []config.Project(nil)
-	key := cc.cacheKey("projects")
-	switch err := cc.retrieve(ctx, key, &p); err {
-	case nil:
-		// Cache hit.
-		return p, nil
-	case errCacheMiss:
-		// Cache miss, load from service.
-		break
-	default:
-		// Return cached error.
-		return nil, err
-	}
-
-	p, err := cc.inner.GetProjects(ctx)
-	if err != nil {
-		cc.storeErr(ctx, key, err)
-		return nil, err
-	}
-
-	cc.store(ctx, key, p)
-	return p, nil
+	err := cc.cached(ctx, "projects", cc.cacheKey("projects"), &p, func(ctx context.Context) (interface{}, error) {
+		return cc.inner.GetProjects(ctx)
+	})
+	return p, err
 }
 
 func (cc *cacheConfig) GetRefConfigs(ctx context.Context, path string, hashesOnly bool) ([]config.Config, error) {
 	c := []config.Config(nil)
 	key := cc.cacheKey("refConfigs", "full", path)
-	switch err := cc.retrieve(ctx, key, &c); err {
-	case nil:
-		// Cache hit.
-		return c, nil
-	case errCacheMiss:
-		// Cache miss, load from service.
-		break
-	default:
-		// Return cached error.
-		return nil, err
+	if res, _, err := cc.retrieve(ctx, key, &c); res == resultHit {
+		cc.record(ctx, "refConfigs", res, 0)
+		return c, err
 	}
 
 	if hashesOnly {
 		key = cc.cacheKey("refConfigs", "hashesOnly", path)
 	}
 
-	c, err := cc.inner.GetRefConfigs(ctx, path, hashesOnly)
-	if err != nil {
-		cc.storeErr(ctx, key, err)
-		return nil, err
-	}
-
-	cc.store(ctx, key, c)
-	return c, nil
+	err := cc.cached(ctx, "refConfigs", key, &c, func(ctx context.Context) (interface{}, error) {
+		return cc.inner.GetRefConfigs(ctx, path, hashesOnly)
+	})
+	return c, err
 }
 
 func (cc *cacheConfig) GetRefs(ctx context.Context, projectID string) ([]string, error) {
 	var refs []string
-	key := cc.cacheKey("refs", projectID)
-	switch err := cc.retrieve(ctx, key, &refs); err {
-	case nil:
-		// Cache hit.
-		return refs, nil
-	case errCacheMiss:
-		// Cache miss, load from service.
-		break
+	err := cc.cached(ctx, "refs", cc.cacheKey("refs", projectID), &refs, func(ctx context.Context) (interface{}, error) {
+		return cc.inner.GetRefs(ctx, projectID)
+	})
+	return refs, err
+}
+
+// fetchFunc loads a value from the inner Interface.
+type fetchFunc func(ctx context.Context) (interface{}, error)
+
+// cached loads the value stored under key into v, fetching it when it is not
+// in the cache or is stale.
+//
+// v must be a pointer to a value of the type fetch returns. Returns
+// config.ErrNoConfig if the config is missing (it is cached too), and fetch
+// errors unless there's a stale value to fall back to.
+func (cc *cacheConfig) cached(ctx context.Context, kind, key string, v interface{}, fetch fetchFunc) error {
+	res, age, cachedErr := cc.retrieve(ctx, key, v)
+	switch {
+	case res == resultHit:
+		cc.record(ctx, kind, res, 0)
+		return cachedErr
+
+	case res == resultStale && cc.opts.RefreshInBackground:
+		cc.record(ctx, kind, res, age)
+		cc.refreshInBackground(ctx, key, fetch)
+		return cachedErr
+	}
+
+	val, err := cc.fetchAndStore(ctx, key, fetch)
+	switch {
+	case err == nil:
+		cc.record(ctx, kind, resultMiss, 0)
+		reflect.ValueOf(v).Elem().Set(reflect.ValueOf(val))
+		return nil
+
+	case err == config.ErrNoConfig:
+		cc.record(ctx, kind, resultMiss, 0)
+		return err
+
+	case res == resultStale:
+		// The stale value is still in v.
+		logging.Fields{
+			logging.ErrorKey: err,
+			"key":            key,
+			"age":            age,
+		}.Warningf(ctx, "Failed to refresh config, using the stale one.")
+		cc.record(ctx, kind, resultStaleOnError, age)
+		return cachedErr
+
 	default:
-		// Return cached error.
-		return nil, err
+		cc.record(ctx, kind, resultError, 0)
+		return err
 	}
+}
 
-	refs, err := cc.inner.GetRefs(ctx, projectID)
+// fetchAndStore calls fetch and caches its result.
+func (cc *cacheConfig) fetchAndStore(ctx context.Context, key string, fetch fetchFunc) (interface{}, error) {
+	val, err := fetch(ctx)
 	if err != nil {
 		cc.storeErr(ctx, key, err)
 		return nil, err
 	}
+	cc.store(ctx, key, val)
+	return val, nil
+}
 
-	cc.store(ctx, key, refs)
-	return refs, nil
+// refreshInBackground launches a goroutine that refreshes the cached value,
+// unless one is already running for the key.
+func (cc *cacheConfig) refreshInBackground(ctx context.Context, key string, fetch fetchFunc) {
+	cc.refreshingLock.Lock()
+	defer cc.refreshingLock.Unlock()
+	if _, ok := cc.refreshing[key]; ok {
+		return
+	}
+	cc.refreshing[key] = struct{}{}
+
+	go func() {
+		defer func() {
+			cc.refreshingLock.Lock()
+			delete(cc.refreshing, key)
+			cc.refreshingLock.Unlock()
+		}()
+		ctx, cancel := clock.WithTimeout(withoutCancel{ctx}, backgroundRefreshTimeout)
+		defer cancel()
+		if _, err := cc.fetchAndStore(ctx, key, fetch); err != nil && err != config.ErrNoConfig {
+			logging.Fields{
+				logging.ErrorKey: err,
+				"key":            key,
+			}.Warningf(ctx, "Failed to refresh config in background.")
+		}
+	}()
 }
 
-func (cc *cacheConfig) retrieve(ctx context.Context, key string, v interface{}) error {
+// withoutCancel is a context that is never canceled and has no deadline, but
+// otherwise looks like the wrapped one.
+//
+// The caller of a config method may cancel its context as soon as the method
+// returns, and the background refresh must survive that.
+type withoutCancel struct {
+	context.Context
+}
+
+func (withoutCancel) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (withoutCancel) Done() <-chan struct{}       { return nil }
+func (withoutCancel) Err() error                  { return nil }
+
+// lifetime is how long cache entries must be kept.
+func (cc *cacheConfig) lifetime() time.Duration {
+	if cc.opts.Expiration <= 0 {
+		return 0
+	}
+	return cc.opts.Expiration + cc.opts.MaxStaleness
+}
+
+// retrieve loads the value stored under key into v.
+//
+// For resultHit and resultStale also returns the age of the entry and the
+// error it holds, if it is an error entry.
+func (cc *cacheConfig) retrieve(ctx context.Context, key string, v interface{}) (lookupResult, time.Duration, error) {
 	if cc.opts.Cache == nil {
-		return errCacheMiss
+		return resultMiss, 0, nil
 	}
 
 	// Load the cache value.
-	d := cc.opts.Cache.Retrieve(ctx, key)
-	if len(d) == 0 {
-		return errCacheMiss
+	e, ok := decodeEntry(cc.opts.Cache.Retrieve(ctx, key))
+	if !ok {
+		return resultMiss, 0, nil
+	}
+
+	// Check its freshness.
+	res := resultHit
+	age := clock.Now(ctx).Sub(e.stored)
+	if exp := cc.opts.Expiration; exp > 0 && age >= exp {
+		if age >= cc.lifetime() {
+			return resultMiss, 0, nil
+		}
+		res = resultStale
 	}
 
 	// Handle the content key.
-	switch contentKey(d[0]) {
+	switch e.content {
 	case contentHit:
-		d = d[1:]
 		break
 
 	case contentErrNoConfig:
-		return config.ErrNoConfig
+		return res, age, config.ErrNoConfig
 
 	default:
 		// Unknown content key, treat as cache miss.
-		return errCacheMiss
+		return resultMiss, 0, nil
 	}
 
 	// Unzip.
-	zr, err := zlib.NewReader(bytes.NewBuffer(d))
+	zr, err := zlib.NewReader(bytes.NewBuffer(e.data))
 	if err != nil {
-		return errCacheMiss
+		return resultMiss, 0, nil
 	}
 	defer zr.Close()
 
 	rd, err := ioutil.ReadAll(zr)
 	if err != nil {
-		return errCacheMiss
+		return resultMiss, 0, nil
 	}
 
 	// Unpack.
 	if err := json.Unmarshal(rd, v); err != nil {
-		return errCacheMiss
+		return resultMiss, 0, nil
 	}
 
-	return nil
+	return res, age, nil
 }
 
 func (cc *cacheConfig) store(ctx context.Context, key string, v interface{}) {
@@ -345,7 +397,6 @@ func (cc *cacheConfig) store(ctx context.Context, key string, v interface{}) {
 
 	// Write a "content hit" record.
 	buf := bytes.Buffer{}
-	buf.WriteByte(byte(contentHit))
 	w := zlib.NewWriter(&buf)
 	_, err = w.Write(d)
 	if err != nil {
@@ -356,7 +407,7 @@ func (cc *cacheConfig) store(ctx context.Context, key string, v interface{}) {
 		return
 	}
 
-	cc.opts.Cache.Store(ctx, key, cc.opts.Expiration, buf.Bytes())
+	cc.storeEntry(ctx, key, contentHit, buf.Bytes())
 }
 
 func (cc *cacheConfig) storeErr(ctx context.Context, key string, err error) {
@@ -366,7 +417,7 @@ func (cc *cacheConfig) storeErr(ctx context.Context, key string, err error) {
 
 	switch err {
 	case config.ErrNoConfig:
-		cc.opts.Cache.Store(ctx, key, cc.opts.Expiration, []byte{byte(contentErrNoConfig)})
+		cc.storeEntry(ctx, key, contentErrNoConfig, nil)
 
 	default:
 		// Don't know how to store this error type.
@@ -374,13 +425,67 @@ func (cc *cacheConfig) storeErr(ctx context.Context, key string, err error) {
 	}
 }
 
+func (cc *cacheConfig) storeEntry(ctx context.Context, key string, content contentKey, data []byte) {
+	e := entry{
+		content:  content,
+		stored:   clock.Now(ctx),
+		lifetime: cc.lifetime(),
+		data:     data,
+	}
+	cc.opts.Cache.Store(ctx, key, e.lifetime, e.encode())
+}
+
+// entry is a value stored in the Cache.
+type entry struct {
+	content  contentKey
+	stored   time.Time     // when the entry was stored
+	lifetime time.Duration // how long to keep it, 0 for forever
+	data     []byte        // zlib-compressed JSON for contentHit
+}
+
+// encode serializes the entry as
+// [content key][varint stored, unix s][varint stored, ns][varint lifetime, ns][data].
+func (e *entry) encode() []byte {
+	const hdrSize = 1 + 3*binary.MaxVarintLen64
+	buf := make([]byte, hdrSize, hdrSize+len(e.data))
+	buf[0] = byte(e.content)
+	n := 1
+	n += binary.PutVarint(buf[n:], e.stored.Unix())
+	n += binary.PutVarint(buf[n:], int64(e.stored.Nanosecond()))
+	n += binary.PutVarint(buf[n:], int64(e.lifetime))
+	return append(buf[:n], e.data...)
+}
+
+// decodeEntry is the reverse of entry.encode.
+func decodeEntry(d []byte) (entry, bool) {
+	if len(d) == 0 {
+		return entry{}, false
+	}
+	e := entry{content: contentKey(d[0])}
+	d = d[1:]
+
+	var hdr [3]int64
+	for i := range hdr {
+		v, n := binary.Varint(d)
+		if n <= 0 {
+			return entry{}, false
+		}
+		hdr[i], d = v, d[n:]
+	}
+
+	e.stored = time.Unix(hdr[0], hdr[1]).UTC()
+	e.lifetime = time.Duration(hdr[2])
+	e.data = d
+	return e, true
+}
+
 // cacheKey constructs a cache key from a set of value segments.
 //
 // In order to ensure that segments remain distinct in the resulting key, each
 // segment is URL query escaped, and segments are joined by the non-escaped
 // character, "|".
 //
-//   For example, ["a|b", "c"] => "a%7Cb|c"
+//	For example, ["a|b", "c"] => "a%7Cb|c"
 func (cc *cacheConfig) cacheKey(values ...string) string {
 	enc := url.QueryEscape
 	parts := make([]string, 0, len(values)+1)
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/luci/luci-go/common/clock/testclock"
 	"github.com/luci/luci-go/common/config"
 	"github.com/luci/luci-go/common/config/impl/memory"
 	"golang.org/x/net/context"
@@ -49,6 +50,11 @@ func (tc *testCache) nothingCached() bool {
 type forceErrConfig struct {
 	inner config.Interface
 	err   error
+
+	// ctxC, if not nil, receives contexts of GetConfig calls.
+	ctxC chan context.Context
+	// resumeC, if not nil, blocks GetConfig calls until it is closed.
+	resumeC chan struct{}
 }
 
 func (tc *forceErrConfig) ServiceURL(ctx context.Context) url.URL {
@@ -56,6 +62,12 @@ func (tc *forceErrConfig) ServiceURL(ctx context.Context) url.URL {
 }
 
 func (tc *forceErrConfig) GetConfig(ctx context.Context, configSet, path string, hashOnly bool) (*config.Config, error) {
+	if tc.ctxC != nil {
+		tc.ctxC <- ctx
+	}
+	if tc.resumeC != nil {
+		<-tc.resumeC
+	}
 	if tc.err != nil {
 		return nil, tc.err
 	}
@@ -386,3 +398,103 @@ func TestConfig(t *testing.T) {
 		})
 	})
 }
+
+func TestStaleness(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A cache with MaxStaleness`, t, func() {
+		c, clk := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+		mbase := map[string]memory.ConfigSet{
+			"services/foo": {
+				"file": "body",
+			},
+		}
+		errCfg := &forceErrConfig{inner: memory.New(mbase)}
+
+		opts := Options{
+			Cache:        NewLRUCache(100),
+			Expiration:   time.Minute,
+			MaxStaleness: time.Hour,
+		}
+		cfg := Wrap(errCfg, opts)
+
+		get := func() string {
+			v, err := cfg.GetConfig(c, "services/foo", "file", false)
+			So(err, ShouldBeNil)
+			return v.Content
+		}
+
+		So(get(), ShouldEqual, "body")
+		mbase["services/foo"]["file"] = "body2"
+
+		Convey(`Fresh values are used.`, func() {
+			clk.Add(30 * time.Second)
+			So(get(), ShouldEqual, "body")
+		})
+
+		Convey(`Stale values are refreshed.`, func() {
+			clk.Add(2 * time.Minute)
+			So(get(), ShouldEqual, "body2")
+		})
+
+		Convey(`Stale values are used if refresh fails.`, func() {
+			clk.Add(2 * time.Minute)
+			errCfg.err = errors.New("service is down")
+			So(get(), ShouldEqual, "body")
+
+			Convey(`Until they are too old.`, func() {
+				clk.Add(time.Hour)
+				_, err := cfg.GetConfig(c, "services/foo", "file", false)
+				So(err, ShouldErrLike, "service is down")
+			})
+		})
+
+		Convey(`Missing configs are stale too.`, func() {
+			_, err := cfg.GetConfig(c, "services/foo", "missing", false)
+			So(err, ShouldEqual, config.ErrNoConfig)
+
+			clk.Add(2 * time.Minute)
+			errCfg.err = errors.New("service is down")
+			_, err = cfg.GetConfig(c, "services/foo", "missing", false)
+			So(err, ShouldEqual, config.ErrNoConfig)
+		})
+
+		Convey(`Stale values are refreshed in background.`, func() {
+			opts.RefreshInBackground = true
+			cfg = Wrap(errCfg, opts)
+
+			clk.Add(2 * time.Minute)
+			So(get(), ShouldEqual, "body")
+
+			// Wait for the refresh to land.
+			v := ""
+			for i := 0; i < 1000 && v != "body2"; i++ {
+				time.Sleep(time.Millisecond)
+				v = get()
+			}
+			So(v, ShouldEqual, "body2")
+		})
+
+		Convey(`Background refresh outlives the caller's context.`, func() {
+			opts.RefreshInBackground = true
+			cfg = Wrap(errCfg, opts)
+			errCfg.ctxC = make(chan context.Context, 1)
+			errCfg.resumeC = make(chan struct{})
+			defer close(errCfg.resumeC)
+
+			clk.Add(2 * time.Minute)
+			cctx, cancel := context.WithCancel(c)
+			v, err := cfg.GetConfig(cctx, "services/foo", "file", false)
+			cancel()
+			So(err, ShouldBeNil)
+			So(v.Content, ShouldEqual, "body")
+
+			// The refresh has its own deadline.
+			rctx := <-errCfg.ctxC
+			So(rctx.Err(), ShouldBeNil)
+			deadline, ok := rctx.Deadline()
+			So(ok, ShouldBeTrue)
+			So(deadline, ShouldResemble, clk.Now().Add(backgroundRefreshTimeout))
+		})
+	})
+}
@@ -4,4 +4,11 @@
 
 // Package caching implements a config.Interface that uses a caching layer to
 // store its configuration values.
+//
+// The caching layer can be made of several tiers (see Tiered), e.g. a process
+// LRU (see NewLRUCache) in front of a shared cache. With Options.MaxStaleness
+// set, cached configs outlive their expiration and are served while the
+// config service is unavailable.
+//
+// Cache hits, misses and staleness are reported as tsmon metrics.
 package caching
@@ -0,0 +1,60 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package caching
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/tsmon/distribution"
+	"github.com/luci/luci-go/common/tsmon/field"
+	"github.com/luci/luci-go/common/tsmon/metric"
+	"github.com/luci/luci-go/common/tsmon/types"
+)
+
+// lookupResult is an outcome of a cache lookup.
+type lookupResult string
+
+const (
+	// resultHit is a fresh cached value.
+	resultHit lookupResult = "hit"
+	// resultMiss means the value was fetched.
+	resultMiss lookupResult = "miss"
+	// resultStale is a stale cached value, refreshed in background.
+	resultStale lookupResult = "stale"
+	// resultStaleOnError is a stale cached value used because the refresh
+	// failed.
+	resultStaleOnError lookupResult = "stale_on_error"
+	// resultError means nothing was cached and the fetch failed.
+	resultError lookupResult = "error"
+)
+
+var (
+	// tsLookups counts cache lookups.
+	//
+	// The "kind" field is the kind of data looked up (e.g. "configs",
+	// "projects"), and "result" is a lookupResult.
+	tsLookups = metric.NewCounter("luci/config/cache/lookups",
+		"Number of config cache lookups, by result.",
+		types.MetricMetadata{},
+		field.String("kind"),
+		field.String("result"))
+
+	// tsStaleness tracks the age of stale values returned from the cache.
+	tsStaleness = metric.NewCumulativeDistribution("luci/config/cache/staleness",
+		"Age of stale cached configs returned to callers.",
+		types.MetricMetadata{Units: types.Milliseconds},
+		distribution.DefaultBucketer,
+		field.String("kind"))
+)
+
+// record updates metrics after a cache lookup.
+func (cc *cacheConfig) record(ctx context.Context, kind string, res lookupResult, age time.Duration) {
+	tsLookups.Add(ctx, 1, kind, string(res))
+	if res == resultStale || res == resultStaleOnError {
+		tsStaleness.Add(ctx, float64(age/time.Millisecond), kind)
+	}
+}
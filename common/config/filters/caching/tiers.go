@@ -0,0 +1,95 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package caching
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/data/caching/lru"
+)
+
+// Tiered is a Cache made of several caches, ordered from the fastest (e.g. an
+// in-process LRU) to the slowest (e.g. a datastore).
+//
+// Store writes to all tiers. Retrieve returns the value from the first tier
+// that has it, and copies it to the faster tiers for the rest of its lifetime.
+//
+// It only works with values stored by the caching layer returned by Wrap.
+type Tiered []Cache
+
+// Store implements Cache.
+func (t Tiered) Store(c context.Context, key string, expire time.Duration, value []byte) {
+	for _, tier := range t {
+		tier.Store(c, key, expire, value)
+	}
+}
+
+// Retrieve implements Cache.
+func (t Tiered) Retrieve(c context.Context, key string) []byte {
+	for i, tier := range t {
+		value := tier.Retrieve(c, key)
+		if value == nil {
+			continue
+		}
+		if i > 0 {
+			if e, ok := decodeEntry(value); ok {
+				expire := time.Duration(0) // forever
+				if e.lifetime > 0 {
+					expire = e.stored.Add(e.lifetime).Sub(clock.Now(c))
+				}
+				if e.lifetime == 0 || expire > 0 {
+					for _, faster := range t[:i] {
+						faster.Store(c, key, expire, value)
+					}
+				}
+			}
+		}
+		return value
+	}
+	return nil
+}
+
+// lruCache is an in-process Cache.
+type lruCache struct {
+	cache *lru.Cache
+}
+
+type lruEntry struct {
+	value  []byte
+	expiry time.Time // zero if never expires
+}
+
+// NewLRUCache returns an in-process Cache that holds at most size values,
+// evicting least recently used ones.
+func NewLRUCache(size int) Cache {
+	return &lruCache{cache: lru.New(size)}
+}
+
+func (lc *lruCache) Store(c context.Context, key string, expire time.Duration, value []byte) {
+	if value == nil {
+		lc.cache.Remove(key)
+		return
+	}
+	e := lruEntry{value: value}
+	if expire > 0 {
+		e.expiry = clock.Now(c).Add(expire)
+	}
+	lc.cache.Put(key, &e)
+}
+
+func (lc *lruCache) Retrieve(c context.Context, key string) []byte {
+	e, _ := lc.cache.Get(key).(*lruEntry)
+	if e == nil {
+		return nil
+	}
+	if !e.expiry.IsZero() && !clock.Now(c).Before(e.expiry) {
+		lc.cache.Remove(key)
+		return nil
+	}
+	return e.value
+}
@@ -0,0 +1,94 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package caching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/clock/testclock"
+	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLRUCache(t *testing.T) {
+	t.Parallel()
+
+	Convey(`An LRU cache`, t, func() {
+		c, clk := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+		lc := NewLRUCache(2)
+
+		Convey(`Stores values until they expire.`, func() {
+			lc.Store(c, "a", time.Second, []byte("a"))
+			lc.Store(c, "b", 0, []byte("b"))
+			So(lc.Retrieve(c, "a"), ShouldResemble, []byte("a"))
+
+			clk.Add(time.Hour)
+			So(lc.Retrieve(c, "a"), ShouldBeNil)
+			So(lc.Retrieve(c, "b"), ShouldResemble, []byte("b"))
+		})
+
+		Convey(`Evicts least recently used values.`, func() {
+			lc.Store(c, "a", 0, []byte("a"))
+			lc.Store(c, "b", 0, []byte("b"))
+			lc.Retrieve(c, "a")
+			lc.Store(c, "c", 0, []byte("c"))
+			So(lc.Retrieve(c, "a"), ShouldResemble, []byte("a"))
+			So(lc.Retrieve(c, "b"), ShouldBeNil)
+		})
+
+		Convey(`Invalidates values.`, func() {
+			lc.Store(c, "a", 0, []byte("a"))
+			lc.Store(c, "a", 0, nil)
+			So(lc.Retrieve(c, "a"), ShouldBeNil)
+		})
+	})
+}
+
+func TestTiered(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A tiered cache`, t, func() {
+		c, clk := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+		fast, slow := NewLRUCache(10), &testCache{}
+		tc := Tiered{fast, slow}
+
+		e := entry{
+			content:  contentHit,
+			stored:   clk.Now(),
+			lifetime: time.Minute,
+			data:     []byte("data"),
+		}
+		value := e.encode()
+
+		Convey(`Stores in all tiers.`, func() {
+			tc.Store(c, "key", time.Minute, value)
+			So(fast.Retrieve(c, "key"), ShouldResemble, value)
+			So(slow.Retrieve(c, "key"), ShouldResemble, value)
+		})
+
+		Convey(`Copies values to faster tiers for the rest of their lifetime.`, func() {
+			slow.Store(c, "key", time.Minute, value)
+			clk.Add(40 * time.Second)
+			So(tc.Retrieve(c, "key"), ShouldResemble, value)
+			So(fast.Retrieve(c, "key"), ShouldResemble, value)
+
+			clk.Add(30 * time.Second)
+			So(fast.Retrieve(c, "key"), ShouldBeNil)
+		})
+
+		Convey(`Doesn't copy expired values.`, func() {
+			slow.Store(c, "key", time.Minute, value)
+			clk.Add(2 * time.Minute)
+			So(tc.Retrieve(c, "key"), ShouldResemble, value)
+			So(fast.Retrieve(c, "key"), ShouldBeNil)
+		})
+
+		Convey(`Misses.`, func() {
+			So(tc.Retrieve(c, "key"), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,456 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package gitrepo implements a config client backend that reads configs from
+// a local git repository.
+//
+// It is useful to run services against real config history in tests and in
+// environments where the luci-config service is not available.
+//
+// Layout
+//
+// The tree at the configured ref has the same layout as a filesystem config
+// folder (see the filesystem package), except for refs:
+//   - ./services/<servicename>/...
+//   - ./projects/<projectname>.json
+//   - ./projects/<projectname>/...
+//
+// Project ref config sets are read from branches of the repository: the config
+// set "projects/<projectname>/refs/heads/<branch>" is the
+// ./projects/<projectname>/ directory of the tree at refs/heads/<branch>. A
+// project has a ref for every branch that has its directory.
+//
+// Revision is the SHA1 of the commit the config was read from. ContentHash is
+// "v1:" followed by the SHA1 of the git blob, which is also how luci-config
+// hashes configs.
+//
+// Quirks
+//
+// The repository is accessed by running the git binary, which must be in
+// PATH. Nothing is cached: every call resolves the ref again, so new commits
+// are picked up right away.
+package gitrepo
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/config"
+	"github.com/luci/luci-go/common/errors"
+)
+
+// RepoType is the RepoType of projects read from a git repository.
+const RepoType config.RepoType = "GIT"
+
+// ProjectConfiguration is the struct that will be used to read the
+// `projectname.json` config file, if any is specified for a given project.
+type ProjectConfiguration struct {
+	Name string
+	URL  string
+}
+
+type gitRepoImpl struct {
+	repoPath string
+	ref      string
+}
+
+// New returns an implementation of the config service which reads configuration
+// from the git repository at `repoPath` (a working tree or a bare clone).
+//
+// Service and project configs are read from `ref`, which may be anything that
+// git can resolve to a commit, e.g. "refs/heads/master" or "HEAD". If `ref` is
+// empty, "HEAD" is used.
+//
+// Returns an error if `ref` can't be resolved.
+func New(repoPath, ref string) (config.Interface, error) {
+	repoPath, err := filepath.Abs(repoPath)
+	if err != nil {
+		return nil, err
+	}
+	if ref == "" {
+		ref = "HEAD"
+	}
+
+	ret := &gitRepoImpl{repoPath: repoPath, ref: ref}
+	if _, err := ret.resolve(ref); err != nil {
+		return nil, (errors.Reason("gitrepo.New(%(repoPath)q, %(ref)q): %(err)s").
+			D("repoPath", repoPath).D("ref", ref).D("err", err).Err())
+	}
+	return ret, nil
+}
+
+// git runs a git command in the repository and returns its stdout.
+func (g *gitRepoImpl) git(args ...string) ([]byte, error) {
+	cmd := exec.Command("git", append([]string{"-C", g.repoPath}, args...)...)
+	stderr := bytes.Buffer{}
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, (errors.Reason("git %(args)s failed - %(err)s: %(stderr)s").
+			D("args", strings.Join(args, " ")).D("err", err).
+			D("stderr", strings.TrimSpace(stderr.String())).Err())
+	}
+	return out, nil
+}
+
+// resolve returns SHA1 of the commit the ref points to.
+func (g *gitRepoImpl) resolve(ref string) (string, error) {
+	out, err := g.git("rev-parse", "--verify", "--quiet", ref+"^{commit}")
+	if err != nil {
+		return "", errors.Reason("unknown ref %(ref)q").D("ref", ref).Err()
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// treeEntry is a line of `git ls-tree` output.
+type treeEntry struct {
+	typ  string // "blob", "tree" or "commit"
+	hash string
+	path string
+}
+
+// lsTree lists the given paths in the tree of the commit.
+//
+// A path that ends with "/" lists the content of that directory. Missing paths
+// are silently skipped.
+func (g *gitRepoImpl) lsTree(commit string, paths ...string) ([]treeEntry, error) {
+	out, err := g.git(append([]string{"ls-tree", "-z", commit, "--"}, paths...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []treeEntry
+	for _, line := range strings.Split(string(out), "\x00") {
+		if line == "" {
+			continue
+		}
+		// <mode> SP <type> SP <object> TAB <file>
+		tab := strings.IndexByte(line, '\t')
+		if tab == -1 {
+			return nil, errors.Reason("bad ls-tree output %(line)q").D("line", line).Err()
+		}
+		meta := strings.Fields(line[:tab])
+		if len(meta) != 3 {
+			return nil, errors.Reason("bad ls-tree output %(line)q").D("line", line).Err()
+		}
+		ret = append(ret, treeEntry{typ: meta[1], hash: meta[2], path: line[tab+1:]})
+	}
+	return ret, nil
+}
+
+// readBlob returns the content of the blob.
+func (g *gitRepoImpl) readBlob(hash string) (string, error) {
+	out, err := g.git("cat-file", "blob", hash)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// configSetLocation says where in the repository the config set lives.
+type configSetLocation struct {
+	ref string // ref to read the config set from
+	dir string // directory in the tree with the config set
+}
+
+// locate parses a config set name.
+func (g *gitRepoImpl) locate(configSet string) (configSetLocation, error) {
+	toks := strings.Split(configSet, "/")
+	switch {
+	case len(toks) == 2 && toks[0] == "services" && toks[1] != "":
+		return configSetLocation{g.ref, configSet}, nil
+	case len(toks) == 2 && toks[0] == "projects" && toks[1] != "":
+		return configSetLocation{g.ref, configSet}, nil
+	case len(toks) > 3 && toks[0] == "projects" && toks[1] != "" && toks[2] == "refs":
+		return configSetLocation{strings.Join(toks[2:], "/"), strings.Join(toks[:2], "/")}, nil
+	}
+	return configSetLocation{}, errors.Reason("invalid config set %(configSet)q").D("configSet", configSet).Err()
+}
+
+// readConfigs reads the config at `path` in each of config sets that live in
+// the same commit.
+//
+// Config sets which don't have the config are skipped.
+func (g *gitRepoImpl) readConfigs(commit string, configSets map[string]string, path string, hashesOnly bool) (configList, error) {
+	paths := make([]string, 0, len(configSets))
+	byPath := make(map[string]string, len(configSets))
+	for cs, dir := range configSets {
+		p := dir + "/" + path
+		paths = append(paths, p)
+		byPath[p] = cs
+	}
+	sort.Strings(paths)
+
+	entries, err := g.lsTree(commit, paths...)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(configList, 0, len(entries))
+	for _, e := range entries {
+		cs, ok := byPath[e.path]
+		if !ok || e.typ != "blob" {
+			continue
+		}
+		cfg := config.Config{
+			ConfigSet:   cs,
+			Path:        path,
+			ContentHash: "v1:" + e.hash,
+			Revision:    commit,
+		}
+		if !hashesOnly {
+			if cfg.Content, err = g.readBlob(e.hash); err != nil {
+				return nil, err
+			}
+		}
+		ret = append(ret, cfg)
+	}
+	return ret, nil
+}
+
+// projectIDs returns IDs of all projects in the commit, sorted.
+func (g *gitRepoImpl) projectIDs(commit string) ([]string, error) {
+	projects, err := g.listProjects(commit)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]string, 0, len(projects))
+	for id := range projects {
+		ret = append(ret, id)
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+// listProjects returns all projects in the commit along with the hash of their
+// `projectname.json` blob, if any.
+func (g *gitRepoImpl) listProjects(commit string) (map[string]string, error) {
+	const jsonExt = ".json"
+
+	entries, err := g.lsTree(commit, "projects/")
+	if err != nil {
+		return nil, err
+	}
+
+	ret := map[string]string{}
+	for _, e := range entries {
+		name := strings.TrimPrefix(e.path, "projects/")
+		switch {
+		case e.typ == "tree":
+			if _, ok := ret[name]; !ok {
+				ret[name] = ""
+			}
+		case e.typ == "blob" && strings.HasSuffix(name, jsonExt) && len(name) > len(jsonExt):
+			ret[strings.TrimSuffix(name, jsonExt)] = e.hash
+		}
+	}
+	return ret, nil
+}
+
+func (g *gitRepoImpl) ServiceURL(ctx context.Context) url.URL {
+	return url.URL{
+		Scheme: "file",
+		Path:   filepath.ToSlash(g.repoPath),
+	}
+}
+
+func (g *gitRepoImpl) GetConfig(ctx context.Context, configSet, path string, hashOnly bool) (*config.Config, error) {
+	loc, err := g.locate(configSet)
+	if err != nil {
+		return nil, err
+	}
+	commit, err := g.resolve(loc.ref)
+	if err != nil {
+		// A ref config set of a branch that doesn't exist.
+		return nil, config.ErrNoConfig
+	}
+
+	cfgs, err := g.readConfigs(commit, map[string]string{configSet: loc.dir}, path, hashOnly)
+	switch {
+	case err != nil:
+		return nil, err
+	case len(cfgs) == 0:
+		return nil, config.ErrNoConfig
+	}
+	return &cfgs[0], nil
+}
+
+func (g *gitRepoImpl) GetConfigByHash(ctx context.Context, contentHash string) (string, error) {
+	hash := strings.TrimPrefix(contentHash, "v1:")
+	if hash == contentHash || len(hash) != 40 {
+		return "", config.ErrNoConfig
+	}
+	if _, err := hex.DecodeString(hash); err != nil {
+		return "", config.ErrNoConfig
+	}
+
+	// Fails if there's no such object.
+	out, err := g.git("cat-file", "-t", hash)
+	if err != nil || strings.TrimSpace(string(out)) != "blob" {
+		return "", config.ErrNoConfig
+	}
+	return g.readBlob(hash)
+}
+
+func (g *gitRepoImpl) GetConfigSetLocation(ctx context.Context, configSet string) (*url.URL, error) {
+	if _, err := g.locate(configSet); err != nil {
+		return nil, err
+	}
+	return &url.URL{
+		Scheme: "file",
+		Path:   filepath.ToSlash(g.repoPath) + "/" + configSet,
+	}, nil
+}
+
+func (g *gitRepoImpl) GetProjectConfigs(ctx context.Context, path string, hashesOnly bool) ([]config.Config, error) {
+	commit, err := g.resolve(g.ref)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := g.projectIDs(commit)
+	if err != nil {
+		return nil, err
+	}
+
+	configSets := make(map[string]string, len(ids))
+	for _, id := range ids {
+		configSets["projects/"+id] = "projects/" + id
+	}
+	ret, err := g.readConfigs(commit, configSets, path, hashesOnly)
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(ret)
+	return ret, nil
+}
+
+func (g *gitRepoImpl) GetProjects(ctx context.Context) ([]config.Project, error) {
+	commit, err := g.resolve(g.ref)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := g.listProjects(commit)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(projList, 0, len(projects))
+	for id, jsonHash := range projects {
+		proj := config.Project{
+			ID:       id,
+			Name:     id,
+			RepoType: RepoType,
+		}
+		if jsonHash != "" {
+			data, err := g.readBlob(jsonHash)
+			if err != nil {
+				return nil, err
+			}
+			pc := ProjectConfiguration{}
+			if err := json.Unmarshal([]byte(data), &pc); err != nil {
+				return nil, (errors.Reason("bad projects/%(id)s.json: %(err)s").
+					D("id", id).D("err", err).Err())
+			}
+			if pc.Name != "" {
+				proj.Name = pc.Name
+			}
+			if pc.URL != "" {
+				if proj.RepoURL, err = url.ParseRequestURI(pc.URL); err != nil {
+					return nil, (errors.Reason("bad URL in projects/%(id)s.json: %(err)s").
+						D("id", id).D("err", err).Err())
+				}
+			}
+		}
+		ret = append(ret, proj)
+	}
+	sort.Sort(ret)
+	return ret, nil
+}
+
+func (g *gitRepoImpl) GetRefConfigs(ctx context.Context, path string, hashesOnly bool) ([]config.Config, error) {
+	commit, err := g.resolve(g.ref)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := g.projectIDs(commit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Group config sets by the branch they are read from, to read each branch
+	// once.
+	branches, err := g.branches()
+	if err != nil {
+		return nil, err
+	}
+	ret := configList{}
+	for _, b := range branches {
+		configSets := make(map[string]string, len(ids))
+		for _, id := range ids {
+			configSets["projects/"+id+"/"+b.ref] = "projects/" + id
+		}
+		cfgs, err := g.readConfigs(b.commit, configSets, path, hashesOnly)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, cfgs...)
+	}
+	sort.Sort(ret)
+	return ret, nil
+}
+
+func (g *gitRepoImpl) GetRefs(ctx context.Context, projectID string) ([]string, error) {
+	branches, err := g.branches()
+	if err != nil {
+		return nil, err
+	}
+
+	dir := "projects/" + projectID
+	ret := []string{}
+	for _, b := range branches {
+		entries, err := g.lsTree(b.commit, dir)
+		if err != nil {
+			return nil, err
+		}
+		if len(entries) == 1 && entries[0].typ == "tree" {
+			ret = append(ret, b.ref)
+		}
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+type branch struct {
+	ref    string // e.g. "refs/heads/master"
+	commit string
+}
+
+// branches returns all branches of the repository.
+func (g *gitRepoImpl) branches() ([]branch, error) {
+	out, err := g.git("for-each-ref", "--format=%(objectname) %(refname)", "refs/heads/")
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []branch
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if line == "" {
+			continue
+		}
+		toks := strings.SplitN(line, " ", 2)
+		if len(toks) != 2 {
+			return nil, errors.Reason("bad for-each-ref output %(line)q").D("line", line).Err()
+		}
+		ret = append(ret, branch{ref: toks[1], commit: toks[0]})
+	}
+	return ret, nil
+}
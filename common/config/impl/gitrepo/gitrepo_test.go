@@ -0,0 +1,258 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package gitrepo
+
+import (
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/config"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// testRepo is a git repository in a temp directory.
+type testRepo string
+
+func (r testRepo) git(args ...string) string {
+	args = append([]string{
+		"-C", string(r),
+		"-c", "user.name=test", "-c", "user.email=test@example.com",
+	}, args...)
+	out, err := exec.Command("git", args...).CombinedOutput()
+	if err != nil {
+		panic(string(out))
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// commit replaces the working tree with the files and commits it to the
+// current branch, returning the commit hash.
+func (r testRepo) commit(files map[string]string) string {
+	r.git("rm", "-rfq", "--ignore-unmatch", ".")
+	for fpath, content := range files {
+		if content == "" {
+			content = fpath
+		}
+		fpath = filepath.Join(string(r), filepath.FromSlash(fpath))
+		if err := os.MkdirAll(filepath.Dir(fpath), 0777); err != nil {
+			panic(err)
+		}
+		if err := ioutil.WriteFile(fpath, []byte(content), 0666); err != nil {
+			panic(err)
+		}
+	}
+	r.git("add", "-A")
+	r.git("commit", "-q", "--allow-empty", "-m", "commit")
+	return r.git("rev-parse", "HEAD")
+}
+
+func withRepo(cb func(r testRepo)) {
+	folder, err := ioutil.TempDir("", "gitrepo_test_")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(folder)
+
+	r := testRepo(folder)
+	r.git("init", "-q")
+	r.git("checkout", "-q", "-b", "master")
+	cb(r)
+}
+
+func TestGitRepoImpl(t *testing.T) {
+	t.Parallel()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	ctx := context.Background()
+
+	Convey("Git repo config client", t, func() {
+		withRepo(func(r testRepo) {
+			r.commit(map[string]string{
+				"projects/foobar/something/file.cfg": "old",
+			})
+			master := r.commit(map[string]string{
+				"projects/doodly/something/file.cfg": "",
+				"projects/foobar/something/file.cfg": "",
+				"services/foosrv/something.cfg":      "",
+				"projects/foobar.json": `{
+					"Name": "A cool project",
+					"Url": "https://something.example.com"
+				}`,
+			})
+			r.git("checkout", "-q", "-b", "someref")
+			someref := r.commit(map[string]string{
+				"projects/foobar/something/file.cfg": "someref content",
+			})
+			r.git("checkout", "-q", "master")
+
+			client, err := New(string(r), "refs/heads/master")
+			So(err, ShouldBeNil)
+
+			Convey("New fails on unknown ref", func() {
+				_, err := New(string(r), "refs/heads/missing")
+				So(err, ShouldErrLike, "unknown ref")
+			})
+
+			Convey("GetConfig", func() {
+				expect := &config.Config{
+					ConfigSet:   "projects/foobar",
+					Path:        "something/file.cfg",
+					Content:     "projects/foobar/something/file.cfg",
+					ContentHash: "v1:" + r.git("rev-parse", master+":projects/foobar/something/file.cfg"),
+					Revision:    master,
+				}
+
+				Convey("All content", func() {
+					cfg, err := client.GetConfig(ctx, "projects/foobar", "something/file.cfg", false)
+					So(err, ShouldBeNil)
+					So(cfg, ShouldResemble, expect)
+				})
+
+				Convey("Hash only", func() {
+					expect.Content = ""
+					cfg, err := client.GetConfig(ctx, "projects/foobar", "something/file.cfg", true)
+					So(err, ShouldBeNil)
+					So(cfg, ShouldResemble, expect)
+				})
+
+				Convey("Ref config set", func() {
+					cfg, err := client.GetConfig(ctx, "projects/foobar/refs/heads/someref", "something/file.cfg", false)
+					So(err, ShouldBeNil)
+					So(cfg.Content, ShouldEqual, "someref content")
+					So(cfg.Revision, ShouldEqual, someref)
+				})
+
+				Convey("Missing", func() {
+					_, err := client.GetConfig(ctx, "projects/foobar", "nope.cfg", false)
+					So(err, ShouldEqual, config.ErrNoConfig)
+
+					_, err = client.GetConfig(ctx, "projects/foobar", "something", false)
+					So(err, ShouldEqual, config.ErrNoConfig)
+
+					_, err = client.GetConfig(ctx, "projects/foobar/refs/heads/missing", "something/file.cfg", false)
+					So(err, ShouldEqual, config.ErrNoConfig)
+				})
+
+				Convey("Bad config set", func() {
+					_, err := client.GetConfig(ctx, "wat/foobar", "something/file.cfg", false)
+					So(err, ShouldErrLike, "invalid config set")
+				})
+			})
+
+			Convey("GetConfigByHash", func() {
+				cfg, err := client.GetConfig(ctx, "services/foosrv", "something.cfg", true)
+				So(err, ShouldBeNil)
+
+				content, err := client.GetConfigByHash(ctx, cfg.ContentHash)
+				So(err, ShouldBeNil)
+				So(content, ShouldEqual, "services/foosrv/something.cfg")
+
+				Convey("Old revisions are reachable", func() {
+					old := r.git("rev-parse", "master~1:projects/foobar/something/file.cfg")
+					content, err := client.GetConfigByHash(ctx, "v1:"+old)
+					So(err, ShouldBeNil)
+					So(content, ShouldEqual, "old")
+				})
+
+				Convey("Not a blob", func() {
+					_, err := client.GetConfigByHash(ctx, "v1:"+master)
+					So(err, ShouldEqual, config.ErrNoConfig)
+				})
+
+				Convey("Malformed", func() {
+					_, err := client.GetConfigByHash(ctx, "v1:--help")
+					So(err, ShouldEqual, config.ErrNoConfig)
+					_, err = client.GetConfigByHash(ctx, strings.TrimPrefix(cfg.ContentHash, "v1:"))
+					So(err, ShouldEqual, config.ErrNoConfig)
+				})
+			})
+
+			Convey("GetConfigSetLocation", func() {
+				loc, err := client.GetConfigSetLocation(ctx, "projects/foobar")
+				So(err, ShouldBeNil)
+				So(loc.Scheme, ShouldEqual, "file")
+				So(loc.Path, ShouldEndWith, "/projects/foobar")
+			})
+
+			Convey("GetProjectConfigs", func() {
+				cfgs, err := client.GetProjectConfigs(ctx, "something/file.cfg", true)
+				So(err, ShouldBeNil)
+				So(len(cfgs), ShouldEqual, 2)
+				So(cfgs[0].ConfigSet, ShouldEqual, "projects/doodly")
+				So(cfgs[0].Content, ShouldEqual, "")
+				So(cfgs[1].ConfigSet, ShouldEqual, "projects/foobar")
+				So(cfgs[1].Revision, ShouldEqual, master)
+			})
+
+			Convey("GetProjects", func() {
+				projs, err := client.GetProjects(ctx)
+				So(err, ShouldBeNil)
+				So(projs, ShouldResemble, []config.Project{
+					{
+						ID:       "doodly",
+						Name:     "doodly",
+						RepoType: RepoType,
+					},
+					{
+						ID:       "foobar",
+						Name:     "A cool project",
+						RepoType: RepoType,
+						RepoURL:  &url.URL{Scheme: "https", Host: "something.example.com"},
+					},
+				})
+			})
+
+			Convey("GetRefConfigs", func() {
+				cfgs, err := client.GetRefConfigs(ctx, "something/file.cfg", false)
+				So(err, ShouldBeNil)
+				So(len(cfgs), ShouldEqual, 3)
+
+				sets := make([]string, len(cfgs))
+				for i, c := range cfgs {
+					sets[i] = c.ConfigSet
+				}
+				So(sets, ShouldResemble, []string{
+					"projects/doodly/refs/heads/master",
+					"projects/foobar/refs/heads/master",
+					"projects/foobar/refs/heads/someref",
+				})
+				So(cfgs[2].Content, ShouldEqual, "someref content")
+				So(cfgs[2].Revision, ShouldEqual, someref)
+			})
+
+			Convey("GetRefs", func() {
+				refs, err := client.GetRefs(ctx, "foobar")
+				So(err, ShouldBeNil)
+				So(refs, ShouldResemble, []string{"refs/heads/master", "refs/heads/someref"})
+
+				refs, err = client.GetRefs(ctx, "doodly")
+				So(err, ShouldBeNil)
+				So(refs, ShouldResemble, []string{"refs/heads/master"})
+			})
+
+			Convey("Picks up new commits", func() {
+				next := r.commit(map[string]string{
+					"projects/foobar/something/file.cfg": "new",
+				})
+				cfg, err := client.GetConfig(ctx, "projects/foobar", "something/file.cfg", false)
+				So(err, ShouldBeNil)
+				So(cfg.Content, ShouldEqual, "new")
+				So(cfg.Revision, ShouldEqual, next)
+			})
+		})
+	})
+}
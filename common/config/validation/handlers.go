@@ -0,0 +1,102 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/luci/luci-go/common/logging"
+	configPb "github.com/luci/luci-go/common/proto/config"
+	"github.com/luci/luci-go/server/router"
+)
+
+const (
+	// MetadataPath is where luci-config fetches the service metadata from.
+	MetadataPath = "/_ah/api/config/v1/metadata"
+
+	// ValidationPath is where luci-config sends configs to validate.
+	ValidationPath = "/_ah/api/config/v1/validate"
+
+	// metadataVersion is the ServiceDynamicMetadata format version.
+	metadataVersion = "1.0"
+)
+
+// InstallHandlers installs the luci-config metadata and validation endpoints
+// serving validators in the registry.
+//
+// The metadata endpoint tells luci-config which configs the service validates
+// and where. The validation endpoint accepts a ValidationRequestMessage and
+// replies with a ValidationResponseMessage, both JSON encoded.
+//
+// base is expected to authenticate the caller, if needed.
+func (r *Registry) InstallHandlers(rt *router.Router, base router.MiddlewareChain) {
+	rt.GET(MetadataPath, base, r.metadataHandler)
+	rt.POST(ValidationPath, base, r.validationHandler)
+}
+
+func (r *Registry) metadataHandler(c *router.Context) {
+	reply(c, http.StatusOK, &configPb.ServiceDynamicMetadata{
+		Version: proto.String(metadataVersion),
+		Validation: &configPb.Validator{
+			Patterns: r.Patterns(),
+			Url:      proto.String(fmt.Sprintf("https://%s%s", c.Request.Host, ValidationPath)),
+		},
+	})
+}
+
+func (r *Registry) validationHandler(c *router.Context) {
+	req := configPb.ValidationRequestMessage{}
+	if err := jsonpb.Unmarshal(c.Request.Body, &req); err != nil {
+		replyError(c, http.StatusBadRequest, fmt.Sprintf("Bad request body - %s", err))
+		return
+	}
+	switch {
+	case req.GetConfigSet() == "":
+		replyError(c, http.StatusBadRequest, "Must specify config_set")
+		return
+	case req.GetPath() == "":
+		replyError(c, http.StatusBadRequest, "Must specify path")
+		return
+	}
+	content, err := base64.StdEncoding.DecodeString(req.GetContent())
+	if err != nil {
+		replyError(c, http.StatusBadRequest, fmt.Sprintf("Content is not base64 - %s", err))
+		return
+	}
+
+	rep := r.Validate(c.Context, req.GetConfigSet(), req.GetPath(), content)
+	if err := rep.Err(); err != nil {
+		logging.Fields{
+			"configSet": req.GetConfigSet(),
+			"path":      req.GetPath(),
+		}.Infof(c.Context, "Config is invalid - %s", err)
+	}
+	reply(c, http.StatusOK, &configPb.ValidationResponseMessage{Messages: rep.Messages})
+}
+
+// reply sends a JSON encoded message.
+func reply(c *router.Context, code int, msg proto.Message) {
+	buf := bytes.Buffer{}
+	m := jsonpb.Marshaler{OrigName: true}
+	if err := m.Marshal(&buf, msg); err != nil {
+		replyError(c, http.StatusInternalServerError, fmt.Sprintf("Can't serialize the reply - %s", err))
+		return
+	}
+	c.Writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+	c.Writer.WriteHeader(code)
+	c.Writer.Write(buf.Bytes())
+}
+
+// replyError logs and sends an error message in plain text.
+func replyError(c *router.Context, code int, msg string) {
+	logging.Errorf(c.Context, "HTTP %d: %s", code, msg)
+	http.Error(c.Writer, msg, code)
+}
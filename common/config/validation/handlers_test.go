@@ -0,0 +1,103 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/server/router"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHandlers(t *testing.T) {
+	t.Parallel()
+
+	Convey("With handlers", t, func() {
+		reg := Registry{}
+		reg.Add("services/svc", "regex:.*\\.cfg", func(c context.Context, configSet, path string, content []byte, r *Report) {
+			if string(content) != "good" {
+				r.Errorf("bad content")
+			}
+		})
+
+		rt := router.New()
+		reg.InstallHandlers(rt, router.MiddlewareChain{})
+
+		call := func(method, path, body string) (int, map[string]interface{}) {
+			req, err := http.NewRequest(method, "https://example.com"+path, strings.NewReader(body))
+			So(err, ShouldBeNil)
+			rec := httptest.NewRecorder()
+			rt.ServeHTTP(rec, req)
+			if rec.Code != http.StatusOK {
+				return rec.Code, nil
+			}
+			out := map[string]interface{}{}
+			So(json.Unmarshal(rec.Body.Bytes(), &out), ShouldBeNil)
+			return rec.Code, out
+		}
+
+		Convey("Metadata", func() {
+			code, out := call("GET", MetadataPath, "")
+			So(code, ShouldEqual, http.StatusOK)
+			So(out, ShouldResemble, map[string]interface{}{
+				"version": "1.0",
+				"validation": map[string]interface{}{
+					"patterns": []interface{}{
+						map[string]interface{}{
+							"config_set": "text:services/svc",
+							"path":       "regex:.*\\.cfg",
+						},
+					},
+					"url": "https://example.com/_ah/api/config/v1/validate",
+				},
+			})
+		})
+
+		Convey("Validate good config", func() {
+			code, out := call("POST", ValidationPath, `{
+				"config_set": "services/svc",
+				"path": "a.cfg",
+				"content": "Z29vZA=="
+			}`)
+			So(code, ShouldEqual, http.StatusOK)
+			So(out, ShouldResemble, map[string]interface{}{})
+		})
+
+		Convey("Validate bad config", func() {
+			code, out := call("POST", ValidationPath, `{
+				"config_set": "services/svc",
+				"path": "a.cfg",
+				"content": "YmFk"
+			}`)
+			So(code, ShouldEqual, http.StatusOK)
+			So(out, ShouldResemble, map[string]interface{}{
+				"messages": []interface{}{
+					map[string]interface{}{
+						"text":     "bad content",
+						"severity": "ERROR",
+					},
+				},
+			})
+		})
+
+		Convey("Bad requests", func() {
+			code, _ := call("POST", ValidationPath, `not json`)
+			So(code, ShouldEqual, http.StatusBadRequest)
+
+			code, _ = call("POST", ValidationPath, `{"path": "a.cfg"}`)
+			So(code, ShouldEqual, http.StatusBadRequest)
+
+			code, _ = call("POST", ValidationPath, `{"config_set": "services/svc", "path": "a.cfg", "content": "!!!"}`)
+			So(code, ShouldEqual, http.StatusBadRequest)
+		})
+	})
+}
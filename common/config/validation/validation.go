@@ -0,0 +1,196 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package validation implements a registry of config validators.
+//
+// Services register validation functions for the configs they consume, keyed
+// by config set and path patterns, and serve them as the luci-config
+// validation endpoint (see InstallHandlers). The same registry can be used to
+// validate configs locally, e.g. in tests or presubmit tools.
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	configPb "github.com/luci/luci-go/common/proto/config"
+)
+
+// Func validates a config, reporting problems to r.
+type Func func(c context.Context, configSet, path string, content []byte, r *Report)
+
+// ProtoFunc returns a Func that parses the config as a text protobuf message
+// of the same type as msg and passes it to check.
+//
+// Parse errors are reported as validation errors. check may be nil, in which
+// case only the syntax is validated.
+func ProtoFunc(msg proto.Message, check func(c context.Context, configSet, path string, msg proto.Message, r *Report)) Func {
+	return func(c context.Context, configSet, path string, content []byte, r *Report) {
+		m := proto.Clone(msg)
+		m.Reset()
+		if err := proto.UnmarshalText(string(content), m); err != nil {
+			r.Errorf("failed to parse %s: %s", proto.MessageName(msg), err)
+			return
+		}
+		if check != nil {
+			check(c, configSet, path, m, r)
+		}
+	}
+}
+
+// Report collects validation messages for a config.
+type Report struct {
+	Messages []*configPb.ValidationResponseMessage_Message
+}
+
+func (r *Report) add(sev configPb.ValidationResponseMessage_Severity, format string, args []interface{}) {
+	r.Messages = append(r.Messages, &configPb.ValidationResponseMessage_Message{
+		Text:     proto.String(fmt.Sprintf(format, args...)),
+		Severity: sev.Enum(),
+	})
+}
+
+// Errorf reports a problem that makes the config invalid.
+func (r *Report) Errorf(format string, args ...interface{}) {
+	r.add(configPb.ValidationResponseMessage_ERROR, format, args)
+}
+
+// Warningf reports a problem that doesn't make the config invalid.
+func (r *Report) Warningf(format string, args ...interface{}) {
+	r.add(configPb.ValidationResponseMessage_WARNING, format, args)
+}
+
+// Infof reports an informational message.
+func (r *Report) Infof(format string, args ...interface{}) {
+	r.add(configPb.ValidationResponseMessage_INFO, format, args)
+}
+
+// Err returns an error listing all errors in the report, or nil if the config
+// is valid.
+func (r *Report) Err() error {
+	var errs []string
+	for _, m := range r.Messages {
+		if m.GetSeverity() >= configPb.ValidationResponseMessage_ERROR {
+			errs = append(errs, m.GetText())
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid config: %s", strings.Join(errs, "; "))
+}
+
+// pattern is a config set or path pattern in luci-config format.
+//
+// "text:<s>" (or just "<s>") matches the string s, "regex:<re>" matches
+// strings the regular expression matches in full.
+type pattern struct {
+	raw string
+	re  *regexp.Regexp // nil for exact matches
+}
+
+func newPattern(p string) (pattern, error) {
+	switch {
+	case strings.HasPrefix(p, "regex:"):
+		re, err := regexp.Compile("^(?:" + strings.TrimPrefix(p, "regex:") + ")$")
+		if err != nil {
+			return pattern{}, fmt.Errorf("bad pattern %q - %s", p, err)
+		}
+		return pattern{raw: p, re: re}, nil
+	case strings.HasPrefix(p, "text:"):
+		return pattern{raw: p}, nil
+	default:
+		return pattern{raw: "text:" + p}, nil
+	}
+}
+
+func (p pattern) match(s string) bool {
+	if p.re != nil {
+		return p.re.MatchString(s)
+	}
+	return s == strings.TrimPrefix(p.raw, "text:")
+}
+
+type rule struct {
+	configSet pattern
+	path      pattern
+	fn        Func
+}
+
+// Registry holds validation functions.
+//
+// The zero value is ready for use.
+type Registry struct {
+	lock  sync.RWMutex
+	rules []*rule
+}
+
+// Default is the registry services register their validators in.
+var Default Registry
+
+// Add registers a validation function for configs matching the config set and
+// path patterns.
+//
+// Patterns are in luci-config format: "text:<s>" (or just "<s>") for an exact
+// match, or "regex:<re>" for a regular expression that must match the whole
+// string. Panics if a pattern is malformed.
+func (r *Registry) Add(configSet, path string, fn Func) {
+	cs, err := newPattern(configSet)
+	if err != nil {
+		panic(err)
+	}
+	p, err := newPattern(path)
+	if err != nil {
+		panic(err)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.rules = append(r.rules, &rule{configSet: cs, path: p, fn: fn})
+}
+
+// Patterns returns patterns of all registered validators, in the order they
+// were added.
+func (r *Registry) Patterns() []*configPb.ConfigPattern {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	ret := make([]*configPb.ConfigPattern, len(r.rules))
+	for i, rl := range r.rules {
+		ret[i] = &configPb.ConfigPattern{
+			ConfigSet: proto.String(rl.configSet.raw),
+			Path:      proto.String(rl.path.raw),
+		}
+	}
+	return ret
+}
+
+// Validate runs all validators matching the config set and path.
+//
+// If no validator matches, the report has an error.
+func (r *Registry) Validate(c context.Context, configSet, path string, content []byte) *Report {
+	r.lock.RLock()
+	var fns []Func
+	for _, rl := range r.rules {
+		if rl.configSet.match(configSet) && rl.path.match(path) {
+			fns = append(fns, rl.fn)
+		}
+	}
+	r.lock.RUnlock()
+
+	rep := &Report{}
+	if len(fns) == 0 {
+		rep.Errorf("no validator for %q in %q", path, configSet)
+		return rep
+	}
+	for _, fn := range fns {
+		fn(c, configSet, path, content, rep)
+	}
+	return rep
+}
@@ -0,0 +1,89 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	configPb "github.com/luci/luci-go/common/proto/config"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	Convey("With a registry", t, func() {
+		c := context.Background()
+		r := Registry{}
+
+		var names []string
+		r.Add("services/svc", "svc.cfg", func(c context.Context, configSet, path string, content []byte, r *Report) {
+			if string(content) != "good" {
+				r.Errorf("bad content %q", content)
+			}
+		})
+		r.Add("regex:projects/[^/]+", "project.cfg", ProtoFunc(&configPb.ProjectCfg{},
+			func(c context.Context, configSet, path string, msg proto.Message, r *Report) {
+				name := msg.(*configPb.ProjectCfg).GetName()
+				names = append(names, name)
+				if name == "" {
+					r.Warningf("no name")
+				}
+			}))
+
+		Convey("Patterns", func() {
+			So(r.Patterns(), ShouldResemble, []*configPb.ConfigPattern{
+				{ConfigSet: proto.String("text:services/svc"), Path: proto.String("text:svc.cfg")},
+				{ConfigSet: proto.String("regex:projects/[^/]+"), Path: proto.String("text:project.cfg")},
+			})
+		})
+
+		Convey("Exact match", func() {
+			So(r.Validate(c, "services/svc", "svc.cfg", []byte("good")).Err(), ShouldBeNil)
+			So(r.Validate(c, "services/svc", "svc.cfg", []byte("bad")).Err(), ShouldErrLike, `bad content "bad"`)
+		})
+
+		Convey("Regex match", func() {
+			rep := r.Validate(c, "projects/foo", "project.cfg", []byte(`name: "foo"`))
+			So(rep.Err(), ShouldBeNil)
+			So(rep.Messages, ShouldHaveLength, 0)
+			So(names, ShouldResemble, []string{"foo"})
+
+			rep = r.Validate(c, "projects/foo", "project.cfg", nil)
+			So(rep.Err(), ShouldBeNil)
+			So(rep.Messages, ShouldResemble, []*configPb.ValidationResponseMessage_Message{
+				{
+					Text:     proto.String("no name"),
+					Severity: configPb.ValidationResponseMessage_WARNING.Enum(),
+				},
+			})
+		})
+
+		Convey("Regex must match the whole string", func() {
+			rep := r.Validate(c, "projects/foo/refs/heads/master", "project.cfg", nil)
+			So(rep.Err(), ShouldErrLike, "no validator")
+		})
+
+		Convey("Bad proto", func() {
+			rep := r.Validate(c, "projects/foo", "project.cfg", []byte("blah"))
+			So(rep.Err(), ShouldErrLike, "failed to parse config.ProjectCfg")
+			So(names, ShouldHaveLength, 0)
+		})
+
+		Convey("No validator", func() {
+			rep := r.Validate(c, "services/svc", "other.cfg", nil)
+			So(rep.Err(), ShouldErrLike, `no validator for "other.cfg" in "services/svc"`)
+		})
+
+		Convey("Bad pattern", func() {
+			So(func() { r.Add("regex:(", "a.cfg", nil) }, ShouldPanic)
+		})
+	})
+}
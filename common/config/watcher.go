@@ -0,0 +1,190 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package config
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+)
+
+// DefaultWatchInterval is how often Watcher polls configs by default.
+const DefaultWatchInterval = time.Minute
+
+// WatchCallback is called by Watcher when a watched config changes.
+//
+// cfg is the new config and msg is its content parsed as a text protobuf
+// message of the type passed to Watch. If the config was deleted, both are nil.
+//
+// If the callback returns an error, it will be called again with the same
+// config on the next check.
+type WatchCallback func(c context.Context, cfg *Config, msg proto.Message) error
+
+// Watcher delivers parsed configs to callbacks when they change.
+//
+// It reads configs using the Interface installed in the context (see
+// SetImplementation). Changes are discovered by polling (see Run), or when the
+// caller is told about them, e.g. by a push notification (see Changed).
+//
+// The zero value is ready for use.
+type Watcher struct {
+	// Interval is how often Run checks the configs. If zero,
+	// DefaultWatchInterval is used.
+	Interval time.Duration
+
+	lock    sync.Mutex
+	watches []*watch
+}
+
+type watch struct {
+	configSet string
+	path      string
+	msg       proto.Message // prototype of the message to parse the config into
+	cb        WatchCallback
+
+	// lock serializes checks of this watch, so callbacks are not called
+	// concurrently and lastHash is consistent with what they saw.
+	lock     sync.Mutex
+	lastHash string // ContentHash of the last delivered config or "" if none
+}
+
+// Watch registers a callback for the config at the given path in the config
+// set.
+//
+// msg is an example of the message the config is parsed into. It is never
+// modified, callbacks receive a fresh message of the same type each time. If
+// msg is nil, the config is not parsed and callbacks receive a nil message.
+//
+// The callback is called on the next check even if the config is already
+// there, to deliver the initial version. Nothing is called for configs that
+// don't exist yet.
+func (w *Watcher) Watch(configSet, path string, msg proto.Message, cb WatchCallback) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.watches = append(w.watches, &watch{
+		configSet: configSet,
+		path:      path,
+		msg:       msg,
+		cb:        cb,
+	})
+}
+
+// Refresh checks all watched configs now and calls callbacks of changed ones.
+//
+// Returns all errors from fetching, parsing and callbacks as an
+// errors.MultiError.
+func (w *Watcher) Refresh(c context.Context) error {
+	return w.check(c, func(*watch) bool { return true })
+}
+
+// Changed checks the configs watched at the given path in the config set, and
+// calls their callbacks if they changed.
+//
+// It is meant for change notifications. Does nothing if there are no such
+// watches.
+func (w *Watcher) Changed(c context.Context, configSet, path string) error {
+	return w.check(c, func(wt *watch) bool {
+		return wt.configSet == configSet && wt.path == path
+	})
+}
+
+// Run calls Refresh every Interval until the context is canceled.
+//
+// Errors are logged.
+func (w *Watcher) Run(c context.Context) {
+	interval := w.Interval
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+
+	for {
+		if err := w.Refresh(c); err != nil {
+			logging.WithError(err).Warningf(c, "Failed to refresh watched configs.")
+		}
+		if tr := <-clock.After(c, interval); tr.Incomplete() {
+			return
+		}
+	}
+}
+
+func (w *Watcher) check(c context.Context, filter func(*watch) bool) error {
+	w.lock.Lock()
+	watches := make([]*watch, 0, len(w.watches))
+	for _, wt := range w.watches {
+		if filter(wt) {
+			watches = append(watches, wt)
+		}
+	}
+	w.lock.Unlock()
+
+	var merr errors.MultiError
+	for _, wt := range watches {
+		if err := wt.check(c); err != nil {
+			merr = append(merr, err)
+		}
+	}
+	if len(merr) != 0 {
+		return merr
+	}
+	return nil
+}
+
+func (wt *watch) check(c context.Context) error {
+	wt.lock.Lock()
+	defer wt.lock.Unlock()
+
+	c = logging.SetFields(c, logging.Fields{
+		"configSet": wt.configSet,
+		"path":      wt.path,
+	})
+
+	cfg, err := GetConfig(c, wt.configSet, wt.path, true)
+	switch {
+	case err == ErrNoConfig:
+		if wt.lastHash == "" {
+			return nil
+		}
+		logging.Infof(c, "Watched config was deleted.")
+		if err := wt.cb(c, nil, nil); err != nil {
+			return err
+		}
+		wt.lastHash = ""
+		return nil
+	case err != nil:
+		return err
+	case cfg.ContentHash == wt.lastHash:
+		return nil
+	}
+
+	if cfg, err = GetConfig(c, wt.configSet, wt.path, false); err != nil {
+		return err
+	}
+
+	var msg proto.Message
+	if wt.msg != nil {
+		msg = proto.Clone(wt.msg)
+		msg.Reset()
+		if err := proto.UnmarshalText(cfg.Content, msg); err != nil {
+			// Retrying won't help, wait for the next version.
+			wt.lastHash = cfg.ContentHash
+			return (errors.Reason("failed to parse %(configSet)s:%(path)s at %(revision)s - %(err)s").
+				D("configSet", wt.configSet).D("path", wt.path).
+				D("revision", cfg.Revision).D("err", err).Err())
+		}
+	}
+
+	logging.Infof(c, "Watched config changed to %s at revision %q.", cfg.ContentHash, cfg.Revision)
+	if err := wt.cb(c, cfg, msg); err != nil {
+		return err
+	}
+	wt.lastHash = cfg.ContentHash
+	return nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	configPb "github.com/luci/luci-go/common/proto/config"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeConfigs is an Interface that serves GetConfig from a map.
+type fakeConfigs struct {
+	Interface // nil, other methods are not used
+
+	configs  map[string]string // configSet:path => content
+	fetched  int               // number of full (not hash-only) fetches
+	revision int
+}
+
+func (f *fakeConfigs) set(configSet, path, content string) {
+	f.configs[configSet+":"+path] = content
+	f.revision++
+}
+
+func (f *fakeConfigs) GetConfig(c context.Context, configSet, path string, hashOnly bool) (*Config, error) {
+	content, ok := f.configs[configSet+":"+path]
+	if !ok {
+		return nil, ErrNoConfig
+	}
+	cfg := &Config{
+		ConfigSet:   configSet,
+		Path:        path,
+		ContentHash: fmt.Sprintf("hash:%q", content),
+		Revision:    fmt.Sprintf("r%d", f.revision),
+	}
+	if !hashOnly {
+		cfg.Content = content
+		f.fetched++
+	}
+	return cfg, nil
+}
+
+func TestWatcher(t *testing.T) {
+	t.Parallel()
+
+	Convey("With a Watcher", t, func() {
+		fake := &fakeConfigs{configs: map[string]string{}}
+		c := SetImplementation(context.Background(), fake)
+
+		fake.set("projects/foo", "project.cfg", `name: "foo"`)
+
+		type call struct {
+			rev  string
+			name string
+		}
+		var calls []call
+		var cbErr error
+
+		w := Watcher{}
+		w.Watch("projects/foo", "project.cfg", &configPb.ProjectCfg{}, func(c context.Context, cfg *Config, msg proto.Message) error {
+			if cfg == nil {
+				So(msg, ShouldBeNil)
+				calls = append(calls, call{})
+				return cbErr
+			}
+			calls = append(calls, call{cfg.Revision, msg.(*configPb.ProjectCfg).GetName()})
+			return cbErr
+		})
+
+		Convey("Delivers the initial config", func() {
+			So(w.Refresh(c), ShouldBeNil)
+			So(calls, ShouldResemble, []call{{"r1", "foo"}})
+
+			Convey("Skips unchanged configs", func() {
+				So(w.Refresh(c), ShouldBeNil)
+				So(calls, ShouldHaveLength, 1)
+				So(fake.fetched, ShouldEqual, 1)
+			})
+
+			Convey("Delivers changes", func() {
+				fake.set("projects/foo", "project.cfg", `name: "bar"`)
+				So(w.Refresh(c), ShouldBeNil)
+				So(calls, ShouldResemble, []call{{"r1", "foo"}, {"r2", "bar"}})
+			})
+
+			Convey("Delivers deletions", func() {
+				delete(fake.configs, "projects/foo:project.cfg")
+				So(w.Refresh(c), ShouldBeNil)
+				So(w.Refresh(c), ShouldBeNil)
+				So(calls, ShouldResemble, []call{{"r1", "foo"}, {}})
+			})
+		})
+
+		Convey("Retries failed callbacks", func() {
+			cbErr = errors.New("boom")
+			So(w.Refresh(c), ShouldErrLike, "boom")
+
+			cbErr = nil
+			So(w.Refresh(c), ShouldBeNil)
+			So(w.Refresh(c), ShouldBeNil)
+			So(calls, ShouldHaveLength, 2)
+		})
+
+		Convey("Reports broken configs once", func() {
+			fake.set("projects/foo", "project.cfg", `not a proto`)
+			So(w.Refresh(c), ShouldErrLike, "failed to parse projects/foo:project.cfg at r2")
+			So(w.Refresh(c), ShouldBeNil)
+			So(calls, ShouldHaveLength, 0)
+		})
+
+		Convey("Changed checks only the given config", func() {
+			fake.set("services/svc", "svc.cfg", "whatever")
+			var svcCalls []string
+			w.Watch("services/svc", "svc.cfg", nil, func(c context.Context, cfg *Config, msg proto.Message) error {
+				So(msg, ShouldBeNil)
+				svcCalls = append(svcCalls, cfg.Content)
+				return nil
+			})
+
+			So(w.Changed(c, "services/svc", "svc.cfg"), ShouldBeNil)
+			So(svcCalls, ShouldResemble, []string{"whatever"})
+			So(calls, ShouldHaveLength, 0)
+
+			So(w.Changed(c, "services/svc", "unknown.cfg"), ShouldBeNil)
+			So(svcCalls, ShouldHaveLength, 1)
+		})
+	})
+}
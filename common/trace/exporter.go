@@ -0,0 +1,93 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package trace
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/logging"
+)
+
+// Exporter records finished spans somewhere.
+//
+// Implementations must be safe for concurrent use.
+type Exporter interface {
+	// ExportSpan is called when a sampled span ends.
+	//
+	// It must not block for long, since it is called from the code being
+	// traced. Exporters that send spans over the network should buffer them
+	// until Flush.
+	ExportSpan(c context.Context, s *SpanData)
+
+	// Flush sends all buffered spans.
+	Flush(c context.Context) error
+}
+
+type jsonExporter struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+// NewJSONExporter returns an Exporter that writes each span to w as a JSON
+// object on its own line.
+//
+// It is meant for local debugging, e.g. with w being a file.
+func NewJSONExporter(w io.Writer) Exporter {
+	return &jsonExporter{w: w}
+}
+
+// jsonSpan is how a span is represented in the JSON exporter output.
+type jsonSpan struct {
+	TraceID       string                 `json:"traceId"`
+	SpanID        string                 `json:"spanId"`
+	ParentSpanID  string                 `json:"parentSpanId,omitempty"`
+	Name          string                 `json:"name"`
+	Kind          string                 `json:"kind"`
+	Start         time.Time              `json:"start"`
+	End           time.Time              `json:"end"`
+	DurationMs    float64                `json:"durationMs"`
+	Attributes    map[string]interface{} `json:"attributes,omitempty"`
+	Error         bool                   `json:"error,omitempty"`
+	StatusMessage string                 `json:"statusMessage,omitempty"`
+}
+
+func (e *jsonExporter) ExportSpan(c context.Context, s *SpanData) {
+	js := jsonSpan{
+		TraceID:       s.TraceID.String(),
+		SpanID:        s.SpanID.String(),
+		Name:          s.Name,
+		Kind:          s.Kind.String(),
+		Start:         s.Start.UTC(),
+		End:           s.End.UTC(),
+		DurationMs:    float64(s.End.Sub(s.Start)) / float64(time.Millisecond),
+		Attributes:    s.Attributes,
+		Error:         s.StatusCode == StatusError,
+		StatusMessage: s.StatusMessage,
+	}
+	if s.ParentSpanID.IsValid() {
+		js.ParentSpanID = s.ParentSpanID.String()
+	}
+
+	blob, err := json.Marshal(&js)
+	if err != nil {
+		logging.WithError(err).Errorf(c, "Failed to serialize span %q", s.Name)
+		return
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if _, err := e.w.Write(append(blob, '\n')); err != nil {
+		logging.WithError(err).Errorf(c, "Failed to write span %q", s.Name)
+	}
+}
+
+func (e *jsonExporter) Flush(c context.Context) error {
+	return nil
+}
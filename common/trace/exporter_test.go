@@ -0,0 +1,166 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package trace
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock/testclock"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestExporters(t *testing.T) {
+	c := context.Background()
+	epoch := time.Unix(1454472306, 7000000).UTC()
+
+	record := func(e Exporter) {
+		c, tc := testclock.UseTime(c, epoch)
+		c = SetExporter(c, e)
+		c, root := StartSpan(c, "root", SpanKindServer)
+		root.SetAttribute("str", "v")
+		root.SetAttribute("int", 42)
+		root.SetAttribute("bool", true)
+		root.SetAttribute("float", 1.5)
+		_, child := StartSpan(c, "child", SpanKindClient)
+		tc.Add(1500 * time.Microsecond)
+		child.SetStatus(StatusError, "failed")
+		child.End()
+		root.End()
+	}
+
+	Convey("JSON exporter", t, func() {
+		buf := bytes.Buffer{}
+		record(NewJSONExporter(&buf))
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		So(len(lines), ShouldEqual, 2)
+
+		var child, root map[string]interface{}
+		So(json.Unmarshal([]byte(lines[0]), &child), ShouldBeNil)
+		So(json.Unmarshal([]byte(lines[1]), &root), ShouldBeNil)
+
+		So(child["name"], ShouldEqual, "child")
+		So(child["kind"], ShouldEqual, "CLIENT")
+		So(child["parentSpanId"], ShouldEqual, root["spanId"])
+		So(child["traceId"], ShouldEqual, root["traceId"])
+		So(child["durationMs"], ShouldEqual, 1.5)
+		So(child["error"], ShouldEqual, true)
+		So(child["statusMessage"], ShouldEqual, "failed")
+
+		So(root["name"], ShouldEqual, "root")
+		So(root["parentSpanId"], ShouldBeNil)
+		So(root["attributes"], ShouldResemble, map[string]interface{}{
+			"str": "v", "int": 42.0, "bool": true, "float": 1.5,
+		})
+	})
+
+	Convey("OTLP exporter with a local collector", t, func() {
+		var requests []string
+		var bodies []map[string]interface{}
+		status := http.StatusOK
+		ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path+" "+r.Header.Get("Content-Type"))
+			blob, _ := ioutil.ReadAll(r.Body)
+			body := map[string]interface{}{}
+			json.Unmarshal(blob, &body)
+			bodies = append(bodies, body)
+			rw.WriteHeader(status)
+		}))
+		defer ts.Close()
+
+		e := NewOTLPExporter(nil, ts.URL+"/v1/traces", "test-service")
+
+		Convey("Nothing to flush", func() {
+			So(e.Flush(c), ShouldBeNil)
+			So(bodies, ShouldBeEmpty)
+		})
+
+		Convey("Sends spans", func() {
+			record(e)
+			So(e.Flush(c), ShouldBeNil)
+			So(requests, ShouldResemble, []string{"POST /v1/traces application/json"})
+
+			rs := bodies[0]["resourceSpans"].([]interface{})[0].(map[string]interface{})
+			So(rs["resource"], ShouldResemble, map[string]interface{}{
+				"attributes": []interface{}{
+					map[string]interface{}{
+						"key":   "service.name",
+						"value": map[string]interface{}{"stringValue": "test-service"},
+					},
+				},
+			})
+			spans := rs["scopeSpans"].([]interface{})[0].(map[string]interface{})["spans"].([]interface{})
+			So(len(spans), ShouldEqual, 2)
+
+			child := spans[0].(map[string]interface{})
+			root := spans[1].(map[string]interface{})
+			So(child["name"], ShouldEqual, "child")
+			So(child["kind"], ShouldEqual, 3)
+			So(child["parentSpanId"], ShouldEqual, root["spanId"])
+			So(child["status"], ShouldResemble, map[string]interface{}{"code": 2.0, "message": "failed"})
+			So(child["startTimeUnixNano"], ShouldEqual, "1454472306007000000")
+			So(child["endTimeUnixNano"], ShouldEqual, "1454472306008500000")
+
+			So(root["attributes"], ShouldResemble, []interface{}{
+				map[string]interface{}{"key": "bool", "value": map[string]interface{}{"boolValue": true}},
+				map[string]interface{}{"key": "float", "value": map[string]interface{}{"doubleValue": 1.5}},
+				map[string]interface{}{"key": "int", "value": map[string]interface{}{"intValue": "42"}},
+				map[string]interface{}{"key": "str", "value": map[string]interface{}{"stringValue": "v"}},
+			})
+
+			// The buffer is empty after the flush.
+			So(e.Flush(c), ShouldBeNil)
+			So(len(bodies), ShouldEqual, 1)
+		})
+
+		Convey("Flushes full batches in the background", func() {
+			e.(*otlpExporter).batchSize = 2
+
+			// The context of the traced code is canceled right after the spans end.
+			c, cancel := context.WithCancel(SetExporter(c, e))
+			for _, name := range []string{"a", "b"} {
+				_, span := StartSpan(c, name, SpanKindInternal)
+				span.End()
+			}
+			cancel()
+
+			// Wait for the background flush to finish.
+			for {
+				oe := e.(*otlpExporter)
+				oe.lock.Lock()
+				flushing := oe.flushing
+				oe.lock.Unlock()
+				if !flushing {
+					break
+				}
+				time.Sleep(time.Millisecond)
+			}
+			So(requests, ShouldResemble, []string{"POST /v1/traces application/json"})
+			rs := bodies[0]["resourceSpans"].([]interface{})[0].(map[string]interface{})
+			spans := rs["scopeSpans"].([]interface{})[0].(map[string]interface{})["spans"].([]interface{})
+			So(len(spans), ShouldEqual, 2)
+
+			// Nothing is left for the explicit flush.
+			So(e.Flush(context.Background()), ShouldBeNil)
+			So(len(bodies), ShouldEqual, 1)
+		})
+
+		Convey("Reports collector errors", func() {
+			status = http.StatusBadRequest
+			record(e)
+			So(e.Flush(c), ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,287 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package trace
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"golang.org/x/net/context/ctxhttp"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/logging"
+)
+
+const (
+	// MaxOTLPBufferedSpans is how many spans the OTLP exporter keeps between
+	// flushes. Spans over this limit are dropped.
+	MaxOTLPBufferedSpans = 10000
+
+	// OTLPFlushBatchSize is how many buffered spans make the OTLP exporter
+	// flush them in the background, without waiting for a Flush call.
+	OTLPFlushBatchSize = 1000
+
+	// otlpBackgroundFlushTimeout limits background flushes.
+	otlpBackgroundFlushTimeout = time.Minute
+)
+
+type otlpExporter struct {
+	client      *http.Client
+	url         string
+	serviceName string
+	batchSize   int // mocked in tests
+
+	lock     sync.Mutex
+	spans    []*SpanData
+	dropped  int
+	flushing bool // true if a background flush is running
+}
+
+// NewOTLPExporter returns an Exporter that sends spans to an OpenTelemetry
+// collector using OTLP over HTTP with JSON encoding.
+//
+// The url is the collector's traces endpoint, usually
+// "http://localhost:4318/v1/traces". The serviceName is reported as the
+// "service.name" resource attribute. If client is nil, http.DefaultClient is
+// used.
+//
+// Spans are buffered in memory until Flush is called, or until there are
+// OTLPFlushBatchSize of them, at which point they are sent in the background.
+func NewOTLPExporter(client *http.Client, url, serviceName string) Exporter {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &otlpExporter{
+		client:      client,
+		url:         url,
+		serviceName: serviceName,
+		batchSize:   OTLPFlushBatchSize,
+	}
+}
+
+func (e *otlpExporter) ExportSpan(c context.Context, s *SpanData) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if len(e.spans) >= MaxOTLPBufferedSpans {
+		e.dropped++
+		return
+	}
+	e.spans = append(e.spans, s)
+	if len(e.spans) >= e.batchSize && !e.flushing {
+		e.flushing = true
+		go e.flushInBackground(c)
+	}
+}
+
+// flushInBackground flushes the buffered spans.
+//
+// It is called from ExportSpan with the context of the traced code, which may
+// be canceled as soon as ExportSpan returns, so it uses a context detached from
+// it (but with the same values, e.g. the logger).
+func (e *otlpExporter) flushInBackground(c context.Context) {
+	defer func() {
+		e.lock.Lock()
+		e.flushing = false
+		e.lock.Unlock()
+	}()
+	c, cancel := clock.WithTimeout(detachedContext{c}, otlpBackgroundFlushTimeout)
+	defer cancel()
+	if err := e.Flush(c); err != nil {
+		logging.WithError(err).Warningf(c, "Failed to flush spans in the background")
+	}
+}
+
+// detachedContext has the values of the wrapped context, but not its deadline
+// and cancellation.
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+
+func (e *otlpExporter) Flush(c context.Context) error {
+	e.lock.Lock()
+	spans, dropped := e.spans, e.dropped
+	e.spans, e.dropped = nil, 0
+	e.lock.Unlock()
+
+	if dropped != 0 {
+		logging.Warningf(c, "Dropped %d spans because the OTLP exporter buffer was full", dropped)
+	}
+	if len(spans) == 0 {
+		return nil
+	}
+
+	blob, err := json.Marshal(e.request(spans))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", e.url, bytes.NewReader(blob))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := ctxhttp.Do(c, e.client, req)
+	if err != nil {
+		return fmt.Errorf("failed to send %d spans - %s", len(spans), err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("OTLP collector replied with HTTP %d: %s", resp.StatusCode, body)
+	}
+	logging.Debugf(c, "Sent %d spans to %s", len(spans), e.url)
+	return nil
+}
+
+// OTLP ExportTraceServiceRequest in its JSON encoding.
+//
+// See https://github.com/open-telemetry/opentelemetry-proto.
+type (
+	otlpRequest struct {
+		ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+	}
+
+	otlpResourceSpans struct {
+		Resource   otlpResource     `json:"resource"`
+		ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+	}
+
+	otlpResource struct {
+		Attributes []otlpKeyValue `json:"attributes"`
+	}
+
+	otlpScopeSpans struct {
+		Scope otlpScope  `json:"scope"`
+		Spans []otlpSpan `json:"spans"`
+	}
+
+	otlpScope struct {
+		Name string `json:"name"`
+	}
+
+	otlpSpan struct {
+		TraceID           string         `json:"traceId"`
+		SpanID            string         `json:"spanId"`
+		ParentSpanID      string         `json:"parentSpanId,omitempty"`
+		Name              string         `json:"name"`
+		Kind              int            `json:"kind"`
+		StartTimeUnixNano string         `json:"startTimeUnixNano"`
+		EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+		Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+		Status            otlpStatus     `json:"status"`
+	}
+
+	otlpStatus struct {
+		Code    int    `json:"code,omitempty"`
+		Message string `json:"message,omitempty"`
+	}
+
+	otlpKeyValue struct {
+		Key   string    `json:"key"`
+		Value otlpValue `json:"value"`
+	}
+
+	otlpValue struct {
+		StringValue *string  `json:"stringValue,omitempty"`
+		BoolValue   *bool    `json:"boolValue,omitempty"`
+		IntValue    *string  `json:"intValue,omitempty"` // int64 is a string in JSON
+		DoubleValue *float64 `json:"doubleValue,omitempty"`
+	}
+)
+
+func (e *otlpExporter) request(spans []*SpanData) *otlpRequest {
+	out := make([]otlpSpan, len(spans))
+	for i, s := range spans {
+		out[i] = otlpSpan{
+			TraceID:           s.TraceID.String(),
+			SpanID:            s.SpanID.String(),
+			Name:              s.Name,
+			Kind:              int(s.Kind),
+			StartTimeUnixNano: strconv.FormatInt(s.Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(s.End.UnixNano(), 10),
+			Attributes:        otlpAttributes(s.Attributes),
+			Status: otlpStatus{
+				Code:    int(s.StatusCode),
+				Message: s.StatusMessage,
+			},
+		}
+		if s.ParentSpanID.IsValid() {
+			out[i].ParentSpanID = s.ParentSpanID.String()
+		}
+	}
+
+	return &otlpRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: otlpAttributes(map[string]interface{}{"service.name": e.serviceName}),
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: "github.com/luci/luci-go/common/trace"},
+				Spans: out,
+			}},
+		}},
+	}
+}
+
+// otlpAttributes converts attributes to OTLP key-values, sorted by key.
+func otlpAttributes(attrs map[string]interface{}) []otlpKeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	ret := make([]otlpKeyValue, len(keys))
+	for i, k := range keys {
+		ret[i] = otlpKeyValue{Key: k, Value: otlpAttributeValue(attrs[k])}
+	}
+	return ret
+}
+
+func otlpAttributeValue(v interface{}) otlpValue {
+	str := func(s string) otlpValue { return otlpValue{StringValue: &s} }
+	integer := func(i int64) otlpValue {
+		s := strconv.FormatInt(i, 10)
+		return otlpValue{IntValue: &s}
+	}
+	double := func(f float64) otlpValue { return otlpValue{DoubleValue: &f} }
+
+	switch v := v.(type) {
+	case string:
+		return str(v)
+	case bool:
+		return otlpValue{BoolValue: &v}
+	case int:
+		return integer(int64(v))
+	case int32:
+		return integer(int64(v))
+	case int64:
+		return integer(v)
+	case uint32:
+		return integer(int64(v))
+	case float32:
+		return double(float64(v))
+	case float64:
+		return double(v)
+	default:
+		return str(fmt.Sprint(v))
+	}
+}
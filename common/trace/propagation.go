@@ -0,0 +1,108 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package trace
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/context"
+)
+
+// TraceparentHeader is the W3C Trace Context HTTP header carrying the span
+// context of the caller.
+//
+// See https://www.w3.org/TR/trace-context/.
+const TraceparentHeader = "Traceparent"
+
+// sampledFlag is the "sampled" bit of traceparent trace flags.
+const sampledFlag = 0x01
+
+// FormatTraceparent returns the traceparent header value for the span context.
+func FormatTraceparent(sc SpanContext) string {
+	flags := 0
+	if sc.Sampled {
+		flags |= sampledFlag
+	}
+	return fmt.Sprintf("00-%s-%s-%02x", sc.TraceID, sc.SpanID, flags)
+}
+
+// ParseTraceparent parses a traceparent header value.
+func ParseTraceparent(v string) (SpanContext, error) {
+	sc := SpanContext{}
+
+	parts := strings.Split(strings.TrimSpace(v), "-")
+	if len(parts) < 4 {
+		return sc, fmt.Errorf("traceparent: want 4 parts, got %q", v)
+	}
+
+	version, err := decodeHex(parts[0], 1)
+	switch {
+	case err != nil:
+		return sc, fmt.Errorf("traceparent: bad version - %s", err)
+	case version[0] == 0xff:
+		return sc, fmt.Errorf("traceparent: invalid version ff")
+	case version[0] == 0 && len(parts) != 4:
+		// Future versions may add more parts, version 00 doesn't.
+		return sc, fmt.Errorf("traceparent: want 4 parts, got %q", v)
+	}
+
+	traceID, err := decodeHex(parts[1], len(sc.TraceID))
+	if err != nil {
+		return sc, fmt.Errorf("traceparent: bad trace ID - %s", err)
+	}
+	spanID, err := decodeHex(parts[2], len(sc.SpanID))
+	if err != nil {
+		return sc, fmt.Errorf("traceparent: bad span ID - %s", err)
+	}
+	flags, err := decodeHex(parts[3], 1)
+	if err != nil {
+		return sc, fmt.Errorf("traceparent: bad flags - %s", err)
+	}
+
+	copy(sc.TraceID[:], traceID)
+	copy(sc.SpanID[:], spanID)
+	sc.Sampled = flags[0]&sampledFlag != 0
+	if !sc.IsValid() {
+		return SpanContext{}, fmt.Errorf("traceparent: all-zero IDs in %q", v)
+	}
+	return sc, nil
+}
+
+// decodeHex decodes a lowercase hex string of exactly n bytes.
+func decodeHex(s string, n int) ([]byte, error) {
+	if len(s) != 2*n {
+		return nil, fmt.Errorf("want %d hex digits, got %q", 2*n, s)
+	}
+	if strings.ToLower(s) != s {
+		return nil, fmt.Errorf("want lowercase hex digits, got %q", s)
+	}
+	return hex.DecodeString(s)
+}
+
+// Inject sets the traceparent header to the current span in the context. Does
+// nothing if there's no span.
+func Inject(c context.Context, h http.Header) {
+	if s := SpanFromContext(c); s != nil {
+		h.Set(TraceparentHeader, FormatTraceparent(s.SpanContext()))
+	}
+}
+
+// Extract returns a context in which new spans are children of the span in
+// the traceparent header. Returns c as is if the header is missing or
+// malformed.
+func Extract(c context.Context, h http.Header) context.Context {
+	v := h.Get(TraceparentHeader)
+	if v == "" {
+		return c
+	}
+	sc, err := ParseTraceparent(v)
+	if err != nil {
+		return c
+	}
+	return WithRemoteParent(c, sc)
+}
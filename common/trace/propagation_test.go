@@ -0,0 +1,68 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package trace
+
+import (
+	"net/http"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTraceparent(t *testing.T) {
+	const valid = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+	Convey("ParseTraceparent", t, func() {
+		sc, err := ParseTraceparent(valid)
+		So(err, ShouldBeNil)
+		So(sc.TraceID.String(), ShouldEqual, "4bf92f3577b34da6a3ce929d0e0e4736")
+		So(sc.SpanID.String(), ShouldEqual, "00f067aa0ba902b7")
+		So(sc.Sampled, ShouldBeTrue)
+		So(FormatTraceparent(sc), ShouldEqual, valid)
+
+		sc, err = ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00")
+		So(err, ShouldBeNil)
+		So(sc.Sampled, ShouldBeFalse)
+
+		// Future versions may have more parts.
+		_, err = ParseTraceparent("01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra")
+		So(err, ShouldBeNil)
+
+		for _, bad := range []string{
+			"",
+			"garbage",
+			"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+			"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+			"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+			"00-4bf92f3577b34da6a3ce929d0e0e47-00f067aa0ba902b7-01",
+			"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+			"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+			"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-zz",
+		} {
+			_, err := ParseTraceparent(bad)
+			So(err, ShouldNotBeNil)
+		}
+	})
+
+	Convey("Inject and Extract", t, func() {
+		c := context.Background()
+		h := http.Header{}
+
+		Inject(c, h)
+		So(h.Get(TraceparentHeader), ShouldEqual, "")
+		So(Extract(c, h).Value(remoteParentKey), ShouldBeNil)
+
+		c, s := StartSpan(c, "client", SpanKindClient)
+		Inject(c, h)
+		So(h.Get(TraceparentHeader), ShouldEqual, FormatTraceparent(s.SpanContext()))
+
+		_, server := StartSpan(Extract(context.Background(), h), "server", SpanKindServer)
+		So(server.SpanContext().TraceID, ShouldEqual, s.SpanContext().TraceID)
+		So(server.data.ParentSpanID, ShouldEqual, s.SpanContext().SpanID)
+	})
+}
@@ -0,0 +1,260 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package trace implements request tracing spans compatible with
+// OpenTelemetry.
+//
+// A span covers a single operation (an RPC, an HTTP request, a datastore
+// transaction) and is carried in the context. Spans started from a context
+// that already has a span become its children, so a tree of spans describes
+// where the time of a request went. Span identifiers are propagated between
+// processes using the W3C Trace Context "traceparent" HTTP header (see Inject
+// and Extract), so a single trace can cover several services.
+//
+// Finished spans are passed to the Exporter installed in the context with
+// SetExporter. If there's no exporter, spans are still created and propagated
+// (so that downstream services can record them), but not recorded.
+package trace
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/data/rand/mathrand"
+)
+
+// TraceID identifies a trace, i.e. a tree of spans.
+type TraceID [16]byte
+
+// IsValid returns true if the ID is not all zeroes.
+func (id TraceID) IsValid() bool { return id != TraceID{} }
+
+func (id TraceID) String() string { return hex.EncodeToString(id[:]) }
+
+// SpanID identifies a span within a trace.
+type SpanID [8]byte
+
+// IsValid returns true if the ID is not all zeroes.
+func (id SpanID) IsValid() bool { return id != SpanID{} }
+
+func (id SpanID) String() string { return hex.EncodeToString(id[:]) }
+
+// SpanContext is the part of a span that is propagated to child spans,
+// possibly in other processes.
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+
+	// Sampled is true if the span is recorded by the exporter.
+	Sampled bool
+}
+
+// IsValid returns true if both IDs are valid.
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID.IsValid() && sc.SpanID.IsValid()
+}
+
+// SpanKind describes the relationship between the span and its parent. The
+// values match OpenTelemetry's.
+type SpanKind int
+
+const (
+	// SpanKindInternal is an operation within the process.
+	SpanKindInternal SpanKind = 1
+	// SpanKindServer is the server side of a remote call.
+	SpanKindServer SpanKind = 2
+	// SpanKindClient is the client side of a remote call.
+	SpanKindClient SpanKind = 3
+)
+
+func (k SpanKind) String() string {
+	switch k {
+	case SpanKindInternal:
+		return "INTERNAL"
+	case SpanKindServer:
+		return "SERVER"
+	case SpanKindClient:
+		return "CLIENT"
+	default:
+		return fmt.Sprintf("SpanKind(%d)", int(k))
+	}
+}
+
+// StatusCode is the outcome of the span's operation. The values match
+// OpenTelemetry's.
+type StatusCode int
+
+const (
+	// StatusUnset means the status wasn't set.
+	StatusUnset StatusCode = 0
+	// StatusOK means the operation succeeded.
+	StatusOK StatusCode = 1
+	// StatusError means the operation failed.
+	StatusError StatusCode = 2
+)
+
+// SpanData is a snapshot of a span passed to exporters.
+type SpanData struct {
+	SpanContext
+
+	Name         string
+	ParentSpanID SpanID // zero for root spans
+	Kind         SpanKind
+	Start        time.Time
+	End          time.Time
+	Attributes   map[string]interface{}
+
+	StatusCode    StatusCode
+	StatusMessage string
+}
+
+// Span is an operation being traced. Create it with StartSpan and finish it
+// with End.
+//
+// Span's methods are safe for concurrent use.
+type Span struct {
+	c context.Context // used for the clock and the exporter
+
+	lock  sync.Mutex
+	data  SpanData
+	ended bool
+}
+
+type key int
+
+const (
+	spanKey key = iota
+	remoteParentKey
+	exporterKey
+)
+
+// SetExporter returns a context with the given exporter installed. Spans
+// ended in this context are passed to the exporter.
+func SetExporter(c context.Context, e Exporter) context.Context {
+	return context.WithValue(c, exporterKey, e)
+}
+
+// GetExporter returns the exporter installed in the context, or nil.
+func GetExporter(c context.Context) Exporter {
+	e, _ := c.Value(exporterKey).(Exporter)
+	return e
+}
+
+// SpanFromContext returns the current span, or nil if there's none.
+func SpanFromContext(c context.Context) *Span {
+	s, _ := c.Value(spanKey).(*Span)
+	return s
+}
+
+// WithRemoteParent returns a context in which new spans are children of the
+// given span from another process.
+//
+// Usually it is called by Extract.
+func WithRemoteParent(c context.Context, sc SpanContext) context.Context {
+	return context.WithValue(c, remoteParentKey, sc)
+}
+
+// StartSpan starts a new span and returns a context that carries it.
+//
+// The span is a child of the current span in the context, or of the remote
+// parent set by WithRemoteParent. Otherwise it starts a new trace, which is
+// sampled if the context has an exporter.
+func StartSpan(c context.Context, name string, kind SpanKind) (context.Context, *Span) {
+	s := &Span{
+		c: c,
+		data: SpanData{
+			Name:  name,
+			Kind:  kind,
+			Start: clock.Now(c),
+		},
+	}
+
+	var parent SpanContext
+	if p := SpanFromContext(c); p != nil {
+		parent = p.SpanContext()
+	} else if p, ok := c.Value(remoteParentKey).(SpanContext); ok {
+		parent = p
+	}
+
+	rnd := mathrand.Get(c)
+	if parent.IsValid() {
+		s.data.TraceID = parent.TraceID
+		s.data.ParentSpanID = parent.SpanID
+		s.data.Sampled = parent.Sampled
+	} else {
+		for !s.data.TraceID.IsValid() {
+			rnd.Read(s.data.TraceID[:])
+		}
+		s.data.Sampled = GetExporter(c) != nil
+	}
+	for !s.data.SpanID.IsValid() {
+		rnd.Read(s.data.SpanID[:])
+	}
+
+	return context.WithValue(c, spanKey, s), s
+}
+
+// SpanContext returns the identifiers of the span.
+func (s *Span) SpanContext() SpanContext {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.data.SpanContext
+}
+
+// SetAttribute records a key-value pair describing the operation.
+//
+// The value should be a string, bool, integer or float. Ignored after End.
+func (s *Span) SetAttribute(key string, value interface{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.ended {
+		return
+	}
+	if s.data.Attributes == nil {
+		s.data.Attributes = map[string]interface{}{}
+	}
+	s.data.Attributes[key] = value
+}
+
+// SetStatus sets the outcome of the operation. Ignored after End.
+func (s *Span) SetStatus(code StatusCode, message string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.ended {
+		return
+	}
+	s.data.StatusCode = code
+	s.data.StatusMessage = message
+}
+
+// SetError marks the span as failed if err is not nil.
+func (s *Span) SetError(err error) {
+	if err != nil {
+		s.SetStatus(StatusError, err.Error())
+	}
+}
+
+// End finishes the span and passes it to the exporter if it is sampled.
+//
+// Calls after the first one are ignored.
+func (s *Span) End() {
+	s.lock.Lock()
+	if s.ended {
+		s.lock.Unlock()
+		return
+	}
+	s.ended = true
+	s.data.End = clock.Now(s.c)
+	data := s.data
+	s.lock.Unlock()
+
+	if e := GetExporter(s.c); e != nil && data.Sampled {
+		e.ExportSpan(s.c, &data)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package trace
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/data/rand/mathrand"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// memExporter collects exported spans in memory.
+type memExporter struct {
+	lock  sync.Mutex
+	spans []*SpanData
+}
+
+func (e *memExporter) ExportSpan(c context.Context, s *SpanData) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.spans = append(e.spans, s)
+}
+
+func (e *memExporter) Flush(c context.Context) error { return nil }
+
+func TestSpans(t *testing.T) {
+	Convey("With a context", t, func() {
+		c, tc := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+		c = mathrand.Set(c, rand.New(rand.NewSource(1)))
+		e := &memExporter{}
+
+		Convey("Without an exporter spans are not sampled", func() {
+			c, s := StartSpan(c, "root", SpanKindInternal)
+			So(s.SpanContext().IsValid(), ShouldBeTrue)
+			So(s.SpanContext().Sampled, ShouldBeFalse)
+			So(SpanFromContext(c), ShouldEqual, s)
+			s.End()
+		})
+
+		Convey("Spans form a tree", func() {
+			c = SetExporter(c, e)
+
+			c1, root := StartSpan(c, "root", SpanKindServer)
+			root.SetAttribute("k", "v")
+			tc.Add(time.Second)
+
+			_, child := StartSpan(c1, "child", SpanKindClient)
+			tc.Add(time.Second)
+			child.SetError(errors.New("boom"))
+			child.End()
+			root.End()
+			root.End() // ignored
+			root.SetAttribute("late", true)
+
+			So(len(e.spans), ShouldEqual, 2)
+			ch, rt := e.spans[0], e.spans[1]
+
+			So(rt.Name, ShouldEqual, "root")
+			So(rt.Kind, ShouldEqual, SpanKindServer)
+			So(rt.Sampled, ShouldBeTrue)
+			So(rt.ParentSpanID.IsValid(), ShouldBeFalse)
+			So(rt.Start, ShouldResemble, testclock.TestTimeUTC)
+			So(rt.End, ShouldResemble, testclock.TestTimeUTC.Add(2*time.Second))
+			So(rt.Attributes, ShouldResemble, map[string]interface{}{"k": "v"})
+			So(rt.StatusCode, ShouldEqual, StatusUnset)
+
+			So(ch.Name, ShouldEqual, "child")
+			So(ch.TraceID, ShouldEqual, rt.TraceID)
+			So(ch.ParentSpanID, ShouldEqual, rt.SpanID)
+			So(ch.SpanID, ShouldNotEqual, rt.SpanID)
+			So(ch.Start, ShouldResemble, testclock.TestTimeUTC.Add(time.Second))
+			So(ch.StatusCode, ShouldEqual, StatusError)
+			So(ch.StatusMessage, ShouldEqual, "boom")
+		})
+
+		Convey("Remote parent is respected", func() {
+			c = SetExporter(c, e)
+			parent := SpanContext{
+				TraceID: TraceID{1, 2, 3},
+				SpanID:  SpanID{4, 5, 6},
+			}
+
+			_, s := StartSpan(WithRemoteParent(c, parent), "s", SpanKindServer)
+			s.End()
+
+			sc := s.SpanContext()
+			So(sc.TraceID, ShouldEqual, parent.TraceID)
+			So(sc.Sampled, ShouldBeFalse)
+			So(e.spans, ShouldBeEmpty)
+
+			parent.Sampled = true
+			_, s = StartSpan(WithRemoteParent(c, parent), "s", SpanKindServer)
+			s.End()
+			So(len(e.spans), ShouldEqual, 1)
+			So(e.spans[0].ParentSpanID, ShouldEqual, parent.SpanID)
+		})
+	})
+}
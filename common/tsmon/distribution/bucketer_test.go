@@ -5,6 +5,7 @@
 package distribution
 
 import (
+	"math"
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -35,6 +36,14 @@ func TestFixedWidthBucketer(t *testing.T) {
 		So(b.Bucket(10), ShouldEqual, 2)
 		So(b.Bucket(100), ShouldEqual, 2)
 	})
+
+	Convey("Upper bounds", t, func() {
+		b := FixedWidthBucketer(10, 2)
+		So(b.UpperBound(0), ShouldEqual, 0)
+		So(b.UpperBound(1), ShouldEqual, 10)
+		So(b.UpperBound(2), ShouldEqual, 20)
+		So(b.UpperBound(3), ShouldEqual, math.Inf(1))
+	})
 }
 
 func TestGeometricBucketer(t *testing.T) {
@@ -17,6 +17,10 @@ import (
 type Field struct {
 	Name string
 	Type pb.MetricsField_FieldType
+
+	// AllowedValues, if not empty, is the set of values this field may take.
+	// Use WithAllowedValues to set it.
+	AllowedValues []interface{}
 }
 
 func (f Field) String() string {
@@ -24,14 +28,45 @@ func (f Field) String() string {
 }
 
 // String returns a new string-typed field.
-func String(name string) Field { return Field{name, pb.MetricsField_STRING} }
+func String(name string) Field { return Field{Name: name, Type: pb.MetricsField_STRING} }
 
 // Bool returns a new bool-typed field.
-func Bool(name string) Field { return Field{name, pb.MetricsField_BOOL} }
+func Bool(name string) Field { return Field{Name: name, Type: pb.MetricsField_BOOL} }
 
 // Int returns a new int-typed field.  Internally values for these fields are
 // stored as int64s.
-func Int(name string) Field { return Field{name, pb.MetricsField_INT} }
+func Int(name string) Field { return Field{Name: name, Type: pb.MetricsField_INT} }
+
+// WithAllowedValues returns a copy of the field that only accepts the given
+// values.  Setting a metric with any other value of this field fails.
+//
+// Use it for fields whose values come from an unbounded source (user input,
+// URL paths) to keep the number of metric cells under control.  Panics if a
+// value has the wrong type.
+func (f Field) WithAllowedValues(values ...interface{}) Field {
+	f.AllowedValues = make([]interface{}, len(values))
+	for i, v := range values {
+		cv, ok := canonicalValue(f.Type, v)
+		if !ok {
+			panic(fmt.Sprintf("field %s: allowed value %T(%v) is not %v", f.Name, v, v, f.Type))
+		}
+		f.AllowedValues[i] = cv
+	}
+	return f
+}
+
+// IsAllowed returns true if the canonical value v is allowed by the field.
+func (f Field) IsAllowed(v interface{}) bool {
+	if len(f.AllowedValues) == 0 {
+		return true
+	}
+	for _, a := range f.AllowedValues {
+		if a == v {
+			return true
+		}
+	}
+	return false
+}
 
 // Serialize returns a slice of ts_mon_proto.MetricsField messages representing
 // the field names, types and values.
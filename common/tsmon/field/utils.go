@@ -22,32 +22,41 @@ func Canonicalize(fields []Field, fieldVals []interface{}) ([]interface{}, error
 
 	out := make([]interface{}, 0, len(fields))
 	for i, f := range fields {
-		fv, ok := fieldVals[i], false
-
-		switch f.Type {
-		case pb.MetricsField_STRING:
-			_, ok = fv.(string)
-		case pb.MetricsField_BOOL:
-			_, ok = fv.(bool)
-		case pb.MetricsField_INT:
-			if _, ok = fv.(int64); !ok {
-				if fvi, oki := fv.(int); oki {
-					fv, ok = int64(fvi), true
-				} else if fvi, oki := fv.(int32); oki {
-					fv, ok = int64(fvi), true
-				}
-			}
-		}
-
+		fv, ok := canonicalValue(f.Type, fieldVals[i])
 		if !ok {
 			return nil, fmt.Errorf(
-				"metric: field %s = %T(%v), want %v", f.Name, fv, fv, f.Type)
+				"metric: field %s = %T(%v), want %v", f.Name, fieldVals[i], fieldVals[i], f.Type)
+		}
+		if !f.IsAllowed(fv) {
+			return nil, fmt.Errorf(
+				"metric: field %s = %v is not one of the allowed values %v", f.Name, fv, f.AllowedValues)
 		}
 		out = append(out, fv)
 	}
 	return out, nil
 }
 
+// canonicalValue converts fv to the canonical type for the field type.  It
+// returns false if fv has the wrong type.
+func canonicalValue(typ pb.MetricsField_FieldType, fv interface{}) (interface{}, bool) {
+	ok := false
+	switch typ {
+	case pb.MetricsField_STRING:
+		_, ok = fv.(string)
+	case pb.MetricsField_BOOL:
+		_, ok = fv.(bool)
+	case pb.MetricsField_INT:
+		if _, ok = fv.(int64); !ok {
+			if fvi, oki := fv.(int); oki {
+				fv, ok = int64(fvi), true
+			} else if fvi, oki := fv.(int32); oki {
+				fv, ok = int64(fvi), true
+			}
+		}
+	}
+	return fv, ok
+}
+
 // Hash returns a uint64 hash of fieldVals.
 func Hash(fieldVals []interface{}) uint64 {
 	if len(fieldVals) == 0 {
@@ -100,6 +100,26 @@ func TestCanonicalize(t *testing.T) {
 			values: makeInterfaceSlice(true),
 			want:   makeInterfaceSlice(true),
 		},
+		{
+			fields: []Field{String("foo").WithAllowedValues("a", "b")},
+			values: makeInterfaceSlice("b"),
+			want:   makeInterfaceSlice("b"),
+		},
+		{
+			fields: []Field{String("foo").WithAllowedValues("a", "b")},
+			values: makeInterfaceSlice("c"),
+			want:   nil,
+		},
+		{
+			fields: []Field{Int("foo").WithAllowedValues(1, 2)},
+			values: makeInterfaceSlice(int32(2)),
+			want:   makeInterfaceSlice(int64(2)),
+		},
+		{
+			fields: []Field{Int("foo").WithAllowedValues(1, 2)},
+			values: makeInterfaceSlice(3),
+			want:   nil,
+		},
 	}
 
 	for i, d := range data {
@@ -117,6 +137,20 @@ func TestCanonicalize(t *testing.T) {
 	}
 }
 
+func TestWithAllowedValues(t *testing.T) {
+	Convey("Wrong types panic", t, func() {
+		So(func() { String("foo").WithAllowedValues(1) }, ShouldPanic)
+		So(func() { Int("foo").WithAllowedValues("a") }, ShouldPanic)
+	})
+
+	Convey("Doesn't modify the original field", t, func() {
+		f := String("foo")
+		f.WithAllowedValues("a")
+		So(f.AllowedValues, ShouldBeNil)
+		So(f.IsAllowed("b"), ShouldBeTrue)
+	})
+}
+
 func TestHash(t *testing.T) {
 	Convey("Empty slice hashes to 0", t, func() {
 		So(Hash([]interface{}{}), ShouldEqual, 0)
@@ -8,6 +8,7 @@ import (
 	"flag"
 	"time"
 
+	"github.com/luci/luci-go/common/tsmon/store"
 	"github.com/luci/luci-go/common/tsmon/target"
 )
 
@@ -27,6 +28,10 @@ type Flags struct {
 	Flush         FlushType
 	FlushInterval time.Duration
 
+	MaxCells          int
+	MaxCellsPerMetric int
+	Overflow          store.OverflowPolicy
+
 	Target target.Flags
 }
 
@@ -39,6 +44,10 @@ func NewFlags() Flags {
 		Flush:         FlushAuto,
 		FlushInterval: time.Minute,
 
+		MaxCells:          0,
+		MaxCellsPerMetric: 0,
+		Overflow:          store.DropOverflow,
+
 		Target: target.NewFlags(),
 	}
 }
@@ -52,7 +61,8 @@ func (fl *Flags) Register(f *flag.FlagSet) {
 			"depend on the machine's position in the network, IP whitelisting and "+
 			"deployment of credentials.")
 	f.StringVar(&fl.Endpoint, "ts-mon-endpoint", fl.Endpoint,
-		"url (including file://, pubsub://project/topic) to post monitoring "+
+		"url (including file://, pubsub://project/topic, "+
+			"prometheus+http://pushgateway/metrics/job/name) to post monitoring "+
 			"metrics to. If set, overrides the value in --ts-mon-config-file")
 	f.StringVar(&fl.Credentials, "ts-mon-credentials", fl.Credentials,
 		"path to a pkcs8 json credential file. If set, overrides the value in "+
@@ -62,6 +72,17 @@ func (fl *Flags) Register(f *flag.FlagSet) {
 			"(send automatically every --ts-mon-flush-interval)")
 	f.DurationVar(&fl.FlushInterval, "ts-mon-flush-interval", fl.FlushInterval,
 		"automatically push metrics on this interval if --ts-mon-flush=auto")
+	f.IntVar(&fl.MaxCells, "ts-mon-max-cells", fl.MaxCells,
+		"maximum number of metric cells held in memory across all metrics, or 0 "+
+			"for no limit")
+	f.IntVar(&fl.MaxCellsPerMetric, "ts-mon-max-cells-per-metric", fl.MaxCellsPerMetric,
+		"maximum number of metric cells held in memory for each metric, or 0 for "+
+			"no limit")
+	f.Var(&fl.Overflow, "ts-mon-overflow",
+		"what to do with new metric cells over the limits: drop (count them in "+
+			"tsmon/store/dropped_cells), or collapse (merge them into one cell per "+
+			"metric with string and int fields set to placeholders, e.g. "+
+			"\"__other__\", and bool fields kept as is)")
 
 	fl.Target.Register(f)
 }
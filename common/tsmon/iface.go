@@ -105,7 +105,11 @@ func InitializeFromFlags(c context.Context, fl *Flags) error {
 		return err
 	}
 
-	Initialize(c, mon, store.NewInMemory(t))
+	Initialize(c, mon, store.NewInMemoryWithLimits(t, store.Limits{
+		MaxCells:          fl.MaxCells,
+		MaxCellsPerMetric: fl.MaxCellsPerMetric,
+		Overflow:          fl.Overflow,
+	}))
 
 	state := GetState(c)
 	if state.Flusher != nil {
@@ -187,6 +191,11 @@ func initMonitor(c context.Context, config config) (monitor.Monitor, error) {
 		}
 
 		return monitor.NewPubsubMonitor(c, client, gcps.NewTopic(endpointURL.Host, strings.TrimPrefix(endpointURL.Path, "/")))
+	case "prometheus+http", "prometheus+https":
+		// Push gateways are usually not authenticated.
+		pushURL := *endpointURL
+		pushURL.Scheme = strings.TrimPrefix(endpointURL.Scheme, "prometheus+")
+		return monitor.NewPrometheusPushMonitor(nil, pushURL.String()), nil
 	default:
 		return nil, fmt.Errorf("unknown tsmon endpoint url: %s", config.Endpoint)
 	}
@@ -0,0 +1,273 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package monitor
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
+	"golang.org/x/net/context/ctxhttp"
+
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/tsmon/distribution"
+	"github.com/luci/luci-go/common/tsmon/types"
+
+	pb "github.com/luci/luci-go/common/tsmon/ts_mon_proto"
+)
+
+// PrometheusContentType is the content type of the Prometheus text exposition
+// format produced by WritePrometheus.
+const PrometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// WritePrometheus writes cells in the Prometheus text exposition format.
+//
+// Metric names are sanitized to match [a-zA-Z_:][a-zA-Z0-9_:]*, so a metric
+// called "/chrome/infra/foo/bar" is exported as "chrome_infra_foo_bar".
+// Target fields and metric fields become labels.  Cumulative metrics are
+// exported as counters, non-cumulative ones as gauges, and distributions as
+// histograms.  Bool metrics are exported as 0 or 1 and string metrics as
+// gauges with value 1 and the string in the "value" label.
+func WritePrometheus(w io.Writer, cells []types.Cell) error {
+	// Cells of one metric must be written together, right after the metric's
+	// HELP and TYPE lines.
+	sorted := make([]types.Cell, len(cells))
+	copy(sorted, cells)
+	sort.Stable(cellsByName(sorted))
+
+	bw := bufio.NewWriter(w)
+	lastName := ""
+	for _, c := range sorted {
+		name := prometheusName(c.Name)
+		if name != lastName {
+			fmt.Fprintf(bw, "# HELP %s %s\n", name, escapeHelp(c.Description))
+			fmt.Fprintf(bw, "# TYPE %s %s\n", name, prometheusType(c.ValueType))
+			lastName = name
+		}
+		writePrometheusCell(bw, name, c)
+	}
+	return bw.Flush()
+}
+
+type prometheusPushMonitor struct {
+	client *http.Client
+	url    string
+}
+
+// NewPrometheusPushMonitor returns a Monitor that pushes metrics to a
+// Prometheus push gateway.
+//
+// The url should include the grouping key, e.g.
+// "http://pushgateway:9091/metrics/job/my_job".  Each Send replaces all
+// metrics previously pushed under that grouping key.  If client is nil,
+// http.DefaultClient is used.
+func NewPrometheusPushMonitor(client *http.Client, url string) Monitor {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &prometheusPushMonitor{
+		client: client,
+		url:    url,
+	}
+}
+
+func (m *prometheusPushMonitor) ChunkSize() int {
+	// Each push replaces the whole group, so all cells must be sent at once.
+	return 0
+}
+
+func (m *prometheusPushMonitor) Send(ctx context.Context, cells []types.Cell) error {
+	buf := bytes.Buffer{}
+	if err := WritePrometheus(&buf, cells); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", m.url, &buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", PrometheusContentType)
+
+	resp, err := ctxhttp.Do(ctx, m.client, req)
+	if err != nil {
+		logging.Errorf(ctx, "Prometheus push error - %s", err)
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		err = fmt.Errorf("prometheus push gateway replied with HTTP %d: %s", resp.StatusCode, body)
+		logging.Errorf(ctx, "Prometheus push error - %s", err)
+		return err
+	}
+	logging.Debugf(ctx, "Pushed %d tsmon cells to %s", len(cells), m.url)
+	return nil
+}
+
+type cellsByName []types.Cell
+
+func (c cellsByName) Len() int           { return len(c) }
+func (c cellsByName) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+func (c cellsByName) Less(i, j int) bool { return c[i].Name < c[j].Name }
+
+// prometheusLabel is a single name="value" pair.
+type prometheusLabel struct {
+	name, value string
+}
+
+func writePrometheusCell(w io.Writer, name string, c types.Cell) {
+	labels := prometheusLabels(c)
+
+	switch c.ValueType {
+	case types.NonCumulativeIntType, types.CumulativeIntType:
+		writeSample(w, name, labels, strconv.FormatInt(c.Value.(int64), 10))
+	case types.NonCumulativeFloatType, types.CumulativeFloatType:
+		writeSample(w, name, labels, formatFloat(c.Value.(float64)))
+	case types.BoolType:
+		v := "0"
+		if c.Value.(bool) {
+			v = "1"
+		}
+		writeSample(w, name, labels, v)
+	case types.StringType:
+		labels = append(labels, prometheusLabel{"value", c.Value.(string)})
+		writeSample(w, name, labels, "1")
+	case types.NonCumulativeDistributionType, types.CumulativeDistributionType:
+		writeHistogram(w, name, labels, c.Value.(*distribution.Distribution))
+	}
+}
+
+// writeHistogram writes the _bucket, _sum and _count samples of a
+// distribution.  Prometheus buckets are cumulative, so each bucket's count
+// includes the counts of all the buckets below it.
+func writeHistogram(w io.Writer, name string, labels []prometheusLabel, d *distribution.Distribution) {
+	b := d.Bucketer()
+	buckets := d.Buckets()
+
+	var total int64
+	for i := 0; i < b.OverflowBucket(); i++ {
+		if i < len(buckets) {
+			total += buckets[i]
+		}
+		le := prometheusLabel{"le", formatFloat(b.UpperBound(i))}
+		writeSample(w, name+"_bucket", append(labels[:len(labels):len(labels)], le), strconv.FormatInt(total, 10))
+	}
+	le := prometheusLabel{"le", "+Inf"}
+	writeSample(w, name+"_bucket", append(labels[:len(labels):len(labels)], le), strconv.FormatInt(d.Count(), 10))
+	writeSample(w, name+"_sum", labels, formatFloat(d.Sum()))
+	writeSample(w, name+"_count", labels, strconv.FormatInt(d.Count(), 10))
+}
+
+func writeSample(w io.Writer, name string, labels []prometheusLabel, value string) {
+	io.WriteString(w, name)
+	if len(labels) > 0 {
+		io.WriteString(w, "{")
+		for i, l := range labels {
+			if i > 0 {
+				io.WriteString(w, ",")
+			}
+			fmt.Fprintf(w, "%s=\"%s\"", l.name, escapeLabelValue(l.value))
+		}
+		io.WriteString(w, "}")
+	}
+	fmt.Fprintf(w, " %s\n", value)
+}
+
+// prometheusLabels returns the target fields followed by the metric fields of
+// the cell.
+func prometheusLabels(c types.Cell) []prometheusLabel {
+	var ret []prometheusLabel
+
+	if c.Target != nil {
+		d := pb.MetricsData{}
+		c.Target.PopulateProto(&d)
+		if t := d.Task; t != nil {
+			ret = append(ret,
+				prometheusLabel{"service_name", t.GetServiceName()},
+				prometheusLabel{"job_name", t.GetJobName()},
+				prometheusLabel{"data_center", t.GetDataCenter()},
+				prometheusLabel{"host_name", t.GetHostName()},
+				prometheusLabel{"task_num", strconv.FormatInt(int64(t.GetTaskNum()), 10)})
+		}
+		if n := d.NetworkDevice; n != nil {
+			ret = append(ret,
+				prometheusLabel{"alertable", strconv.FormatBool(n.GetAlertable())},
+				prometheusLabel{"realm", n.GetRealm()},
+				prometheusLabel{"metro", n.GetMetro()},
+				prometheusLabel{"role", n.GetRole()},
+				prometheusLabel{"hostname", n.GetHostname()},
+				prometheusLabel{"hostgroup", n.GetHostgroup()})
+		}
+	}
+
+	for i, f := range c.Fields {
+		ret = append(ret, prometheusLabel{prometheusName(f.Name), fmt.Sprint(c.FieldVals[i])})
+	}
+	return ret
+}
+
+func prometheusType(t types.ValueType) string {
+	switch t {
+	case types.CumulativeIntType, types.CumulativeFloatType:
+		return "counter"
+	case types.NonCumulativeDistributionType, types.CumulativeDistributionType:
+		return "histogram"
+	default:
+		return "gauge"
+	}
+}
+
+// prometheusName converts a tsmon metric or field name into a valid Prometheus
+// name by replacing all invalid characters with underscores.
+func prometheusName(name string) string {
+	name = strings.TrimLeft(name, "/")
+	ret := make([]byte, 0, len(name)+1)
+	for i := 0; i < len(name); i++ {
+		ch := name[i]
+		switch {
+		case ch >= 'a' && ch <= 'z', ch >= 'A' && ch <= 'Z', ch == '_', ch == ':':
+		case ch >= '0' && ch <= '9':
+			if i == 0 {
+				ret = append(ret, '_')
+			}
+		default:
+			ch = '_'
+		}
+		ret = append(ret, ch)
+	}
+	if len(ret) == 0 {
+		return "_"
+	}
+	return string(ret)
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string       { return helpEscaper.Replace(s) }
+func escapeLabelValue(s string) string { return labelEscaper.Replace(s) }
@@ -0,0 +1,207 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package monitor
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/tsmon/distribution"
+	"github.com/luci/luci-go/common/tsmon/field"
+	"github.com/luci/luci-go/common/tsmon/target"
+	"github.com/luci/luci-go/common/tsmon/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPrometheusName(t *testing.T) {
+	Convey("prometheusName", t, func() {
+		So(prometheusName("/chrome/infra/foo/bar"), ShouldEqual, "chrome_infra_foo_bar")
+		So(prometheusName("foo.bar-baz:qux"), ShouldEqual, "foo_bar_baz:qux")
+		So(prometheusName("1foo"), ShouldEqual, "_1foo")
+		So(prometheusName(""), ShouldEqual, "_")
+	})
+}
+
+func TestWritePrometheus(t *testing.T) {
+	task := &target.Task{
+		ServiceName: proto.String("service"),
+		JobName:     proto.String("job"),
+		DataCenter:  proto.String("dc"),
+		HostName:    proto.String("host"),
+		TaskNum:     proto.Int32(1),
+	}
+	taskLabels := `service_name="service",job_name="job",data_center="dc",host_name="host",task_num="1"`
+
+	render := func(cells ...types.Cell) string {
+		buf := bytes.Buffer{}
+		So(WritePrometheus(&buf, cells), ShouldBeNil)
+		return buf.String()
+	}
+
+	Convey("Int counter with fields", t, func() {
+		So(render(types.Cell{
+			types.MetricInfo{
+				Name:        "/foo/count",
+				Description: "Counts\nthings",
+				Fields:      []field.Field{field.String("status"), field.Bool("ok")},
+				ValueType:   types.CumulativeIntType,
+			},
+			types.MetricMetadata{},
+			types.CellData{
+				FieldVals: []interface{}{`a"b`, true},
+				Target:    task,
+				ResetTime: time.Unix(1234, 0),
+				Value:     int64(42),
+			},
+		}), ShouldEqual,
+			"# HELP foo_count Counts\\nthings\n"+
+				"# TYPE foo_count counter\n"+
+				"foo_count{"+taskLabels+`,status="a\"b",ok="true"} 42`+"\n")
+	})
+
+	Convey("Cells are grouped by metric", t, func() {
+		cell := func(name string, v float64) types.Cell {
+			return types.Cell{
+				types.MetricInfo{
+					Name:      name,
+					Fields:    []field.Field{field.Int("n")},
+					ValueType: types.NonCumulativeFloatType,
+				},
+				types.MetricMetadata{},
+				types.CellData{FieldVals: []interface{}{int64(v)}, Value: v},
+			}
+		}
+		So(render(cell("b", 1), cell("a", 2), cell("b", 3)), ShouldEqual,
+			"# HELP a \n# TYPE a gauge\n"+
+				"a{n=\"2\"} 2\n"+
+				"# HELP b \n# TYPE b gauge\n"+
+				"b{n=\"1\"} 1\n"+
+				"b{n=\"3\"} 3\n")
+	})
+
+	Convey("Bool and string", t, func() {
+		So(render(
+			types.Cell{
+				types.MetricInfo{Name: "bool", ValueType: types.BoolType},
+				types.MetricMetadata{},
+				types.CellData{Value: true},
+			},
+			types.Cell{
+				types.MetricInfo{Name: "str", ValueType: types.StringType},
+				types.MetricMetadata{},
+				types.CellData{Value: "v1"},
+			}), ShouldEqual,
+			"# HELP bool \n# TYPE bool gauge\nbool 1\n"+
+				"# HELP str \n# TYPE str gauge\nstr{value=\"v1\"} 1\n")
+	})
+
+	Convey("Network device target", t, func() {
+		So(render(types.Cell{
+			types.MetricInfo{Name: "x", ValueType: types.NonCumulativeIntType},
+			types.MetricMetadata{},
+			types.CellData{
+				Target: &target.NetworkDevice{
+					Alertable: proto.Bool(true),
+					Realm:     proto.String("realm"),
+					Metro:     proto.String("metro"),
+					Role:      proto.String("role"),
+					Hostname:  proto.String("host"),
+					Hostgroup: proto.String("group"),
+				},
+				Value: int64(-1),
+			},
+		}), ShouldEqual,
+			"# HELP x \n# TYPE x gauge\n"+
+				`x{alertable="true",realm="realm",metro="metro",role="role",hostname="host",hostgroup="group"} -1`+"\n")
+	})
+
+	Convey("Distribution", t, func() {
+		d := distribution.New(distribution.FixedWidthBucketer(10, 2))
+		d.Add(5)
+		d.Add(15)
+		d.Add(15)
+		d.Add(100)
+
+		So(render(types.Cell{
+			types.MetricInfo{
+				Name:      "latency",
+				Fields:    []field.Field{field.String("f")},
+				ValueType: types.CumulativeDistributionType,
+			},
+			types.MetricMetadata{},
+			types.CellData{FieldVals: []interface{}{"v"}, Value: d},
+		}), ShouldEqual,
+			"# HELP latency \n# TYPE latency histogram\n"+
+				"latency_bucket{f=\"v\",le=\"0\"} 0\n"+
+				"latency_bucket{f=\"v\",le=\"10\"} 1\n"+
+				"latency_bucket{f=\"v\",le=\"20\"} 3\n"+
+				"latency_bucket{f=\"v\",le=\"+Inf\"} 4\n"+
+				"latency_sum{f=\"v\"} 135\n"+
+				"latency_count{f=\"v\"} 4\n")
+	})
+
+	Convey("Distribution with omitted trailing buckets", t, func() {
+		d := distribution.New(distribution.FixedWidthBucketer(10, 2))
+		d.Add(5)
+
+		So(render(types.Cell{
+			types.MetricInfo{Name: "latency", ValueType: types.NonCumulativeDistributionType},
+			types.MetricMetadata{},
+			types.CellData{Value: d},
+		}), ShouldEqual,
+			"# HELP latency \n# TYPE latency histogram\n"+
+				"latency_bucket{le=\"0\"} 0\n"+
+				"latency_bucket{le=\"10\"} 1\n"+
+				"latency_bucket{le=\"20\"} 1\n"+
+				"latency_bucket{le=\"+Inf\"} 1\n"+
+				"latency_sum 5\n"+
+				"latency_count 1\n")
+	})
+}
+
+func TestPrometheusPushMonitor(t *testing.T) {
+	Convey("With a fake push gateway", t, func() {
+		c := context.Background()
+		var method, contentType, body string
+		status := http.StatusAccepted
+		ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			method = r.Method
+			contentType = r.Header.Get("Content-Type")
+			blob, _ := ioutil.ReadAll(r.Body)
+			body = string(blob)
+			rw.WriteHeader(status)
+		}))
+		defer ts.Close()
+
+		m := NewPrometheusPushMonitor(nil, ts.URL+"/metrics/job/test")
+		So(m.ChunkSize(), ShouldEqual, 0)
+
+		cells := []types.Cell{{
+			types.MetricInfo{Name: "foo", ValueType: types.NonCumulativeIntType},
+			types.MetricMetadata{},
+			types.CellData{Value: int64(1)},
+		}}
+
+		Convey("Pushes metrics", func() {
+			So(m.Send(c, cells), ShouldBeNil)
+			So(method, ShouldEqual, "PUT")
+			So(contentType, ShouldEqual, PrometheusContentType)
+			So(body, ShouldEqual, "# HELP foo \n# TYPE foo gauge\nfoo 1\n")
+		})
+
+		Convey("Fails on bad status", func() {
+			status = http.StatusBadRequest
+			So(m.Send(c, cells), ShouldNotBeNil)
+		})
+	})
+}
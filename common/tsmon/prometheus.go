@@ -0,0 +1,35 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tsmon
+
+import (
+	"bytes"
+	"net/http"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/tsmon/monitor"
+)
+
+// PrometheusHandler returns an http.Handler that serves the current contents
+// of the global store in the Prometheus text exposition format, to be scraped
+// by a Prometheus server.
+//
+// The store is looked up in the context on every request, so the handler can
+// be installed before tsmon is initialized.
+func PrometheusHandler(c context.Context) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		buf := bytes.Buffer{}
+		if err := monitor.WritePrometheus(&buf, Store(c).GetAll(c)); err != nil {
+			logging.Errorf(c, "Failed to render tsmon metrics - %s", err)
+			http.Error(rw, "Failed to render metrics", http.StatusInternalServerError)
+			return
+		}
+		rw.Header().Set("Content-Type", monitor.PrometheusContentType)
+		rw.WriteHeader(http.StatusOK)
+		rw.Write(buf.Bytes())
+	})
+}
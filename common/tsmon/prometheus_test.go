@@ -0,0 +1,51 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tsmon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/tsmon/monitor"
+	"github.com/luci/luci-go/common/tsmon/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPrometheus(t *testing.T) {
+	Convey("PrometheusHandler serves the store", t, func() {
+		c, s, _ := WithFakes(context.Background())
+		s.Cells = []types.Cell{{
+			types.MetricInfo{
+				Name:        "/foo/bar",
+				Description: "Description",
+				ValueType:   types.CumulativeIntType,
+			},
+			types.MetricMetadata{},
+			types.CellData{Value: int64(5)},
+		}}
+
+		rec := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/metrics", nil)
+		PrometheusHandler(c).ServeHTTP(rec, req)
+
+		So(rec.Code, ShouldEqual, http.StatusOK)
+		So(rec.HeaderMap.Get("Content-Type"), ShouldEqual, monitor.PrometheusContentType)
+		So(rec.Body.String(), ShouldEqual,
+			"# HELP foo_bar Description\n# TYPE foo_bar counter\nfoo_bar 5\n")
+	})
+
+	Convey("prometheus+http endpoint creates a push monitor", t, func() {
+		m, err := initMonitor(context.Background(), config{
+			Endpoint: "prometheus+http://localhost:9091/metrics/job/test",
+		})
+		So(err, ShouldBeNil)
+		So(m, ShouldNotBeNil)
+		So(m.ChunkSize(), ShouldEqual, 0)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/luci/luci-go/common/clock"
@@ -24,6 +25,9 @@ type inMemoryStore struct {
 
 	data     map[string]*metricData
 	dataLock sync.RWMutex
+
+	limits   Limits
+	numCells int64 // accessed atomically
 }
 
 type cellKey struct {
@@ -34,45 +38,137 @@ type metricData struct {
 	types.MetricInfo
 	types.MetricMetadata
 
-	cells map[cellKey][]*types.CellData
-	lock  sync.Mutex
+	store    *inMemoryStore
+	cells    map[cellKey][]*types.CellData
+	overflow map[cellKey]*types.CellData // collapsed cells of CollapseOverflow policy
+	numCells int                         // including the overflow cells
+	lock     sync.Mutex
+
+	// Writes dropped because of the store's limits, and the reset time of the
+	// first of them.
+	dropped      int64
+	droppedSince time.Time
 }
 
-func (m *metricData) get(fieldVals []interface{}, t types.Target, resetTime time.Time) (*types.CellData, error) {
+// get returns the cell for the given field values and target, creating it if
+// necessary.
+//
+// If the cell doesn't exist and the store's limits don't allow creating it,
+// get returns nil (for DropOverflow) or the metric's overflow cell for the
+// field values (for CollapseOverflow).  Dropped writes are counted if isWrite
+// is true.
+func (m *metricData) get(fieldVals []interface{}, t types.Target, resetTime time.Time, isWrite bool) (*types.CellData, error) {
 	fieldVals, err := field.Canonicalize(m.Fields, fieldVals)
 	if err != nil {
 		return nil, err
 	}
 
-	key := cellKey{fieldValuesHash: field.Hash(fieldVals)}
-	if t != nil {
-		key.targetHash = t.Hash()
+	if cell := m.find(fieldVals, t); cell != nil {
+		return cell, nil
 	}
 
-	cells, ok := m.cells[key]
-	if ok {
-		for _, cell := range cells {
-			if reflect.DeepEqual(fieldVals, cell.FieldVals) &&
-				reflect.DeepEqual(t, cell.Target) {
-				return cell, nil
+	if !m.store.reserveCell(m) {
+		if m.store.limits.Overflow != CollapseOverflow {
+			if isWrite {
+				if m.dropped == 0 {
+					m.droppedSince = resetTime
+				}
+				m.dropped++
+			}
+			return nil, nil
+		}
+
+		collapsed := collapsedFieldValues(m.Fields, fieldVals)
+		key := cellKeyFor(collapsed, nil)
+		cell := m.overflow[key]
+		if cell == nil {
+			// reserveCell keeps a slot of the per-metric limit for the first
+			// overflow cell, but the global limit may be exhausted by other
+			// metrics, and there may be more overflow cells if the metric has bool
+			// fields. Create it anyway, otherwise there would be nowhere to
+			// collapse into.
+			atomic.AddInt64(&m.store.numCells, 1)
+			m.numCells++
+			if m.overflow == nil {
+				m.overflow = map[cellKey]*types.CellData{}
 			}
+			cell = &types.CellData{collapsed, nil, resetTime, nil}
+			m.overflow[key] = cell
 		}
+		return cell, nil
 	}
 
+	key := cellKeyFor(fieldVals, t)
 	cell := &types.CellData{fieldVals, t, resetTime, nil}
-	m.cells[key] = append(cells, cell)
+	m.cells[key] = append(m.cells[key], cell)
 	return cell, nil
 }
 
+// find returns an existing cell or nil.
+func (m *metricData) find(fieldVals []interface{}, t types.Target) *types.CellData {
+	for _, cell := range m.cells[cellKeyFor(fieldVals, t)] {
+		if reflect.DeepEqual(fieldVals, cell.FieldVals) &&
+			reflect.DeepEqual(t, cell.Target) {
+			return cell
+		}
+	}
+	return nil
+}
+
+// clear removes all cells of the metric.
+func (m *metricData) clear() {
+	atomic.AddInt64(&m.store.numCells, -int64(m.numCells))
+	m.cells = make(map[cellKey][]*types.CellData)
+	m.overflow = nil
+	m.numCells = 0
+}
+
+func cellKeyFor(fieldVals []interface{}, t types.Target) cellKey {
+	key := cellKey{fieldValuesHash: field.Hash(fieldVals)}
+	if t != nil {
+		key.targetHash = t.Hash()
+	}
+	return key
+}
+
 // NewInMemory creates a new metric store that holds metric data in this
 // process' memory.
 func NewInMemory(defaultTarget types.Target) Store {
+	return NewInMemoryWithLimits(defaultTarget, Limits{})
+}
+
+// NewInMemoryWithLimits creates a new in-memory metric store that caps the
+// number of cells it holds.
+func NewInMemoryWithLimits(defaultTarget types.Target, limits Limits) Store {
 	return &inMemoryStore{
 		defaultTarget: defaultTarget,
 		data:          map[string]*metricData{},
+		limits:        limits,
 	}
 }
 
+// reserveCell accounts for a new cell of the metric and returns true if it
+// fits into the limits.  Under CollapseOverflow policy the last cell allowed by
+// the per-metric limit is kept for the first overflow cell.  Must be called
+// with the metric's lock held.
+func (s *inMemoryStore) reserveCell(m *metricData) bool {
+	if limit := s.limits.maxCellsFor(m.Name); limit > 0 {
+		if s.limits.Overflow == CollapseOverflow && len(m.overflow) == 0 {
+			limit--
+		}
+		if m.numCells >= limit {
+			return false
+		}
+	}
+	n := atomic.AddInt64(&s.numCells, 1)
+	if s.limits.MaxCells > 0 && n > int64(s.limits.MaxCells) {
+		atomic.AddInt64(&s.numCells, -1)
+		return false
+	}
+	m.numCells++
+	return true
+}
+
 // Register does nothing.
 func (s *inMemoryStore) Register(m types.Metric) {}
 
@@ -81,7 +177,12 @@ func (s *inMemoryStore) Unregister(h types.Metric) {
 	s.dataLock.Lock()
 	defer s.dataLock.Unlock()
 
-	delete(s.data, h.Info().Name)
+	if m, ok := s.data[h.Info().Name]; ok {
+		m.lock.Lock()
+		m.clear()
+		m.lock.Unlock()
+		delete(s.data, h.Info().Name)
+	}
 }
 
 func (s *inMemoryStore) getOrCreateData(m types.Metric) *metricData {
@@ -102,6 +203,7 @@ func (s *inMemoryStore) getOrCreateData(m types.Metric) *metricData {
 
 	d = &metricData{
 		MetricInfo: m.Info(),
+		store:      s,
 		cells:      map[cellKey][]*types.CellData{},
 	}
 
@@ -133,8 +235,8 @@ func (s *inMemoryStore) Get(ctx context.Context, h types.Metric, resetTime time.
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	c, err := m.get(fieldVals, target.Get(ctx), resetTime)
-	if err != nil {
+	c, err := m.get(fieldVals, target.Get(ctx), resetTime, false)
+	if err != nil || c == nil {
 		return nil, err
 	}
 
@@ -167,8 +269,8 @@ func (s *inMemoryStore) set(h types.Metric, resetTime time.Time, fieldVals []int
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	c, err := m.get(fieldVals, t, resetTime)
-	if err != nil {
+	c, err := m.get(fieldVals, t, resetTime, true)
+	if err != nil || c == nil {
 		return err
 	}
 
@@ -194,8 +296,8 @@ func (s *inMemoryStore) incr(h types.Metric, resetTime time.Time, fieldVals []in
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	c, err := m.get(fieldVals, t, resetTime)
-	if err != nil {
+	c, err := m.get(fieldVals, t, resetTime, true)
+	if err != nil || c == nil {
 		return err
 	}
 
@@ -244,16 +346,34 @@ func (s *inMemoryStore) GetAll(ctx context.Context) []types.Cell {
 	ret := []types.Cell{}
 	for _, m := range s.data {
 		m.lock.Lock()
+		add := func(cell *types.CellData) {
+			// Add the default target to the cell if it doesn't have one set.
+			cellCopy := *cell
+			if cellCopy.Target == nil {
+				cellCopy.Target = defaultTarget
+			}
+			ret = append(ret, types.Cell{m.MetricInfo, m.MetricMetadata, cellCopy})
+		}
 		for _, cells := range m.cells {
 			for _, cell := range cells {
-				// Add the default target to the cell if it doesn't have one set.
-				cellCopy := *cell
-				if cellCopy.Target == nil {
-					cellCopy.Target = defaultTarget
-				}
-				ret = append(ret, types.Cell{m.MetricInfo, m.MetricMetadata, cellCopy})
+				add(cell)
 			}
 		}
+		for _, cell := range m.overflow {
+			add(cell)
+		}
+		if m.dropped > 0 {
+			ret = append(ret, types.Cell{
+				DroppedCellsMetric,
+				types.MetricMetadata{},
+				types.CellData{
+					FieldVals: []interface{}{m.Name},
+					Target:    defaultTarget,
+					ResetTime: m.droppedSince,
+					Value:     m.dropped,
+				},
+			})
+		}
 		m.lock.Unlock()
 	}
 	return ret
@@ -263,6 +383,6 @@ func (s *inMemoryStore) Reset(ctx context.Context, h types.Metric) {
 	m := s.getOrCreateData(h)
 
 	m.lock.Lock()
-	m.cells = make(map[cellKey][]*types.CellData)
+	m.clear()
 	m.lock.Unlock()
 }
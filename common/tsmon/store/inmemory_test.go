@@ -4,12 +4,18 @@
 package store
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/luci/luci-go/common/tsmon/field"
 	"github.com/luci/luci-go/common/tsmon/store/storetest"
 	"github.com/luci/luci-go/common/tsmon/target"
+	"github.com/luci/luci-go/common/tsmon/types"
 	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
 )
 
 func TestInMemory(t *testing.T) {
@@ -24,3 +30,148 @@ func TestInMemory(t *testing.T) {
 		},
 	})
 }
+
+func TestInMemoryLimits(t *testing.T) {
+	ctx := context.Background()
+	resetTime := time.Unix(1234, 0)
+	defaultTarget := &target.Task{ServiceName: proto.String("default target")}
+
+	counter := func(name string) types.Metric {
+		return &storetest.FakeMetric{
+			types.MetricInfo{name, "", []field.Field{field.String("f"), field.Int("i")}, types.CumulativeIntType},
+			types.MetricMetadata{},
+		}
+	}
+	incr := func(s Store, m types.Metric, f string, i int64) {
+		So(s.Incr(ctx, m, resetTime, []interface{}{f, i}, int64(1)), ShouldBeNil)
+	}
+	get := func(s Store, m types.Metric, f string, i int64) interface{} {
+		v, err := s.Get(ctx, m, resetTime, []interface{}{f, i})
+		So(err, ShouldBeNil)
+		return v
+	}
+	minInt := fmt.Sprintf("%d", OtherIntFieldValue)
+	dropped := func(s Store) map[string]int64 {
+		ret := map[string]int64{}
+		for _, c := range s.GetAll(ctx) {
+			if c.Name == DroppedCellsMetric.Name {
+				So(c.ResetTime, ShouldResemble, resetTime)
+				ret[c.FieldVals[0].(string)] = c.Value.(int64)
+			}
+		}
+		return ret
+	}
+
+	Convey("Per metric limit drops new cells", t, func() {
+		s := NewInMemoryWithLimits(defaultTarget, Limits{
+			MaxCellsPerMetric: 2,
+			PerMetric:         map[string]int{"big": 3},
+		})
+		m, big := counter("m"), counter("big")
+
+		incr(s, m, "a", 1)
+		incr(s, m, "b", 1)
+		incr(s, m, "c", 1)
+		incr(s, m, "c", 1)
+		incr(s, m, "a", 1)
+		for _, f := range []string{"a", "b", "c", "d"} {
+			incr(s, big, f, 1)
+		}
+
+		So(get(s, m, "a", 1), ShouldEqual, 2)
+		So(get(s, m, "b", 1), ShouldEqual, 1)
+		So(get(s, m, "c", 1), ShouldBeNil)
+		So(get(s, big, "c", 1), ShouldEqual, 1)
+		So(get(s, big, "d", 1), ShouldBeNil)
+		So(dropped(s), ShouldResemble, map[string]int64{"m": 2, "big": 1})
+
+		Convey("Reset frees up the cells", func() {
+			s.Reset(ctx, m)
+			incr(s, m, "c", 1)
+			So(get(s, m, "c", 1), ShouldEqual, 1)
+			So(dropped(s)["m"], ShouldEqual, 2)
+		})
+	})
+
+	Convey("Global limit drops new cells", t, func() {
+		s := NewInMemoryWithLimits(defaultTarget, Limits{MaxCells: 3})
+		m1, m2 := counter("m1"), counter("m2")
+
+		incr(s, m1, "a", 1)
+		incr(s, m1, "b", 1)
+		incr(s, m2, "a", 1)
+		incr(s, m2, "b", 1)
+		So(get(s, m2, "b", 1), ShouldBeNil)
+		So(dropped(s), ShouldResemble, map[string]int64{"m2": 1})
+
+		Convey("Unregister frees up the cells", func() {
+			s.Unregister(m1)
+			incr(s, m2, "b", 1)
+			So(get(s, m2, "b", 1), ShouldEqual, 1)
+		})
+	})
+
+	Convey("Collapse policy merges new cells into one", t, func() {
+		s := NewInMemoryWithLimits(defaultTarget, Limits{
+			MaxCellsPerMetric: 2,
+			Overflow:          CollapseOverflow,
+		})
+		m := counter("m")
+
+		incr(s, m, "a", 1)
+		incr(s, m, "b", 1)
+		incr(s, m, "c", 1)
+		incr(s, m, "d", 2)
+		incr(s, m, "a", 1)
+
+		cells := map[string]int64{}
+		for _, c := range s.GetAll(ctx) {
+			So(c.Target, ShouldResemble, defaultTarget)
+			cells[fmt.Sprintf("%v", c.FieldVals)] = c.Value.(int64)
+		}
+		So(cells, ShouldResemble, map[string]int64{
+			"[a 1]":                      2,
+			"[__other__ " + minInt + "]": 3,
+		})
+		So(s.(*inMemoryStore).numCells, ShouldEqual, 2)
+		So(dropped(s), ShouldBeEmpty)
+
+		Convey("Reset frees up the overflow cell", func() {
+			s.Reset(ctx, m)
+			So(s.(*inMemoryStore).numCells, ShouldEqual, 0)
+			incr(s, m, "b", 1)
+			So(get(s, m, "b", 1), ShouldEqual, 1)
+		})
+	})
+
+	Convey("Collapse policy keeps bool fields", t, func() {
+		s := NewInMemoryWithLimits(defaultTarget, Limits{
+			MaxCellsPerMetric: 2,
+			Overflow:          CollapseOverflow,
+		})
+		m := &storetest.FakeMetric{
+			types.MetricInfo{"m", "", []field.Field{field.String("f"), field.Bool("b")}, types.CumulativeIntType},
+			types.MetricMetadata{},
+		}
+		incr := func(f string, b bool) {
+			So(s.Incr(ctx, m, resetTime, []interface{}{f, b}, int64(1)), ShouldBeNil)
+		}
+
+		incr("a", false)
+		incr("b", false)
+		incr("c", false)
+		incr("d", true)
+		incr("e", true)
+
+		cells := map[string]int64{}
+		for _, c := range s.GetAll(ctx) {
+			cells[fmt.Sprintf("%v", c.FieldVals)] = c.Value.(int64)
+		}
+		So(cells, ShouldResemble, map[string]int64{
+			"[a false]":         1,
+			"[__other__ false]": 2,
+			"[__other__ true]":  2,
+		})
+		So(s.(*inMemoryStore).numCells, ShouldEqual, 3)
+	})
+}
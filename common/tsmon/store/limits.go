@@ -0,0 +1,119 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package store
+
+import (
+	"flag"
+	"math"
+
+	"github.com/luci/luci-go/common/flag/flagenum"
+	"github.com/luci/luci-go/common/tsmon/field"
+	"github.com/luci/luci-go/common/tsmon/types"
+
+	pb "github.com/luci/luci-go/common/tsmon/ts_mon_proto"
+)
+
+// OverflowPolicy says what happens to a new cell that doesn't fit into the
+// store's cardinality limits.
+type OverflowPolicy string
+
+var _ flag.Value = (*OverflowPolicy)(nil)
+
+const (
+	// DropOverflow silently drops writes to new cells over the limit.  They
+	// are counted in the DroppedCellsMetric.
+	DropOverflow = OverflowPolicy("drop")
+	// CollapseOverflow redirects writes to new cells over the limit into an
+	// overflow cell of the metric, in which string and int field values are
+	// replaced by placeholders (see OtherFieldValue) and the target is the
+	// default one.  Bool fields are exempt: they keep their values, since a
+	// placeholder would be indistinguishable from a real one, so a metric has an
+	// overflow cell per combination of its bool field values.  Overflow cells
+	// count against the limits like any other cells, but may exceed the
+	// per-metric limit by that many cells.
+	CollapseOverflow = OverflowPolicy("collapse")
+)
+
+var overflowPolicyEnum = flagenum.Enum{
+	"drop":     DropOverflow,
+	"collapse": CollapseOverflow,
+}
+
+func (p *OverflowPolicy) String() string {
+	return overflowPolicyEnum.FlagString(p)
+}
+
+// Set implements flag.Value.
+func (p *OverflowPolicy) Set(v string) error {
+	return overflowPolicyEnum.FlagSet(p, v)
+}
+
+// OtherFieldValue and OtherIntFieldValue replace string and int field values
+// of overflow cells of the CollapseOverflow policy.
+const (
+	OtherFieldValue          = "__other__"
+	OtherIntFieldValue int64 = math.MinInt64
+)
+
+// DroppedCellsMetric describes the cumulative counter of cells dropped by the
+// DropOverflow policy, keyed by the name of the metric they belong to.
+//
+// The in-memory store reports it with the rest of the metrics.
+var DroppedCellsMetric = types.MetricInfo{
+	Name:        "tsmon/store/dropped_cells",
+	Description: "Number of writes to metric cells dropped because of cardinality limits.",
+	Fields:      []field.Field{field.String("metric")},
+	ValueType:   types.CumulativeIntType,
+}
+
+// Limits caps the number of cells in a store.
+//
+// A cell is created for every distinct combination of field values and target
+// of a metric, so a metric with a field that takes unbounded values can use
+// unbounded memory.  When a limit is reached, writes to new cells are handled
+// according to Overflow.  Existing cells are always updated.
+type Limits struct {
+	// MaxCells is the maximum number of cells in the store across all metrics.
+	// 0 means no limit.
+	MaxCells int
+
+	// MaxCellsPerMetric is the maximum number of cells of any single metric.
+	// 0 means no limit.
+	MaxCellsPerMetric int
+
+	// PerMetric overrides MaxCellsPerMetric for individual metrics, keyed by
+	// metric name.
+	PerMetric map[string]int
+
+	// Overflow is what to do with cells over the limits.  Defaults to
+	// DropOverflow.
+	Overflow OverflowPolicy
+}
+
+// maxCellsFor returns the per-metric limit for the given metric, or 0 if it is
+// unlimited.
+func (l *Limits) maxCellsFor(name string) int {
+	if n, ok := l.PerMetric[name]; ok {
+		return n
+	}
+	return l.MaxCellsPerMetric
+}
+
+// collapsedFieldValues returns the field values of the overflow cell that
+// writes to the given (canonicalized) field values collapse into.
+func collapsedFieldValues(fields []field.Field, fieldVals []interface{}) []interface{} {
+	ret := make([]interface{}, len(fields))
+	for i, f := range fields {
+		switch f.Type {
+		case pb.MetricsField_STRING:
+			ret[i] = OtherFieldValue
+		case pb.MetricsField_INT:
+			ret[i] = OtherIntFieldValue
+		case pb.MetricsField_BOOL:
+			ret[i] = fieldVals[i]
+		}
+	}
+	return ret
+}
@@ -13,12 +13,15 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
 
 	"github.com/luci/gae/service/info"
 	"github.com/luci/luci-go/common/config"
+	"github.com/luci/luci-go/common/config/validation"
+	"github.com/luci/luci-go/common/errors"
 	"github.com/luci/luci-go/common/logging"
 
 	"github.com/luci/luci-go/cron/appengine/messages"
@@ -59,7 +62,22 @@ type Catalog interface {
 	//
 	// It assumes there's config.Interface implementation installed in
 	// the context, will panic if it's not there.
+	//
+	// Invalid jobs (see ValidateProjectConfig) are logged and skipped.
 	GetProjectJobs(c context.Context, projectID string) ([]Definition, error)
+
+	// ValidateProjectConfig validates the content of a project's config file
+	// (text-encoded cron.ProjectConfig message) before it is imported. It
+	// returns errors.MultiError with a problem for each invalid job, including
+	// jobs with bad triggers or trigger loops.
+	ValidateProjectConfig(content string) error
+
+	// RegisterConfigRules adds ValidateProjectConfig to the registry as the
+	// validator of project config files, so luci-config rejects invalid configs
+	// before they are imported.
+	//
+	// The context is used to find the name of the config file.
+	RegisterConfigRules(c context.Context, r *validation.Registry)
 }
 
 // Definition wraps serialized definition of a cron job fetched from the config.
@@ -79,6 +97,69 @@ type Definition struct {
 	// Task is serialized representation of cron job. It can be fed back to
 	// Catalog.UnmarshalTask(...) to get proto.Message describing the task.
 	Task []byte
+
+	// Triggers is a list of events that start the job (in addition to its
+	// schedule).
+	Triggers []Trigger
+
+	// Policy defines how to handle failed, overlapping and hanging invocations.
+	Policy Policy
+}
+
+// Trigger describes an event that starts a job: an invocation of some other
+// job in the same project finishing with the given status.
+type Trigger struct {
+	// JobID is globally unique ID of the watched job: "<ProjectID>/<JobName>".
+	JobID string
+
+	// Status is the final status of the watched job's invocation, either
+	// task.StatusSucceeded or task.StatusFailed.
+	Status task.Status
+}
+
+// OverlapPolicy defines what to do when a job should start a new invocation,
+// but there are already Policy.MaxConcurrent invocations running.
+type OverlapPolicy string
+
+const (
+	// OverlapSkip skips the new invocation, recording it as an overrun. This is
+	// the default.
+	OverlapSkip OverlapPolicy = "SKIP"
+
+	// OverlapQueue starts the new invocation as soon as some running one
+	// finishes.
+	OverlapQueue OverlapPolicy = "QUEUE"
+
+	// OverlapReplace aborts the oldest running invocation and starts the new one
+	// right away.
+	OverlapReplace OverlapPolicy = "REPLACE"
+)
+
+// Policy defines how the engine handles failed, overlapping and hanging
+// invocations of a job.
+//
+// Zero value is the default policy: no retries, at most one invocation at
+// a time, overlapping invocations are skipped and there's no timeout.
+type Policy struct {
+	// MaxRetries is how many times to retry a failed invocation.
+	MaxRetries int
+
+	// RetryBackoff is a delay before the first retry. It doubles with each
+	// following retry, up to MaxRetryBackoff (if it is not zero).
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+
+	// MaxConcurrent is how many invocations may be running at the same time.
+	// Zero means one.
+	MaxConcurrent int
+
+	// Overlap defines what to do when there are MaxConcurrent invocations
+	// running already. Empty string means OverlapSkip.
+	Overlap OverlapPolicy
+
+	// Timeout is how long an invocation may run before it is aborted. Zero
+	// means no limit.
+	Timeout time.Duration
 }
 
 // New returns implementation of Catalog.
@@ -161,35 +242,197 @@ func (cat *catalog) GetProjectJobs(c context.Context, projectID string) ([]Defin
 	if err = proto.UnmarshalText(rawCfg.Content, &cfg); err != nil {
 		return nil, err
 	}
-	out := make([]Definition, 0, len(cfg.Job))
-	for _, job := range cfg.Job {
-		if job.GetDisabled() {
-			continue
-		}
-		id := "(nil)"
-		if job.Id != nil {
-			id = *job.Id
-		}
-		if err = cat.validateJobProto(job); err != nil {
-			logging.Errorf(c, "Invalid job definition %s/%s: %s", projectID, id, err)
-			continue
-		}
+	valid, invalid := cat.validateProjectJobs(&cfg)
+	for _, err := range invalid {
+		logging.Errorf(c, "Invalid job definition in %s: %s", projectID, err)
+	}
+	out := make([]Definition, 0, len(valid))
+	for _, job := range valid {
 		packed, err := proto.Marshal(job.Task)
 		if err != nil {
-			logging.Errorf(c, "Failed to marshal the task: %s/%s: %s", projectID, id, err)
+			logging.Errorf(c, "Failed to marshal the task: %s/%s: %s", projectID, *job.Id, err)
 			continue
 		}
 		out = append(out, Definition{
 			JobID:       fmt.Sprintf("%s/%s", projectID, *job.Id),
 			Revision:    rawCfg.Revision,
 			RevisionURL: revisionURL,
-			Schedule:    *job.Schedule,
+			Schedule:    jobSchedule(job),
 			Task:        packed,
+			Triggers:    jobTriggers(projectID, job),
+			Policy:      jobPolicy(job),
 		})
 	}
 	return out, nil
 }
 
+func (cat *catalog) ValidateProjectConfig(content string) error {
+	cfg := messages.ProjectConfig{}
+	if err := proto.UnmarshalText(content, &cfg); err != nil {
+		return err
+	}
+	if _, invalid := cat.validateProjectJobs(&cfg); len(invalid) != 0 {
+		return invalid
+	}
+	return nil
+}
+
+func (cat *catalog) RegisterConfigRules(c context.Context, r *validation.Registry) {
+	r.Add("regex:projects/.*", cat.configFile(c), func(c context.Context, configSet, path string, content []byte, r *validation.Report) {
+		switch err := cat.ValidateProjectConfig(string(content)).(type) {
+		case nil:
+		case errors.MultiError:
+			for _, e := range err {
+				r.Errorf("%s", e)
+			}
+		default:
+			r.Errorf("%s", err)
+		}
+	})
+}
+
+// validateProjectJobs validates enabled jobs of a project config. It returns
+// valid jobs and an error for each invalid one. Disabled jobs are skipped.
+func (cat *catalog) validateProjectJobs(cfg *messages.ProjectConfig) ([]*messages.Job, errors.MultiError) {
+	var invalid errors.MultiError
+	wellFormed := make([]*messages.Job, 0, len(cfg.Job))
+	for _, job := range cfg.Job {
+		if job.GetDisabled() {
+			continue
+		}
+		if err := cat.validateJobProto(job); err != nil {
+			id := "(nil)"
+			if job.Id != nil {
+				id = *job.Id
+			}
+			invalid = append(invalid, fmt.Errorf("job %s: %s", id, err))
+			continue
+		}
+		wellFormed = append(wellFormed, job)
+	}
+
+	triggerErrs := validateTriggers(wellFormed)
+	valid := make([]*messages.Job, 0, len(wellFormed))
+	for _, job := range wellFormed {
+		if err := triggerErrs[*job.Id]; err != nil {
+			invalid = append(invalid, fmt.Errorf("job %s: %s", *job.Id, err))
+			continue
+		}
+		valid = append(valid, job)
+	}
+	return valid, invalid
+}
+
+// jobSchedule returns the schedule of a valid job. Jobs without a schedule
+// are started only by triggers, i.e. they are on "manual" schedule.
+func jobSchedule(j *messages.Job) string {
+	if j.Schedule == nil {
+		return "manual"
+	}
+	return *j.Schedule
+}
+
+// jobTriggers converts Trigger protos of a valid job into Trigger structs.
+func jobTriggers(projectID string, j *messages.Job) []Trigger {
+	var out []Trigger
+	for _, t := range j.Trigger {
+		jobID := fmt.Sprintf("%s/%s", projectID, t.GetJob())
+		if t.GetOnSuccess() {
+			out = append(out, Trigger{JobID: jobID, Status: task.StatusSucceeded})
+		}
+		if t.GetOnFailure() {
+			out = append(out, Trigger{JobID: jobID, Status: task.StatusFailed})
+		}
+	}
+	return out
+}
+
+// jobPolicy converts policy related fields of a valid job into Policy struct.
+// Default values of the fields result in zero Policy.
+func jobPolicy(j *messages.Job) Policy {
+	p := Policy{}
+	if j.Retry != nil && j.Retry.GetMaxRetries() > 0 {
+		p.MaxRetries = int(j.Retry.GetMaxRetries())
+		p.RetryBackoff = time.Duration(j.Retry.GetBackoffSec()) * time.Second
+		p.MaxRetryBackoff = time.Duration(j.Retry.GetMaxBackoffSec()) * time.Second
+	}
+	if n := j.GetMaxConcurrentInvocations(); n > 1 {
+		p.MaxConcurrent = int(n)
+	}
+	switch j.GetOverlapPolicy() {
+	case messages.Job_QUEUE:
+		p.Overlap = OverlapQueue
+	case messages.Job_REPLACE:
+		p.Overlap = OverlapReplace
+	}
+	p.Timeout = time.Duration(j.GetTimeoutSec()) * time.Second
+	return p
+}
+
+// validateTriggers checks that jobs trigger only on other known jobs and that
+// there are no trigger loops. It returns a map from the ID of each bad job to
+// the problem with it.
+//
+// Each job is considered separately: a job that triggers on a job with a bad
+// trigger is fine (as long as it's not in a loop itself), it just won't ever
+// be triggered.
+func validateTriggers(jobs []*messages.Job) map[string]error {
+	deps := make(map[string][]string, len(jobs))
+	for _, j := range jobs {
+		deps[*j.Id] = nil
+	}
+	errs := map[string]error{}
+	for _, j := range jobs {
+		for _, t := range j.Trigger {
+			if _, ok := deps[t.GetJob()]; !ok {
+				errs[*j.Id] = fmt.Errorf("triggers on unknown or disabled job %q", t.GetJob())
+				break
+			}
+			deps[*j.Id] = append(deps[*j.Id], t.GetJob())
+		}
+	}
+
+	// Depth first search for back edges. Every job on a found loop is bad.
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(jobs))
+	stack := []string{}
+	var visit func(id string)
+	visit = func(id string) {
+		state[id] = visiting
+		stack = append(stack, id)
+		for _, dep := range deps[id] {
+			switch state[dep] {
+			case unvisited:
+				visit(dep)
+			case visiting:
+				// The loop is the tail of the stack starting at 'dep'.
+				i := len(stack) - 1
+				for stack[i] != dep {
+					i--
+				}
+				loop := append(append([]string(nil), stack[i:]...), dep)
+				for _, l := range stack[i:] {
+					if errs[l] == nil {
+						errs[l] = fmt.Errorf("trigger loop %s", strings.Join(loop, " -> "))
+					}
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[id] = visited
+	}
+	for _, j := range jobs {
+		if state[*j.Id] == unvisited {
+			visit(*j.Id)
+		}
+	}
+	return errs
+}
+
 // configFile returns a name of *.cfg file (inside project's config set) with
 // all cron job definitions for a project. This file contains text-encoded
 // cron.ProjectConfig message.
@@ -229,11 +472,35 @@ func (cat *catalog) validateJobProto(j *messages.Job) error {
 	if !jobIDRe.MatchString(*j.Id) {
 		return fmt.Errorf("%q is not valid value for 'id' field", *j.Id)
 	}
-	if j.Schedule == nil {
+	if j.Schedule == nil && len(j.Trigger) == 0 {
 		return fmt.Errorf("missing 'schedule' field")
 	}
-	if _, err := schedule.Parse(*j.Schedule, 0); err != nil {
-		return fmt.Errorf("%s is not valid value for 'schedule' field - %s", *j.Schedule, err)
+	if j.Schedule != nil {
+		if _, err := schedule.Parse(*j.Schedule, 0); err != nil {
+			return fmt.Errorf("%s is not valid value for 'schedule' field - %s", *j.Schedule, err)
+		}
+	}
+	if j.GetMaxConcurrentInvocations() < 1 {
+		return fmt.Errorf("'max_concurrent_invocations' must be positive")
+	}
+	if j.GetTimeoutSec() < 0 {
+		return fmt.Errorf("'timeout_sec' must not be negative")
+	}
+	if r := j.Retry; r != nil {
+		if r.GetMaxRetries() < 0 || r.GetBackoffSec() < 0 || r.GetMaxBackoffSec() < 0 {
+			return fmt.Errorf("'retry' fields must not be negative")
+		}
+	}
+	for _, t := range j.Trigger {
+		if t.Job == nil {
+			return fmt.Errorf("missing 'job' field in 'trigger'")
+		}
+		if !jobIDRe.MatchString(*t.Job) {
+			return fmt.Errorf("%q is not valid value for 'job' field in 'trigger'", *t.Job)
+		}
+		if !t.GetOnSuccess() && !t.GetOnFailure() {
+			return fmt.Errorf("trigger on %q never fires, set 'on_success' or 'on_failure'", *t.Job)
+		}
 	}
 	_, err := cat.extractTaskProto(j.Task)
 	return err
@@ -5,8 +5,8 @@
 package catalog
 
 import (
-	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
@@ -14,6 +14,8 @@ import (
 
 	"github.com/luci/luci-go/common/config"
 	memcfg "github.com/luci/luci-go/common/config/impl/memory"
+	"github.com/luci/luci-go/common/config/validation"
+	"github.com/luci/luci-go/common/errors"
 
 	"github.com/luci/luci-go/cron/appengine/messages"
 	"github.com/luci/luci-go/cron/appengine/task"
@@ -64,6 +66,97 @@ func TestProtoValidation(t *testing.T) {
 			Schedule: strPtr("* * * * *"),
 			Task:     &messages.Task{Noop: &messages.NoopTask{}},
 		}), ShouldBeNil)
+		So(c.validateJobProto(&messages.Job{
+			Id:      strPtr("good"),
+			Trigger: []*messages.Trigger{{Job: strPtr("bad id")}},
+			Task:    &messages.Task{Noop: &messages.NoopTask{}},
+		}), ShouldErrLike, "not valid value for 'job' field in 'trigger'")
+		So(c.validateJobProto(&messages.Job{
+			Id:      strPtr("good"),
+			Trigger: []*messages.Trigger{{Job: strPtr("other"), OnSuccess: boolPtr(false)}},
+			Task:    &messages.Task{Noop: &messages.NoopTask{}},
+		}), ShouldErrLike, "never fires")
+		So(c.validateJobProto(&messages.Job{
+			Id:      strPtr("good"),
+			Trigger: []*messages.Trigger{{Job: strPtr("other")}},
+			Task:    &messages.Task{Noop: &messages.NoopTask{}},
+		}), ShouldBeNil)
+	})
+
+	Convey("validateJobProto checks policy fields", t, func() {
+		c := New("cron.cfg").(*catalog)
+		c.RegisterTaskManager(noopTaskManager{})
+		job := func() *messages.Job {
+			return &messages.Job{
+				Id:       strPtr("good"),
+				Schedule: strPtr("* * * * *"),
+				Task:     &messages.Task{Noop: &messages.NoopTask{}},
+			}
+		}
+
+		j := job()
+		j.MaxConcurrentInvocations = int32Ptr(0)
+		So(c.validateJobProto(j), ShouldErrLike, "'max_concurrent_invocations' must be positive")
+
+		j = job()
+		j.TimeoutSec = int32Ptr(-1)
+		So(c.validateJobProto(j), ShouldErrLike, "'timeout_sec' must not be negative")
+
+		j = job()
+		j.Retry = &messages.RetryPolicy{BackoffSec: int32Ptr(-1)}
+		So(c.validateJobProto(j), ShouldErrLike, "'retry' fields must not be negative")
+	})
+
+	Convey("jobPolicy works", t, func() {
+		So(jobPolicy(&messages.Job{}), ShouldResemble, Policy{})
+		So(jobPolicy(&messages.Job{
+			Retry:                    &messages.RetryPolicy{MaxRetries: int32Ptr(3)},
+			MaxConcurrentInvocations: int32Ptr(2),
+			OverlapPolicy:            messages.Job_REPLACE.Enum(),
+			TimeoutSec:               int32Ptr(600),
+		}), ShouldResemble, Policy{
+			MaxRetries:      3,
+			RetryBackoff:    time.Minute,
+			MaxRetryBackoff: time.Hour,
+			MaxConcurrent:   2,
+			Overlap:         OverlapReplace,
+			Timeout:         10 * time.Minute,
+		})
+	})
+
+	Convey("validateTriggers works", t, func() {
+		job := func(id string, triggers ...string) *messages.Job {
+			j := &messages.Job{Id: strPtr(id)}
+			for _, t := range triggers {
+				j.Trigger = append(j.Trigger, &messages.Trigger{Job: strPtr(t)})
+			}
+			return j
+		}
+
+		So(validateTriggers([]*messages.Job{
+			job("a"),
+			job("b", "a"),
+			job("c", "a", "b"),
+		}), ShouldResemble, map[string]error{})
+
+		errs := validateTriggers([]*messages.Job{
+			job("a"),
+			job("b", "unknown"),
+			job("c", "b"),
+			job("self", "self"),
+			job("x", "z"),
+			job("y", "x"),
+			job("z", "y"),
+			job("w", "x"),
+		})
+		So(errs["a"], ShouldBeNil)
+		So(errs["b"], ShouldErrLike, `unknown or disabled job "unknown"`)
+		So(errs["c"], ShouldBeNil)
+		So(errs["self"], ShouldErrLike, "trigger loop self -> self")
+		So(errs["x"], ShouldErrLike, "trigger loop x -> z -> y -> x")
+		So(errs["y"], ShouldErrLike, "trigger loop x -> z -> y -> x")
+		So(errs["z"], ShouldErrLike, "trigger loop x -> z -> y -> x")
+		So(errs["w"], ShouldBeNil)
 	})
 
 	Convey("extractTaskProto works", t, func() {
@@ -111,7 +204,7 @@ func TestConfigReading(t *testing.T) {
 		Convey("GetAllProjects works", func() {
 			projects, err := cat.GetAllProjects(ctx)
 			So(err, ShouldBeNil)
-			So(projects, ShouldResemble, []string{"broken", "project1", "project2"})
+			So(projects, ShouldResemble, []string{"broken", "project1", "project2", "project3"})
 		})
 
 		Convey("GetProjectJobs works", func() {
@@ -133,6 +226,29 @@ func TestConfigReading(t *testing.T) {
 			})
 		})
 
+		Convey("GetProjectJobs with triggers", func() {
+			defs, err := cat.GetProjectJobs(ctx, "project3")
+			So(err, ShouldBeNil)
+			So(defs, ShouldResemble, []Definition{
+				{
+					JobID:    "project3/upstream",
+					Revision: "4172de3896d79f6df02708e781adc665197306a8",
+					Schedule: "*/10 * * * * * *",
+					Task:     []uint8{0xa, 0x0},
+				},
+				{
+					JobID:    "project3/downstream",
+					Revision: "4172de3896d79f6df02708e781adc665197306a8",
+					Schedule: "manual",
+					Task:     []uint8{0xa, 0x0},
+					Triggers: []Trigger{
+						{JobID: "project3/upstream", Status: task.StatusSucceeded},
+						{JobID: "project3/upstream", Status: task.StatusFailed},
+					},
+				},
+			})
+		})
+
 		Convey("GetProjectJobs unknown project", func() {
 			defs, err := cat.GetProjectJobs(ctx, "unknown")
 			So(defs, ShouldBeNil)
@@ -145,6 +261,37 @@ func TestConfigReading(t *testing.T) {
 			So(err, ShouldNotBeNil)
 		})
 
+		Convey("ValidateProjectConfig works", func() {
+			So(cat.ValidateProjectConfig(project2CronCfg), ShouldBeNil)
+			So(cat.ValidateProjectConfig("blarg"), ShouldNotBeNil)
+
+			err := cat.ValidateProjectConfig(project1CronCfg)
+			So(err, ShouldErrLike, "job noop-job-4: ")
+
+			err = cat.ValidateProjectConfig(project3CronCfg)
+			So(err, ShouldHaveSameTypeAs, errors.MultiError{})
+			So(err.(errors.MultiError)[0], ShouldErrLike, "job loop-1: trigger loop loop-1 -> loop-2 -> loop-1")
+			So(err.(errors.MultiError)[1], ShouldErrLike, "job loop-2: trigger loop loop-1 -> loop-2 -> loop-1")
+		})
+
+		Convey("RegisterConfigRules works", func() {
+			r := validation.Registry{}
+			cat.RegisterConfigRules(ctx, &r)
+
+			rep := r.Validate(ctx, "projects/project2", "cron.cfg", []byte(project2CronCfg))
+			So(rep.Err(), ShouldBeNil)
+
+			rep = r.Validate(ctx, "projects/project3", "cron.cfg", []byte(project3CronCfg))
+			So(rep.Messages, ShouldHaveLength, 2)
+			So(rep.Err(), ShouldErrLike, "job loop-1: trigger loop loop-1 -> loop-2 -> loop-1")
+
+			rep = r.Validate(ctx, "projects/broken", "cron.cfg", []byte("blarg"))
+			So(rep.Err(), ShouldNotBeNil)
+
+			rep = r.Validate(ctx, "services/cron", "cron.cfg", []byte(project2CronCfg))
+			So(rep.Err(), ShouldErrLike, "no validator")
+		})
+
 		Convey("UnmarshalTask works", func() {
 			defs, err := cat.GetProjectJobs(ctx, "project1")
 			So(err, ShouldBeNil)
@@ -164,6 +311,10 @@ func TestConfigReading(t *testing.T) {
 
 func strPtr(s string) *string { return &s }
 
+func boolPtr(b bool) *bool { return &b }
+
+func int32Ptr(i int32) *int32 { return &i }
+
 type noopTaskManager struct {
 	validationErr error
 }
@@ -252,6 +403,44 @@ job {
 }
 `
 
+const project3CronCfg = `
+job {
+  id: "upstream"
+  schedule: "*/10 * * * * * *"
+  task: {
+    noop: {}
+  }
+}
+
+job {
+  id: "downstream"
+  trigger: {
+    job: "upstream"
+    on_failure: true
+  }
+  task: {
+    noop: {}
+  }
+}
+
+# Will be skipped since they trigger each other.
+job {
+  id: "loop-1"
+  trigger: { job: "loop-2" }
+  task: {
+    noop: {}
+  }
+}
+
+job {
+  id: "loop-2"
+  trigger: { job: "loop-1" }
+  task: {
+    noop: {}
+  }
+}
+`
+
 var mockedConfigs = map[string]memcfg.ConfigSet{
 	"projects/project1": {
 		"cron.cfg": project1CronCfg,
@@ -259,6 +448,9 @@ var mockedConfigs = map[string]memcfg.ConfigSet{
 	"projects/project2": {
 		"cron.cfg": project2CronCfg,
 	},
+	"projects/project3": {
+		"cron.cfg": project3CronCfg,
+	},
 	"projects/broken": {
 		"cron.cfg": "broken!!!!111",
 	},
@@ -166,8 +166,14 @@ type actionTaskPayload struct {
 	TickNonce           int64  `json:",omitempty"` // valid for "TickLaterAction" kind
 	InvocationNonce     int64  `json:",omitempty"` // valid for "StartInvocationAction" kind
 	TriggeredBy         string `json:",omitempty"` // valid for "StartInvocationAction" kind
+	TriggeringJobID     string `json:",omitempty"` // valid for "StartInvocationAction" kind
+	TriggeringInvID     int64  `json:",omitempty"` // valid for "StartInvocationAction" kind
+	Retry               int    `json:",omitempty"` // valid for "StartInvocationAction" kind
 	Overruns            int    `json:",omitempty"` // valid for "RecordOverrunAction" kind
 	RunningInvocationID int64  `json:",omitempty"` // valid for "RecordOverrunAction" kind
+	InvocationID        int64  `json:",omitempty"` // valid for "InvocationDoneAction" and "AbortInvocationAction" kinds
+	Status              string `json:",omitempty"` // valid for "InvocationDoneAction" kind
+	Reason              string `json:",omitempty"` // valid for "AbortInvocationAction" kind
 }
 
 // CronJob stores the last known definition of a cron job, as well as its
@@ -211,10 +217,51 @@ type CronJob struct {
 	// of the engine. See Catalog.UnmarshalTask().
 	Task []byte `gae:",noindex"`
 
+	// Triggers is a list of events that start this job, as produced by
+	// triggerKey. Indexed, to find jobs to start when some invocation finishes.
+	Triggers []string
+
+	// Policy defines how to retry failed invocations, how many invocations may
+	// run concurrently and when to abort hanging ones.
+	Policy catalog.Policy `gae:",noindex"`
+
 	// State is cron job state machine state, see StateMachine.
 	State JobState
 }
 
+// triggerKey returns a string that identifies the event of an invocation of
+// job 'jobID' finishing with the given status. It is used as a value of
+// CronJob.Triggers.
+func triggerKey(jobID string, status task.Status) string {
+	return fmt.Sprintf("%s:%s", jobID, status)
+}
+
+// triggerKeys converts a list of catalog.Trigger to a list of trigger keys.
+func triggerKeys(triggers []catalog.Trigger) []string {
+	if len(triggers) == 0 {
+		return nil
+	}
+	out := make([]string, len(triggers))
+	for i, t := range triggers {
+		out[i] = triggerKey(t.JobID, t.Status)
+	}
+	return out
+}
+
+// equalStrings returns true if two string slices are equal. nil and empty
+// slices are considered equal.
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 // effectiveSchedule returns schedule string to use for the job, considering its
 // Paused field.
 //
@@ -252,14 +299,17 @@ func (e *CronJob) isEqual(other *CronJob) bool {
 		e.RevisionURL == other.RevisionURL &&
 		e.Schedule == other.Schedule &&
 		bytes.Equal(e.Task, other.Task) &&
-		e.State == other.State)
+		equalStrings(e.Triggers, other.Triggers) &&
+		e.Policy == other.Policy &&
+		e.State.isEqual(&other.State))
 }
 
 // matches returns true if job definition in the entity matches the one
 // specified by catalog.Definition struct. UpdateProjectJobs skips updates for
 // such jobs (assuming they are up-to-date).
 func (e *CronJob) matches(def catalog.Definition) bool {
-	return e.JobID == def.JobID && e.Schedule == def.Schedule && bytes.Equal(e.Task, def.Task)
+	return e.JobID == def.JobID && e.Schedule == def.Schedule && bytes.Equal(e.Task, def.Task) &&
+		equalStrings(e.Triggers, triggerKeys(def.Triggers)) && e.Policy == def.Policy
 }
 
 // Invocation entity stores single attempt to run a cron job. Its parent entity
@@ -279,6 +329,10 @@ type Invocation struct {
 	// Started is time when this invocation was created.
 	Started time.Time `gae:",noindex"`
 
+	// Scheduled is time when the request to start this invocation was queued
+	// by the state machine (on a timer tick, a trigger, "Run now", etc).
+	Scheduled time.Time `gae:",noindex"`
+
 	// Finished is time when this invocation transitioned to a terminal state.
 	Finished time.Time `gae:",noindex"`
 
@@ -292,6 +346,14 @@ type Invocation struct {
 	// Empty identity string if it was triggered by cron service itself.
 	TriggeredBy identity.Identity
 
+	// TriggeringJobID is ID of a job whose invocation triggered this one, if
+	// it was triggered by a finished invocation of another job.
+	TriggeringJobID string `gae:",noindex"`
+
+	// TriggeringInvID is ID of an invocation of TriggeringJobID that triggered
+	// this one.
+	TriggeringInvID int64 `gae:",noindex"`
+
 	// Revision is revision number of cron.cfg when this invocation was created.
 	// For informational purpose.
 	Revision string `gae:",noindex"`
@@ -332,8 +394,11 @@ func (e *Invocation) isEqual(other *Invocation) bool {
 	return e == other || (e.ID == other.ID &&
 		(e.JobKey == other.JobKey || e.JobKey.Equal(other.JobKey)) &&
 		e.Started == other.Started &&
+		e.Scheduled == other.Scheduled &&
 		e.Finished == other.Finished &&
 		e.InvocationNonce == other.InvocationNonce &&
+		e.TriggeringJobID == other.TriggeringJobID &&
+		e.TriggeringInvID == other.TriggeringInvID &&
 		e.Revision == other.Revision &&
 		e.RevisionURL == other.RevisionURL &&
 		bytes.Equal(e.Task, other.Task) &&
@@ -763,6 +828,7 @@ func (e *engineImpl) rollSM(c context.Context, job *CronJob, cb func(*StateMachi
 		State:    job.State,
 		Now:      now,
 		Schedule: sched,
+		Policy:   job.Policy,
 		Nonce:    func() int64 { return rnd.Int63() + 1 },
 		Context:  c,
 	}
@@ -813,13 +879,16 @@ func (e *engineImpl) enqueueActions(c context.Context, jobID string, actions []A
 				Kind:            "StartInvocationAction",
 				InvocationNonce: a.InvocationNonce,
 				TriggeredBy:     string(a.TriggeredBy),
+				TriggeringJobID: a.TriggeringJobID,
+				TriggeringInvID: a.TriggeringInvID,
+				Retry:           a.Retry,
 			})
 			if err != nil {
 				return err
 			}
 			qs[e.InvocationsQueueName] = append(qs[e.InvocationsQueueName], &taskqueue.Task{
 				Path:    e.InvocationsQueuePath,
-				Delay:   time.Second, // give the transaction time to land
+				Delay:   time.Second + a.Delay, // give the transaction time to land
 				Payload: payload,
 			})
 		case RecordOverrunAction:
@@ -837,6 +906,44 @@ func (e *engineImpl) enqueueActions(c context.Context, jobID string, actions []A
 				Delay:   time.Second, // give the transaction time to land
 				Payload: payload,
 			})
+		case InvocationDoneAction:
+			payload, err := json.Marshal(actionTaskPayload{
+				JobID:        jobID,
+				Kind:         "InvocationDoneAction",
+				InvocationID: a.InvocationID,
+				Status:       string(a.Status),
+			})
+			if err != nil {
+				return err
+			}
+			qs[e.InvocationsQueueName] = append(qs[e.InvocationsQueueName], &taskqueue.Task{
+				Path:    e.InvocationsQueuePath,
+				Delay:   time.Second, // give the transaction time to land
+				Payload: payload,
+			})
+		case AbortInvocationAction:
+			payload, err := json.Marshal(actionTaskPayload{
+				JobID:        jobID,
+				Kind:         "AbortInvocationAction",
+				InvocationID: a.InvocationID,
+				Reason:       a.Reason,
+			})
+			if err != nil {
+				return err
+			}
+			if a.When.IsZero() {
+				qs[e.InvocationsQueueName] = append(qs[e.InvocationsQueueName], &taskqueue.Task{
+					Path:    e.InvocationsQueuePath,
+					Delay:   time.Second, // give the transaction time to land
+					Payload: payload,
+				})
+			} else {
+				qs[e.TimersQueueName] = append(qs[e.TimersQueueName], &taskqueue.Task{
+					Path:    e.TimersQueuePath,
+					ETA:     a.When,
+					Payload: payload,
+				})
+			}
 		default:
 			logging.Errorf(c, "Unexpected action type %T, skipping", a)
 		}
@@ -868,9 +975,14 @@ func (e *engineImpl) ExecuteSerializedAction(c context.Context, action []byte, r
 	case "StartInvocationAction":
 		return e.startInvocation(
 			c, payload.JobID, payload.InvocationNonce,
-			identity.Identity(payload.TriggeredBy), retryCount)
+			identity.Identity(payload.TriggeredBy),
+			payload.TriggeringJobID, payload.TriggeringInvID, payload.Retry, retryCount)
 	case "RecordOverrunAction":
 		return e.recordOverrun(c, payload.JobID, payload.Overruns, payload.RunningInvocationID)
+	case "InvocationDoneAction":
+		return e.triggerDownstream(c, payload.JobID, payload.InvocationID, task.Status(payload.Status))
+	case "AbortInvocationAction":
+		return e.abortInvocation(c, payload.JobID, payload.InvocationID, payload.Reason)
 	default:
 		return fmt.Errorf("unexpected action kind %q", payload.Kind)
 	}
@@ -930,6 +1042,32 @@ func (e *engineImpl) setPausedFlag(c context.Context, jobID string, paused bool,
 }
 
 func (e *engineImpl) AbortInvocation(c context.Context, jobID string, invID int64, who identity.Identity) error {
+	return e.abortInvocationImpl(c, jobID, invID, fmt.Sprintf("manually aborted by %q", who), task.StatusAborted)
+}
+
+// abortInvocation is called via task queue to abort an invocation that timed
+// out or was replaced by a newer one (see AbortInvocationAction). Does nothing
+// if the invocation has finished already.
+//
+// Timed out invocations are marked as failed, so that job's retry policy and
+// failure triggers apply to them.
+func (e *engineImpl) abortInvocation(c context.Context, jobID string, invID int64, reason string) error {
+	status := task.StatusAborted
+	switch reason {
+	case "timeout":
+		reason = "aborted due to timeout"
+		status = task.StatusFailed
+	case "replaced":
+		reason = "aborted to give way to a newer invocation"
+	default:
+		reason = fmt.Sprintf("aborted (%s)", reason)
+	}
+	return e.abortInvocationImpl(c, jobID, invID, reason, status)
+}
+
+// abortInvocationImpl aborts the invocation (if it is still running), putting
+// given explanation in its debug log and moving it to the given final status.
+func (e *engineImpl) abortInvocationImpl(c context.Context, jobID string, invID int64, why string, status task.Status) error {
 	c = logging.SetField(c, "JobID", jobID)
 	c = logging.SetField(c, "InvID", invID)
 
@@ -952,13 +1090,13 @@ func (e *engineImpl) AbortInvocation(c context.Context, jobID string, invID int6
 		return err
 	}
 
-	ctl.DebugLog("Invocation is manually aborted by %q", who)
+	ctl.DebugLog("Invocation is %s", why)
 	if err = ctl.manager.AbortTask(c, ctl); err != nil {
 		logging.Errorf(c, "Failed to abort the task - %s", err)
 		return err
 	}
 
-	ctl.State().Status = task.StatusAborted
+	ctl.State().Status = status
 	if err = ctl.Save(); err != nil {
 		logging.Errorf(c, "Failed to save the invocation - %s", err)
 		return err
@@ -1014,6 +1152,8 @@ func (e *engineImpl) updateJob(c context.Context, def catalog.Definition) error
 		job.Enabled = true
 		job.Schedule = def.Schedule
 		job.Task = def.Task
+		job.Triggers = triggerKeys(def.Triggers)
+		job.Policy = def.Policy
 
 		// Do state machine transitions.
 		if !oldEnabled {
@@ -1101,10 +1241,65 @@ func (e *engineImpl) recordOverrun(c context.Context, jobID string, overruns int
 	return errors.WrapTransient(ds.Put(&inv))
 }
 
+// triggerDownstream is invoked via task queue when an invocation of a job
+// finishes. It starts all enabled jobs that trigger on the invocation's final
+// status.
+func (e *engineImpl) triggerDownstream(c context.Context, jobID string, invID int64, status task.Status) error {
+	ds := datastore.Get(c)
+	q := datastore.NewQuery("CronJob").Eq("Triggers", triggerKey(jobID, status))
+	keys := []*datastore.Key{}
+	if err := ds.GetAll(q, &keys); err != nil {
+		return errors.WrapTransient(err)
+	}
+	wg := sync.WaitGroup{}
+	errs := errors.NewLazyMultiError(len(keys))
+	for i, key := range keys {
+		wg.Add(1)
+		go func(i int, downstreamID string) {
+			errs.Assign(i, e.txn(c, downstreamID, func(c context.Context, job *CronJob, isNew bool) error {
+				// Non-ancestor query used, need to recheck the job is still enabled
+				// and still triggers on the event.
+				if isNew || !job.Enabled {
+					return errSkipPut
+				}
+				want := triggerKey(jobID, status)
+				found := false
+				for _, t := range job.Triggers {
+					if t == want {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return errSkipPut
+				}
+				logging.Infof(c, "Triggered by invocation %d of %s (%s)", invID, jobID, status)
+				return e.rollSM(c, job, func(sm *StateMachine) error {
+					if sm.State.State == JobStateDisabled {
+						logging.Warningf(c, "The job is disabled, ignoring the trigger")
+						return nil
+					}
+					if err := sm.OnTriggered(jobID, invID); err != nil {
+						return err
+					}
+					if sm.State.Pending && sm.State.PendingTriggeringJobID == jobID && sm.State.PendingTriggeringInvID == invID {
+						logging.Infof(c, "The job is %s, the trigger is queued as pending", sm.State.State)
+					}
+					return nil
+				})
+			}))
+			wg.Done()
+		}(i, key.StringID())
+	}
+	wg.Wait()
+	return errors.WrapTransient(errs.Get())
+}
+
 // startInvocation is called via task queue to start running a job. This call
 // may be retried by task queue service.
 func (e *engineImpl) startInvocation(c context.Context, jobID string, invocationNonce int64,
-	triggeredBy identity.Identity, retryCount int) error {
+	triggeredBy identity.Identity, triggeringJobID string, triggeringInvID int64,
+	retry int, retryCount int) error {
 
 	c = logging.SetField(c, "JobID", jobID)
 	c = logging.SetField(c, "InvNonce", invocationNonce)
@@ -1148,8 +1343,11 @@ func (e *engineImpl) startInvocation(c context.Context, jobID string, invocation
 			ID:              invID,
 			JobKey:          jobKey,
 			Started:         clock.Now(c).UTC(),
+			Scheduled:       job.State.InvocationTime,
 			InvocationNonce: invocationNonce,
 			TriggeredBy:     triggeredBy,
+			TriggeringJobID: triggeringJobID,
+			TriggeringInvID: triggeringInvID,
 			Revision:        job.Revision,
 			RevisionURL:     job.RevisionURL,
 			Task:            job.Task,
@@ -1160,6 +1358,12 @@ func (e *engineImpl) startInvocation(c context.Context, jobID string, invocation
 		if triggeredBy != "" {
 			inv.debugLog(c, "Manually triggered by %s", triggeredBy)
 		}
+		if triggeringJobID != "" {
+			inv.debugLog(c, "Triggered by invocation %d of %s", triggeringInvID, triggeringJobID)
+		}
+		if retry != 0 {
+			inv.debugLog(c, "Retrying failed invocation (retry %d)", retry)
+		}
 		if err := ds.Put(&inv); err != nil {
 			return err
 		}
@@ -1493,6 +1697,11 @@ func (ctl *taskController) InvocationNonce() int64 {
 	return ctl.saved.InvocationNonce
 }
 
+// ScheduledTime is part of task.Controller interface.
+func (ctl *taskController) ScheduledTime() time.Time {
+	return ctl.saved.Scheduled
+}
+
 // Task is part of task.Controller interface.
 func (ctl *taskController) Task() proto.Message {
 	return ctl.task
@@ -1631,7 +1840,7 @@ func (ctl *taskController) saveImpl(updateCronJob bool) (err error) {
 		}
 		if hasFinished {
 			return ctl.eng.rollSM(c, job, func(sm *StateMachine) error {
-				return sm.OnInvocationDone(saving.ID)
+				return sm.OnInvocationDone(saving.ID, saving.Status)
 			})
 		}
 		return nil
@@ -487,6 +487,109 @@ func TestFullFlow(t *testing.T) {
 	})
 }
 
+func TestTriggeredFlow(t *testing.T) {
+	Convey("triggered flow", t, func() {
+		c := newTestContext(epoch)
+		e, mgr := newTestEngine()
+		taskBytes := noopTaskBytes()
+
+		// "abc/up" is on a manual schedule, "abc/down" runs when it succeeds.
+		So(e.UpdateProjectJobs(c, "abc", []catalog.Definition{
+			{
+				JobID:    "abc/up",
+				Revision: "rev1",
+				Schedule: "manual",
+				Task:     taskBytes,
+			},
+			{
+				JobID:    "abc/down",
+				Revision: "rev1",
+				Schedule: "manual",
+				Task:     taskBytes,
+				Triggers: []catalog.Trigger{
+					{JobID: "abc/up", Status: task.StatusSucceeded},
+				},
+			},
+		}), ShouldBeNil)
+		down, err := e.GetCronJob(c, "abc/down")
+		So(err, ShouldBeNil)
+		So(down.Triggers, ShouldResemble, []string{"abc/up:SUCCEEDED"})
+		So(down.State.State, ShouldEqual, JobStateSuspended)
+
+		// Same triggers -> noop.
+		So(down.matches(catalog.Definition{
+			JobID:    "abc/down",
+			Schedule: "manual",
+			Task:     taskBytes,
+			Triggers: []catalog.Trigger{
+				{JobID: "abc/up", Status: task.StatusSucceeded},
+			},
+		}), ShouldBeTrue)
+		So(down.matches(catalog.Definition{
+			JobID:    "abc/down",
+			Schedule: "manual",
+			Task:     taskBytes,
+		}), ShouldBeFalse)
+
+		// Run "abc/up" to completion.
+		_, err = e.TriggerInvocation(c, "abc/up", "user:someone@example.com")
+		So(err, ShouldBeNil)
+		invTask := ensureOneTask(c, "invs-q")
+		taskqueue.Get(c).Testable().ResetTasks()
+		mgr.launchTask = func(ctl task.Controller) error {
+			ctl.State().Status = task.StatusSucceeded
+			return nil
+		}
+		So(e.ExecuteSerializedAction(c, invTask.Payload, 0), ShouldBeNil)
+		up, err := e.GetCronJob(c, "abc/up")
+		So(err, ShouldBeNil)
+		So(up.State.State, ShouldEqual, JobStateSuspended)
+
+		// It emitted InvocationDoneAction.
+		doneTask := ensureOneTask(c, "invs-q")
+		taskqueue.Get(c).Testable().ResetTasks()
+		payload := actionTaskPayload{}
+		So(json.Unmarshal(doneTask.Payload, &payload), ShouldBeNil)
+		So(payload.Kind, ShouldEqual, "InvocationDoneAction")
+		So(payload.JobID, ShouldEqual, "abc/up")
+		So(payload.Status, ShouldEqual, "SUCCEEDED")
+		upInvID := payload.InvocationID
+
+		// Executing it queues "abc/down".
+		datastore.Get(c).Testable().CatchupIndexes()
+		So(e.ExecuteSerializedAction(c, doneTask.Payload, 0), ShouldBeNil)
+		down, err = e.GetCronJob(c, "abc/down")
+		So(err, ShouldBeNil)
+		So(down.State.State, ShouldEqual, JobStateQueued)
+		startTask := ensureOneTask(c, "invs-q")
+		taskqueue.Get(c).Testable().ResetTasks()
+		payload = actionTaskPayload{}
+		So(json.Unmarshal(startTask.Payload, &payload), ShouldBeNil)
+		So(payload.Kind, ShouldEqual, "StartInvocationAction")
+		So(payload.JobID, ShouldEqual, "abc/down")
+		So(payload.TriggeringJobID, ShouldEqual, "abc/up")
+		So(payload.TriggeringInvID, ShouldEqual, upInvID)
+
+		// Redelivery of the same event is ignored, the job is already queued.
+		So(e.ExecuteSerializedAction(c, doneTask.Payload, 1), ShouldBeNil)
+		ensureZeroTasks(c, "invs-q")
+
+		// The invocation of "abc/down" knows what triggered it.
+		So(e.ExecuteSerializedAction(c, startTask.Payload, 0), ShouldBeNil)
+		invs, _, err := e.ListInvocations(c, "abc/down", 0, "")
+		So(err, ShouldBeNil)
+		So(len(invs), ShouldEqual, 1)
+		So(invs[0].TriggeringJobID, ShouldEqual, "abc/up")
+		So(invs[0].TriggeringInvID, ShouldEqual, upInvID)
+		So(invs[0].DebugLog, ShouldContainSubstring, "Triggered by invocation")
+
+		// Failures of "abc/up" don't trigger "abc/down".
+		taskqueue.Get(c).Testable().ResetTasks()
+		So(e.triggerDownstream(c, "abc/up", 123, task.StatusFailed), ShouldBeNil)
+		ensureZeroTasks(c, "invs-q")
+	})
+}
+
 func TestGenerateInvocationID(t *testing.T) {
 	Convey("generateInvocationID does not collide", t, func() {
 		c := newTestContext(epoch)
@@ -776,7 +879,7 @@ func TestAborts(t *testing.T) {
 			So(ctl.Save(), ShouldBeNil)
 			return nil
 		}
-		So(e.startInvocation(c, jobID, invNonce, "", 0), ShouldBeNil)
+		So(e.startInvocation(c, jobID, invNonce, "", "", 0, 0, 0), ShouldBeNil)
 
 		// It is alive and cron job entity tracks it.
 		inv, err := e.GetInvocation(c, jobID, invID)
@@ -803,6 +906,27 @@ func TestAborts(t *testing.T) {
 			So(job.State.InvocationID, ShouldEqual, 0)
 		})
 
+		Convey("AbortInvocationAction works", func() {
+			// Kill it, as if it timed out.
+			blob, err := json.Marshal(actionTaskPayload{
+				JobID:        jobID,
+				Kind:         "AbortInvocationAction",
+				InvocationID: invID,
+				Reason:       "timeout",
+			})
+			So(err, ShouldBeNil)
+			So(e.ExecuteSerializedAction(c, blob, 0), ShouldBeNil)
+
+			// It is dead.
+			inv, err = e.GetInvocation(c, jobID, invID)
+			So(err, ShouldBeNil)
+			So(inv.Status, ShouldEqual, task.StatusFailed)
+			So(inv.DebugLog, ShouldContainSubstring, "Invocation is aborted due to timeout")
+
+			// Aborting it again is noop.
+			So(e.abortInvocation(c, jobID, invID, "timeout"), ShouldBeNil)
+		})
+
 		Convey("AbortJob works", func() {
 			// Kill it.
 			So(e.AbortJob(c, jobID, ""), ShouldBeNil)
@@ -11,7 +11,9 @@ import (
 
 	"golang.org/x/net/context"
 
+	"github.com/luci/luci-go/cron/appengine/catalog"
 	"github.com/luci/luci-go/cron/appengine/schedule"
+	"github.com/luci/luci-go/cron/appengine/task"
 	"github.com/luci/luci-go/server/auth/identity"
 )
 
@@ -81,6 +83,53 @@ type JobState struct {
 
 	// InvocationID is ID of currently running invocation or 0 if none is running.
 	InvocationID int64 `gae:",noindex"`
+
+	// Retries is how many times the current invocation request was retried.
+	Retries int `gae:",noindex"`
+
+	// InvocationTriggeredBy, InvocationTriggeringJobID and
+	// InvocationTriggeringInvID describe what started the current invocation
+	// request (see StartInvocationAction). They are passed on to retries.
+	InvocationTriggeredBy     identity.Identity `gae:",noindex"`
+	InvocationTriggeringJobID string            `gae:",noindex"`
+	InvocationTriggeringInvID int64             `gae:",noindex"`
+
+	// Detached is a list of IDs of invocations that are still running, but are
+	// no longer tracked as the current one, because a newer invocation has been
+	// started since then. Used only if the job allows concurrent invocations.
+	Detached []int64 `gae:",noindex"`
+
+	// Pending is true if the job was asked to start an invocation while all
+	// invocation slots were taken, and the job's overlap policy says to start it
+	// later, when some running invocation finishes. Triggers (see OnTriggered)
+	// are always kept pending this way.
+	Pending bool `gae:",noindex"`
+
+	// PendingTriggeringJobID and PendingTriggeringInvID identify the invocation
+	// whose completion triggered the pending invocation, if it was triggered.
+	// If many triggers arrive while the job is busy, the latest one is kept.
+	PendingTriggeringJobID string `gae:",noindex"`
+	PendingTriggeringInvID int64  `gae:",noindex"`
+}
+
+// isEqual returns true iff 's' is equal to 'other'.
+func (s *JobState) isEqual(other *JobState) bool {
+	return s == other || (s.State == other.State &&
+		s.Overruns == other.Overruns &&
+		s.TickNonce == other.TickNonce &&
+		s.TickTime.Equal(other.TickTime) &&
+		s.PrevTime.Equal(other.PrevTime) &&
+		s.InvocationNonce == other.InvocationNonce &&
+		s.InvocationTime.Equal(other.InvocationTime) &&
+		s.InvocationID == other.InvocationID &&
+		s.Retries == other.Retries &&
+		s.InvocationTriggeredBy == other.InvocationTriggeredBy &&
+		s.InvocationTriggeringJobID == other.InvocationTriggeringJobID &&
+		s.InvocationTriggeringInvID == other.InvocationTriggeringInvID &&
+		equalIDs(s.Detached, other.Detached) &&
+		s.Pending == other.Pending &&
+		s.PendingTriggeringJobID == other.PendingTriggeringJobID &&
+		s.PendingTriggeringInvID == other.PendingTriggeringInvID)
 }
 
 // IsExpectingInvocation returns true if the state machine accepts
@@ -108,9 +157,18 @@ func (a TickLaterAction) IsAction() bool { return true }
 // StartInvocationAction enqueues invocation of the actual job.
 // OnInvocationDone(invocationNonce) will be called sometime later when the job
 // is done.
+//
+// TriggeredBy is set for manual invocations, TriggeringJobID and
+// TriggeringInvID are set for invocations started by some other job finishing
+// (see OnTriggered). Retry is non zero for retries of failed invocations, they
+// should start no sooner than Delay from now.
 type StartInvocationAction struct {
 	InvocationNonce int64
 	TriggeredBy     identity.Identity
+	TriggeringJobID string
+	TriggeringInvID int64
+	Retry           int
+	Delay           time.Duration
 }
 
 // IsAction makes StartInvocationAction implement Action interface.
@@ -128,6 +186,28 @@ type RecordOverrunAction struct {
 // IsAction makes RecordOverrunAction implement Action interface.
 func (a RecordOverrunAction) IsAction() bool { return true }
 
+// InvocationDoneAction instructs Engine to notify jobs that trigger on this job
+// that its invocation has finished with the given status. See OnTriggered.
+type InvocationDoneAction struct {
+	InvocationID int64
+	Status       task.Status
+}
+
+// IsAction makes InvocationDoneAction implement Action interface.
+func (a InvocationDoneAction) IsAction() bool { return true }
+
+// AbortInvocationAction instructs Engine to abort a running invocation at
+// given moment in time (or right away if When is zero). Used to enforce
+// invocation timeouts and to replace overlapping invocations.
+type AbortInvocationAction struct {
+	InvocationID int64
+	When         time.Time
+	Reason       string
+}
+
+// IsAction makes AbortInvocationAction implement Action interface.
+func (a AbortInvocationAction) IsAction() bool { return true }
+
 // StateMachine advances state of some single cron job. It performs a single
 // step only (one On* call). As input it takes the state of the job and state of
 // the world (the schedule is considered to be a part of the world state).
@@ -142,10 +222,19 @@ func (a RecordOverrunAction) IsAction() bool { return true }
 //
 // The lifecycle of a healthy cron job:
 // DISABLED -> SCHEDULED -> QUEUED -> QUEUED (starting) -> RUNNING -> SCHEDULED
+//
+// A job goes from SCHEDULED (or SUSPENDED) to QUEUED on a timer tick, when
+// started manually, or when triggered by another job (see OnTriggered).
+//
+// If the job's policy allows concurrent invocations, a running invocation is
+// "detached" (see JobState.Detached) when a new one is queued, so the job goes
+// from RUNNING to QUEUED directly. Failed invocations may be retried, in that
+// case the job goes from RUNNING back to QUEUED too.
 type StateMachine struct {
 	// Inputs.
 	Now      time.Time          // current time
 	Schedule *schedule.Schedule // knows when to run the job next time
+	Policy   catalog.Policy     // how to handle retries, overlaps and timeouts
 	Nonce    func() int64       // produces a series of nonces on demand
 
 	// Mutated.
@@ -203,30 +292,39 @@ func (m *StateMachine) OnTimerTick(tickNonce int64) error {
 		m.resetTick()
 	}
 
-	// Was waiting for a tick to start a job? Add invocation to the queue.
-	if m.State.State == JobStateScheduled {
-		m.State.State = JobStateQueued
-		m.queueInvocation("")
+	// Was waiting for a tick to start a job (or can run one more invocation
+	// concurrently)? Add invocation to the queue. Job's overlap policy may also
+	// tell to queue or replace the invocation if there are no free slots.
+	if m.tryStart(StartInvocationAction{}) {
 		return nil
 	}
 
 	// Already running a job (or have one in the queue) and it's time to launch
 	// a new invocation? Skip this tick completely.
-	//
-	// TODO(vadimsh): Make overrun policy configurable. Also handle permanently
-	// stuck jobs.
 	switch m.State.State {
 	case JobStateRunning, JobStateOverrun:
 		m.State.State = JobStateOverrun
 	case JobStateQueued, JobStateSlowQueue:
 		m.State.State = JobStateSlowQueue
+	case JobStateScheduled:
+		// All invocation slots are taken by detached invocations. Their completion
+		// doesn't schedule ticks, so on a relative schedule start waiting for the
+		// next one right away, counting the interval from now. Otherwise the job
+		// would never tick again.
+		if !m.Schedule.IsAbsolute() {
+			m.scheduleTickAfter(m.Now)
+		}
 	default:
 		impossible("impossible state %s", m.State.State)
 	}
+	runningID := m.State.InvocationID
+	if runningID == 0 && len(m.State.Detached) != 0 {
+		runningID = m.State.Detached[0]
+	}
 	m.State.Overruns++
 	m.emitAction(RecordOverrunAction{
 		Overruns:            m.State.Overruns,
-		RunningInvocationID: m.State.InvocationID,
+		RunningInvocationID: runningID,
 	})
 	return nil
 }
@@ -253,25 +351,72 @@ func (m *StateMachine) OnInvocationStarted(invocationID int64) error {
 		default:
 			impossible("impossible state %s", m.State.State)
 		}
+		if m.Policy.Timeout > 0 {
+			m.emitAction(AbortInvocationAction{
+				InvocationID: invocationID,
+				When:         m.Now.Add(m.Policy.Timeout),
+				Reason:       "timeout",
+			})
+		}
 	}
 	return nil
 }
 
-// OnInvocationDone happens when invocation completes.
-func (m *StateMachine) OnInvocationDone(invocationID int64) error {
+// OnInvocationDone happens when invocation completes with the given final
+// status.
+//
+// Failed invocations are retried (if job's policy says so) by queuing a new
+// invocation request. InvocationDoneAction is emitted only when there will be
+// no more retries.
+func (m *StateMachine) OnInvocationDone(invocationID int64, status task.Status) error {
+	// One of the detached invocations has finished? Its slot is free now.
+	if idx := indexOfID(m.State.Detached, invocationID); idx != -1 {
+		m.State.Detached = append(m.State.Detached[:idx:idx], m.State.Detached[idx+1:]...)
+		if len(m.State.Detached) == 0 {
+			m.State.Detached = nil
+		}
+		m.emitAction(InvocationDoneAction{
+			InvocationID: invocationID,
+			Status:       status,
+		})
+		m.maybeStartPending()
+		return nil
+	}
+
 	// Ignore unexpected events. Can happen if job was moved to disabled state
-	// while invocation was still running.
+	// while invocation was still running, or if the invocation was replaced by
+	// a newer one.
 	if m.State.State != JobStateRunning && m.State.State != JobStateOverrun {
 		return nil
 	}
 	if m.State.InvocationID != invocationID {
 		return nil
 	}
+
+	// Queue a retry if allowed. Don't touch the tick: the job is still busy.
+	if status == task.StatusFailed && m.State.Retries < m.Policy.MaxRetries {
+		retry := m.State.Retries + 1
+		m.State.State = JobStateQueued
+		m.queueInvocation(StartInvocationAction{
+			TriggeredBy:     m.State.InvocationTriggeredBy,
+			TriggeringJobID: m.State.InvocationTriggeringJobID,
+			TriggeringInvID: m.State.InvocationTriggeringInvID,
+			Retry:           retry,
+			Delay:           m.retryDelay(retry),
+		})
+		return nil
+	}
+
 	m.State.State = JobStateScheduled
 	m.State.PrevTime = m.Now
 	m.resetInvocation()      // forget about just finished invocation
 	m.scheduleTick()         // start waiting for a new one
 	m.maybeSuspendOrResume() // switch back to suspended state if necessary
+	m.emitAction(InvocationDoneAction{
+		InvocationID: invocationID,
+		Status:       status,
+	})
+	m.maybeStartPending()
 	return nil
 }
 
@@ -315,16 +460,33 @@ func (m *StateMachine) OnScheduleChange() error {
 }
 
 // OnManualInvocation happens when user starts invocation via "Run now" button.
-// Manual invocation only works if the job is currently not running or not
-// queued for run (i.e. it is in Scheduled state waiting for a timer tick).
+// Manual invocation only works if the job has a free invocation slot (i.e. it
+// is in Scheduled state waiting for a timer tick, or it is allowed to run more
+// invocations concurrently). Job's overlap policy is not applied.
 func (m *StateMachine) OnManualInvocation(triggeredBy identity.Identity) error {
-	if m.State.State != JobStateScheduled && m.State.State != JobStateSuspended {
+	if !m.hasFreeSlot() {
 		return errors.New("the job is already running or about to start")
 	}
-	m.State.State = JobStateQueued
-	m.queueInvocation(triggeredBy)
-	if !m.Schedule.IsAbsolute() {
-		m.resetTick() // will be set again when invocation ends
+	m.startInvocation(StartInvocationAction{TriggeredBy: triggeredBy})
+	return nil
+}
+
+// OnTriggered happens when an invocation of a job this job triggers on (see
+// InvocationDoneAction) finishes. Like timer ticks, triggers start a new
+// invocation if the job has a free invocation slot (or its overlap policy says
+// what to do otherwise). Unlike OnTimerTick, triggers that arrive while the job
+// is busy are not skipped: the invocation is kept pending until some running
+// invocation finishes (see JobState.Pending).
+func (m *StateMachine) OnTriggered(jobID string, invocationID int64) error {
+	if m.State.State == JobStateDisabled {
+		return nil
+	}
+	a := StartInvocationAction{
+		TriggeringJobID: jobID,
+		TriggeringInvID: invocationID,
+	}
+	if !m.tryStart(a) {
+		m.setPending(a)
 	}
 	return nil
 }
@@ -334,7 +496,13 @@ func (m *StateMachine) OnManualInvocation(triggeredBy identity.Identity) error {
 // scheduleTick emits TickLaterAction action according to job's schedule. Does
 // nothing if the tick is already scheduled.
 func (m *StateMachine) scheduleTick() {
-	nextTick := m.Schedule.Next(m.Now, m.State.PrevTime)
+	m.scheduleTickAfter(m.State.PrevTime)
+}
+
+// scheduleTickAfter is like scheduleTick, except it counts relative schedule
+// intervals from 'prev' instead of the time the last invocation finished.
+func (m *StateMachine) scheduleTickAfter(prev time.Time) {
+	nextTick := m.Schedule.Next(m.Now, prev)
 	if nextTick != m.State.TickTime {
 		m.State.TickTime = nextTick
 		m.State.TickNonce = m.Nonce()
@@ -366,16 +534,19 @@ func (m *StateMachine) resetTick() {
 }
 
 // queueInvocation generates a new invocation nonce and asks engine to start
-// a new invocation.
-func (m *StateMachine) queueInvocation(triggeredBy identity.Identity) {
+// a new invocation. 'a' describes what triggered the invocation, its nonce is
+// populated here.
+func (m *StateMachine) queueInvocation(a StartInvocationAction) {
 	m.State.InvocationTime = m.Now
 	m.State.InvocationNonce = m.Nonce()
 	m.State.InvocationID = 0
 	m.State.Overruns = 0
-	m.emitAction(StartInvocationAction{
-		InvocationNonce: m.State.InvocationNonce,
-		TriggeredBy:     triggeredBy,
-	})
+	m.State.Retries = a.Retry
+	m.State.InvocationTriggeredBy = a.TriggeredBy
+	m.State.InvocationTriggeringJobID = a.TriggeringJobID
+	m.State.InvocationTriggeringInvID = a.TriggeringInvID
+	a.InvocationNonce = m.State.InvocationNonce
+	m.emitAction(a)
 }
 
 // resetInvocation clears invocation related part of the state.
@@ -384,6 +555,168 @@ func (m *StateMachine) resetInvocation() {
 	m.State.InvocationTime = time.Time{}
 	m.State.InvocationID = 0
 	m.State.Overruns = 0
+	m.State.Retries = 0
+	m.State.InvocationTriggeredBy = ""
+	m.State.InvocationTriggeringJobID = ""
+	m.State.InvocationTriggeringInvID = 0
+}
+
+// maxConcurrent returns how many invocations of the job may run at once.
+func (m *StateMachine) maxConcurrent() int {
+	if m.Policy.MaxConcurrent < 1 {
+		return 1
+	}
+	return m.Policy.MaxConcurrent
+}
+
+// hasFreeSlot returns true if the job can start one more invocation right now
+// without exceeding its concurrency limit.
+func (m *StateMachine) hasFreeSlot() bool {
+	switch m.State.State {
+	case JobStateScheduled, JobStateSuspended:
+		return len(m.State.Detached) < m.maxConcurrent()
+	case JobStateRunning, JobStateOverrun:
+		return len(m.State.Detached)+1 < m.maxConcurrent()
+	default:
+		return false // already have an invocation request in the queue
+	}
+}
+
+// startInvocation moves the job to Queued state and queues a new invocation,
+// detaching currently running invocation first (if any). Must be called only
+// if hasFreeSlot() is true.
+func (m *StateMachine) startInvocation(a StartInvocationAction) {
+	if m.State.State == JobStateRunning || m.State.State == JobStateOverrun {
+		m.State.Detached = append(m.State.Detached, m.State.InvocationID)
+	}
+	m.State.State = JobStateQueued
+	m.queueInvocation(a)
+	if !m.Schedule.IsAbsolute() {
+		m.resetTick() // will be set again when invocation ends
+	}
+}
+
+// tryStart starts a new invocation if the job has a free invocation slot, or
+// applies the job's overlap policy otherwise. Returns false if the request to
+// start the invocation should be skipped.
+func (m *StateMachine) tryStart(a StartInvocationAction) bool {
+	if m.hasFreeSlot() {
+		m.startInvocation(a)
+		return true
+	}
+	switch m.Policy.Overlap {
+	case catalog.OverlapQueue:
+		m.setPending(a)
+		return true
+	case catalog.OverlapReplace:
+		return m.replaceOldest(a)
+	default:
+		return false
+	}
+}
+
+// replaceOldest aborts the oldest running invocation and starts a new one in
+// its place, superseding a pending invocation, if any. Does nothing and
+// returns false if the job has an invocation request in the queue already: it
+// will start soon anyway.
+//
+// The aborted invocation is forgotten right away, so its completion doesn't
+// trigger other jobs.
+func (m *StateMachine) replaceOldest(a StartInvocationAction) bool {
+	var victim int64
+	switch {
+	case m.State.State == JobStateQueued || m.State.State == JobStateSlowQueue:
+		return false
+	case len(m.State.Detached) != 0:
+		victim = m.State.Detached[0]
+		m.State.Detached = m.State.Detached[1:]
+		if len(m.State.Detached) == 0 {
+			m.State.Detached = nil
+		}
+	case m.State.State == JobStateRunning || m.State.State == JobStateOverrun:
+		victim = m.State.InvocationID
+		m.State.State = JobStateScheduled
+		m.resetInvocation()
+	default:
+		return false
+	}
+	m.emitAction(AbortInvocationAction{
+		InvocationID: victim,
+		Reason:       "replaced",
+	})
+	m.clearPending()
+	if m.hasFreeSlot() {
+		m.startInvocation(a)
+	}
+	return true
+}
+
+// setPending postpones the invocation described by 'a' until some running
+// invocation finishes. Postponed invocations are coalesced into one.
+func (m *StateMachine) setPending(a StartInvocationAction) {
+	m.State.Pending = true
+	if a.TriggeringJobID != "" {
+		m.State.PendingTriggeringJobID = a.TriggeringJobID
+		m.State.PendingTriggeringInvID = a.TriggeringInvID
+	}
+}
+
+// maybeStartPending starts a postponed invocation (see setPending) if there's
+// a free invocation slot for it now.
+func (m *StateMachine) maybeStartPending() {
+	if m.State.Pending && m.hasFreeSlot() {
+		a := StartInvocationAction{
+			TriggeringJobID: m.State.PendingTriggeringJobID,
+			TriggeringInvID: m.State.PendingTriggeringInvID,
+		}
+		m.clearPending()
+		m.startInvocation(a)
+	}
+}
+
+// clearPending forgets a postponed invocation.
+func (m *StateMachine) clearPending() {
+	m.State.Pending = false
+	m.State.PendingTriggeringJobID = ""
+	m.State.PendingTriggeringInvID = 0
+}
+
+// retryDelay returns how long to wait before starting retry number 'retry'.
+func (m *StateMachine) retryDelay(retry int) time.Duration {
+	delay := m.Policy.RetryBackoff
+	for i := 1; i < retry; i++ {
+		if m.Policy.MaxRetryBackoff > 0 && delay >= m.Policy.MaxRetryBackoff {
+			break
+		}
+		delay *= 2
+	}
+	if m.Policy.MaxRetryBackoff > 0 && delay > m.Policy.MaxRetryBackoff {
+		delay = m.Policy.MaxRetryBackoff
+	}
+	return delay
+}
+
+// indexOfID returns index of 'id' in 'ids' or -1 if it's not there.
+func indexOfID(ids []int64, id int64) int {
+	for i, x := range ids {
+		if x == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// equalIDs returns true if two lists of IDs are equal.
+func equalIDs(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
 
 // emitAction adds a generic action to 'actions' array.
@@ -8,7 +8,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/luci/luci-go/cron/appengine/catalog"
 	"github.com/luci/luci-go/cron/appengine/schedule"
+	"github.com/luci/luci-go/cron/appengine/task"
+	"github.com/luci/luci-go/server/auth/identity"
+
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -25,7 +29,7 @@ func TestStateMachine(t *testing.T) {
 		So(m.state.State, ShouldEqual, JobStateDisabled)
 		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarted(1) }), ShouldBeNil)
 		So(m.state.State, ShouldEqual, JobStateDisabled)
-		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1, task.StatusSucceeded) }), ShouldBeNil)
 		So(m.state.State, ShouldEqual, JobStateDisabled)
 		So(m.roll(func(sm *StateMachine) error { return sm.OnScheduleChange() }), ShouldBeNil)
 		So(m.state.State, ShouldEqual, JobStateDisabled)
@@ -82,14 +86,18 @@ func TestStateMachine(t *testing.T) {
 		So(m.state.State, ShouldEqual, JobStateRunning)
 
 		// Skip wrong invocation ID.
-		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1001) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1001, task.StatusSucceeded) }), ShouldBeNil)
 		So(m.state.State, ShouldEqual, JobStateRunning)
 
 		// End of the cycle. Ends up in scheduled state, waiting for the tick added
 		// when StartInvocationAction was issued.
-		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1000) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1000, task.StatusSucceeded) }), ShouldBeNil)
 		So(m.state.State, ShouldEqual, JobStateScheduled)
 		So(m.state.TickNonce, ShouldEqual, 2)
+		So(m.actions, ShouldResemble, []Action{
+			InvocationDoneAction{InvocationID: 1000, Status: task.StatusSucceeded},
+		})
+		m.actions = nil
 
 		// Disable cancels the timer.
 		So(m.roll(func(sm *StateMachine) error { return sm.OnJobDisabled() }), ShouldBeNil)
@@ -136,7 +144,7 @@ func TestStateMachine(t *testing.T) {
 		So(m.state.Overruns, ShouldEqual, 1)
 
 		// End of the cycle.
-		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(100) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(100, task.StatusSucceeded) }), ShouldBeNil)
 		So(m.state.State, ShouldEqual, JobStateScheduled)
 	})
 
@@ -178,7 +186,7 @@ func TestStateMachine(t *testing.T) {
 		m.actions = nil
 
 		// End of the cycle.
-		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(100) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(100, task.StatusSucceeded) }), ShouldBeNil)
 		So(m.state.State, ShouldEqual, JobStateScheduled)
 	})
 
@@ -216,7 +224,7 @@ func TestStateMachine(t *testing.T) {
 		m.now = epoch.Add(20 * time.Second)
 
 		// End of the cycle. New tick is scheduled, 10s from current time.
-		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1000) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1000, task.StatusSucceeded) }), ShouldBeNil)
 		So(m.state.State, ShouldEqual, JobStateScheduled)
 		So(m.state.TickNonce, ShouldEqual, 3)
 		So(m.state.TickTime, ShouldResemble, m.now.Add(10*time.Second))
@@ -351,11 +359,395 @@ func TestStateMachine(t *testing.T) {
 	})
 }
 
+func TestTriggers(t *testing.T) {
+	Convey("OnTriggered works with rel schedule", t, func() {
+		m := newTestStateMachine("with 5s interval")
+
+		// Disabled jobs ignore triggers.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTriggered("abc/up", 123) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateDisabled)
+		So(m.actions, ShouldBeNil)
+
+		// Enabling schedules a tick after random amount of seconds.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobEnabled() }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateScheduled)
+		So(m.state.TickNonce, ShouldEqual, 1)
+		m.actions = nil
+
+		// Trigger queues an invocation and resets the tick.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTriggered("abc/up", 123) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.state.TickNonce, ShouldEqual, 0) // reset
+		So(m.actions, ShouldResemble, []Action{
+			StartInvocationAction{
+				InvocationNonce: 2,
+				TriggeringJobID: "abc/up",
+				TriggeringInvID: 123,
+			},
+		})
+		m.actions = nil
+
+		// Second trigger is kept pending. The job is queued already.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTriggered("abc/up", 456) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.state.InvocationNonce, ShouldEqual, 2)
+		So(m.state.Pending, ShouldBeTrue)
+		So(m.state.PendingTriggeringJobID, ShouldEqual, "abc/up")
+		So(m.state.PendingTriggeringInvID, ShouldEqual, 456)
+		So(m.actions, ShouldBeNil)
+
+		// It starts when the queued invocation finishes.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarting(2, 1000) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarted(1000) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1000, task.StatusSucceeded) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.state.Pending, ShouldBeFalse)
+		So(m.state.PendingTriggeringJobID, ShouldEqual, "")
+		So(m.state.TickNonce, ShouldEqual, 0) // reset again
+		So(m.actions[len(m.actions)-2:], ShouldResemble, []Action{
+			InvocationDoneAction{InvocationID: 1000, Status: task.StatusSucceeded},
+			StartInvocationAction{
+				InvocationNonce: 4,
+				TriggeringJobID: "abc/up",
+				TriggeringInvID: 456,
+			},
+		})
+	})
+
+	Convey("OnTriggered works with manual schedule", t, func() {
+		m := newTestStateMachine("manual")
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobEnabled() }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateSuspended)
+		So(m.actions, ShouldBeNil)
+
+		// Triggered.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTriggered("abc/up", 123) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.actions, ShouldResemble, []Action{
+			StartInvocationAction{
+				InvocationNonce: 2,
+				TriggeringJobID: "abc/up",
+				TriggeringInvID: 123,
+			},
+		})
+		m.actions = nil
+
+		// Runs and fails.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarting(2, 1000) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarted(1000) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateRunning)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1000, task.StatusFailed) }), ShouldBeNil)
+
+		// Back to waiting for a trigger, notifying downstream jobs.
+		So(m.state.State, ShouldEqual, JobStateSuspended)
+		So(m.actions, ShouldResemble, []Action{
+			InvocationDoneAction{InvocationID: 1000, Status: task.StatusFailed},
+		})
+	})
+}
+
+func TestPolicy(t *testing.T) {
+	// start moves queued invocation request with given nonce to running state.
+	start := func(m *testStateMachine, invNonce, invID int64) {
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarting(invNonce, invID) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationStarted(invID) }), ShouldBeNil)
+	}
+
+	Convey("Failed invocations are retried with backoff", t, func() {
+		m := newTestStateMachine("manual")
+		m.policy = catalog.Policy{
+			MaxRetries:      3,
+			RetryBackoff:    time.Minute,
+			MaxRetryBackoff: 3 * time.Minute,
+		}
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobEnabled() }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTriggered("abc/up", 123) }), ShouldBeNil)
+		So(m.state.InvocationNonce, ShouldEqual, 2)
+		m.actions = nil
+
+		// First failure. Retried in 1 min, keeping the trigger info.
+		start(m, 2, 1000)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1000, task.StatusFailed) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.state.Retries, ShouldEqual, 1)
+		So(m.actions, ShouldResemble, []Action{
+			StartInvocationAction{
+				InvocationNonce: 3,
+				TriggeringJobID: "abc/up",
+				TriggeringInvID: 123,
+				Retry:           1,
+				Delay:           time.Minute,
+			},
+		})
+		m.actions = nil
+
+		// Second failure. Retried in 2 min.
+		start(m, 3, 1001)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1001, task.StatusFailed) }), ShouldBeNil)
+		So(m.actions, ShouldResemble, []Action{
+			StartInvocationAction{
+				InvocationNonce: 4,
+				TriggeringJobID: "abc/up",
+				TriggeringInvID: 123,
+				Retry:           2,
+				Delay:           2 * time.Minute,
+			},
+		})
+		m.actions = nil
+
+		// Third failure. Retried in 3 min (capped by MaxRetryBackoff).
+		start(m, 4, 1002)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1002, task.StatusFailed) }), ShouldBeNil)
+		So(m.actions, ShouldResemble, []Action{
+			StartInvocationAction{
+				InvocationNonce: 5,
+				TriggeringJobID: "abc/up",
+				TriggeringInvID: 123,
+				Retry:           3,
+				Delay:           3 * time.Minute,
+			},
+		})
+		m.actions = nil
+
+		// Last failure. No more retries, downstream jobs are notified.
+		start(m, 5, 1003)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1003, task.StatusFailed) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateSuspended)
+		So(m.state.Retries, ShouldEqual, 0)
+		So(m.actions, ShouldResemble, []Action{
+			InvocationDoneAction{InvocationID: 1003, Status: task.StatusFailed},
+		})
+	})
+
+	Convey("Manual invocations are retried on behalf of the same user", t, func() {
+		m := newTestStateMachine("manual")
+		m.policy = catalog.Policy{MaxRetries: 1, RetryBackoff: time.Minute}
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobEnabled() }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnManualInvocation("user:abc@example.com") }), ShouldBeNil)
+		start(m, 2, 1000)
+		m.actions = nil
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1000, task.StatusFailed) }), ShouldBeNil)
+		So(m.actions, ShouldResemble, []Action{
+			StartInvocationAction{
+				InvocationNonce: 3,
+				TriggeredBy:     "user:abc@example.com",
+				Retry:           1,
+				Delay:           time.Minute,
+			},
+		})
+		m.actions = nil
+
+		// The retry fails too. Trigger info is forgotten with the invocation.
+		start(m, 3, 1001)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1001, task.StatusFailed) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateSuspended)
+		So(m.state.InvocationTriggeredBy, ShouldEqual, identity.Identity(""))
+	})
+
+	Convey("Succeeded invocations are not retried", t, func() {
+		m := newTestStateMachine("manual")
+		m.policy = catalog.Policy{MaxRetries: 3, RetryBackoff: time.Minute}
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobEnabled() }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTriggered("abc/up", 123) }), ShouldBeNil)
+		start(m, 2, 1000)
+		m.actions = nil
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1000, task.StatusSucceeded) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateSuspended)
+		So(m.actions, ShouldResemble, []Action{
+			InvocationDoneAction{InvocationID: 1000, Status: task.StatusSucceeded},
+		})
+	})
+
+	Convey("Invocations run concurrently if allowed", t, func() {
+		m := newTestStateMachine("manual")
+		m.policy = catalog.Policy{MaxConcurrent: 2}
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobEnabled() }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTriggered("abc/up", 1) }), ShouldBeNil)
+		start(m, 2, 1000)
+
+		// Second invocation starts, the first one is detached.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTriggered("abc/up", 2) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.state.Detached, ShouldResemble, []int64{1000})
+		start(m, 3, 1001)
+		m.actions = nil
+
+		// Third one is kept pending, no free slots.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTriggered("abc/up", 3) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateRunning)
+		So(m.state.InvocationID, ShouldEqual, 1001)
+		So(m.state.Pending, ShouldBeTrue)
+		So(m.actions, ShouldBeNil)
+
+		// Manual invocation is rejected.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnManualInvocation("user:abc@example.com") }), ShouldNotBeNil)
+
+		// Detached invocation finishes, the pending one takes its slot.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1000, task.StatusSucceeded) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.state.Detached, ShouldResemble, []int64{1001})
+		So(m.state.Pending, ShouldBeFalse)
+		So(m.actions, ShouldResemble, []Action{
+			InvocationDoneAction{InvocationID: 1000, Status: task.StatusSucceeded},
+			StartInvocationAction{
+				InvocationNonce: 4,
+				TriggeringJobID: "abc/up",
+				TriggeringInvID: 3,
+			},
+		})
+		start(m, 4, 1002)
+		m.actions = nil
+
+		// Both finish.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1001, task.StatusSucceeded) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1002, task.StatusSucceeded) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateSuspended)
+		So(m.state.Detached, ShouldBeNil)
+		So(m.actions, ShouldResemble, []Action{
+			InvocationDoneAction{InvocationID: 1001, Status: task.StatusSucceeded},
+			InvocationDoneAction{InvocationID: 1002, Status: task.StatusSucceeded},
+		})
+	})
+
+	Convey("QUEUE overlap policy works", t, func() {
+		m := newTestStateMachine("manual")
+		m.policy = catalog.Policy{Overlap: catalog.OverlapQueue}
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobEnabled() }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTriggered("abc/up", 1) }), ShouldBeNil)
+		start(m, 2, 1000)
+		m.actions = nil
+
+		// Two triggers while running are coalesced into one pending invocation.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTriggered("abc/up", 2) }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTriggered("abc/up", 3) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateRunning)
+		So(m.state.Pending, ShouldBeTrue)
+		So(m.actions, ShouldBeNil)
+
+		// Pending invocation is queued when the running one finishes.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1000, task.StatusSucceeded) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.state.Pending, ShouldBeFalse)
+		So(m.actions, ShouldResemble, []Action{
+			InvocationDoneAction{InvocationID: 1000, Status: task.StatusSucceeded},
+			StartInvocationAction{
+				InvocationNonce: 3,
+				TriggeringJobID: "abc/up",
+				TriggeringInvID: 3,
+			},
+		})
+	})
+
+	Convey("REPLACE overlap policy works", t, func() {
+		m := newTestStateMachine("manual")
+		m.policy = catalog.Policy{Overlap: catalog.OverlapReplace}
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobEnabled() }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTriggered("abc/up", 1) }), ShouldBeNil)
+		m.actions = nil
+
+		// Queued invocation is not replaced, the trigger is kept pending.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTriggered("abc/up", 2) }), ShouldBeNil)
+		So(m.state.InvocationNonce, ShouldEqual, 2)
+		So(m.state.Pending, ShouldBeTrue)
+		So(m.actions, ShouldBeNil)
+
+		// Running invocation is aborted and a new one is queued.
+		start(m, 2, 1000)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTriggered("abc/up", 3) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.state.Detached, ShouldBeNil)
+		So(m.state.Pending, ShouldBeFalse) // superseded
+		So(m.actions, ShouldResemble, []Action{
+			AbortInvocationAction{InvocationID: 1000, Reason: "replaced"},
+			StartInvocationAction{
+				InvocationNonce: 3,
+				TriggeringJobID: "abc/up",
+				TriggeringInvID: 3,
+			},
+		})
+		m.actions = nil
+
+		// Completion of the aborted invocation is ignored.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1000, task.StatusAborted) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.actions, ShouldBeNil)
+	})
+
+	Convey("Ticks are not lost when detached invocations take all slots", t, func() {
+		m := newTestStateMachine("with 5s interval")
+		m.policy = catalog.Policy{MaxConcurrent: 2}
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobEnabled() }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTriggered("abc/up", 1) }), ShouldBeNil)
+		start(m, 2, 1000)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTriggered("abc/up", 2) }), ShouldBeNil)
+		start(m, 3, 1001)
+
+		// Concurrency limit is lowered while both are running, then the current
+		// one finishes. The detached one takes the only slot.
+		m.policy.MaxConcurrent = 1
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1001, task.StatusSucceeded) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateScheduled)
+		So(m.state.Detached, ShouldResemble, []int64{1000})
+		So(m.state.TickNonce, ShouldEqual, 4)
+		m.actions = nil
+
+		// The tick is skipped, but the next one is scheduled right away.
+		m.now = m.now.Add(5 * time.Second)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTimerTick(4) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateScheduled)
+		So(m.state.TickNonce, ShouldEqual, 5)
+		So(m.actions, ShouldResemble, []Action{
+			TickLaterAction{epoch.Add(10 * time.Second), 5},
+			RecordOverrunAction{Overruns: 1, RunningInvocationID: 1000},
+		})
+		m.actions = nil
+
+		// The detached invocation finishes, the next tick starts a new one.
+		So(m.roll(func(sm *StateMachine) error { return sm.OnInvocationDone(1000, task.StatusSucceeded) }), ShouldBeNil)
+		m.now = m.now.Add(5 * time.Second)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTimerTick(5) }), ShouldBeNil)
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.actions, ShouldResemble, []Action{
+			InvocationDoneAction{InvocationID: 1000, Status: task.StatusSucceeded},
+			StartInvocationAction{InvocationNonce: 6},
+		})
+	})
+
+	Convey("Timeout aborts invocations", t, func() {
+		m := newTestStateMachine("manual")
+		m.policy = catalog.Policy{Timeout: time.Hour}
+
+		So(m.roll(func(sm *StateMachine) error { return sm.OnJobEnabled() }), ShouldBeNil)
+		So(m.roll(func(sm *StateMachine) error { return sm.OnTriggered("abc/up", 1) }), ShouldBeNil)
+		m.actions = nil
+
+		start(m, 2, 1000)
+		So(m.actions, ShouldResemble, []Action{
+			AbortInvocationAction{
+				InvocationID: 1000,
+				When:         epoch.Add(time.Hour),
+				Reason:       "timeout",
+			},
+		})
+	})
+}
+
 type testStateMachine struct {
 	state    JobState
 	now      time.Time
 	nonce    int64
 	schedule *schedule.Schedule
+	policy   catalog.Policy
 	actions  []Action
 }
 
@@ -377,6 +769,7 @@ func (t *testStateMachine) roll(cb func(sm *StateMachine) error) error {
 		State:    t.state,
 		Now:      t.now,
 		Schedule: t.schedule,
+		Policy:   t.policy,
 		Nonce: func() int64 {
 			nonce++
 			return nonce
@@ -36,6 +36,7 @@ import (
 	"github.com/luci/luci-go/appengine/gaeauth/server"
 	"github.com/luci/luci-go/appengine/gaemiddleware"
 
+	"github.com/luci/luci-go/common/config/validation"
 	"github.com/luci/luci-go/common/errors"
 	"github.com/luci/luci-go/common/logging"
 
@@ -43,6 +44,7 @@ import (
 	"github.com/luci/luci-go/cron/appengine/engine"
 	"github.com/luci/luci-go/cron/appengine/task"
 	"github.com/luci/luci-go/cron/appengine/task/buildbucket"
+	"github.com/luci/luci-go/cron/appengine/task/httpcall"
 	"github.com/luci/luci-go/cron/appengine/task/noop"
 	"github.com/luci/luci-go/cron/appengine/task/swarming"
 	"github.com/luci/luci-go/cron/appengine/task/urlfetch"
@@ -58,6 +60,7 @@ var (
 	// Known kinds of tasks.
 	managers = []task.Manager{
 		&buildbucket.TaskManager{},
+		&httpcall.TaskManager{},
 		&noop.TaskManager{},
 		&swarming.TaskManager{},
 		&urlfetch.TaskManager{},
@@ -102,6 +105,10 @@ var globalInit sync.Once
 // initializeGlobalState does one time initialization for stuff that needs
 // active GAE context.
 func initializeGlobalState(c context.Context) {
+	// The name of the config file depends on the app ID, which is known only
+	// here. This runs before the first luci-config metadata request is served.
+	globalCatalog.RegisterConfigRules(c, &validation.Default)
+
 	if info.Get(c).IsDevAppServer() {
 		// Dev app server doesn't preserve the state of task queues across restarts,
 		// need to reset datastore state accordingly, otherwise everything gets stuck.
@@ -160,6 +167,7 @@ func init() {
 	r := router.New()
 
 	gaemiddleware.InstallHandlers(r, base())
+	validation.Default.InstallHandlers(r, base())
 	ui.InstallHandlers(r, base(), ui.Config{
 		Engine:        globalEngine,
 		TemplatesPath: "templates",
@@ -10,11 +10,14 @@ It is generated from these files:
 
 It has these top-level messages:
 	Job
+	Trigger
+	RetryPolicy
 	Task
 	NoopTask
 	UrlFetchTask
 	SwarmingTask
 	BuildbucketTask
+	HttpCallTask
 	ProjectConfig
 */
 package messages
@@ -34,6 +37,92 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// OverlapPolicy defines what to do when the job should start a new
+// invocation (due to its schedule or a trigger), but there are already
+// max_concurrent_invocations invocations running.
+type Job_OverlapPolicy int32
+
+const (
+	// SKIP skips the new invocation, recording it as an overrun.
+	Job_SKIP Job_OverlapPolicy = 0
+	// QUEUE starts the new invocation as soon as some running one finishes.
+	// Multiple skipped starts are coalesced into one.
+	Job_QUEUE Job_OverlapPolicy = 1
+	// REPLACE aborts the oldest running invocation and starts the new one.
+	Job_REPLACE Job_OverlapPolicy = 2
+)
+
+var Job_OverlapPolicy_name = map[int32]string{
+	0: "SKIP",
+	1: "QUEUE",
+	2: "REPLACE",
+}
+var Job_OverlapPolicy_value = map[string]int32{
+	"SKIP":    0,
+	"QUEUE":   1,
+	"REPLACE": 2,
+}
+
+func (x Job_OverlapPolicy) Enum() *Job_OverlapPolicy {
+	p := new(Job_OverlapPolicy)
+	*p = x
+	return p
+}
+func (x Job_OverlapPolicy) String() string {
+	return proto.EnumName(Job_OverlapPolicy_name, int32(x))
+}
+func (x *Job_OverlapPolicy) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Job_OverlapPolicy_value, data, "Job_OverlapPolicy")
+	if err != nil {
+		return err
+	}
+	*x = Job_OverlapPolicy(value)
+	return nil
+}
+func (Job_OverlapPolicy) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 0} }
+
+// Auth defines how to authenticate the call.
+type HttpCallTask_Auth int32
+
+const (
+	// NONE means the call is not authenticated.
+	HttpCallTask_NONE HttpCallTask_Auth = 0
+	// OAUTH means the call has OAuth access token of the service account.
+	HttpCallTask_OAUTH HttpCallTask_Auth = 1
+	// ID_TOKEN means the call has a JWT signed by the service account, with
+	// 'aud' claim set to 'audience'.
+	HttpCallTask_ID_TOKEN HttpCallTask_Auth = 2
+)
+
+var HttpCallTask_Auth_name = map[int32]string{
+	0: "NONE",
+	1: "OAUTH",
+	2: "ID_TOKEN",
+}
+var HttpCallTask_Auth_value = map[string]int32{
+	"NONE":     0,
+	"OAUTH":    1,
+	"ID_TOKEN": 2,
+}
+
+func (x HttpCallTask_Auth) Enum() *HttpCallTask_Auth {
+	p := new(HttpCallTask_Auth)
+	*p = x
+	return p
+}
+func (x HttpCallTask_Auth) String() string {
+	return proto.EnumName(HttpCallTask_Auth_name, int32(x))
+}
+func (x *HttpCallTask_Auth) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(HttpCallTask_Auth_value, data, "HttpCallTask_Auth")
+	if err != nil {
+		return err
+	}
+	*x = HttpCallTask_Auth(value)
+	return nil
+}
+func (HttpCallTask_Auth) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{8, 0} }
+
 // Job specifies a single cron job belonging to a project.
 type Job struct {
 	// Id is a name of the job (unique for the project).
@@ -43,7 +132,24 @@ type Job struct {
 	// Disables is true to disable this job.
 	Disabled *bool `protobuf:"varint,3,opt,name=disabled" json:"disabled,omitempty"`
 	// Task defines what exactly to execute.
-	Task             *Task  `protobuf:"bytes,4,opt,name=task" json:"task,omitempty"`
+	Task *Task `protobuf:"bytes,4,opt,name=task" json:"task,omitempty"`
+	// Trigger is a set of jobs (in the same project) whose completion starts
+	// this job. It's singular to make text-encoded proto definitions more
+	// readable. If set, 'schedule' may be omitted, in which case the job runs
+	// only when triggered (or manually).
+	Trigger []*Trigger `protobuf:"bytes,5,rep,name=trigger" json:"trigger,omitempty"`
+	// Retry defines how to retry failed invocations. They are not retried by
+	// default.
+	Retry *RetryPolicy `protobuf:"bytes,6,opt,name=retry" json:"retry,omitempty"`
+	// MaxConcurrentInvocations is how many invocations of the job may be running
+	// at the same time.
+	MaxConcurrentInvocations *int32 `protobuf:"varint,7,opt,name=max_concurrent_invocations,json=maxConcurrentInvocations,def=1" json:"max_concurrent_invocations,omitempty"`
+	// OverlapPolicy defines what to do when the job should start while
+	// max_concurrent_invocations invocations are running already.
+	OverlapPolicy *Job_OverlapPolicy `protobuf:"varint,8,opt,name=overlap_policy,json=overlapPolicy,enum=messages.Job_OverlapPolicy,def=0" json:"overlap_policy,omitempty"`
+	// TimeoutSec is how long an invocation may run before it is aborted. Zero
+	// means no limit.
+	TimeoutSec       *int32 `protobuf:"varint,9,opt,name=timeout_sec,json=timeoutSec" json:"timeout_sec,omitempty"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -52,6 +158,9 @@ func (m *Job) String() string            { return proto.CompactTextString(m) }
 func (*Job) ProtoMessage()               {}
 func (*Job) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
 
+const Default_Job_MaxConcurrentInvocations int32 = 1
+const Default_Job_OverlapPolicy Job_OverlapPolicy = Job_SKIP
+
 func (m *Job) GetId() string {
 	if m != nil && m.Id != nil {
 		return *m.Id
@@ -80,6 +189,121 @@ func (m *Job) GetTask() *Task {
 	return nil
 }
 
+func (m *Job) GetTrigger() []*Trigger {
+	if m != nil {
+		return m.Trigger
+	}
+	return nil
+}
+
+func (m *Job) GetRetry() *RetryPolicy {
+	if m != nil {
+		return m.Retry
+	}
+	return nil
+}
+
+func (m *Job) GetMaxConcurrentInvocations() int32 {
+	if m != nil && m.MaxConcurrentInvocations != nil {
+		return *m.MaxConcurrentInvocations
+	}
+	return Default_Job_MaxConcurrentInvocations
+}
+
+func (m *Job) GetOverlapPolicy() Job_OverlapPolicy {
+	if m != nil && m.OverlapPolicy != nil {
+		return *m.OverlapPolicy
+	}
+	return Default_Job_OverlapPolicy
+}
+
+func (m *Job) GetTimeoutSec() int32 {
+	if m != nil && m.TimeoutSec != nil {
+		return *m.TimeoutSec
+	}
+	return 0
+}
+
+// Trigger starts a job whenever an invocation of another job finishes.
+type Trigger struct {
+	// Job is an id of the job to watch. It must belong to the same project.
+	Job *string `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+	// OnSuccess is true to start the job when the watched job succeeds.
+	OnSuccess *bool `protobuf:"varint,2,opt,name=on_success,json=onSuccess,def=1" json:"on_success,omitempty"`
+	// OnFailure is true to start the job when the watched job fails.
+	OnFailure        *bool  `protobuf:"varint,3,opt,name=on_failure,json=onFailure" json:"on_failure,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Trigger) Reset()                    { *m = Trigger{} }
+func (m *Trigger) String() string            { return proto.CompactTextString(m) }
+func (*Trigger) ProtoMessage()               {}
+func (*Trigger) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+const Default_Trigger_OnSuccess bool = true
+
+func (m *Trigger) GetJob() string {
+	if m != nil && m.Job != nil {
+		return *m.Job
+	}
+	return ""
+}
+
+func (m *Trigger) GetOnSuccess() bool {
+	if m != nil && m.OnSuccess != nil {
+		return *m.OnSuccess
+	}
+	return Default_Trigger_OnSuccess
+}
+
+func (m *Trigger) GetOnFailure() bool {
+	if m != nil && m.OnFailure != nil {
+		return *m.OnFailure
+	}
+	return false
+}
+
+// RetryPolicy defines how to retry failed invocations of a job.
+type RetryPolicy struct {
+	// MaxRetries is how many times to retry a failed invocation.
+	MaxRetries *int32 `protobuf:"varint,1,opt,name=max_retries,json=maxRetries" json:"max_retries,omitempty"`
+	// BackoffSec is how long to wait before the first retry. The delay doubles
+	// with each following retry.
+	BackoffSec *int32 `protobuf:"varint,2,opt,name=backoff_sec,json=backoffSec,def=60" json:"backoff_sec,omitempty"`
+	// MaxBackoffSec is the upper bound on the delay between retries.
+	MaxBackoffSec    *int32 `protobuf:"varint,3,opt,name=max_backoff_sec,json=maxBackoffSec,def=3600" json:"max_backoff_sec,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *RetryPolicy) Reset()                    { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string            { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()               {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+const Default_RetryPolicy_BackoffSec int32 = 60
+const Default_RetryPolicy_MaxBackoffSec int32 = 3600
+
+func (m *RetryPolicy) GetMaxRetries() int32 {
+	if m != nil && m.MaxRetries != nil {
+		return *m.MaxRetries
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetBackoffSec() int32 {
+	if m != nil && m.BackoffSec != nil {
+		return *m.BackoffSec
+	}
+	return Default_RetryPolicy_BackoffSec
+}
+
+func (m *RetryPolicy) GetMaxBackoffSec() int32 {
+	if m != nil && m.MaxBackoffSec != nil {
+		return *m.MaxBackoffSec
+	}
+	return Default_RetryPolicy_MaxBackoffSec
+}
+
 // Task defines what exactly to do. One and only one field must be set.
 type Task struct {
 	// Noop is used for testing. It is "do nothing" task.
@@ -89,14 +313,16 @@ type Task struct {
 	// SwarmingTask can be used to schedule swarming job.
 	SwarmingTask *SwarmingTask `protobuf:"bytes,3,opt,name=swarming_task,json=swarmingTask" json:"swarming_task,omitempty"`
 	// BuildbucketTask can be used to schedule buildbucket job.
-	BuildbucketTask  *BuildbucketTask `protobuf:"bytes,4,opt,name=buildbucket_task,json=buildbucketTask" json:"buildbucket_task,omitempty"`
-	XXX_unrecognized []byte           `json:"-"`
+	BuildbucketTask *BuildbucketTask `protobuf:"bytes,4,opt,name=buildbucket_task,json=buildbucketTask" json:"buildbucket_task,omitempty"`
+	// HttpCall can be used to make an HTTP or pRPC call with a templated body.
+	HttpCall         *HttpCallTask `protobuf:"bytes,5,opt,name=http_call,json=httpCall" json:"http_call,omitempty"`
+	XXX_unrecognized []byte        `json:"-"`
 }
 
 func (m *Task) Reset()                    { *m = Task{} }
 func (m *Task) String() string            { return proto.CompactTextString(m) }
 func (*Task) ProtoMessage()               {}
-func (*Task) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (*Task) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
 func (m *Task) GetNoop() *NoopTask {
 	if m != nil {
@@ -126,6 +352,13 @@ func (m *Task) GetBuildbucketTask() *BuildbucketTask {
 	return nil
 }
 
+func (m *Task) GetHttpCall() *HttpCallTask {
+	if m != nil {
+		return m.HttpCall
+	}
+	return nil
+}
+
 // NoopTask is used for testing. It is "do nothing" task.
 type NoopTask struct {
 	XXX_unrecognized []byte `json:"-"`
@@ -134,7 +367,7 @@ type NoopTask struct {
 func (m *NoopTask) Reset()                    { *m = NoopTask{} }
 func (m *NoopTask) String() string            { return proto.CompactTextString(m) }
 func (*NoopTask) ProtoMessage()               {}
-func (*NoopTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*NoopTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 // UrlFetchTask specifies parameters for simple HTTP call.
 type UrlFetchTask struct {
@@ -150,7 +383,7 @@ type UrlFetchTask struct {
 func (m *UrlFetchTask) Reset()                    { *m = UrlFetchTask{} }
 func (m *UrlFetchTask) String() string            { return proto.CompactTextString(m) }
 func (*UrlFetchTask) ProtoMessage()               {}
-func (*UrlFetchTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*UrlFetchTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 const Default_UrlFetchTask_Method string = "GET"
 const Default_UrlFetchTask_TimeoutSec int32 = 60
@@ -203,7 +436,7 @@ type SwarmingTask struct {
 func (m *SwarmingTask) Reset()                    { *m = SwarmingTask{} }
 func (m *SwarmingTask) String() string            { return proto.CompactTextString(m) }
 func (*SwarmingTask) ProtoMessage()               {}
-func (*SwarmingTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*SwarmingTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 const Default_SwarmingTask_Priority int32 = 200
 const Default_SwarmingTask_GracePeriodSecs int32 = 30
@@ -297,7 +530,7 @@ type SwarmingTask_IsolatedRef struct {
 func (m *SwarmingTask_IsolatedRef) Reset()                    { *m = SwarmingTask_IsolatedRef{} }
 func (m *SwarmingTask_IsolatedRef) String() string            { return proto.CompactTextString(m) }
 func (*SwarmingTask_IsolatedRef) ProtoMessage()               {}
-func (*SwarmingTask_IsolatedRef) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4, 0} }
+func (*SwarmingTask_IsolatedRef) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6, 0} }
 
 func (m *SwarmingTask_IsolatedRef) GetIsolated() string {
 	if m != nil && m.Isolated != nil {
@@ -338,7 +571,7 @@ type BuildbucketTask struct {
 func (m *BuildbucketTask) Reset()                    { *m = BuildbucketTask{} }
 func (m *BuildbucketTask) String() string            { return proto.CompactTextString(m) }
 func (*BuildbucketTask) ProtoMessage()               {}
-func (*BuildbucketTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*BuildbucketTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func (m *BuildbucketTask) GetServer() string {
 	if m != nil && m.Server != nil {
@@ -375,6 +608,146 @@ func (m *BuildbucketTask) GetTags() []string {
 	return nil
 }
 
+// HttpCallTask specifies parameters of an HTTP or pRPC call to some service.
+//
+// The request body is a Go text/template. It can refer to the following values:
+//   .JobID: full ID of the job, e.g. "project/job".
+//   .InvocationID: ID of the invocation.
+//   .InvocationNonce: ID of the request to start the invocation. It is the
+//       same for all attempts to launch the invocation.
+//   .ScheduledTime: when the invocation was requested, as time.Time.
+//   .PubSubTopic and .PubSubAuthToken: see 'async' field.
+// A function 'json' encodes its argument as JSON value, e.g.
+// {{json .ScheduledTime}} produces a quoted RFC3339 timestamp.
+type HttpCallTask struct {
+	// Url to send the request to. Either 'url' or 'prpc_host' must be set.
+	Url *string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	// Method is HTTP method to use. Ignored for pRPC calls (they use POST).
+	Method *string `protobuf:"bytes,2,opt,name=method,def=POST" json:"method,omitempty"`
+	// PrpcHost is a host of pRPC server to call, e.g. "example.appspot.com".
+	PrpcHost *string `protobuf:"bytes,3,opt,name=prpc_host,json=prpcHost" json:"prpc_host,omitempty"`
+	// PrpcMethod is a pRPC method to call as "<service>/<method>", e.g.
+	// "pkg.Service/Method". The body must be JSON-encoded request message.
+	PrpcMethod *string `protobuf:"bytes,4,opt,name=prpc_method,json=prpcMethod" json:"prpc_method,omitempty"`
+	// Headers is a list of "Name:value" pairs to add to the request.
+	Headers []string `protobuf:"bytes,5,rep,name=headers" json:"headers,omitempty"`
+	// Body is a template of the request body, see above.
+	Body *string `protobuf:"bytes,6,opt,name=body" json:"body,omitempty"`
+	// ContentType is a type of the body. Ignored for pRPC calls (they use JSON).
+	ContentType *string `protobuf:"bytes,7,opt,name=content_type,json=contentType,def=application/json" json:"content_type,omitempty"`
+	// Auth defines how to authenticate the call.
+	Auth *HttpCallTask_Auth `protobuf:"varint,8,opt,name=auth,enum=messages.HttpCallTask_Auth,def=0" json:"auth,omitempty"`
+	// Audience of ID tokens. Default is the URL of the call.
+	Audience *string `protobuf:"bytes,9,opt,name=audience" json:"audience,omitempty"`
+	// SuccessCodes is a list of response codes that indicate success. For HTTP
+	// calls they are HTTP status codes (default is any 2xx code), for pRPC calls
+	// they are gRPC codes (default is 0, i.e. OK).
+	SuccessCodes []int32 `protobuf:"varint,10,rep,name=success_codes,json=successCodes" json:"success_codes,omitempty"`
+	// Async, if true, means the invocation doesn't finish when the call succeeds.
+	// Instead, the called service must publish a PubSub message to the topic
+	// passed to it via {{.PubSubTopic}}, with 'auth_token' attribute set to
+	// {{.PubSubAuthToken}} and data set to {"status": "SUCCEEDED"} or
+	// {"status": "FAILED"} JSON.
+	Async *bool `protobuf:"varint,11,opt,name=async" json:"async,omitempty"`
+	// Timeout is how long to wait for the call to complete.
+	TimeoutSec       *int32 `protobuf:"varint,12,opt,name=timeout_sec,json=timeoutSec,def=60" json:"timeout_sec,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *HttpCallTask) Reset()                    { *m = HttpCallTask{} }
+func (m *HttpCallTask) String() string            { return proto.CompactTextString(m) }
+func (*HttpCallTask) ProtoMessage()               {}
+func (*HttpCallTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+const Default_HttpCallTask_Method string = "POST"
+const Default_HttpCallTask_ContentType string = "application/json"
+const Default_HttpCallTask_Auth HttpCallTask_Auth = HttpCallTask_NONE
+const Default_HttpCallTask_TimeoutSec int32 = 60
+
+func (m *HttpCallTask) GetUrl() string {
+	if m != nil && m.Url != nil {
+		return *m.Url
+	}
+	return ""
+}
+
+func (m *HttpCallTask) GetMethod() string {
+	if m != nil && m.Method != nil {
+		return *m.Method
+	}
+	return Default_HttpCallTask_Method
+}
+
+func (m *HttpCallTask) GetPrpcHost() string {
+	if m != nil && m.PrpcHost != nil {
+		return *m.PrpcHost
+	}
+	return ""
+}
+
+func (m *HttpCallTask) GetPrpcMethod() string {
+	if m != nil && m.PrpcMethod != nil {
+		return *m.PrpcMethod
+	}
+	return ""
+}
+
+func (m *HttpCallTask) GetHeaders() []string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *HttpCallTask) GetBody() string {
+	if m != nil && m.Body != nil {
+		return *m.Body
+	}
+	return ""
+}
+
+func (m *HttpCallTask) GetContentType() string {
+	if m != nil && m.ContentType != nil {
+		return *m.ContentType
+	}
+	return Default_HttpCallTask_ContentType
+}
+
+func (m *HttpCallTask) GetAuth() HttpCallTask_Auth {
+	if m != nil && m.Auth != nil {
+		return *m.Auth
+	}
+	return Default_HttpCallTask_Auth
+}
+
+func (m *HttpCallTask) GetAudience() string {
+	if m != nil && m.Audience != nil {
+		return *m.Audience
+	}
+	return ""
+}
+
+func (m *HttpCallTask) GetSuccessCodes() []int32 {
+	if m != nil {
+		return m.SuccessCodes
+	}
+	return nil
+}
+
+func (m *HttpCallTask) GetAsync() bool {
+	if m != nil && m.Async != nil {
+		return *m.Async
+	}
+	return false
+}
+
+func (m *HttpCallTask) GetTimeoutSec() int32 {
+	if m != nil && m.TimeoutSec != nil {
+		return *m.TimeoutSec
+	}
+	return Default_HttpCallTask_TimeoutSec
+}
+
 // ProjectConfig defines a schema for cron.cfg files that describe cron jobs
 // belonging to some project.
 type ProjectConfig struct {
@@ -387,7 +760,7 @@ type ProjectConfig struct {
 func (m *ProjectConfig) Reset()                    { *m = ProjectConfig{} }
 func (m *ProjectConfig) String() string            { return proto.CompactTextString(m) }
 func (*ProjectConfig) ProtoMessage()               {}
-func (*ProjectConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*ProjectConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *ProjectConfig) GetJob() []*Job {
 	if m != nil {
@@ -398,13 +771,18 @@ func (m *ProjectConfig) GetJob() []*Job {
 
 func init() {
 	proto.RegisterType((*Job)(nil), "messages.Job")
+	proto.RegisterType((*Trigger)(nil), "messages.Trigger")
+	proto.RegisterType((*RetryPolicy)(nil), "messages.RetryPolicy")
 	proto.RegisterType((*Task)(nil), "messages.Task")
 	proto.RegisterType((*NoopTask)(nil), "messages.NoopTask")
 	proto.RegisterType((*UrlFetchTask)(nil), "messages.UrlFetchTask")
 	proto.RegisterType((*SwarmingTask)(nil), "messages.SwarmingTask")
 	proto.RegisterType((*SwarmingTask_IsolatedRef)(nil), "messages.SwarmingTask.IsolatedRef")
 	proto.RegisterType((*BuildbucketTask)(nil), "messages.BuildbucketTask")
+	proto.RegisterType((*HttpCallTask)(nil), "messages.HttpCallTask")
 	proto.RegisterType((*ProjectConfig)(nil), "messages.ProjectConfig")
+	proto.RegisterEnum("messages.Job_OverlapPolicy", Job_OverlapPolicy_name, Job_OverlapPolicy_value)
+	proto.RegisterEnum("messages.HttpCallTask_Auth", HttpCallTask_Auth_name, HttpCallTask_Auth_value)
 }
 
 func init() {
@@ -412,46 +790,79 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 647 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x54, 0x5b, 0x6f, 0xd3, 0x4c,
-	0x10, 0x55, 0xea, 0xb4, 0x4d, 0x26, 0x49, 0xd3, 0x6f, 0xf5, 0xa9, 0x32, 0xe5, 0x2a, 0x23, 0x15,
-	0x5e, 0x48, 0xa2, 0x14, 0xf1, 0x50, 0x9e, 0xb8, 0x14, 0x04, 0x0f, 0xa8, 0x72, 0xcb, 0xb3, 0xe5,
-	0xcb, 0xc6, 0xd9, 0xd6, 0xf1, 0x9a, 0xdd, 0x75, 0x29, 0x3f, 0x02, 0xf1, 0x2f, 0xf9, 0x1d, 0xcc,
-	0xae, 0xd7, 0x97, 0x56, 0xf0, 0x12, 0xed, 0x9c, 0x33, 0x33, 0xbb, 0x73, 0xce, 0xc4, 0x70, 0x92,
-	0x32, 0xb5, 0x2e, 0xa3, 0x59, 0xcc, 0x37, 0xf3, 0xac, 0x8c, 0x99, 0xf9, 0x79, 0x91, 0xf2, 0x79,
-	0x2c, 0x78, 0x3e, 0x0f, 0x8b, 0x82, 0xe6, 0x29, 0xcb, 0xe9, 0x7c, 0x43, 0xa5, 0x0c, 0x53, 0x2a,
-	0x0d, 0x3e, 0x2b, 0x04, 0x57, 0x9c, 0x0c, 0x6a, 0xd0, 0xfb, 0x06, 0xce, 0x67, 0x1e, 0x91, 0x3d,
-	0xd8, 0x62, 0x89, 0xdb, 0x7b, 0xd2, 0x7b, 0x3e, 0xf4, 0xf1, 0x44, 0x0e, 0x61, 0x20, 0xe3, 0x35,
-	0x4d, 0xca, 0x8c, 0xba, 0x5b, 0x06, 0x6d, 0x62, 0xcd, 0x25, 0x4c, 0x86, 0x51, 0x46, 0x13, 0xd7,
-	0x41, 0x6e, 0xe0, 0x37, 0x31, 0xf1, 0xa0, 0xaf, 0x42, 0x79, 0xe5, 0xf6, 0x11, 0x1f, 0x2d, 0xf7,
-	0x66, 0xf5, 0x3d, 0xb3, 0x0b, 0x44, 0x7d, 0xc3, 0x79, 0xbf, 0x7b, 0xd0, 0xd7, 0x21, 0x39, 0x82,
-	0x7e, 0xce, 0x79, 0x61, 0xae, 0x1d, 0x2d, 0x49, 0x9b, 0xfc, 0x05, 0xd1, 0xaa, 0x40, 0xf3, 0xe4,
-	0x18, 0x86, 0xa5, 0xc8, 0x82, 0x15, 0x55, 0xf1, 0xda, 0xbc, 0x66, 0xb4, 0x3c, 0x68, 0x93, 0xbf,
-	0x8a, 0xec, 0x83, 0x66, 0x4c, 0xc1, 0xa0, 0xb4, 0x11, 0x79, 0x0d, 0x13, 0xf9, 0x3d, 0x14, 0x1b,
-	0x96, 0xa7, 0x81, 0x79, 0x92, 0x73, 0xb7, 0xf0, 0xdc, 0xd2, 0xa6, 0x70, 0x2c, 0x3b, 0x11, 0x79,
-	0x0f, 0xfb, 0x51, 0xc9, 0xb2, 0x24, 0x2a, 0xe3, 0x2b, 0xaa, 0x82, 0xce, 0x48, 0xf7, 0xda, 0xfa,
-	0xb7, 0x6d, 0x86, 0x69, 0x31, 0x8d, 0x6e, 0x03, 0x1e, 0xc0, 0xa0, 0x9e, 0xc4, 0x8b, 0x60, 0xdc,
-	0x7d, 0x28, 0xb9, 0x0f, 0x3b, 0x1b, 0xaa, 0xd6, 0xdc, 0x8a, 0x7e, 0xe2, 0x7c, 0x3c, 0xbd, 0xf0,
-	0x2d, 0x44, 0xf6, 0xc1, 0xc1, 0x39, 0xac, 0xf0, 0xfa, 0x48, 0x9e, 0xc2, 0x48, 0xb1, 0x0d, 0xe5,
-	0xa5, 0x0a, 0x24, 0x8d, 0xcd, 0x2c, 0xdb, 0x27, 0x5b, 0xaf, 0x16, 0x3e, 0x58, 0xf8, 0x9c, 0xc6,
-	0xde, 0xcf, 0x3e, 0x8c, 0xbb, 0x43, 0x91, 0x03, 0xd8, 0x91, 0x54, 0x5c, 0x53, 0x61, 0x9d, 0xb5,
-	0x11, 0x71, 0x61, 0x17, 0xb7, 0x66, 0x13, 0xe6, 0x09, 0xde, 0xe1, 0x20, 0x51, 0x87, 0xe4, 0x14,
-	0xc6, 0x4c, 0xf2, 0x2c, 0x54, 0x34, 0x09, 0x04, 0x5d, 0x59, 0xd1, 0xbc, 0xbf, 0x8b, 0x36, 0xfb,
-	0x64, 0x53, 0x7d, 0xba, 0xf2, 0x47, 0xac, 0x0d, 0xc8, 0x43, 0x00, 0x7a, 0xa3, 0x44, 0x18, 0x84,
-	0x22, 0x95, 0xa8, 0x9c, 0xbe, 0x63, 0x68, 0x90, 0x37, 0x08, 0xe8, 0xf9, 0x68, 0x7e, 0xed, 0x6e,
-	0x1b, 0x5c, 0x1f, 0xc9, 0x23, 0x80, 0x04, 0x07, 0xc9, 0x25, 0xe3, 0xb9, 0x74, 0x77, 0x0c, 0xd1,
-	0x41, 0x08, 0xd1, 0x7b, 0x85, 0xad, 0x76, 0x0d, 0x63, 0xce, 0xe4, 0x31, 0x0c, 0x0a, 0xc1, 0xb8,
-	0x60, 0xea, 0x87, 0x3b, 0x30, 0x82, 0x38, 0xcb, 0xc5, 0xc2, 0x6f, 0x40, 0xf2, 0x12, 0x0e, 0xe8,
-	0x0d, 0x8d, 0x4b, 0x85, 0x2d, 0x82, 0x8e, 0x7c, 0xd2, 0x1d, 0xea, 0x74, 0xff, 0xff, 0x86, 0xbd,
-	0x68, 0x44, 0x94, 0x64, 0x06, 0xff, 0xa5, 0x22, 0x8c, 0x69, 0x50, 0x50, 0x6c, 0x94, 0x54, 0x05,
-	0x50, 0x09, 0x7e, 0xbc, 0xf0, 0xa7, 0x86, 0x3c, 0x33, 0x9c, 0xc9, 0x3f, 0x82, 0x29, 0xe3, 0xb7,
-	0xdb, 0x8f, 0x4c, 0xfb, 0x09, 0xe3, 0x9d, 0xbe, 0x87, 0x05, 0x8c, 0x3a, 0x7a, 0xe9, 0x7f, 0x51,
-	0xad, 0x98, 0x75, 0xa7, 0x89, 0xc9, 0x33, 0x6c, 0x59, 0xbb, 0x60, 0x0d, 0xac, 0x76, 0x61, 0xaf,
-	0x86, 0xcf, 0x2b, 0x23, 0x1f, 0xc0, 0x30, 0x0f, 0xd1, 0x9b, 0x02, 0x9f, 0x64, 0xbc, 0x42, 0x99,
-	0x1b, 0xc0, 0xfb, 0xd5, 0x83, 0xe9, 0x9d, 0x25, 0xfd, 0xe7, 0x4a, 0x20, 0x5e, 0x65, 0xd9, 0x9b,
-	0x6c, 0xa4, 0x57, 0xc5, 0xac, 0x35, 0x16, 0x54, 0xfd, 0xeb, 0x50, 0x5b, 0x86, 0x1f, 0x13, 0x14,
-	0x49, 0x31, 0x5a, 0x7b, 0xdc, 0x41, 0x1a, 0xcb, 0xb6, 0x5b, 0xcb, 0xbc, 0x05, 0x4c, 0xce, 0x04,
-	0xbf, 0xa4, 0xb1, 0x7a, 0xc7, 0xf3, 0x15, 0x4b, 0xd1, 0x43, 0xe7, 0x92, 0x47, 0xf8, 0x16, 0x07,
-	0xd7, 0x6c, 0xd2, 0xae, 0x19, 0x7e, 0x93, 0x7c, 0xcd, 0xfc, 0x09, 0x00, 0x00, 0xff, 0xff, 0x53,
-	0x9d, 0xce, 0xbb, 0xe6, 0x04, 0x00, 0x00,
+	// 1169 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x56, 0xd1, 0x72, 0xdb, 0xb6,
+	0x12, 0xbd, 0x92, 0x28, 0x5b, 0x5c, 0x49, 0xb6, 0x82, 0xc9, 0xf5, 0xf0, 0x26, 0xb9, 0x37, 0x1a,
+	0x65, 0x26, 0x57, 0x9d, 0xb4, 0xb2, 0x62, 0xb7, 0x79, 0x50, 0x1f, 0x3a, 0x8e, 0xa3, 0xd4, 0x4e,
+	0x5a, 0xdb, 0x85, 0xec, 0x67, 0x0e, 0x04, 0x42, 0x12, 0x62, 0x8a, 0xe0, 0x00, 0xa0, 0x6b, 0x3f,
+	0xf6, 0x03, 0x3a, 0xfd, 0x80, 0xfe, 0x41, 0xff, 0xa1, 0xff, 0xd6, 0x01, 0x08, 0x8a, 0xb4, 0x9b,
+	0xbe, 0x68, 0xb8, 0x67, 0x0f, 0x16, 0xc0, 0xee, 0xc1, 0xae, 0x60, 0xb2, 0xe4, 0x7a, 0x95, 0xcd,
+	0x47, 0x54, 0xac, 0xf7, 0xe3, 0x8c, 0x72, 0xfb, 0xf3, 0xd5, 0x52, 0xec, 0x53, 0x29, 0x92, 0x7d,
+	0x92, 0xa6, 0x2c, 0x59, 0xf2, 0x84, 0xed, 0xaf, 0x99, 0x52, 0x64, 0xc9, 0x94, 0xc5, 0x47, 0xa9,
+	0x14, 0x5a, 0xa0, 0x56, 0x01, 0x0e, 0xfe, 0x68, 0x40, 0xe3, 0x83, 0x98, 0xa3, 0x1d, 0xa8, 0xf3,
+	0x28, 0xa8, 0xf5, 0x6b, 0x43, 0x1f, 0xd7, 0x79, 0x84, 0x9e, 0x40, 0x4b, 0xd1, 0x15, 0x8b, 0xb2,
+	0x98, 0x05, 0x75, 0x8b, 0x6e, 0x6c, 0xe3, 0x8b, 0xb8, 0x22, 0xf3, 0x98, 0x45, 0x41, 0xa3, 0x5f,
+	0x1b, 0xb6, 0xf0, 0xc6, 0x46, 0x03, 0xf0, 0x34, 0x51, 0xd7, 0x81, 0xd7, 0xaf, 0x0d, 0xdb, 0x07,
+	0x3b, 0xa3, 0x62, 0xa3, 0xd1, 0x25, 0x51, 0xd7, 0xd8, 0xfa, 0xd0, 0x2b, 0xd8, 0xd6, 0x92, 0x2f,
+	0x97, 0x4c, 0x06, 0xcd, 0x7e, 0x63, 0xd8, 0x3e, 0x78, 0x54, 0xa1, 0xe5, 0x0e, 0x5c, 0x30, 0xd0,
+	0x2b, 0x68, 0x4a, 0xa6, 0xe5, 0x5d, 0xb0, 0x65, 0x23, 0xfe, 0xbb, 0xa4, 0x62, 0x03, 0x5f, 0x88,
+	0x98, 0xd3, 0x3b, 0x9c, 0x73, 0xd0, 0x77, 0xf0, 0x64, 0x4d, 0x6e, 0x43, 0x2a, 0x12, 0x9a, 0x49,
+	0xc9, 0x12, 0x1d, 0xf2, 0xe4, 0x46, 0x50, 0xa2, 0xb9, 0x48, 0x54, 0xb0, 0xdd, 0xaf, 0x0d, 0x9b,
+	0x93, 0xda, 0x6b, 0x1c, 0xac, 0xc9, 0xed, 0xf1, 0x86, 0x73, 0x5a, 0x52, 0xd0, 0x09, 0xec, 0x88,
+	0x1b, 0x26, 0x63, 0x92, 0x86, 0xa9, 0x8d, 0x1c, 0xb4, 0xfa, 0xb5, 0xe1, 0xce, 0xc1, 0xd3, 0x72,
+	0xdb, 0x0f, 0x62, 0x3e, 0x3a, 0xcf, 0x39, 0xf9, 0xe6, 0x13, 0x6f, 0xf6, 0xf1, 0xf4, 0x02, 0x77,
+	0x45, 0x15, 0x44, 0xcf, 0xa1, 0xad, 0xf9, 0x9a, 0x89, 0x4c, 0x87, 0x8a, 0xd1, 0xc0, 0x37, 0x7b,
+	0x63, 0x70, 0xd0, 0x8c, 0xd1, 0xc1, 0x6b, 0xe8, 0xde, 0x0b, 0x83, 0x5a, 0x60, 0x03, 0xf5, 0xfe,
+	0x85, 0x7c, 0x68, 0xfe, 0x74, 0x35, 0xbd, 0x9a, 0xf6, 0x6a, 0xa8, 0x0d, 0xdb, 0x78, 0x7a, 0xf1,
+	0xc3, 0xd1, 0xf1, 0xb4, 0x57, 0x1f, 0x84, 0xb0, 0xed, 0xf2, 0x83, 0x7a, 0xd0, 0xf8, 0x24, 0xe6,
+	0xae, 0x60, 0xe6, 0x13, 0xbd, 0x00, 0x10, 0x49, 0xa8, 0x32, 0x4a, 0x99, 0x52, 0xb6, 0x66, 0xad,
+	0x89, 0xa7, 0x65, 0xc6, 0xb0, 0x2f, 0x92, 0x59, 0x0e, 0xa3, 0xff, 0x5a, 0xd2, 0x82, 0xf0, 0x38,
+	0x93, 0xcc, 0x15, 0xcf, 0x17, 0xc9, 0xfb, 0x1c, 0x18, 0xfc, 0x52, 0x83, 0x76, 0x25, 0xad, 0xe6,
+	0x12, 0x26, 0x9f, 0x26, 0xb9, 0x9c, 0x29, 0xbb, 0x5b, 0x13, 0xc3, 0x9a, 0xdc, 0xe2, 0x1c, 0x41,
+	0x2f, 0xa0, 0x3d, 0x27, 0xf4, 0x5a, 0x2c, 0x16, 0xf6, 0x96, 0x75, 0x9b, 0xe1, 0xfa, 0x9b, 0x31,
+	0x06, 0x07, 0xcf, 0x18, 0x45, 0x5f, 0xc2, 0xae, 0x89, 0x52, 0x25, 0x36, 0x2c, 0xd1, 0x3b, 0x7c,
+	0x33, 0x1e, 0xe3, 0xee, 0x9a, 0xdc, 0xbe, 0xdd, 0xb0, 0x07, 0xbf, 0xd7, 0xc1, 0x33, 0x62, 0x41,
+	0x2f, 0xc1, 0x4b, 0x84, 0x48, 0xed, 0xae, 0xed, 0x03, 0x54, 0x56, 0xe0, 0x4c, 0x88, 0x34, 0x97,
+	0x93, 0xf1, 0xa3, 0x43, 0xf0, 0x33, 0x19, 0x87, 0x0b, 0xa6, 0xe9, 0xca, 0x9e, 0xa0, 0x7d, 0xb0,
+	0x57, 0x92, 0xaf, 0x64, 0xfc, 0xde, 0x78, 0xec, 0x82, 0x56, 0xe6, 0x2c, 0xf4, 0x2d, 0x74, 0xd5,
+	0xcf, 0x44, 0xae, 0x79, 0xb2, 0x0c, 0xad, 0x60, 0x1b, 0x0f, 0x17, 0xce, 0x9c, 0xdb, 0x2e, 0xec,
+	0xa8, 0x8a, 0x85, 0xde, 0x41, 0x6f, 0x9e, 0xf1, 0x38, 0x9a, 0x67, 0xf4, 0x9a, 0xe9, 0xb0, 0x22,
+	0xf8, 0xff, 0x94, 0xeb, 0xdf, 0x96, 0x0c, 0x1b, 0x62, 0x77, 0x7e, 0x1f, 0x30, 0xe7, 0x5e, 0x69,
+	0x9d, 0x86, 0x94, 0xc4, 0x71, 0xd0, 0x7c, 0xb8, 0xfd, 0x89, 0xd6, 0xe9, 0x31, 0x89, 0xe3, 0xfc,
+	0xdc, 0x2b, 0x67, 0x0d, 0x00, 0x5a, 0xc5, 0xf5, 0x07, 0x73, 0xe8, 0x54, 0x6f, 0x87, 0x9e, 0xc2,
+	0xd6, 0x9a, 0xe9, 0x95, 0x70, 0xef, 0x78, 0xd2, 0xf8, 0x7e, 0x7a, 0x89, 0x1d, 0x64, 0x04, 0x93,
+	0xc9, 0xd8, 0xbd, 0x65, 0xf3, 0x69, 0x6a, 0x57, 0x55, 0x68, 0xa3, 0xac, 0x5d, 0x45, 0xa5, 0xbf,
+	0x7a, 0xd0, 0xa9, 0x66, 0x02, 0xed, 0xc1, 0x96, 0x62, 0xf2, 0x86, 0x49, 0xa7, 0x3d, 0x67, 0xa1,
+	0x00, 0xb6, 0xa9, 0x58, 0xaf, 0x49, 0x12, 0x05, 0xf5, 0x7e, 0x63, 0xe8, 0xe3, 0xc2, 0x44, 0x53,
+	0xe8, 0x70, 0x25, 0x62, 0xa2, 0x59, 0x14, 0x4a, 0xb6, 0x70, 0x99, 0x1e, 0x7c, 0x3e, 0xd3, 0xa3,
+	0x53, 0x47, 0xc5, 0x6c, 0x81, 0xdb, 0xbc, 0x34, 0x8c, 0x74, 0xd9, 0xad, 0x96, 0x24, 0x24, 0x72,
+	0xa9, 0x02, 0xcf, 0xee, 0xe1, 0x5b, 0xe4, 0x48, 0x2e, 0x95, 0xb9, 0x1f, 0x4b, 0x6e, 0x6c, 0x43,
+	0xf1, 0xb1, 0xf9, 0x44, 0xff, 0x03, 0x88, 0xf8, 0x9a, 0x25, 0xca, 0x3e, 0xfe, 0x2d, 0xeb, 0xa8,
+	0x20, 0x08, 0x99, 0x56, 0xb5, 0x34, 0x6d, 0xc1, 0x78, 0xec, 0x37, 0x7a, 0x0e, 0xad, 0x54, 0x72,
+	0x21, 0xb9, 0xce, 0x5f, 0x7e, 0x73, 0xd2, 0x38, 0x18, 0x8f, 0xf1, 0x06, 0x44, 0x5f, 0xc3, 0x1e,
+	0xbb, 0x65, 0x34, 0x33, 0xed, 0x22, 0xac, 0xa4, 0x4f, 0xb9, 0x17, 0xfe, 0x78, 0xe3, 0xbd, 0xdc,
+	0x24, 0x51, 0xa1, 0x11, 0x3c, 0x5a, 0x4a, 0x42, 0x59, 0x98, 0x32, 0xc9, 0x45, 0x94, 0x2f, 0x80,
+	0x3c, 0xe1, 0x87, 0x63, 0xbc, 0x6b, 0x9d, 0x17, 0xd6, 0x67, 0xf9, 0x2f, 0x61, 0x97, 0x8b, 0xfb,
+	0xe1, 0xdb, 0x36, 0x7c, 0x97, 0x8b, 0x4a, 0xdc, 0x27, 0x29, 0xb4, 0x2b, 0xf9, 0x32, 0x8d, 0xb9,
+	0xc8, 0x98, 0xab, 0xce, 0xc6, 0x46, 0xff, 0x87, 0xdd, 0x4d, 0x15, 0x5c, 0x01, 0x73, 0x2d, 0xec,
+	0x14, 0xf0, 0x2c, 0x2f, 0xe4, 0x33, 0xf0, 0x13, 0xb2, 0x66, 0x2a, 0x25, 0x34, 0xef, 0x10, 0x3e,
+	0x2e, 0x81, 0xc1, 0x6f, 0x35, 0xd8, 0x7d, 0xa0, 0xec, 0x7f, 0x94, 0xc4, 0x1e, 0x6c, 0xe5, 0x2c,
+	0xb7, 0x93, 0xb3, 0x8c, 0x54, 0xec, 0x5b, 0x60, 0xd2, 0xc5, 0x2f, 0x4c, 0x53, 0xb2, 0x54, 0x8a,
+	0x94, 0x49, 0xcd, 0x59, 0x51, 0xe3, 0x0a, 0xb2, 0x29, 0x59, 0xb3, 0x2c, 0xd9, 0xe0, 0xcf, 0x06,
+	0x74, 0xaa, 0x8f, 0xa5, 0x50, 0x7a, 0xad, 0x54, 0xfa, 0xb3, 0xcd, 0xc3, 0xb0, 0x07, 0x99, 0x78,
+	0x17, 0xe7, 0xb3, 0xf2, 0x65, 0x3c, 0x05, 0x3f, 0x95, 0x29, 0x0d, 0x57, 0x42, 0x69, 0x77, 0xa0,
+	0x96, 0x01, 0x4e, 0x84, 0xd2, 0xa6, 0x03, 0x5a, 0xa7, 0x5b, 0xef, 0x59, 0x37, 0x18, 0xe8, 0xc7,
+	0x7c, 0x75, 0x00, 0xdb, 0x2b, 0x46, 0x22, 0x26, 0x8b, 0x53, 0x15, 0xa6, 0x39, 0xec, 0x5c, 0x44,
+	0xf9, 0xe0, 0xf2, 0xb1, 0xfd, 0x46, 0x87, 0xd0, 0xa1, 0x22, 0xd1, 0x66, 0x32, 0xe9, 0xbb, 0x94,
+	0xd9, 0x91, 0xe4, 0x4f, 0x7a, 0x24, 0x4d, 0x63, 0x9e, 0xcf, 0xa0, 0xfd, 0x4f, 0x4a, 0x24, 0xb8,
+	0xed, 0x58, 0x97, 0x77, 0x29, 0x43, 0xdf, 0x80, 0x47, 0x32, 0xbd, 0xfa, 0xfb, 0x28, 0xaa, 0x5e,
+	0x7b, 0x74, 0x94, 0xe9, 0xd5, 0xc4, 0x3b, 0x3b, 0x3f, 0x9b, 0x62, 0x4b, 0x37, 0x6a, 0x20, 0x59,
+	0xc4, 0x59, 0x42, 0x99, 0x15, 0xa7, 0x8f, 0x37, 0x36, 0x7a, 0x01, 0x5d, 0x37, 0x29, 0x42, 0x2a,
+	0x22, 0x66, 0xc4, 0xd8, 0x18, 0x36, 0x71, 0xc7, 0x81, 0xc7, 0x06, 0x43, 0x8f, 0xa1, 0x49, 0xd4,
+	0x5d, 0x42, 0xad, 0xf6, 0x5a, 0x38, 0x37, 0x1e, 0xb6, 0x8d, 0xce, 0x67, 0xdb, 0xc6, 0x17, 0xe0,
+	0x99, 0xf3, 0x98, 0x99, 0x66, 0x4e, 0x94, 0xcf, 0xb4, 0xf3, 0xa3, 0xab, 0xcb, 0x93, 0x5e, 0x0d,
+	0x75, 0xa0, 0x75, 0xfa, 0x2e, 0xbc, 0x3c, 0xff, 0x38, 0x3d, 0xeb, 0xd5, 0x07, 0x63, 0xe8, 0x5e,
+	0x48, 0xf1, 0x89, 0x51, 0x7d, 0x2c, 0x92, 0x05, 0x5f, 0xa2, 0xe7, 0xc5, 0x68, 0x33, 0x7f, 0x0d,
+	0xba, 0xf7, 0x06, 0xaf, 0x9d, 0x74, 0x7f, 0x0d, 0x00, 0xc4, 0xbd, 0x1f, 0xa3, 0xfa, 0x08, 0x00,
+	0x00,
 }
@@ -0,0 +1,456 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package httpcall implements cron tasks that make HTTP or pRPC calls with
+// templated bodies.
+package httpcall
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/api/pubsub/v1"
+
+	"github.com/luci/gae/service/urlfetch"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/cron/appengine/messages"
+	"github.com/luci/luci-go/cron/appengine/task"
+	"github.com/luci/luci-go/cron/appengine/task/utils"
+)
+
+// TaskManager implements task.Manager interface for tasks defined with
+// HttpCallTask proto message.
+type TaskManager struct {
+}
+
+// Name is part of Manager interface.
+func (m TaskManager) Name() string {
+	return "http_call"
+}
+
+// ProtoMessageType is part of Manager interface.
+func (m TaskManager) ProtoMessageType() proto.Message {
+	return (*messages.HttpCallTask)(nil)
+}
+
+// ValidateProtoMessage is part of Manager interface.
+func (m TaskManager) ValidateProtoMessage(msg proto.Message) error {
+	cfg, ok := msg.(*messages.HttpCallTask)
+	if !ok {
+		return fmt.Errorf("wrong type %T, expecting *messages.HttpCallTask", msg)
+	}
+
+	// Validate 'url' or 'prpc_host' and 'prpc_method' fields.
+	isPRPC := cfg.GetPrpcHost() != ""
+	switch {
+	case cfg.GetUrl() == "" && !isPRPC:
+		return fmt.Errorf("either 'url' or 'prpc_host' field is required")
+	case cfg.GetUrl() != "" && isPRPC:
+		return fmt.Errorf("only one of 'url' or 'prpc_host' fields can be set")
+	case isPRPC:
+		if strings.Contains(cfg.GetPrpcHost(), "/") {
+			return fmt.Errorf("'prpc_host' must be a host name, got %q", cfg.GetPrpcHost())
+		}
+		chunks := strings.Split(cfg.GetPrpcMethod(), "/")
+		if len(chunks) != 2 || chunks[0] == "" || chunks[1] == "" {
+			return fmt.Errorf("'prpc_method' must have form <service>/<method>, got %q", cfg.GetPrpcMethod())
+		}
+	default:
+		u, err := url.Parse(cfg.GetUrl())
+		if err != nil {
+			return fmt.Errorf("invalid URL %q: %s", cfg.GetUrl(), err)
+		}
+		if !u.IsAbs() {
+			return fmt.Errorf("not an absolute url: %q", cfg.GetUrl())
+		}
+		if cfg.PrpcMethod != nil {
+			return fmt.Errorf("'prpc_method' can be used only with 'prpc_host'")
+		}
+	}
+
+	// Validate 'method' field. Not used by pRPC calls.
+	if !isPRPC {
+		goodMethods := map[string]bool{"GET": true, "POST": true, "PUT": true, "PATCH": true, "DELETE": true}
+		if !goodMethods[cfg.GetMethod()] {
+			return fmt.Errorf("unsupported HTTP method: %q", cfg.GetMethod())
+		}
+		if cfg.GetMethod() == "GET" && cfg.GetBody() != "" {
+			return fmt.Errorf("'body' can't be used with GET requests")
+		}
+	}
+
+	// Validate 'headers' and 'body' fields.
+	if err := utils.ValidateKVList("header", cfg.GetHeaders(), ':'); err != nil {
+		return err
+	}
+	if _, err := parseBody(cfg); err != nil {
+		return fmt.Errorf("bad 'body' template: %s", err)
+	}
+
+	// Validate 'audience' and 'success_codes' fields.
+	if cfg.Audience != nil && cfg.GetAuth() != messages.HttpCallTask_ID_TOKEN {
+		return fmt.Errorf("'audience' can be used only with ID_TOKEN auth")
+	}
+	for _, code := range cfg.GetSuccessCodes() {
+		if isPRPC && (code < 0 || code > 16) {
+			return fmt.Errorf("%d is not a valid gRPC code", code)
+		}
+		if !isPRPC && (code < 100 || code > 599) {
+			return fmt.Errorf("%d is not a valid HTTP status code", code)
+		}
+	}
+
+	// Validate 'timeout_sec' field. GAE task queue request deadline is 10 min, so
+	// limit the call duration to 8 min (giving 2 min to spare).
+	if cfg.GetTimeoutSec() < 1 {
+		return fmt.Errorf("minimum allowed 'timeout_sec' is 1 sec, got %d", cfg.GetTimeoutSec())
+	}
+	if cfg.GetTimeoutSec() > 480 {
+		return fmt.Errorf("maximum allowed 'timeout_sec' is 480 sec, got %d", cfg.GetTimeoutSec())
+	}
+
+	return nil
+}
+
+// templateParams is passed to the body template.
+type templateParams struct {
+	JobID           string
+	InvocationID    int64
+	InvocationNonce int64
+	ScheduledTime   time.Time
+	PubSubTopic     string
+	PubSubAuthToken string
+}
+
+// parseBody parses 'body' template.
+func parseBody(cfg *messages.HttpCallTask) (*template.Template, error) {
+	return template.New("body").Funcs(template.FuncMap{
+		"json": func(v interface{}) (string, error) {
+			blob, err := json.Marshal(v)
+			return string(blob), err
+		},
+	}).Parse(cfg.GetBody())
+}
+
+// callURL returns URL to send the request to.
+func callURL(cfg *messages.HttpCallTask) string {
+	if cfg.GetPrpcHost() != "" {
+		return fmt.Sprintf("https://%s/prpc/%s", cfg.GetPrpcHost(), cfg.GetPrpcMethod())
+	}
+	return cfg.GetUrl()
+}
+
+// LaunchTask is part of Manager interface.
+func (m TaskManager) LaunchTask(c context.Context, ctl task.Controller) error {
+	// At this point config is already validated by ValidateProtoMessage.
+	cfg := ctl.Task().(*messages.HttpCallTask)
+	isPRPC := cfg.GetPrpcHost() != ""
+	target := callURL(cfg)
+
+	params := templateParams{
+		JobID:           ctl.JobID(),
+		InvocationID:    ctl.InvocationID(),
+		InvocationNonce: ctl.InvocationNonce(),
+		ScheduledTime:   ctl.ScheduledTime().UTC(),
+	}
+
+	// Async calls must tell the service where to send the notification. Only
+	// services that use luci auth component can be called this way, since
+	// PrepareTopic uses their /auth/api/v1/server/info endpoint.
+	if cfg.GetAsync() {
+		u, err := url.Parse(target)
+		if err != nil {
+			return err
+		}
+		publisher := u.Scheme + "://" + u.Host
+		ctl.DebugLog("Preparing PubSub topic for %q", publisher)
+		params.PubSubTopic, params.PubSubAuthToken, err = ctl.PrepareTopic(publisher)
+		if err != nil {
+			ctl.DebugLog("Failed to prepare PubSub topic - %s", err)
+			return err
+		}
+		ctl.DebugLog("PubSub topic is %q", params.PubSubTopic)
+	}
+
+	// Render the body. The template is validated already, but it still can fail
+	// when executing, e.g. if it refers to unknown fields.
+	tmpl, err := parseBody(cfg)
+	if err != nil {
+		return err
+	}
+	body := bytes.Buffer{}
+	if err = tmpl.Execute(&body, &params); err != nil {
+		ctl.DebugLog("Failed to render the body - %s", err)
+		ctl.State().Status = task.StatusFailed
+		return nil
+	}
+
+	// Prepare the request.
+	method := cfg.GetMethod()
+	if isPRPC {
+		method = "POST"
+	}
+	req, err := http.NewRequest(method, target, bytes.NewReader(body.Bytes()))
+	if err != nil {
+		return err
+	}
+	for _, kv := range utils.UnpackKVList(cfg.GetHeaders(), ':') {
+		req.Header.Add(strings.TrimSpace(kv.Key), strings.TrimSpace(kv.Value))
+	}
+	switch {
+	case isPRPC:
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept", "application/json")
+	case body.Len() != 0:
+		req.Header.Set("Content-Type", cfg.GetContentType())
+	}
+
+	// Log the request before adding auth headers to it. The logged body has
+	// '...' in place of the PubSub auth token.
+	ctl.DebugLog("%s %s", req.Method, target)
+	if body.Len() != 0 {
+		logParams := params
+		if logParams.PubSubAuthToken != "" {
+			logParams.PubSubAuthToken = "..."
+		}
+		logBody := bytes.Buffer{}
+		tmpl.Execute(&logBody, &logParams)
+		ctl.DebugLog("Request body:\n%s", logBody.String())
+	}
+
+	timeout := time.Duration(cfg.GetTimeoutSec()) * time.Second
+	client, err := m.getClient(c, ctl, cfg, req, timeout)
+	if err != nil {
+		ctl.DebugLog("Failed to prepare the client - %s", err)
+		return err
+	}
+
+	type tuple struct {
+		resp *http.Response
+		body []byte // first 4Kb of the response, for debug log
+		err  error
+	}
+	result := make(chan tuple)
+
+	// Do the call asynchronously with datastore update.
+	started := clock.Now(c)
+	go func() {
+		defer close(result)
+		resp, err := client.Do(req)
+		if err != nil {
+			result <- tuple{nil, nil, err}
+			return
+		}
+		defer resp.Body.Close()
+		// Ignore read errors here. Response code is set, it's the main output of
+		// the operation. Read 4K only since we use body only for debug message
+		// that is limited in size.
+		buf := bytes.Buffer{}
+		io.CopyN(&buf, resp.Body, 4096)
+		result <- tuple{resp, buf.Bytes(), nil}
+	}()
+
+	// Notify outside world that the task is running (since the call can take up
+	// to 8 minutes). Ignore errors. As long as final Save is OK, we don't care
+	// about this one.
+	//
+	// Async services may publish their PubSub notification before the call
+	// returns. The invocation must stay in StatusStarting until LaunchTask is
+	// done, so HandleNotification asks PubSub to retry such notifications later
+	// instead of racing with us.
+	if !cfg.GetAsync() {
+		ctl.State().Status = task.StatusRunning
+		if err := ctl.Save(); err != nil {
+			logging.Warningf(c, "Failed to save invocation state: %s", err)
+		}
+	}
+
+	// Wait for completion.
+	res := <-result
+	duration := clock.Now(c).Sub(started)
+	if res.err != nil {
+		ctl.DebugLog("Call failed in %s - %s", duration, res.err)
+		ctl.State().Status = task.StatusFailed
+		return nil
+	}
+	ctl.DebugLog("Response (in %s):\n%s", duration, dumpResponse(res.resp, res.body))
+
+	ok, err := isSuccess(cfg, res.resp)
+	switch {
+	case err != nil:
+		ctl.DebugLog("Bad response - %s", err)
+		ctl.State().Status = task.StatusFailed
+	case !ok:
+		ctl.State().Status = task.StatusFailed
+	case cfg.GetAsync():
+		ctl.DebugLog("Waiting for PubSub notification")
+		ctl.State().Status = task.StatusRunning
+	default:
+		ctl.State().Status = task.StatusSucceeded
+	}
+	if ctl.State().Status != task.StatusRunning {
+		ctl.DebugLog("Finished with overall status %s", ctl.State().Status)
+	}
+	return nil
+}
+
+// AbortTask is part of Manager interface.
+func (m TaskManager) AbortTask(c context.Context, ctl task.Controller) error {
+	// There's no generic way to abort an asynchronous operation in the remote
+	// service. Notifications arriving after the abort will be ignored.
+	return nil
+}
+
+// notification is expected JSON structure of PubSub messages for async tasks.
+type notification struct {
+	Status string `json:"status"`
+}
+
+// HandleNotification is part of Manager interface.
+func (m TaskManager) HandleNotification(c context.Context, ctl task.Controller, msg *pubsub.PubsubMessage) error {
+	switch status := ctl.State().Status; {
+	// This can happen if the service manages to send PubSub message before
+	// LaunchTask finishes. Do not touch State or DebugLog to avoid collision with
+	// still running LaunchTask when saving the invocation.
+	case status == task.StatusStarting:
+		return errors.WrapTransient(errors.New("invocation is still starting, try again later"))
+	case status != task.StatusRunning:
+		return fmt.Errorf("unexpected invocation status %q, expecting %q", status, task.StatusRunning)
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(msg.Data)
+	if err != nil {
+		ctl.DebugLog("Received PubSub notification with bad data - %s", err)
+		return fmt.Errorf("bad PubSub message data - %s", err)
+	}
+	n := notification{}
+	if err := json.Unmarshal(blob, &n); err != nil {
+		ctl.DebugLog("Received PubSub notification with bad data - %s", err)
+		return fmt.Errorf("bad PubSub message data - %s", err)
+	}
+
+	ctl.DebugLog("Received PubSub notification with status %q", n.Status)
+	switch task.Status(n.Status) {
+	case task.StatusSucceeded, task.StatusFailed:
+		ctl.State().Status = task.Status(n.Status)
+		ctl.DebugLog("Finished with overall status %s", ctl.State().Status)
+		return nil
+	default:
+		return fmt.Errorf("unexpected status %q in PubSub message", n.Status)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// getClient returns http.Client to use for the call, adding auth headers to
+// the request if necessary.
+func (m TaskManager) getClient(c context.Context, ctl task.Controller, cfg *messages.HttpCallTask, req *http.Request, timeout time.Duration) (*http.Client, error) {
+	switch cfg.GetAuth() {
+	case messages.HttpCallTask_OAUTH:
+		return ctl.GetClient(timeout)
+	case messages.HttpCallTask_ID_TOKEN:
+		aud := cfg.GetAudience()
+		if aud == "" {
+			aud = req.URL.String()
+		}
+		tok, err := mintIDToken(c, aud, timeout)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+tok)
+	}
+	c, _ = clock.WithTimeout(c, timeout)
+	return &http.Client{Transport: urlfetch.Get(c)}, nil
+}
+
+// isSuccess examines the response code and returns true if it indicates
+// success.
+func isSuccess(cfg *messages.HttpCallTask, resp *http.Response) (bool, error) {
+	if cfg.GetPrpcHost() == "" {
+		codes := cfg.GetSuccessCodes()
+		if len(codes) == 0 {
+			return resp.StatusCode >= 200 && resp.StatusCode < 300, nil
+		}
+		return containsCode(codes, resp.StatusCode), nil
+	}
+
+	// pRPC servers put gRPC code into the header.
+	hdr := resp.Header.Get("X-Prpc-Grpc-Code")
+	if hdr == "" {
+		return false, fmt.Errorf("not a pRPC response, no X-Prpc-Grpc-Code header")
+	}
+	code, err := strconv.Atoi(hdr)
+	if err != nil {
+		return false, fmt.Errorf("bad X-Prpc-Grpc-Code header %q", hdr)
+	}
+	codes := cfg.GetSuccessCodes()
+	if len(codes) == 0 {
+		return code == 0, nil
+	}
+	return containsCode(codes, code), nil
+}
+
+// containsCode returns true if 'code' is in 'codes'.
+func containsCode(codes []int32, code int) bool {
+	for _, c := range codes {
+		if int(c) == code {
+			return true
+		}
+	}
+	return false
+}
+
+// dumpResponse converts http.Response to text for the invocation debug log.
+func dumpResponse(resp *http.Response, body []byte) string {
+	out := &bytes.Buffer{}
+	fmt.Fprintln(out, resp.Status)
+	resp.Header.Write(out)
+	fmt.Fprintln(out)
+	if len(body) == 0 {
+		fmt.Fprintln(out, "<empty body>")
+	} else if isTextContent(resp.Header) {
+		out.Write(body)
+		if body[len(body)-1] != '\n' {
+			fmt.Fprintln(out)
+		}
+		if int64(len(body)) < resp.ContentLength {
+			fmt.Fprintln(out, "<truncated>")
+		}
+	} else {
+		fmt.Fprintln(out, "<binary response>")
+	}
+	return out.String()
+}
+
+var textContentTypes = []string{
+	"text/",
+	"application/json",
+	"application/xml",
+}
+
+// isTextContent returns True if Content-Type header corresponds to some
+// readable text type.
+func isTextContent(h http.Header) bool {
+	for _, header := range h["Content-Type"] {
+		for _, good := range textContentTypes {
+			if strings.HasPrefix(header, good) {
+				return true
+			}
+		}
+	}
+	return false
+}
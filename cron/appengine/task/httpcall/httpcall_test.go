@@ -0,0 +1,443 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package httpcall
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/api/pubsub/v1"
+
+	"github.com/luci/gae/service/urlfetch"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/idtoken"
+	"github.com/luci/luci-go/server/auth/signing"
+	"github.com/luci/luci-go/server/auth/signing/signingtest"
+
+	"github.com/luci/luci-go/cron/appengine/messages"
+	"github.com/luci/luci-go/cron/appengine/task"
+	"github.com/luci/luci-go/cron/appengine/task/utils/tasktest"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+var epoch = time.Unix(1442270520, 0).UTC()
+
+func TestValidateProtoMessage(t *testing.T) {
+	tm := TaskManager{}
+
+	Convey("ValidateProtoMessage passes good msg", t, func() {
+		So(tm.ValidateProtoMessage(&messages.HttpCallTask{
+			Url:     strPtr("https://blah.com"),
+			Headers: []string{"X-Header: value"},
+			Body:    strPtr(`{"job": {{json .JobID}}}`),
+		}), ShouldBeNil)
+	})
+
+	Convey("ValidateProtoMessage passes good pRPC msg", t, func() {
+		So(tm.ValidateProtoMessage(&messages.HttpCallTask{
+			PrpcHost:     strPtr("blah.com"),
+			PrpcMethod:   strPtr("pkg.Service/Method"),
+			SuccessCodes: []int32{0, 6},
+		}), ShouldBeNil)
+	})
+
+	Convey("ValidateProtoMessage wrong type", t, func() {
+		So(tm.ValidateProtoMessage(&messages.NoopTask{}), ShouldErrLike, "wrong type")
+	})
+
+	Convey("ValidateProtoMessage empty", t, func() {
+		So(tm.ValidateProtoMessage(tm.ProtoMessageType()), ShouldErrLike, "either 'url' or 'prpc_host' field is required")
+	})
+
+	Convey("ValidateProtoMessage both URL and pRPC", t, func() {
+		So(tm.ValidateProtoMessage(&messages.HttpCallTask{
+			Url:      strPtr("https://blah.com"),
+			PrpcHost: strPtr("blah.com"),
+		}), ShouldErrLike, "only one of 'url' or 'prpc_host'")
+	})
+
+	Convey("ValidateProtoMessage bad pRPC host", t, func() {
+		So(tm.ValidateProtoMessage(&messages.HttpCallTask{
+			PrpcHost:   strPtr("https://blah.com"),
+			PrpcMethod: strPtr("pkg.Service/Method"),
+		}), ShouldErrLike, "'prpc_host' must be a host name")
+	})
+
+	Convey("ValidateProtoMessage bad pRPC method", t, func() {
+		So(tm.ValidateProtoMessage(&messages.HttpCallTask{
+			PrpcHost:   strPtr("blah.com"),
+			PrpcMethod: strPtr("Method"),
+		}), ShouldErrLike, "'prpc_method' must have form <service>/<method>")
+	})
+
+	Convey("ValidateProtoMessage bad URL", t, func() {
+		So(tm.ValidateProtoMessage(&messages.HttpCallTask{
+			Url: strPtr("%%%%"),
+		}), ShouldErrLike, "invalid URL")
+	})
+
+	Convey("ValidateProtoMessage non-absolute URL", t, func() {
+		So(tm.ValidateProtoMessage(&messages.HttpCallTask{
+			Url: strPtr("/abc"),
+		}), ShouldErrLike, "not an absolute url")
+	})
+
+	Convey("ValidateProtoMessage bad method", t, func() {
+		So(tm.ValidateProtoMessage(&messages.HttpCallTask{
+			Url:    strPtr("https://blah.com"),
+			Method: strPtr("BLAH"),
+		}), ShouldErrLike, "unsupported HTTP method")
+	})
+
+	Convey("ValidateProtoMessage GET with body", t, func() {
+		So(tm.ValidateProtoMessage(&messages.HttpCallTask{
+			Url:    strPtr("https://blah.com"),
+			Method: strPtr("GET"),
+			Body:   strPtr("zzz"),
+		}), ShouldErrLike, "'body' can't be used with GET requests")
+	})
+
+	Convey("ValidateProtoMessage bad header", t, func() {
+		So(tm.ValidateProtoMessage(&messages.HttpCallTask{
+			Url:     strPtr("https://blah.com"),
+			Headers: []string{"zzz"},
+		}), ShouldErrLike, "bad header")
+	})
+
+	Convey("ValidateProtoMessage bad template", t, func() {
+		So(tm.ValidateProtoMessage(&messages.HttpCallTask{
+			Url:  strPtr("https://blah.com"),
+			Body: strPtr("{{.JobID"),
+		}), ShouldErrLike, "bad 'body' template")
+	})
+
+	Convey("ValidateProtoMessage audience without ID token", t, func() {
+		So(tm.ValidateProtoMessage(&messages.HttpCallTask{
+			Url:      strPtr("https://blah.com"),
+			Audience: strPtr("zzz"),
+		}), ShouldErrLike, "'audience' can be used only with ID_TOKEN auth")
+	})
+
+	Convey("ValidateProtoMessage bad success codes", t, func() {
+		So(tm.ValidateProtoMessage(&messages.HttpCallTask{
+			Url:          strPtr("https://blah.com"),
+			SuccessCodes: []int32{20},
+		}), ShouldErrLike, "20 is not a valid HTTP status code")
+		So(tm.ValidateProtoMessage(&messages.HttpCallTask{
+			PrpcHost:     strPtr("blah.com"),
+			PrpcMethod:   strPtr("pkg.Service/Method"),
+			SuccessCodes: []int32{200},
+		}), ShouldErrLike, "200 is not a valid gRPC code")
+	})
+
+	Convey("ValidateProtoMessage bad timeout", t, func() {
+		So(tm.ValidateProtoMessage(&messages.HttpCallTask{
+			Url:        strPtr("https://blah.com"),
+			TimeoutSec: intPtr(0),
+		}), ShouldErrLike, "minimum allowed 'timeout_sec' is 1 sec")
+		So(tm.ValidateProtoMessage(&messages.HttpCallTask{
+			Url:        strPtr("https://blah.com"),
+			TimeoutSec: intPtr(10000),
+		}), ShouldErrLike, "maximum allowed 'timeout_sec' is 480 sec")
+	})
+}
+
+func TestLaunchTask(t *testing.T) {
+	tm := TaskManager{}
+
+	Convey("with test server", t, func() {
+		var lastReq *http.Request
+		var lastBody string
+		handler := func(w http.ResponseWriter, r *http.Request) {}
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			lastReq, lastBody = r, string(body)
+			handler(w, r)
+		}))
+		defer ts.Close()
+
+		c := context.Background()
+		c = clock.Set(c, testclock.New(epoch))
+		c = urlfetch.Set(c, http.DefaultTransport)
+
+		ctl := &tasktest.TestController{
+			Scheduled: epoch.Add(-time.Minute),
+			TaskMessage: &messages.HttpCallTask{
+				Url:     strPtr(ts.URL + "/call"),
+				Headers: []string{"X-Header: value"},
+				Body:    strPtr(`{"job": {{json .JobID}}, "inv": {{.InvocationID}}, "ts": {{json .ScheduledTime}}}`),
+			},
+			SaveCallback: func() error { return nil },
+		}
+
+		Convey("sends templated body", func() {
+			So(tm.LaunchTask(c, ctl), ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusSucceeded)
+			So(lastReq.Method, ShouldEqual, "POST")
+			So(lastReq.URL.Path, ShouldEqual, "/call")
+			So(lastReq.Header.Get("X-Header"), ShouldEqual, "value")
+			So(lastReq.Header.Get("Content-Type"), ShouldEqual, "application/json")
+			So(lastBody, ShouldEqual, `{"job": "some-project/some-job", "inv": 1, "ts": "2015-09-14T22:41:00Z"}`)
+		})
+
+		Convey("fails on bad status code", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+			So(tm.LaunchTask(c, ctl), ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusFailed)
+		})
+
+		Convey("respects success_codes", func() {
+			ctl.TaskMessage.(*messages.HttpCallTask).SuccessCodes = []int32{404}
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			}
+			So(tm.LaunchTask(c, ctl), ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusSucceeded)
+		})
+
+		Convey("checks pRPC codes", func() {
+			ctl.TaskMessage = &messages.HttpCallTask{
+				PrpcHost:   strPtr(strings.TrimPrefix(ts.URL, "http://")),
+				PrpcMethod: strPtr("pkg.Service/Method"),
+				Body:       strPtr(`{}`),
+			}
+			// pRPC calls always use https://, redirect them to the test server.
+			c = urlfetch.Set(c, httpRewriter{})
+
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Prpc-Grpc-Code", "0")
+			}
+			So(tm.LaunchTask(c, ctl), ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusSucceeded)
+			So(lastReq.URL.Path, ShouldEqual, "/prpc/pkg.Service/Method")
+			So(lastReq.Header.Get("Accept"), ShouldEqual, "application/json")
+
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Prpc-Grpc-Code", "5")
+			}
+			So(tm.LaunchTask(c, ctl), ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusFailed)
+
+			handler = func(w http.ResponseWriter, r *http.Request) {}
+			So(tm.LaunchTask(c, ctl), ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusFailed)
+		})
+
+		Convey("uses ID tokens", func() {
+			signer := signingtest.NewSigner(0, &signing.ServiceInfo{
+				ServiceAccountName: "cron@example.com",
+			})
+			c = auth.SetConfig(c, auth.Config{Signer: signer})
+			ctl.TaskMessage.(*messages.HttpCallTask).Auth = messages.HttpCallTask_ID_TOKEN.Enum()
+			ctl.TaskMessage.(*messages.HttpCallTask).Audience = strPtr("https://aud")
+
+			So(tm.LaunchTask(c, ctl), ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusSucceeded)
+
+			authHeader := lastReq.Header.Get("Authorization")
+			So(authHeader, ShouldStartWith, "Bearer ")
+			chunks := strings.Split(strings.TrimPrefix(authHeader, "Bearer "), ".")
+			So(len(chunks), ShouldEqual, 3)
+
+			// The signature is valid.
+			certs, err := signer.Certificates(c)
+			So(err, ShouldBeNil)
+			sig, err := base64.RawURLEncoding.DecodeString(chunks[2])
+			So(err, ShouldBeNil)
+			So(certs.CheckSignature(certs.Certificates[0].KeyName, []byte(chunks[0]+"."+chunks[1]), sig), ShouldBeNil)
+
+			// The header names the signing key.
+			blob, err := base64.RawURLEncoding.DecodeString(chunks[0])
+			So(err, ShouldBeNil)
+			hdr := idTokenHeader{}
+			So(json.Unmarshal(blob, &hdr), ShouldBeNil)
+			So(hdr, ShouldResemble, idTokenHeader{
+				Alg: "RS256",
+				Typ: "JWT",
+				Kid: certs.Certificates[0].KeyName,
+			})
+
+			// The claims are correct.
+			blob, err = base64.RawURLEncoding.DecodeString(chunks[1])
+			So(err, ShouldBeNil)
+			claims := idTokenClaims{}
+			So(json.Unmarshal(blob, &claims), ShouldBeNil)
+			So(claims, ShouldResemble, idTokenClaims{
+				Iss:   "cron@example.com",
+				Sub:   "cron@example.com",
+				Aud:   "https://aud",
+				Iat:   epoch.Unix(),
+				Exp:   epoch.Add(6 * time.Minute).Unix(), // timeout + 5 min
+				Email: "cron@example.com",
+			})
+		})
+
+		Convey("async calls wait for notification", func() {
+			ctl.TaskMessage.(*messages.HttpCallTask).Async = boolPtr(true)
+			ctl.TaskMessage.(*messages.HttpCallTask).Body = strPtr(`{{.PubSubTopic}} {{.PubSubAuthToken}}`)
+			ctl.PrepareTopicCallback = func(publisher string) (string, string, error) {
+				So(publisher, ShouldEqual, ts.URL)
+				return "topic", "token", nil
+			}
+
+			So(tm.LaunchTask(c, ctl), ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusRunning)
+			So(lastBody, ShouldEqual, "topic token")
+			So(ctl.Log, ShouldContain, "Request body:\ntopic ...")
+
+			// Bad notification is rejected.
+			err := tm.HandleNotification(c, ctl, &pubsub.PubsubMessage{
+				Data: base64.StdEncoding.EncodeToString([]byte(`{"status": "ZZZ"}`)),
+			})
+			So(err, ShouldErrLike, `unexpected status "ZZZ"`)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusRunning)
+
+			// Good one finishes the invocation.
+			err = tm.HandleNotification(c, ctl, &pubsub.PubsubMessage{
+				Data: base64.StdEncoding.EncodeToString([]byte(`{"status": "SUCCEEDED"}`)),
+			})
+			So(err, ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusSucceeded)
+		})
+
+		Convey("async calls handle notifications that arrive before the response", func() {
+			ctl.TaskMessage.(*messages.HttpCallTask).Async = boolPtr(true)
+			ctl.PrepareTopicCallback = func(string) (string, string, error) {
+				return "topic", "token", nil
+			}
+			ctl.TaskState.Status = task.StatusStarting
+
+			// Saved state of the invocation, as seen by HandleNotification.
+			saved := ctl.TaskState
+			ctl.SaveCallback = func() error {
+				saved = ctl.TaskState
+				return nil
+			}
+
+			succeeded := &pubsub.PubsubMessage{
+				Data: base64.StdEncoding.EncodeToString([]byte(`{"status": "SUCCEEDED"}`)),
+			}
+
+			// The service finishes and notifies before replying.
+			var earlyErr error
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				earlyCtl := &tasktest.TestController{TaskState: saved}
+				earlyErr = tm.HandleNotification(c, earlyCtl, succeeded)
+			}
+
+			So(tm.LaunchTask(c, ctl), ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusRunning)
+			So(ctl.Log, ShouldContain, "Waiting for PubSub notification")
+
+			// The early notification is retried later.
+			So(earlyErr, ShouldErrLike, "still starting")
+			So(errors.IsTransient(earlyErr), ShouldBeTrue)
+
+			// The retry finishes the invocation.
+			So(tm.HandleNotification(c, ctl, succeeded), ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusSucceeded)
+		})
+	})
+}
+
+func TestMintIDToken(t *testing.T) {
+	Convey("Minted ID tokens are accepted by idtoken.AuthMethod", t, func() {
+		c := context.Background()
+		c = clock.Set(c, testclock.New(epoch))
+
+		signer := signingtest.NewSigner(0, &signing.ServiceInfo{
+			ServiceAccountName: "cron@example.com",
+		})
+		c = auth.SetConfig(c, auth.Config{Signer: signer})
+
+		// Serve signer's public keys as JWKS.
+		certs, err := signer.Certificates(c)
+		So(err, ShouldBeNil)
+		keys := []map[string]string{}
+		for _, cert := range certs.Certificates {
+			block, _ := pem.Decode([]byte(cert.X509CertificatePEM))
+			parsed, err := x509.ParseCertificate(block.Bytes)
+			So(err, ShouldBeNil)
+			pub := parsed.PublicKey.(*rsa.PublicKey)
+			keys = append(keys, map[string]string{
+				"kty": "RSA",
+				"kid": cert.KeyName,
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+			})
+		}
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(map[string]interface{}{"keys": keys})
+		}))
+		defer ts.Close()
+
+		method := idtoken.AuthMethod{
+			Issuers: []idtoken.Issuer{
+				{
+					Issuer:    "cron@example.com",
+					JWKSURL:   ts.URL,
+					Audiences: []string{"https://aud"},
+				},
+			},
+		}
+
+		tok, err := mintIDToken(c, "https://aud", time.Minute)
+		So(err, ShouldBeNil)
+
+		req, _ := http.NewRequest("POST", "https://aud", nil)
+		req.Header.Set("Authorization", "Bearer "+tok)
+		user, err := method.Authenticate(c, req)
+		So(err, ShouldBeNil)
+		So(user, ShouldResemble, &auth.User{
+			Identity: "user:cron@example.com",
+			Email:    "cron@example.com",
+		})
+
+		// Expires 'lifetime' + 5 min later (plus default clock skew).
+		c = clock.Set(c, testclock.New(epoch.Add(8*time.Minute)))
+		_, err = method.Authenticate(c, req)
+		So(err, ShouldEqual, idtoken.ErrForbiddenIDToken)
+	})
+}
+
+// httpRewriter sends https:// requests over plain http://.
+type httpRewriter struct{}
+
+func (httpRewriter) RoundTrip(r *http.Request) (*http.Response, error) {
+	r.URL.Scheme = "http"
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func intPtr(i int) *int32 {
+	j := int32(i)
+	return &j
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
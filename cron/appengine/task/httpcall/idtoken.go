@@ -0,0 +1,93 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package httpcall
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/server/auth"
+)
+
+// idTokenHeader is JWT header of ID tokens.
+type idTokenHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+	Kid string `json:"kid"`
+}
+
+// idTokenClaims is JWT claims set of ID tokens.
+type idTokenClaims struct {
+	Iss   string `json:"iss"`
+	Sub   string `json:"sub"`
+	Aud   string `json:"aud"`
+	Iat   int64  `json:"iat"`
+	Exp   int64  `json:"exp"`
+	Email string `json:"email"`
+}
+
+// mintIDToken returns a JWT signed by the service's private key that asserts
+// the service account identity to the given audience.
+//
+// The receiving side can verify it using public certificates of the service
+// account (see signing.FetchServiceAccountCertificates), picking the one named
+// by "kid" header field. The token expires 'lifetime' after it is minted, plus
+// 5 min to account for clock skew.
+func mintIDToken(c context.Context, audience string, lifetime time.Duration) (string, error) {
+	signer := auth.GetSigner(c)
+	if signer == nil {
+		return "", errors.New("no signer in the context, can't mint ID token")
+	}
+	info, err := signer.ServiceInfo(c)
+	if err != nil {
+		return "", err
+	}
+	now := clock.Now(c)
+	claims, err := json.Marshal(idTokenClaims{
+		Iss:   info.ServiceAccountName,
+		Sub:   info.ServiceAccountName,
+		Aud:   audience,
+		Iat:   now.Unix(),
+		Exp:   now.Add(lifetime + 5*time.Minute).Unix(),
+		Email: info.ServiceAccountName,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// The name of the key used by the signer is known only after signing, but
+	// it must be in the signed header. Guess it from the list of active keys,
+	// and sign again if the signer used some other key (e.g. keys were rotated
+	// in between).
+	certs, err := signer.Certificates(c)
+	if err != nil {
+		return "", err
+	}
+	kid := ""
+	if len(certs.Certificates) != 0 {
+		kid = certs.Certificates[0].KeyName
+	}
+	for attempt := 0; attempt < 2; attempt++ {
+		hdr, err := json.Marshal(idTokenHeader{Alg: "RS256", Typ: "JWT", Kid: kid})
+		if err != nil {
+			return "", err
+		}
+		toSign := base64.RawURLEncoding.EncodeToString(hdr) + "." + base64.RawURLEncoding.EncodeToString(claims)
+		keyName, sig, err := signer.SignBytes(c, []byte(toSign))
+		if err != nil {
+			return "", err
+		}
+		if keyName == kid {
+			return toSign + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+		}
+		kid = keyName
+	}
+	return "", errors.New("the signer keeps switching keys, can't mint ID token")
+}
@@ -124,6 +124,11 @@ type Controller interface {
 	// idempotency to LaunchTask calls.
 	InvocationNonce() int64
 
+	// ScheduledTime returns when the request to start the invocation was queued
+	// (when the job's schedule ticked, the job was triggered, etc). It is the
+	// same for all invocations with the same InvocationNonce.
+	ScheduledTime() time.Time
+
 	// Task is proto message with task definition.
 	//
 	// It is guaranteed to have same underlying type as manager.ProtoMessageType()
@@ -20,6 +20,7 @@ type TestController struct {
 	OverrideInvID    int64  // return value of InvocationID() if not 0
 	OverrideInvNonce int64  // return value of InvocationNonce() if not 0
 
+	Scheduled   time.Time     // return value of ScheduledTime()
 	TaskMessage proto.Message // return value of Task
 	TaskState   task.State    // return value of State(), mutated in place
 	Client      *http.Client  // return value by GetClient()
@@ -53,6 +54,11 @@ func (c *TestController) InvocationNonce() int64 {
 	return 2
 }
 
+// ScheduledTime is part of Controller interface.
+func (c *TestController) ScheduledTime() time.Time {
+	return c.Scheduled
+}
+
 // Task is part of Controller interface.
 func (c *TestController) Task() proto.Message {
 	return c.TaskMessage
@@ -116,6 +116,7 @@ type invocation struct {
 	RevisionURL string
 	Definition  string
 	TriggeredBy string
+	TriggerURL  string
 	Started     string
 	Duration    string
 	Status      string
@@ -145,11 +146,17 @@ var statusToLabelClass = map[task.Status]string{
 
 func makeInvocation(projecID, jobID string, i *engine.Invocation, now time.Time) *invocation {
 	triggeredBy := "-"
-	if i.TriggeredBy != "" {
+	triggerURL := ""
+	switch {
+	case i.TriggeredBy != "":
 		triggeredBy = string(i.TriggeredBy)
 		if i.TriggeredBy.Email() != "" {
 			triggeredBy = i.TriggeredBy.Email() // triggered by a user (not a service)
 		}
+	case i.TriggeringJobID != "":
+		// TriggeringJobID has form <project>/<id>, same as URL path of job pages.
+		triggeredBy = fmt.Sprintf("%s #%d", i.TriggeringJobID, i.TriggeringInvID)
+		triggerURL = fmt.Sprintf("/jobs/%s/%d", i.TriggeringJobID, i.TriggeringInvID)
 	}
 	finished := i.Finished
 	if finished.IsZero() {
@@ -168,6 +175,7 @@ func makeInvocation(projecID, jobID string, i *engine.Invocation, now time.Time)
 		RevisionURL: i.RevisionURL,
 		Definition:  taskToText(i.Task),
 		TriggeredBy: triggeredBy,
+		TriggerURL:  triggerURL,
 		Started:     humanize.RelTime(i.Started, now, "ago", "from now"),
 		Duration:    duration,
 		Status:      string(i.Status),
@@ -19,6 +19,7 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import jobsim "github.com/luci/luci-go/dm/api/distributor/jobsim"
+import swarmingV1 "github.com/luci/luci-go/dm/api/distributor/swarming/v1"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -49,6 +50,7 @@ type Distributor struct {
 	//
 	// Types that are valid to be assigned to DistributorType:
 	//	*Distributor_Alias
+	//	*Distributor_SwarmingV1
 	//	*Distributor_Jobsim
 	DistributorType isDistributor_DistributorType `protobuf_oneof:"distributor_type"`
 }
@@ -65,12 +67,16 @@ type isDistributor_DistributorType interface {
 type Distributor_Alias struct {
 	Alias *Alias `protobuf:"bytes,1,opt,name=alias,oneof"`
 }
+type Distributor_SwarmingV1 struct {
+	SwarmingV1 *swarmingV1.Config `protobuf:"bytes,4,opt,name=swarming_v1,json=swarmingV1,oneof"`
+}
 type Distributor_Jobsim struct {
 	Jobsim *jobsim.Config `protobuf:"bytes,2048,opt,name=jobsim,oneof"`
 }
 
-func (*Distributor_Alias) isDistributor_DistributorType()  {}
-func (*Distributor_Jobsim) isDistributor_DistributorType() {}
+func (*Distributor_Alias) isDistributor_DistributorType()      {}
+func (*Distributor_SwarmingV1) isDistributor_DistributorType() {}
+func (*Distributor_Jobsim) isDistributor_DistributorType()     {}
 
 func (m *Distributor) GetDistributorType() isDistributor_DistributorType {
 	if m != nil {
@@ -86,6 +92,13 @@ func (m *Distributor) GetAlias() *Alias {
 	return nil
 }
 
+func (m *Distributor) GetSwarmingV1() *swarmingV1.Config {
+	if x, ok := m.GetDistributorType().(*Distributor_SwarmingV1); ok {
+		return x.SwarmingV1
+	}
+	return nil
+}
+
 func (m *Distributor) GetJobsim() *jobsim.Config {
 	if x, ok := m.GetDistributorType().(*Distributor_Jobsim); ok {
 		return x.Jobsim
@@ -97,6 +110,7 @@ func (m *Distributor) GetJobsim() *jobsim.Config {
 func (*Distributor) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Distributor_OneofMarshaler, _Distributor_OneofUnmarshaler, _Distributor_OneofSizer, []interface{}{
 		(*Distributor_Alias)(nil),
+		(*Distributor_SwarmingV1)(nil),
 		(*Distributor_Jobsim)(nil),
 	}
 }
@@ -110,6 +124,11 @@ func _Distributor_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Alias); err != nil {
 			return err
 		}
+	case *Distributor_SwarmingV1:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SwarmingV1); err != nil {
+			return err
+		}
 	case *Distributor_Jobsim:
 		b.EncodeVarint(2048<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.Jobsim); err != nil {
@@ -133,6 +152,14 @@ func _Distributor_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Bu
 		err := b.DecodeMessage(msg)
 		m.DistributorType = &Distributor_Alias{msg}
 		return true, err
+	case 4: // distributor_type.swarming_v1
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(swarmingV1.Config)
+		err := b.DecodeMessage(msg)
+		m.DistributorType = &Distributor_SwarmingV1{msg}
+		return true, err
 	case 2048: // distributor_type.jobsim
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
@@ -155,6 +182,11 @@ func _Distributor_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(1<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Distributor_SwarmingV1:
+		s := proto.Size(x.SwarmingV1)
+		n += proto.SizeVarint(4<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case *Distributor_Jobsim:
 		s := proto.Size(x.Jobsim)
 		n += proto.SizeVarint(2048<<3 | proto.WireBytes)
@@ -194,24 +226,26 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x51, 0xcb, 0x4a, 0xc5, 0x30,
-	0x10, 0xb5, 0x4f, 0x74, 0x2a, 0x12, 0xc6, 0x85, 0xe5, 0xae, 0xb4, 0x2b, 0x1f, 0x98, 0x82, 0x6e,
-	0x44, 0x44, 0xf0, 0x05, 0xe2, 0xb2, 0x6b, 0xa1, 0xf4, 0x65, 0x6f, 0xb4, 0xbd, 0x29, 0x6d, 0x2a,
-	0x74, 0xe7, 0xda, 0x1f, 0xf3, 0xb7, 0x6c, 0x93, 0x82, 0xb9, 0x88, 0x0b, 0x37, 0x93, 0xe1, 0xe4,
-	0x9c, 0x33, 0x67, 0x12, 0xb8, 0x2a, 0x99, 0x58, 0xf6, 0x29, 0xcd, 0x78, 0x1d, 0x56, 0x7d, 0xc6,
-	0x64, 0x39, 0x2d, 0x79, 0x98, 0xd7, 0x61, 0xd2, 0xb0, 0x30, 0x67, 0x9d, 0x68, 0x59, 0xda, 0x0b,
-	0xde, 0xea, 0x3d, 0x6d, 0x5a, 0x2e, 0x38, 0x7a, 0x1a, 0xb4, 0xb8, 0xfe, 0x87, 0xd5, 0x2b, 0x4f,
-	0x3b, 0x56, 0xcf, 0x87, 0x32, 0x0b, 0x8e, 0xc1, 0xb9, 0xa9, 0x58, 0xd2, 0xe1, 0x01, 0x6c, 0x73,
-	0xb1, 0x2c, 0xda, 0x38, 0xe3, 0xab, 0x17, 0x56, 0xfa, 0xc6, 0xbe, 0x71, 0xb8, 0x15, 0x79, 0x12,
-	0xbb, 0x93, 0x50, 0xf0, 0x69, 0x80, 0x77, 0xff, 0xe3, 0x87, 0xa3, 0x36, 0x99, 0xb4, 0x92, 0xeb,
-	0x9d, 0x21, 0xd5, 0xb3, 0x4a, 0xd7, 0xc7, 0x8d, 0x48, 0x51, 0xf0, 0x08, 0x5c, 0x35, 0xd7, 0xff,
-	0x20, 0x92, 0xbd, 0x43, 0xe7, 0x1c, 0xca, 0x7c, 0x64, 0xce, 0x84, 0x5b, 0x04, 0xa2, 0x19, 0xc5,
-	0x62, 0x68, 0x8a, 0x27, 0x7b, 0xd3, 0x24, 0xd6, 0x58, 0x2d, 0x62, 0x8f, 0xd5, 0x26, 0x4e, 0xf0,
-	0x65, 0x80, 0xab, 0xa4, 0xf8, 0x0c, 0xbb, 0xba, 0x40, 0x2d, 0x30, 0xa5, 0xb2, 0xc6, 0x39, 0x27,
-	0x6b, 0xa9, 0x94, 0x82, 0x6a, 0x5b, 0x28, 0xa4, 0x7b, 0x58, 0x89, 0x76, 0x88, 0x30, 0xff, 0x75,
-	0xb1, 0x88, 0x61, 0xef, 0x0f, 0x3a, 0x12, 0xb0, 0xde, 0x8a, 0x61, 0x7e, 0xaa, 0xa9, 0x45, 0x0a,
-	0xce, 0x7b, 0x52, 0xf5, 0x85, 0x6f, 0xca, 0x25, 0xfd, 0xb5, 0xe1, 0x9a, 0x4d, 0xa4, 0x68, 0x97,
-	0xe6, 0x85, 0x91, 0xba, 0xf2, 0x27, 0xce, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x85, 0x86,
-	0x24, 0x16, 0x02, 0x00, 0x00,
+	// 333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0x51, 0x4b, 0xf3, 0x30,
+	0x14, 0x5d, 0xd7, 0x6e, 0xec, 0xbb, 0xf9, 0x90, 0x12, 0x1f, 0x2c, 0x7b, 0xd2, 0x3d, 0xcd, 0x89,
+	0x09, 0x9b, 0x08, 0x22, 0x22, 0xe8, 0x14, 0xc6, 0x1e, 0xfb, 0xe0, 0x93, 0x50, 0xda, 0xad, 0x76,
+	0xd1, 0x75, 0x19, 0x69, 0x5a, 0xe9, 0x9b, 0xff, 0xcb, 0x1f, 0xe0, 0xdf, 0x92, 0x26, 0x9d, 0xcb,
+	0x10, 0x1f, 0xf6, 0x92, 0x5c, 0xce, 0x3d, 0xe7, 0xdc, 0x9c, 0x1b, 0xb8, 0x49, 0x98, 0x5c, 0xe4,
+	0x11, 0x99, 0xf1, 0x94, 0x2e, 0xf3, 0x19, 0x53, 0xc7, 0x79, 0xc2, 0xe9, 0x3c, 0xa5, 0xe1, 0x9a,
+	0xd1, 0x39, 0xcb, 0xa4, 0x60, 0x51, 0x2e, 0xb9, 0x30, 0x6b, 0xb2, 0x16, 0x5c, 0x72, 0x8c, 0x0c,
+	0xa8, 0x7b, 0xbb, 0x87, 0xd5, 0x2b, 0x8f, 0x32, 0x96, 0xd6, 0x97, 0x36, 0xeb, 0x8e, 0xf7, 0xd0,
+	0x67, 0xef, 0xa1, 0x48, 0xd9, 0x2a, 0xa1, 0xc5, 0x90, 0xce, 0xf8, 0xea, 0x85, 0x25, 0xda, 0xa4,
+	0x37, 0x80, 0xd6, 0xdd, 0x92, 0x85, 0x19, 0x3e, 0x81, 0xff, 0x5c, 0x2e, 0x62, 0x11, 0xe8, 0xb6,
+	0x67, 0x1d, 0x5b, 0xfd, 0x7f, 0x3e, 0x52, 0xd8, 0x58, 0x41, 0xbd, 0x4f, 0x0b, 0xd0, 0xc3, 0xd6,
+	0x14, 0x0f, 0xa0, 0x15, 0x56, 0x5a, 0xc5, 0x45, 0x23, 0x4c, 0xcc, 0xc0, 0xca, 0x75, 0xd2, 0xf0,
+	0x35, 0x05, 0x5f, 0x02, 0xda, 0xbc, 0x21, 0x28, 0x86, 0x9e, 0x53, 0x2b, 0x36, 0xd8, 0xd3, 0x90,
+	0xe8, 0x21, 0x93, 0x86, 0x0f, 0x5b, 0x10, 0x9f, 0x42, 0x5b, 0x67, 0xf6, 0x3e, 0x5c, 0x25, 0x39,
+	0x20, 0xf5, 0x0e, 0x7e, 0xe8, 0x35, 0xe1, 0x1e, 0x83, 0x6b, 0xcc, 0x0f, 0x64, 0xb9, 0x8e, 0xa7,
+	0x4e, 0xa7, 0xe9, 0xda, 0x53, 0xa7, 0x63, 0xbb, 0x4e, 0xef, 0xcb, 0x82, 0xb6, 0x16, 0xe1, 0x67,
+	0x38, 0x34, 0xa9, 0x3a, 0x71, 0x15, 0xc3, 0xee, 0xa3, 0xd1, 0xd9, 0x4e, 0x0c, 0xad, 0x20, 0x46,
+	0x6c, 0x8d, 0x64, 0x8f, 0x2b, 0x29, 0x4a, 0x1f, 0xcf, 0x7f, 0x35, 0xba, 0x01, 0x1c, 0xfd, 0x41,
+	0xc7, 0x2e, 0xd8, 0x6f, 0x71, 0x59, 0xef, 0xb6, 0x2a, 0x31, 0x81, 0x56, 0x11, 0x2e, 0xf3, 0xd8,
+	0x6b, 0xaa, 0x78, 0xde, 0xce, 0x70, 0xc3, 0xc6, 0xd7, 0xb4, 0xeb, 0xe6, 0x95, 0x15, 0xb5, 0xd5,
+	0xd7, 0x5d, 0x7c, 0x0f, 0x00, 0x4d, 0x44, 0x39, 0x2c, 0x8c, 0x02, 0x00, 0x00,
 }
@@ -0,0 +1,198 @@
+// Code generated by protoc-gen-go.
+// source: github.com/luci/luci-go/dm/api/distributor/swarming/v1/config.proto
+// DO NOT EDIT!
+
+/*
+Package swarmingV1 is a generated protocol buffer package.
+
+It is generated from these files:
+	github.com/luci/luci-go/dm/api/distributor/swarming/v1/config.proto
+	github.com/luci/luci-go/dm/api/distributor/swarming/v1/params.proto
+
+It has these top-level messages:
+	Config
+	CipdPackage
+	CipdSpec
+	Parameters
+*/
+package swarmingV1
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// Config is the configuration for a swarming_v1 distributor.
+type Config struct {
+	// swarming describes the swarming service that tasks will run on.
+	Swarming *Config_Swarming `protobuf:"bytes,1,opt,name=swarming" json:"swarming,omitempty"`
+	// isolate describes the isolate service that task inputs are stored on.
+	Isolate *Config_Isolate `protobuf:"bytes,2,opt,name=isolate" json:"isolate,omitempty"`
+	// cipd describes the CIPD packages that will be installed for every task
+	// (e.g. a DM-aware task runner). Quests may add additional packages.
+	Cipd *CipdSpec `protobuf:"bytes,3,opt,name=cipd" json:"cipd,omitempty"`
+	// dimensions are swarming dimensions that will be applied to every task.
+	// Quests may add additional dimensions, but may not override these.
+	Dimensions map[string]string `protobuf:"bytes,4,rep,name=dimensions" json:"dimensions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// default_priority is the swarming priority of tasks whose quests don't
+	// specify one. If omitted, 100 will be used.
+	DefaultPriority uint32 `protobuf:"varint,5,opt,name=default_priority,json=defaultPriority" json:"default_priority,omitempty"`
+}
+
+func (m *Config) Reset()                    { *m = Config{} }
+func (m *Config) String() string            { return proto.CompactTextString(m) }
+func (*Config) ProtoMessage()               {}
+func (*Config) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *Config) GetSwarming() *Config_Swarming {
+	if m != nil {
+		return m.Swarming
+	}
+	return nil
+}
+
+func (m *Config) GetIsolate() *Config_Isolate {
+	if m != nil {
+		return m.Isolate
+	}
+	return nil
+}
+
+func (m *Config) GetCipd() *CipdSpec {
+	if m != nil {
+		return m.Cipd
+	}
+	return nil
+}
+
+func (m *Config) GetDimensions() map[string]string {
+	if m != nil {
+		return m.Dimensions
+	}
+	return nil
+}
+
+type Config_Swarming struct {
+	// url is the base url of the swarming service (e.g.
+	// "https://chromium-swarm.appspot.com").
+	Url string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+}
+
+func (m *Config_Swarming) Reset()                    { *m = Config_Swarming{} }
+func (m *Config_Swarming) String() string            { return proto.CompactTextString(m) }
+func (*Config_Swarming) ProtoMessage()               {}
+func (*Config_Swarming) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 0} }
+
+type Config_Isolate struct {
+	// url is the base url of the isolate service that task inputs are stored
+	// on (e.g. "https://isolateserver.appspot.com").
+	Url string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	// namespace is the isolate namespace of the task inputs. If omitted,
+	// "default-gzip" will be used.
+	Namespace string `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
+}
+
+func (m *Config_Isolate) Reset()                    { *m = Config_Isolate{} }
+func (m *Config_Isolate) String() string            { return proto.CompactTextString(m) }
+func (*Config_Isolate) ProtoMessage()               {}
+func (*Config_Isolate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 1} }
+
+// CipdPackage is a single CIPD package to install in a swarming task.
+type CipdPackage struct {
+	// name is the full name of the CIPD package. It may contain swarming
+	// template parameters like "${platform}".
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// version is the CIPD version (instance ID, ref or tag) of the package.
+	Version string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty"`
+	// path is the path, relative to the task's root directory, that the package
+	// will be installed in. If omitted, "." will be used.
+	Path string `protobuf:"bytes,3,opt,name=path" json:"path,omitempty"`
+}
+
+func (m *CipdPackage) Reset()                    { *m = CipdPackage{} }
+func (m *CipdPackage) String() string            { return proto.CompactTextString(m) }
+func (*CipdPackage) ProtoMessage()               {}
+func (*CipdPackage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+// CipdSpec describes the CIPD packages to install in a swarming task.
+type CipdSpec struct {
+	// server is the base url of the CIPD service. If omitted, swarming's
+	// default CIPD service will be used.
+	Server string `protobuf:"bytes,1,opt,name=server" json:"server,omitempty"`
+	// client is the CIPD client package that swarming will use to install the
+	// packages. If omitted, swarming's default CIPD client will be used.
+	Client *CipdPackage `protobuf:"bytes,2,opt,name=client" json:"client,omitempty"`
+	// packages are the CIPD packages to install.
+	Packages []*CipdPackage `protobuf:"bytes,3,rep,name=packages" json:"packages,omitempty"`
+}
+
+func (m *CipdSpec) Reset()                    { *m = CipdSpec{} }
+func (m *CipdSpec) String() string            { return proto.CompactTextString(m) }
+func (*CipdSpec) ProtoMessage()               {}
+func (*CipdSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *CipdSpec) GetClient() *CipdPackage {
+	if m != nil {
+		return m.Client
+	}
+	return nil
+}
+
+func (m *CipdSpec) GetPackages() []*CipdPackage {
+	if m != nil {
+		return m.Packages
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Config)(nil), "swarmingV1.Config")
+	proto.RegisterType((*Config_Swarming)(nil), "swarmingV1.Config.Swarming")
+	proto.RegisterType((*Config_Isolate)(nil), "swarmingV1.Config.Isolate")
+	proto.RegisterType((*CipdPackage)(nil), "swarmingV1.CipdPackage")
+	proto.RegisterType((*CipdSpec)(nil), "swarmingV1.CipdSpec")
+}
+
+func init() {
+	proto.RegisterFile("github.com/luci/luci-go/dm/api/distributor/swarming/v1/config.proto", fileDescriptor0)
+}
+
+var fileDescriptor0 = []byte{
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcb, 0x6e, 0xd4, 0x30,
+	0x18, 0x85, 0x95, 0x66, 0x3a, 0x97, 0x7f, 0x84, 0x5a, 0x59, 0x15, 0x44, 0x43, 0x17, 0x51, 0x56,
+	0x61, 0x41, 0xac, 0xb6, 0x48, 0x5c, 0x24, 0x36, 0x14, 0x16, 0xac, 0xa8, 0x5c, 0x89, 0x2d, 0xf2,
+	0x38, 0x6e, 0x6a, 0x4d, 0x12, 0x5b, 0xb6, 0x13, 0x94, 0x37, 0xe0, 0x51, 0x78, 0x4c, 0x14, 0xc7,
+	0x9e, 0x42, 0x19, 0x75, 0x33, 0xfa, 0x2f, 0xdf, 0x39, 0x63, 0x1f, 0x07, 0xae, 0x2b, 0x61, 0xef,
+	0xbb, 0x6d, 0xc1, 0x64, 0x83, 0xeb, 0x8e, 0x09, 0xf7, 0xf3, 0xba, 0x92, 0xb8, 0x6c, 0x30, 0x55,
+	0x02, 0x97, 0xc2, 0x58, 0x2d, 0xb6, 0x9d, 0x95, 0x1a, 0x9b, 0x9f, 0x54, 0x37, 0xa2, 0xad, 0x70,
+	0x7f, 0x81, 0x99, 0x6c, 0xef, 0x44, 0x55, 0x28, 0x2d, 0xad, 0x44, 0x10, 0x36, 0xdf, 0x2f, 0xb2,
+	0xdf, 0x31, 0xcc, 0xaf, 0xdd, 0x12, 0xbd, 0x85, 0x65, 0x58, 0x24, 0x51, 0x1a, 0xe5, 0xeb, 0xcb,
+	0x97, 0xc5, 0x03, 0x59, 0x4c, 0x54, 0x71, 0xeb, 0x27, 0x64, 0x0f, 0xa3, 0x37, 0xb0, 0x10, 0x46,
+	0xd6, 0xd4, 0xf2, 0xe4, 0xc8, 0xe9, 0x36, 0x07, 0x74, 0x5f, 0x27, 0x82, 0x04, 0x14, 0xe5, 0x30,
+	0x63, 0x42, 0x95, 0x49, 0xec, 0x24, 0x67, 0xff, 0x48, 0x84, 0x2a, 0x6f, 0x15, 0x67, 0xc4, 0x11,
+	0xe8, 0x13, 0x40, 0x29, 0x1a, 0xde, 0x1a, 0x21, 0x5b, 0x93, 0xcc, 0xd2, 0x38, 0x5f, 0x5f, 0x66,
+	0x07, 0xfe, 0xe2, 0xf3, 0x1e, 0xfa, 0xd2, 0x5a, 0x3d, 0x90, 0xbf, 0x54, 0xe8, 0x15, 0x9c, 0x96,
+	0xfc, 0x8e, 0x76, 0xb5, 0xfd, 0xa1, 0xb4, 0x90, 0x5a, 0xd8, 0x21, 0x39, 0x4e, 0xa3, 0xfc, 0x19,
+	0x39, 0xf1, 0xf3, 0x1b, 0x3f, 0xde, 0x9c, 0xc3, 0x32, 0x5c, 0x12, 0x9d, 0x42, 0xdc, 0xe9, 0xda,
+	0xc5, 0xb1, 0x22, 0x63, 0xb9, 0x79, 0x0f, 0x0b, 0x7f, 0x95, 0xff, 0x97, 0xe8, 0x1c, 0x56, 0x2d,
+	0x6d, 0xb8, 0x51, 0x94, 0x4d, 0x59, 0xac, 0xc8, 0xc3, 0x60, 0xf3, 0x11, 0x4e, 0x1e, 0x1d, 0x71,
+	0xb4, 0xd8, 0xf1, 0x21, 0x58, 0xec, 0xf8, 0x80, 0xce, 0xe0, 0xb8, 0xa7, 0x75, 0x17, 0xe4, 0x53,
+	0xf3, 0xe1, 0xe8, 0x5d, 0x94, 0x7d, 0x83, 0xf5, 0x18, 0xcc, 0x0d, 0x65, 0x3b, 0x5a, 0x71, 0x84,
+	0x60, 0x36, 0x5a, 0x7b, 0xad, 0xab, 0x51, 0x02, 0x8b, 0x9e, 0xeb, 0xd1, 0xdf, 0xcb, 0x43, 0x3b,
+	0xd2, 0x8a, 0xda, 0x7b, 0x97, 0xf6, 0x8a, 0xb8, 0x3a, 0xfb, 0x15, 0xc1, 0x32, 0x44, 0x8d, 0x9e,
+	0xc3, 0xdc, 0x70, 0xdd, 0x73, 0xed, 0x0d, 0x7d, 0x87, 0x30, 0xcc, 0x59, 0x2d, 0x78, 0x6b, 0xfd,
+	0xdb, 0xbe, 0x78, 0xfc, 0x50, 0xfe, 0x3c, 0xc4, 0x63, 0xe8, 0x0a, 0x96, 0x6a, 0x1a, 0x99, 0x24,
+	0x4e, 0xe3, 0xa7, 0x24, 0x7b, 0x70, 0x3b, 0x77, 0x5f, 0xe6, 0xd5, 0x9f, 0x01, 0x00, 0x52, 0x0e,
+	0x17, 0x1d, 0xe0, 0x02, 0x00, 0x00,
+}
@@ -0,0 +1,163 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package swarmingV1
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	// MaxPriority is the largest (least urgent) swarming task priority.
+	MaxPriority = 255
+
+	// ReservedEnvPrefix is the prefix of environment variables that the
+	// distributor sets for DM tasks. Quests may not set variables with this
+	// prefix.
+	ReservedEnvPrefix = "DM_"
+)
+
+var _ interface {
+	Normalize() error
+} = (*Config)(nil)
+
+// Normalize returns an error iff the Config is invalid.
+func (c *Config) Normalize() error {
+	if c.Swarming == nil {
+		return errors.New("swarming is required")
+	}
+	if err := normalizeURL(c.Swarming.Url); err != nil {
+		return fmt.Errorf("swarming.url: %s", err)
+	}
+	if c.Isolate != nil {
+		if err := normalizeURL(c.Isolate.Url); err != nil {
+			return fmt.Errorf("isolate.url: %s", err)
+		}
+	}
+	if err := c.Cipd.Normalize(); err != nil {
+		return fmt.Errorf("cipd: %s", err)
+	}
+	if err := normalizeDimensions(c.Dimensions); err != nil {
+		return err
+	}
+	if c.DefaultPriority > MaxPriority {
+		return fmt.Errorf("default_priority must be <= %d", MaxPriority)
+	}
+	return nil
+}
+
+// Normalize returns an error iff the CipdSpec is invalid.
+func (c *CipdSpec) Normalize() error {
+	if c == nil {
+		return nil
+	}
+	if c.Server != "" {
+		if err := normalizeURL(c.Server); err != nil {
+			return fmt.Errorf("server: %s", err)
+		}
+	}
+	if c.Client != nil {
+		if err := c.Client.Normalize(); err != nil {
+			return fmt.Errorf("client: %s", err)
+		}
+	}
+	for i, pkg := range c.Packages {
+		if err := pkg.Normalize(); err != nil {
+			return fmt.Errorf("packages[%d]: %s", i, err)
+		}
+	}
+	return nil
+}
+
+// Normalize returns an error iff the CipdPackage is invalid.
+func (p *CipdPackage) Normalize() error {
+	if p.Name == "" {
+		return errors.New("name is required")
+	}
+	if p.Version == "" {
+		return errors.New("version is required")
+	}
+	return nil
+}
+
+// Normalize returns an error iff the Parameters are invalid.
+func (p *Parameters) Normalize() error {
+	if s := p.Scheduling; s != nil {
+		if s.Priority > MaxPriority {
+			return fmt.Errorf("scheduling.priority must be <= %d", MaxPriority)
+		}
+		if err := normalizeDimensions(s.Dimensions); err != nil {
+			return fmt.Errorf("scheduling: %s", err)
+		}
+		if s.Expiration.Duration() < 0 {
+			return errors.New("scheduling.expiration may not be negative")
+		}
+		if s.IoTimeout.Duration() < 0 {
+			return errors.New("scheduling.io_timeout may not be negative")
+		}
+	}
+
+	j := p.Job
+	if j == nil {
+		return errors.New("job is required")
+	}
+	switch {
+	case j.Isolated == "" && len(j.Command) == 0:
+		return errors.New("job: one of isolated or command is required")
+	case j.Isolated == "" && len(j.ExtraArgs) > 0:
+		return errors.New("job: extra_args may only be used with isolated")
+	}
+	for k := range j.Env {
+		if k == "" {
+			return errors.New("job: empty env key")
+		}
+		if strings.HasPrefix(k, ReservedEnvPrefix) {
+			return fmt.Errorf("job: env key %q uses the reserved %q prefix", k, ReservedEnvPrefix)
+		}
+	}
+	for i, pkg := range j.CipdPackages {
+		if err := pkg.Normalize(); err != nil {
+			return fmt.Errorf("job.cipd_packages[%d]: %s", i, err)
+		}
+	}
+	if j.ExecutionTimeout.Duration() < 0 {
+		return errors.New("job.execution_timeout may not be negative")
+	}
+	if j.GracePeriod.Duration() < 0 {
+		return errors.New("job.grace_period may not be negative")
+	}
+	return nil
+}
+
+func normalizeURL(u string) error {
+	if u == "" {
+		return errors.New("empty url")
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return err
+	}
+	if !parsed.IsAbs() || parsed.Host == "" {
+		return fmt.Errorf("not an absolute url: %q", u)
+	}
+	if parsed.Path != "" && parsed.Path != "/" {
+		return fmt.Errorf("url may not have a path: %q", u)
+	}
+	return nil
+}
+
+func normalizeDimensions(dims map[string]string) error {
+	for k, v := range dims {
+		if k == "" {
+			return errors.New("empty dimension key")
+		}
+		if v == "" {
+			return fmt.Errorf("empty value for dimension %q", k)
+		}
+	}
+	return nil
+}
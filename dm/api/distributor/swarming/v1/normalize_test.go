@@ -0,0 +1,129 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package swarmingV1
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	Convey("Config", t, func() {
+		cfg := &Config{}
+		So(proto.UnmarshalText(`
+			swarming: < url: "https://swarming.example.com" >
+			isolate: < url: "https://isolate.example.com" >
+			cipd: <
+				client: < name: "infra/tools/cipd/${platform}" version: "latest" >
+				packages: < name: "infra/dm/runner" version: "stable" >
+			>
+			dimensions: < key: "pool" value: "dm" >
+		`, cfg), ShouldBeNil)
+
+		Convey("good", func() {
+			So(cfg.Normalize(), ShouldBeNil)
+		})
+
+		Convey("bad", func() {
+			Convey("no swarming", func() {
+				cfg.Swarming = nil
+				So(cfg.Normalize(), ShouldErrLike, "swarming is required")
+			})
+
+			Convey("relative swarming url", func() {
+				cfg.Swarming.Url = "swarming.example.com"
+				So(cfg.Normalize(), ShouldErrLike, "not an absolute url")
+			})
+
+			Convey("isolate url with path", func() {
+				cfg.Isolate.Url = "https://isolate.example.com/some/path"
+				So(cfg.Normalize(), ShouldErrLike, "may not have a path")
+			})
+
+			Convey("cipd package without version", func() {
+				cfg.Cipd.Packages[0].Version = ""
+				So(cfg.Normalize(), ShouldErrLike, "packages[0]: version is required")
+			})
+
+			Convey("empty dimension value", func() {
+				cfg.Dimensions["os"] = ""
+				So(cfg.Normalize(), ShouldErrLike, `empty value for dimension "os"`)
+			})
+
+			Convey("priority", func() {
+				cfg.DefaultPriority = 256
+				So(cfg.Normalize(), ShouldErrLike, "default_priority must be <= 255")
+			})
+		})
+	})
+
+	Convey("Parameters", t, func() {
+		p := &Parameters{}
+		So(jsonpb.UnmarshalString(`{
+			"scheduling": {
+				"priority": 50,
+				"dimensions": {"os": "Linux"},
+				"expiration": "600s"
+			},
+			"job": {
+				"isolated": "deadbeef",
+				"extraArgs": ["--verbose"],
+				"env": {"FOO": "bar"},
+				"cipdPackages": [{"name": "some/package", "version": "latest"}],
+				"executionTimeout": "3600s"
+			}
+		}`, p), ShouldBeNil)
+
+		Convey("good", func() {
+			So(p.Normalize(), ShouldBeNil)
+			So(p.Scheduling.Expiration.Seconds, ShouldEqual, 600)
+		})
+
+		Convey("bad", func() {
+			Convey("no job", func() {
+				p.Job = nil
+				So(p.Normalize(), ShouldErrLike, "job is required")
+			})
+
+			Convey("nothing to run", func() {
+				p.Job.Isolated = ""
+				p.Job.ExtraArgs = nil
+				So(p.Normalize(), ShouldErrLike, "one of isolated or command is required")
+			})
+
+			Convey("extra_args without isolated", func() {
+				p.Job.Isolated = ""
+				p.Job.Command = []string{"echo", "hi"}
+				So(p.Normalize(), ShouldErrLike, "extra_args may only be used with isolated")
+			})
+
+			Convey("priority", func() {
+				p.Scheduling.Priority = 300
+				So(p.Normalize(), ShouldErrLike, "scheduling.priority must be <= 255")
+			})
+
+			Convey("negative timeout", func() {
+				p.Job.ExecutionTimeout.Seconds = -1
+				So(p.Normalize(), ShouldErrLike, "execution_timeout may not be negative")
+			})
+
+			Convey("reserved env", func() {
+				p.Job.Env["DM_HOST"] = "evil.example.com"
+				So(p.Normalize(), ShouldErrLike, `env key "DM_HOST" uses the reserved "DM_" prefix`)
+			})
+
+			Convey("bad cipd package", func() {
+				p.Job.CipdPackages[0].Name = ""
+				So(p.Normalize(), ShouldErrLike, "cipd_packages[0]: name is required")
+			})
+		})
+	})
+}
@@ -0,0 +1,211 @@
+// Code generated by protoc-gen-go.
+// source: github.com/luci/luci-go/dm/api/distributor/swarming/v1/params.proto
+// DO NOT EDIT!
+
+package swarmingV1
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import google_protobuf "github.com/luci/luci-go/common/proto/google"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Parameters are the quest parameters for the swarming_v1 distributor. They
+// are the JSONPB encoding of this message, and are stored in the quest's
+// Quest_Desc.parameters field.
+type Parameters struct {
+	Scheduling *Parameters_Scheduling `protobuf:"bytes,1,opt,name=scheduling" json:"scheduling,omitempty"`
+	Job        *Parameters_Job        `protobuf:"bytes,2,opt,name=job" json:"job,omitempty"`
+	Meta       *Parameters_Meta       `protobuf:"bytes,3,opt,name=meta" json:"meta,omitempty"`
+}
+
+func (m *Parameters) Reset()                    { *m = Parameters{} }
+func (m *Parameters) String() string            { return proto.CompactTextString(m) }
+func (*Parameters) ProtoMessage()               {}
+func (*Parameters) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+
+func (m *Parameters) GetScheduling() *Parameters_Scheduling {
+	if m != nil {
+		return m.Scheduling
+	}
+	return nil
+}
+
+func (m *Parameters) GetJob() *Parameters_Job {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *Parameters) GetMeta() *Parameters_Meta {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+type Parameters_Scheduling struct {
+	// priority is the swarming priority of the task. Lower values are more
+	// urgent. If omitted, the distributor's default_priority will be used.
+	Priority uint32 `protobuf:"varint,1,opt,name=priority" json:"priority,omitempty"`
+	// dimensions are swarming dimensions that the task requires, in addition
+	// to the distributor's dimensions. They may not override the
+	// distributor's dimensions.
+	Dimensions map[string]string `protobuf:"bytes,2,rep,name=dimensions" json:"dimensions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// expiration is the amount of time that the task may wait for a bot
+	// before it expires. If omitted, one hour will be used.
+	Expiration *google_protobuf.Duration `protobuf:"bytes,3,opt,name=expiration" json:"expiration,omitempty"`
+	// io_timeout is the amount of time that the task may go without
+	// producing output before it's killed. If omitted, swarming's default
+	// will be used.
+	IoTimeout *google_protobuf.Duration `protobuf:"bytes,4,opt,name=io_timeout,json=ioTimeout" json:"io_timeout,omitempty"`
+}
+
+func (m *Parameters_Scheduling) Reset()                    { *m = Parameters_Scheduling{} }
+func (m *Parameters_Scheduling) String() string            { return proto.CompactTextString(m) }
+func (*Parameters_Scheduling) ProtoMessage()               {}
+func (*Parameters_Scheduling) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0, 0} }
+
+func (m *Parameters_Scheduling) GetDimensions() map[string]string {
+	if m != nil {
+		return m.Dimensions
+	}
+	return nil
+}
+
+func (m *Parameters_Scheduling) GetExpiration() *google_protobuf.Duration {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *Parameters_Scheduling) GetIoTimeout() *google_protobuf.Duration {
+	if m != nil {
+		return m.IoTimeout
+	}
+	return nil
+}
+
+type Parameters_Job struct {
+	// isolated is the hash of the isolated on the distributor's isolate
+	// service which contains the task's inputs.
+	Isolated string `protobuf:"bytes,1,opt,name=isolated" json:"isolated,omitempty"`
+	// command is the command line to run. If omitted, the isolated's command
+	// will be used. At least one of isolated or command must be specified.
+	Command []string `protobuf:"bytes,2,rep,name=command" json:"command,omitempty"`
+	// extra_args are appended to the isolated's command. They may only be
+	// specified along with isolated.
+	ExtraArgs []string `protobuf:"bytes,3,rep,name=extra_args,json=extraArgs" json:"extra_args,omitempty"`
+	// env is additional environment variables for the task. Variables with the
+	// "DM_" prefix are reserved; the distributor sets DM_HOST,
+	// DM_EXECUTION_AUTH and DM_PREVIOUS_RESULT so that the task can call back
+	// into DM.
+	Env map[string]string `protobuf:"bytes,4,rep,name=env" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// cipd_packages are CIPD packages to install, in addition to the
+	// distributor's packages.
+	CipdPackages []*CipdPackage `protobuf:"bytes,5,rep,name=cipd_packages,json=cipdPackages" json:"cipd_packages,omitempty"`
+	// execution_timeout is the amount of time that the task may run for once
+	// it has started. If omitted, one hour will be used.
+	ExecutionTimeout *google_protobuf.Duration `protobuf:"bytes,6,opt,name=execution_timeout,json=executionTimeout" json:"execution_timeout,omitempty"`
+	// grace_period is the amount of time that the task has to clean up after
+	// it's been signalled to stop. If omitted, swarming's default will be used.
+	GracePeriod *google_protobuf.Duration `protobuf:"bytes,7,opt,name=grace_period,json=gracePeriod" json:"grace_period,omitempty"`
+}
+
+func (m *Parameters_Job) Reset()                    { *m = Parameters_Job{} }
+func (m *Parameters_Job) String() string            { return proto.CompactTextString(m) }
+func (*Parameters_Job) ProtoMessage()               {}
+func (*Parameters_Job) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0, 1} }
+
+func (m *Parameters_Job) GetEnv() map[string]string {
+	if m != nil {
+		return m.Env
+	}
+	return nil
+}
+
+func (m *Parameters_Job) GetCipdPackages() []*CipdPackage {
+	if m != nil {
+		return m.CipdPackages
+	}
+	return nil
+}
+
+func (m *Parameters_Job) GetExecutionTimeout() *google_protobuf.Duration {
+	if m != nil {
+		return m.ExecutionTimeout
+	}
+	return nil
+}
+
+func (m *Parameters_Job) GetGracePeriod() *google_protobuf.Duration {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return nil
+}
+
+type Parameters_Meta struct {
+	// name_prefix is prepended to the swarming task's name to make it easier
+	// to identify in the swarming UI.
+	NamePrefix string `protobuf:"bytes,1,opt,name=name_prefix,json=namePrefix" json:"name_prefix,omitempty"`
+}
+
+func (m *Parameters_Meta) Reset()                    { *m = Parameters_Meta{} }
+func (m *Parameters_Meta) String() string            { return proto.CompactTextString(m) }
+func (*Parameters_Meta) ProtoMessage()               {}
+func (*Parameters_Meta) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0, 2} }
+
+func init() {
+	proto.RegisterType((*Parameters)(nil), "swarmingV1.Parameters")
+	proto.RegisterType((*Parameters_Scheduling)(nil), "swarmingV1.Parameters.Scheduling")
+	proto.RegisterType((*Parameters_Job)(nil), "swarmingV1.Parameters.Job")
+	proto.RegisterType((*Parameters_Meta)(nil), "swarmingV1.Parameters.Meta")
+}
+
+func init() {
+	proto.RegisterFile("github.com/luci/luci-go/dm/api/distributor/swarming/v1/params.proto", fileDescriptor1)
+}
+
+var fileDescriptor1 = []byte{
+	// 528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xd5, 0x38, 0x6d, 0x9a, 0x49, 0x2b, 0xca, 0x0a, 0x09, 0x63, 0x04, 0x14, 0x38, 0xd0,
+	0x03, 0xd8, 0x4a, 0x11, 0xa8, 0xa0, 0x72, 0xa8, 0xda, 0x72, 0xa8, 0x84, 0x14, 0x0c, 0xe2, 0x1a,
+	0xad, 0xed, 0xcd, 0x76, 0x68, 0xbc, 0x63, 0xed, 0xae, 0x43, 0xfa, 0x10, 0x3c, 0x00, 0x4f, 0xc1,
+	0x2b, 0x22, 0xaf, 0x1d, 0x27, 0x42, 0x8a, 0x22, 0xb8, 0x44, 0x99, 0x99, 0xef, 0x9f, 0x9d, 0xf9,
+	0xc7, 0x70, 0x2e, 0xd1, 0x5e, 0x97, 0x49, 0x98, 0x52, 0x1e, 0x4d, 0xcb, 0x14, 0xdd, 0xcf, 0x2b,
+	0x49, 0x51, 0x96, 0x47, 0xbc, 0xc0, 0x28, 0x43, 0x63, 0x35, 0x26, 0xa5, 0x25, 0x1d, 0x99, 0x1f,
+	0x5c, 0xe7, 0xa8, 0x64, 0x34, 0x1b, 0x46, 0x05, 0xd7, 0x3c, 0x37, 0x61, 0xa1, 0xc9, 0x12, 0x83,
+	0x45, 0xe5, 0xdb, 0x30, 0x78, 0x2c, 0x89, 0xe4, 0x54, 0x44, 0xae, 0x92, 0x94, 0x93, 0x28, 0x2b,
+	0x35, 0xb7, 0x48, 0xaa, 0x66, 0x83, 0xff, 0x7d, 0x30, 0x25, 0x35, 0x41, 0x59, 0x37, 0x79, 0xf6,
+	0xab, 0x07, 0x30, 0xaa, 0x26, 0x10, 0x56, 0x68, 0xc3, 0xce, 0x00, 0x4c, 0x7a, 0x2d, 0xb2, 0x72,
+	0x8a, 0x4a, 0xfa, 0x5b, 0x87, 0x5b, 0x47, 0x83, 0xe3, 0xa7, 0xe1, 0x72, 0xa8, 0x70, 0xc9, 0x86,
+	0x5f, 0x5a, 0x30, 0x5e, 0x11, 0xb1, 0x97, 0xe0, 0x7d, 0xa7, 0xc4, 0xef, 0x38, 0x6d, 0xb0, 0x46,
+	0x7b, 0x45, 0x49, 0x5c, 0x61, 0x2c, 0x82, 0x6e, 0x2e, 0x2c, 0xf7, 0x3d, 0x87, 0x3f, 0x5c, 0x83,
+	0x7f, 0x12, 0x96, 0xc7, 0x0e, 0x0c, 0x7e, 0x77, 0x00, 0x96, 0x2f, 0xb3, 0x00, 0x76, 0x0b, 0x8d,
+	0xa4, 0xd1, 0xde, 0xba, 0x71, 0xf7, 0xe3, 0x36, 0x66, 0x9f, 0x01, 0x32, 0xcc, 0x85, 0x32, 0x48,
+	0xca, 0xf8, 0x9d, 0x43, 0xef, 0x68, 0x70, 0x3c, 0xdc, 0xb8, 0x4c, 0x78, 0xd1, 0x6a, 0x2e, 0x95,
+	0xd5, 0xb7, 0xf1, 0x4a, 0x13, 0xf6, 0x0e, 0x40, 0xcc, 0x0b, 0xac, 0xef, 0xd0, 0x0c, 0xfd, 0x20,
+	0xac, 0x0f, 0x15, 0x2e, 0x0e, 0x15, 0x5e, 0x34, 0x87, 0x8a, 0x57, 0x60, 0x76, 0x02, 0x80, 0x34,
+	0xb6, 0x98, 0x0b, 0x2a, 0xad, 0xdf, 0xdd, 0x24, 0xed, 0x23, 0x7d, 0xad, 0xd9, 0xe0, 0x03, 0xdc,
+	0xf9, 0x6b, 0x26, 0x76, 0x00, 0xde, 0x8d, 0xa8, 0x37, 0xee, 0xc7, 0xd5, 0x5f, 0x76, 0x0f, 0xb6,
+	0x67, 0x7c, 0x5a, 0x0a, 0x67, 0x7c, 0x3f, 0xae, 0x83, 0xf7, 0x9d, 0x93, 0xad, 0xe0, 0xa7, 0x07,
+	0xde, 0x15, 0x25, 0x95, 0x55, 0x68, 0x68, 0xca, 0xad, 0xc8, 0x1a, 0x61, 0x1b, 0x33, 0x1f, 0x7a,
+	0x29, 0xe5, 0x39, 0x57, 0x99, 0xf3, 0xa9, 0x1f, 0x2f, 0x42, 0xf6, 0xa8, 0xda, 0xd8, 0x6a, 0x3e,
+	0xe6, 0x5a, 0x1a, 0xdf, 0x73, 0xc5, 0xbe, 0xcb, 0x9c, 0x69, 0x69, 0xd8, 0x1b, 0xf0, 0x84, 0x9a,
+	0xf9, 0x5d, 0x67, 0xee, 0xf3, 0xf5, 0xd7, 0x0e, 0x2f, 0xd5, 0xac, 0xb6, 0xb3, 0xe2, 0xd9, 0x29,
+	0xec, 0xa7, 0x58, 0x64, 0xe3, 0x82, 0xa7, 0x37, 0x5c, 0x0a, 0xe3, 0x6f, 0xbb, 0x06, 0xf7, 0x57,
+	0x1b, 0x9c, 0x63, 0x91, 0x8d, 0xea, 0x7a, 0xbc, 0x97, 0x2e, 0x03, 0xc3, 0x3e, 0xc2, 0x5d, 0x31,
+	0x17, 0x69, 0x59, 0x19, 0xd5, 0x3a, 0xba, 0xb3, 0xc9, 0xd1, 0x83, 0x56, 0xd3, 0x18, 0xcb, 0x4e,
+	0x61, 0x4f, 0x6a, 0x9e, 0x8a, 0x71, 0x21, 0x34, 0x52, 0xe6, 0xf7, 0x36, 0xb5, 0x18, 0x38, 0x7c,
+	0xe4, 0xe8, 0xe0, 0x2d, 0xec, 0x2e, 0x96, 0xfa, 0xa7, 0x7b, 0xbc, 0x80, 0x6e, 0xf5, 0x3d, 0xb3,
+	0x27, 0x30, 0x50, 0x3c, 0x17, 0xe3, 0x42, 0x8b, 0x09, 0xce, 0x1b, 0x2d, 0x54, 0xa9, 0x91, 0xcb,
+	0x24, 0x3b, 0x6e, 0x80, 0xd7, 0x7f, 0x06, 0x00, 0xc8, 0x96, 0x2c, 0x98, 0x5a, 0x04, 0x00, 0x00,
+}
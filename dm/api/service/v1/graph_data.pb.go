@@ -75,6 +75,8 @@ const (
 	// Executions: the distributor refused to run this job.
 	//
 	// Attempts: the last Execution had a REJECTED Status.
+	//
+	// Retryable.
 	AbnormalFinish_REJECTED AbnormalFinish_Status = 6
 	// The job is unrecognized.
 	//
@@ -373,6 +375,16 @@ type Quest_Desc_Meta_Retry struct {
 	// The number of times in a row to retry Executions which have an
 	// ABNORMAL_FINISHED status of TIMED_OUT.
 	TimedOut uint32 `protobuf:"varint,4,opt,name=timed_out,json=timedOut" json:"timed_out,omitempty"`
+	// The number of times in a row to retry Executions which have an
+	// ABNORMAL_FINISHED status of REJECTED.
+	Rejected uint32 `protobuf:"varint,6,opt,name=rejected" json:"rejected,omitempty"`
+	// The amount of time to wait before re-Executing an Attempt after its
+	// first abnormal Execution. This doubles for each further retry in a
+	// row, up to max_backoff. If unset or 0, DM re-Executes immediately.
+	Backoff *google_protobuf.Duration `protobuf:"bytes,16,opt,name=backoff" json:"backoff,omitempty"`
+	// The upper bound for the delay between retries. If unset or 0, the
+	// delay is unbounded.
+	MaxBackoff *google_protobuf.Duration `protobuf:"bytes,17,opt,name=max_backoff,json=maxBackoff" json:"max_backoff,omitempty"`
 }
 
 func (m *Quest_Desc_Meta_Retry) Reset()                    { *m = Quest_Desc_Meta_Retry{} }
@@ -380,6 +392,20 @@ func (m *Quest_Desc_Meta_Retry) String() string            { return proto.Compac
 func (*Quest_Desc_Meta_Retry) ProtoMessage()               {}
 func (*Quest_Desc_Meta_Retry) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1, 1, 0, 0} }
 
+func (m *Quest_Desc_Meta_Retry) GetBackoff() *google_protobuf.Duration {
+	if m != nil {
+		return m.Backoff
+	}
+	return nil
+}
+
+func (m *Quest_Desc_Meta_Retry) GetMaxBackoff() *google_protobuf.Duration {
+	if m != nil {
+		return m.MaxBackoff
+	}
+	return nil
+}
+
 // Timing describes the amount of time that Executions for this Quest
 // should have, on the following timeline:
 //   Event: execution sent to distributor
@@ -606,6 +632,9 @@ type Attempt_Data struct {
 	Created       *google_protobuf1.Timestamp `protobuf:"bytes,1,opt,name=created" json:"created,omitempty"`
 	Modified      *google_protobuf1.Timestamp `protobuf:"bytes,2,opt,name=modified" json:"modified,omitempty"`
 	NumExecutions uint32                      `protobuf:"varint,3,opt,name=num_executions,json=numExecutions" json:"num_executions,omitempty"`
+	// The number of times in a row that this Attempt has been re-Executed due
+	// to an abnormal result. See Retries.
+	Retries *Attempt_Data_Retries `protobuf:"bytes,4,opt,name=retries" json:"retries,omitempty"`
 	// Types that are valid to be assigned to AttemptType:
 	//	*Attempt_Data_Scheduling_
 	//	*Attempt_Data_Executing_
@@ -667,6 +696,13 @@ func (m *Attempt_Data) GetModified() *google_protobuf1.Timestamp {
 	return nil
 }
 
+func (m *Attempt_Data) GetRetries() *Attempt_Data_Retries {
+	if m != nil {
+		return m.Retries
+	}
+	return nil
+}
+
 func (m *Attempt_Data) GetScheduling() *Attempt_Data_Scheduling {
 	if x, ok := m.GetAttemptType().(*Attempt_Data_Scheduling_); ok {
 		return x.Scheduling
@@ -887,6 +923,26 @@ func (m *Attempt_Data_Finished) GetData() *JsonResult {
 	return nil
 }
 
+// Retries holds the number of times in a row that this Attempt has been
+// re-Executed due to an abnormal result, broken down by the Execution's
+// AbnormalFinish.Status. These are reset when an Execution finishes
+// normally.
+//
+// NOTE: The proto tag numbers for these MUST be aligned with the
+// enumeration values of AbnormalFinish.Status!
+type Attempt_Data_Retries struct {
+	Failed   uint32 `protobuf:"varint,1,opt,name=failed" json:"failed,omitempty"`
+	Crashed  uint32 `protobuf:"varint,2,opt,name=crashed" json:"crashed,omitempty"`
+	Expired  uint32 `protobuf:"varint,3,opt,name=expired" json:"expired,omitempty"`
+	TimedOut uint32 `protobuf:"varint,4,opt,name=timed_out,json=timedOut" json:"timed_out,omitempty"`
+	Rejected uint32 `protobuf:"varint,6,opt,name=rejected" json:"rejected,omitempty"`
+}
+
+func (m *Attempt_Data_Retries) Reset()                    { *m = Attempt_Data_Retries{} }
+func (m *Attempt_Data_Retries) String() string            { return proto.CompactTextString(m) }
+func (*Attempt_Data_Retries) ProtoMessage()               {}
+func (*Attempt_Data_Retries) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4, 1, 4} }
+
 type Attempt_Partial struct {
 	// Data is true iff the AttemptData should have been filled, but wasn't
 	Data bool `protobuf:"varint,1,opt,name=data" json:"data,omitempty"`
@@ -1321,6 +1377,7 @@ func init() {
 	proto.RegisterType((*Attempt_Data_Executing)(nil), "dm.Attempt.Data.Executing")
 	proto.RegisterType((*Attempt_Data_Waiting)(nil), "dm.Attempt.Data.Waiting")
 	proto.RegisterType((*Attempt_Data_Finished)(nil), "dm.Attempt.Data.Finished")
+	proto.RegisterType((*Attempt_Data_Retries)(nil), "dm.Attempt.Data.Retries")
 	proto.RegisterType((*Attempt_Partial)(nil), "dm.Attempt.Partial")
 	proto.RegisterType((*Execution)(nil), "dm.Execution")
 	proto.RegisterType((*Execution_Auth)(nil), "dm.Execution.Auth")
@@ -1343,114 +1400,121 @@ func init() {
 }
 
 var fileDescriptor3 = []byte{
-	// 1742 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xc4, 0x58, 0x49, 0x73, 0xdb, 0x56,
-	0x12, 0x36, 0x77, 0xb0, 0x25, 0x51, 0x9c, 0xe7, 0x8d, 0x86, 0xbc, 0x0d, 0x67, 0x73, 0x79, 0xc6,
-	0x64, 0xd9, 0x1e, 0x7b, 0x3c, 0x76, 0x25, 0x29, 0x58, 0x84, 0x2c, 0xb8, 0x48, 0x4a, 0x01, 0x29,
-	0xdb, 0x95, 0x0b, 0x0a, 0x04, 0x40, 0x09, 0x31, 0x49, 0x30, 0x58, 0xe4, 0x28, 0xb7, 0x5c, 0x73,
-	0xcd, 0x21, 0x97, 0xfc, 0x85, 0xfc, 0x85, 0xfc, 0x92, 0xe4, 0x9e, 0xca, 0x21, 0xb7, 0x54, 0xe5,
-	0x98, 0x7e, 0x0b, 0x16, 0x52, 0x4b, 0xc9, 0x95, 0x43, 0x2e, 0x2a, 0xf4, 0xeb, 0xaf, 0xdf, 0xf2,
-	0xbd, 0xee, 0xef, 0x35, 0x05, 0x4f, 0xf7, 0xdd, 0xf0, 0x20, 0x1a, 0xb5, 0x2c, 0x6f, 0xda, 0x9e,
-	0x44, 0x96, 0xcb, 0xfe, 0xdc, 0xdb, 0xf7, 0xda, 0xf6, 0xb4, 0x6d, 0xce, 0xdd, 0x76, 0xe0, 0xf8,
-	0x87, 0xae, 0xe5, 0xb4, 0x0f, 0xef, 0xb7, 0xf7, 0x7d, 0x73, 0x7e, 0x60, 0xd8, 0x66, 0x68, 0xb6,
-	0xe6, 0xbe, 0x17, 0x7a, 0x24, 0x6f, 0x4f, 0xe5, 0x9b, 0xfb, 0x9e, 0xb7, 0x3f, 0x71, 0xda, 0x6c,
-	0x64, 0x14, 0x8d, 0xdb, 0x76, 0xe4, 0x9b, 0xa1, 0xeb, 0xcd, 0x38, 0x46, 0xbe, 0xb5, 0xec, 0x0f,
-	0xdd, 0xa9, 0x13, 0x84, 0xe6, 0x74, 0x2e, 0x00, 0x8f, 0xce, 0xbf, 0x81, 0xf0, 0x68, 0xee, 0x04,
-	0x3c, 0xac, 0xf9, 0x53, 0x0e, 0x6a, 0xca, 0x68, 0xe6, 0xf9, 0x53, 0x73, 0xb2, 0xe5, 0xce, 0xdc,
-	0xe0, 0x80, 0xdc, 0x87, 0x32, 0x4e, 0x1c, 0x46, 0x41, 0x23, 0x77, 0x3b, 0x77, 0xa7, 0xf6, 0xe0,
-	0x5a, 0xcb, 0x9e, 0xb6, 0x16, 0x31, 0xad, 0x01, 0x03, 0xe8, 0x02, 0x48, 0xae, 0x40, 0xd9, 0x77,
-	0xcc, 0xc0, 0x9b, 0x35, 0xf2, 0x18, 0x52, 0xd5, 0x85, 0xd5, 0xfc, 0x2a, 0x07, 0x65, 0x0e, 0x25,
-	0x2b, 0x50, 0xd1, 0xfa, 0xaf, 0x94, 0xae, 0xd6, 0xa9, 0x5f, 0x20, 0x00, 0xe5, 0x2d, 0x45, 0xeb,
-	0xaa, 0x9d, 0x7a, 0x8e, 0x3a, 0x36, 0x75, 0x65, 0xb0, 0x8d, 0x46, 0x9e, 0x1a, 0xea, 0x9b, 0x5d,
-	0x4d, 0x47, 0xa3, 0x40, 0xd6, 0xa0, 0x3a, 0xd4, 0x7a, 0x6a, 0xc7, 0xd8, 0xd9, 0x1b, 0xd6, 0x8b,
-	0xd4, 0xdc, 0x54, 0xfa, 0x9b, 0x6a, 0x97, 0xc6, 0x95, 0xc8, 0x2a, 0x48, 0xba, 0xfa, 0x52, 0xdd,
-	0x1c, 0xa2, 0x55, 0xa6, 0x81, 0x3d, 0x6d, 0x30, 0xd0, 0xfa, 0x2f, 0xea, 0x15, 0x72, 0x09, 0xea,
-	0xba, 0x3a, 0xd8, 0xeb, 0x0e, 0x8d, 0x9e, 0xd2, 0xdd, 0xda, 0xd1, 0x71, 0x8e, 0xba, 0xd4, 0xfc,
-	0x59, 0x82, 0xd2, 0xc7, 0x11, 0x92, 0x46, 0xae, 0x43, 0xde, 0xb5, 0xd9, 0xe9, 0x56, 0x1e, 0xac,
-	0xd2, 0xd3, 0xb1, 0xe1, 0x96, 0xd6, 0xd1, 0x71, 0x9c, 0xd4, 0xa1, 0xd0, 0xe9, 0xab, 0xec, 0x24,
-	0x92, 0x5e, 0xb0, 0xfb, 0x2a, 0x69, 0x42, 0x91, 0x5e, 0x57, 0xa3, 0xc0, 0x22, 0x6a, 0x69, 0x44,
-	0x07, 0x47, 0x75, 0xe6, 0x23, 0x0f, 0x41, 0x32, 0xc3, 0xd0, 0x99, 0xce, 0xc3, 0xa0, 0x51, 0xbc,
-	0x5d, 0x40, 0xdc, 0xd5, 0x14, 0xa7, 0x08, 0x8f, 0x3a, 0x0b, 0xfd, 0x23, 0x3d, 0x01, 0x92, 0x06,
-	0x54, 0xe6, 0xa6, 0x1f, 0xba, 0xe6, 0xa4, 0x51, 0x67, 0xcb, 0xc5, 0xa6, 0x7c, 0x09, 0xf2, 0x5a,
-	0x87, 0xd4, 0x92, 0x8d, 0x56, 0xe9, 0xd6, 0xe4, 0x1f, 0x8b, 0x50, 0xec, 0x38, 0x81, 0x45, 0x1e,
-	0xc3, 0x55, 0xdb, 0x0d, 0x42, 0xdf, 0x1d, 0x45, 0xa1, 0xe7, 0x1b, 0x96, 0x37, 0x1b, 0xbb, 0xfb,
-	0xc6, 0xcc, 0x9c, 0x3a, 0x02, 0x7d, 0x39, 0xe3, 0xde, 0x64, 0xde, 0x3e, 0x3a, 0xc9, 0x4d, 0x00,
-	0x5c, 0x01, 0xbf, 0x42, 0xc7, 0x0f, 0xc4, 0x65, 0x65, 0x46, 0xc8, 0x23, 0xb8, 0x92, 0x9d, 0x37,
-	0x83, 0x2d, 0x1c, 0x9b, 0x76, 0x37, 0x0d, 0xfb, 0x17, 0x14, 0xf1, 0xcb, 0xc4, 0x83, 0x53, 0x82,
-	0x2e, 0x66, 0x08, 0xc2, 0xcd, 0xb6, 0x7a, 0x0e, 0x65, 0x89, 0x02, 0xe4, 0x6f, 0x0b, 0x50, 0xa4,
-	0x26, 0xb9, 0x01, 0x60, 0x06, 0x86, 0x69, 0x59, 0x5e, 0x34, 0x0b, 0xc5, 0x9e, 0xab, 0x66, 0xa0,
-	0xf0, 0x01, 0xd2, 0x86, 0x92, 0xef, 0x20, 0x57, 0x6c, 0x8b, 0x2b, 0x3c, 0x05, 0x97, 0x66, 0x6c,
-	0xe9, 0x14, 0xa0, 0x73, 0x1c, 0x79, 0x02, 0x12, 0xad, 0x08, 0x2f, 0x0a, 0x03, 0x71, 0x4d, 0xd7,
-	0x4f, 0x8a, 0x19, 0x0a, 0x8c, 0x9e, 0xa0, 0xe5, 0x39, 0x94, 0xd8, 0x4c, 0x34, 0x89, 0xc7, 0xa6,
-	0x3b, 0x71, 0x38, 0xe1, 0x6b, 0xba, 0xb0, 0xe8, 0x25, 0x59, 0xbe, 0x19, 0x1c, 0xa0, 0x23, 0xcf,
-	0x1c, 0xb1, 0x49, 0x3d, 0xce, 0xe7, 0x73, 0xd7, 0x47, 0x4f, 0x81, 0x7b, 0x84, 0x49, 0x36, 0xa0,
-	0x4a, 0x17, 0xb0, 0x0d, 0x5c, 0x82, 0xb1, 0xb2, 0xc6, 0x57, 0xb4, 0x77, 0xa2, 0x50, 0xfe, 0x26,
-	0x07, 0x52, 0xbc, 0x11, 0x7a, 0x52, 0x2c, 0x22, 0x3f, 0x14, 0xe9, 0x78, 0xad, 0xc5, 0x0b, 0xbd,
-	0x15, 0x17, 0x7a, 0xab, 0x23, 0x84, 0x40, 0xe7, 0x38, 0xf2, 0x6f, 0x28, 0xf8, 0xd1, 0x2c, 0x21,
-	0xe6, 0x54, 0x38, 0x45, 0x91, 0x7b, 0x50, 0x0c, 0x42, 0x6f, 0x2e, 0x28, 0x39, 0x03, 0xcd, 0x60,
-	0xf2, 0x01, 0xac, 0x0e, 0x31, 0x33, 0x27, 0x66, 0xe8, 0x0c, 0xe6, 0x8e, 0xc5, 0xf2, 0xd3, 0xf7,
-	0x3e, 0x75, 0xac, 0xf8, 0x8a, 0x62, 0x93, 0x16, 0x89, 0xef, 0x8c, 0x45, 0x06, 0xd1, 0x4f, 0x8a,
-	0x3d, 0xc4, 0x5c, 0xc0, 0xc9, 0x44, 0xae, 0xc4, 0x26, 0x21, 0x50, 0x64, 0x99, 0x59, 0x64, 0xc3,
-	0xec, 0x5b, 0xfe, 0x3a, 0x87, 0x99, 0x4c, 0xeb, 0xe6, 0xbf, 0x94, 0x5d, 0x07, 0x17, 0x8c, 0x0b,
-	0x52, 0x3e, 0xb6, 0xc9, 0x61, 0x2c, 0x75, 0x7a, 0x0c, 0x65, 0x15, 0x89, 0x97, 0x2a, 0x58, 0xa8,
-	0x2d, 0x5e, 0xb5, 0xce, 0x7c, 0xa8, 0x63, 0xd2, 0x28, 0x72, 0x27, 0xa1, 0x31, 0x3a, 0xc2, 0x1d,
-	0xd1, 0x8a, 0xbc, 0x92, 0xe2, 0xb2, 0xc7, 0xd4, 0x2b, 0x0c, 0xf7, 0xfc, 0x48, 0xde, 0x86, 0xb5,
-	0x85, 0x52, 0xa5, 0xc7, 0x7c, 0xeb, 0x1c, 0x89, 0x84, 0xa0, 0x9f, 0xe4, 0xaf, 0x50, 0x3a, 0x34,
-	0x27, 0x91, 0x23, 0x96, 0x5e, 0x61, 0xe2, 0xc8, 0x63, 0x74, 0xee, 0x79, 0x9a, 0x7f, 0x92, 0x6b,
-	0x86, 0x00, 0x2f, 0x51, 0x01, 0x75, 0x27, 0x88, 0x26, 0x21, 0x4d, 0x2d, 0x6f, 0x94, 0xa1, 0x51,
-	0x58, 0x94, 0x99, 0xc0, 0xfd, 0xc2, 0x11, 0x79, 0xc5, 0xbe, 0xc9, 0x53, 0x00, 0x96, 0x45, 0xec,
-	0x5e, 0xc4, 0xc5, 0x9d, 0xc5, 0x49, 0x06, 0xdd, 0x74, 0xa1, 0x2c, 0x56, 0x8c, 0x25, 0x2b, 0x97,
-	0x12, 0x94, 0xee, 0x47, 0x48, 0xd6, 0x33, 0x58, 0x37, 0x85, 0xac, 0x1b, 0x63, 0xa6, 0xeb, 0xe2,
-	0x50, 0xe4, 0xb8, 0xe2, 0xeb, 0x35, 0x73, 0xc1, 0x6e, 0xfe, 0x02, 0x50, 0x11, 0xe7, 0x46, 0x55,
-	0x49, 0xf5, 0xb4, 0x96, 0x21, 0xe4, 0x74, 0x45, 0xfd, 0xfb, 0x82, 0xa2, 0xd6, 0xb3, 0x31, 0x19,
-	0x4d, 0x7d, 0x46, 0xa9, 0x70, 0xac, 0x88, 0x9e, 0x2d, 0x56, 0xd5, 0x8d, 0x2c, 0x56, 0x4d, 0xbc,
-	0x5c, 0x59, 0x33, 0x70, 0x72, 0x17, 0xa4, 0xf1, 0x3b, 0xdb, 0xb0, 0x9d, 0x79, 0xd0, 0x28, 0xb1,
-	0x65, 0xd6, 0x33, 0xa1, 0x5d, 0xd4, 0x31, 0xbd, 0x82, 0x80, 0x0e, 0xfa, 0xc9, 0x7f, 0xa0, 0x3a,
-	0x32, 0xad, 0xb7, 0x1c, 0x5c, 0x3e, 0x19, 0x2c, 0x51, 0x04, 0x43, 0xdf, 0x5b, 0x54, 0x6d, 0x21,
-	0x78, 0xf1, 0x9e, 0x76, 0xb9, 0x2b, 0x95, 0xf2, 0xbb, 0x4c, 0xca, 0x2f, 0x41, 0xe9, 0x33, 0x9a,
-	0x79, 0x22, 0x03, 0xb8, 0x21, 0x04, 0x9e, 0x5f, 0x3f, 0x15, 0xf8, 0x5f, 0x8b, 0x7f, 0xa8, 0x2c,
-	0x1e, 0x83, 0x34, 0xf5, 0x6c, 0x77, 0xec, 0x0a, 0xad, 0x3a, 0x3b, 0x2c, 0xc1, 0x92, 0x7f, 0x40,
-	0x6d, 0x16, 0x4d, 0x8d, 0x0c, 0xd9, 0x5c, 0xcf, 0xd6, 0x70, 0x34, 0xe5, 0x98, 0x7c, 0x00, 0x10,
-	0x58, 0x28, 0x7c, 0xd1, 0xc4, 0x9d, 0xed, 0x0b, 0x52, 0x37, 0x96, 0xef, 0xae, 0x35, 0x48, 0x20,
-	0xdb, 0x17, 0xf4, 0x4c, 0x00, 0x66, 0x76, 0x55, 0xac, 0x80, 0xd1, 0x65, 0xb1, 0xbd, 0xe5, 0x68,
-	0x35, 0x46, 0x60, 0x70, 0x0a, 0xa7, 0x7c, 0xbc, 0x33, 0x5d, 0x16, 0x59, 0x61, 0x91, 0x8d, 0x63,
-	0x91, 0xaf, 0xb9, 0x1f, 0xe3, 0x62, 0x28, 0xf9, 0x1f, 0xe6, 0x00, 0x4b, 0x57, 0xe4, 0x43, 0x4a,
-	0x5f, 0x92, 0x85, 0xb0, 0x2d, 0x01, 0xc0, 0xb8, 0x04, 0x8c, 0x27, 0x3d, 0x56, 0x1a, 0xd5, 0xd3,
-	0x4a, 0x03, 0x03, 0x97, 0x8a, 0x43, 0x5e, 0x05, 0x48, 0x59, 0x90, 0x1f, 0x41, 0x35, 0x39, 0x15,
-	0xb9, 0x03, 0x75, 0x2b, 0xf2, 0x53, 0xaa, 0x0d, 0x37, 0x7e, 0x6f, 0x6a, 0x38, 0x9e, 0x90, 0xad,
-	0xd9, 0x98, 0x37, 0x15, 0x71, 0x24, 0x72, 0x0b, 0x56, 0xe8, 0xfd, 0xc4, 0x0c, 0x70, 0x3c, 0xe0,
-	0x90, 0x00, 0xc8, 0x2d, 0x90, 0xe2, 0x73, 0x9c, 0xa7, 0xf4, 0x9f, 0xd7, 0x60, 0x55, 0x34, 0x21,
-	0x06, 0xed, 0x06, 0xe5, 0x2e, 0xac, 0x2f, 0xd5, 0xd2, 0x09, 0xd2, 0xf7, 0xb7, 0x45, 0xe9, 0x5b,
-	0xa3, 0x33, 0x27, 0x51, 0x19, 0xf1, 0x93, 0x7f, 0xcb, 0x41, 0x45, 0x94, 0x01, 0x95, 0xb8, 0x64,
-	0x37, 0x92, 0xa8, 0xeb, 0x9b, 0x0b, 0x75, 0xcd, 0x65, 0x21, 0x5b, 0xba, 0xd7, 0x32, 0xa5, 0x5b,
-	0xe0, 0x7d, 0x51, 0x5c, 0xa9, 0x1b, 0xd9, 0x4a, 0x2d, 0x32, 0x5f, 0x5a, 0x98, 0x0f, 0x68, 0x1b,
-	0x4a, 0x4f, 0xc9, 0x72, 0xb3, 0xb6, 0x98, 0x5d, 0x62, 0x43, 0x2d, 0xc1, 0x83, 0x40, 0x36, 0x7b,
-	0x89, 0x64, 0x62, 0x53, 0xda, 0xdd, 0x51, 0x3a, 0x2a, 0x6d, 0x50, 0x6b, 0x00, 0xfd, 0x9d, 0xa1,
-	0x21, 0xec, 0x1c, 0x9e, 0xa2, 0x46, 0x6d, 0x65, 0x6f, 0xb8, 0xbd, 0xa3, 0x6b, 0x9f, 0xb0, 0x5e,
-	0xf5, 0x22, 0xac, 0x77, 0x94, 0xa1, 0x62, 0x0c, 0xd0, 0x36, 0xba, 0x5a, 0x4f, 0x1b, 0xd6, 0x0b,
-	0xcd, 0x37, 0x50, 0xa2, 0x0d, 0xaf, 0x43, 0x67, 0x18, 0x6c, 0x62, 0x53, 0xbb, 0xd7, 0xa5, 0x3d,
-	0xe9, 0x05, 0xda, 0xbd, 0xaa, 0x6f, 0xd4, 0xcd, 0xbd, 0x21, 0x35, 0x59, 0xd7, 0xfb, 0x5a, 0xd1,
-	0x98, 0x91, 0xa7, 0xad, 0xec, 0x96, 0xd6, 0xd7, 0x58, 0x0f, 0x5c, 0x20, 0x97, 0xe1, 0x2f, 0xca,
-	0xf3, 0x3e, 0x76, 0xad, 0x4a, 0xd7, 0x48, 0x86, 0x8b, 0xcd, 0xef, 0xa4, 0x24, 0x8d, 0xf0, 0x4d,
-	0xbd, 0x9d, 0x91, 0xdc, 0xfa, 0xc2, 0x45, 0xc4, 0xa2, 0xfb, 0x4f, 0x41, 0x7c, 0x46, 0xd2, 0x53,
-	0x4c, 0x46, 0x64, 0x4f, 0xef, 0x41, 0x3f, 0x84, 0xa2, 0x12, 0x85, 0x07, 0xe7, 0x58, 0x0b, 0xc5,
-	0x2d, 0xf4, 0xde, 0x3a, 0xbc, 0x2b, 0x59, 0xd5, 0xb9, 0x21, 0x77, 0xce, 0x10, 0x3e, 0x5c, 0x55,
-	0x24, 0x60, 0xdc, 0x54, 0x09, 0x53, 0x48, 0x62, 0x21, 0x91, 0xc4, 0x1f, 0x4a, 0x7f, 0x8a, 0x24,
-	0xf6, 0xa0, 0x9e, 0xed, 0x84, 0xdd, 0xd9, 0xd8, 0x13, 0xaf, 0x55, 0xf3, 0x38, 0x95, 0xad, 0x4e,
-	0x0a, 0xd5, 0x10, 0xa9, 0xaf, 0xdb, 0x8b, 0x03, 0xe4, 0xa3, 0x05, 0xe9, 0xe4, 0x7d, 0xf2, 0x8d,
-	0x13, 0x26, 0x3a, 0x55, 0x3c, 0x1f, 0x43, 0x05, 0x1b, 0xba, 0x59, 0x2a, 0xbc, 0xf2, 0x09, 0xd1,
-	0x3a, 0x47, 0x50, 0x09, 0x14, 0x60, 0xf2, 0x7f, 0x90, 0x68, 0x6b, 0x37, 0x4f, 0x35, 0x77, 0xe3,
-	0xa4, 0x65, 0x05, 0x84, 0x8a, 0x60, 0x0c, 0xa7, 0xa1, 0x89, 0x7a, 0x56, 0x4e, 0x0d, 0x3d, 0xaf,
-	0x7e, 0x4a, 0xef, 0xa1, 0x9f, 0x5f, 0xe6, 0xb0, 0xb6, 0x96, 0x18, 0x44, 0x0d, 0x3c, 0xfe, 0x33,
-	0x07, 0xac, 0xf4, 0xb7, 0x0d, 0x3e, 0x62, 0x02, 0x10, 0xf7, 0xa1, 0xbc, 0x3b, 0x5d, 0xe3, 0xa3,
-	0xaf, 0x44, 0x37, 0x9a, 0xe4, 0x2a, 0xef, 0x52, 0xb9, 0x41, 0xd5, 0x2e, 0xf2, 0x27, 0xa2, 0x45,
-	0xa5, 0x9f, 0x4b, 0x1a, 0x5e, 0x85, 0x8a, 0x20, 0x57, 0x06, 0x90, 0x62, 0xba, 0xde, 0x5b, 0x77,
-	0xeb, 0x50, 0x4b, 0x95, 0x9f, 0x2a, 0x6f, 0xf3, 0xf5, 0x69, 0x82, 0x81, 0x0a, 0xa1, 0xef, 0xf5,
-	0xfb, 0x5c, 0x2e, 0x50, 0x21, 0x06, 0xc3, 0x9d, 0xdd, 0xdd, 0xf7, 0xd0, 0x8b, 0xef, 0x73, 0x50,
-	0x7d, 0x41, 0xff, 0xd5, 0xc0, 0x8a, 0x07, 0x7f, 0xd4, 0xb3, 0xc2, 0xa3, 0x3f, 0xea, 0x0b, 0xf1,
-	0x3b, 0x98, 0xb8, 0x79, 0x53, 0x2c, 0x9a, 0x28, 0x01, 0xa4, 0x3f, 0xd1, 0x0e, 0x4c, 0xdb, 0x70,
-	0x7c, 0xdf, 0xf3, 0x63, 0x95, 0xae, 0xe2, 0x88, 0xca, 0x06, 0xa8, 0x48, 0x53, 0xf7, 0xd4, 0xf3,
-	0x9d, 0x58, 0xa4, 0xd1, 0xee, 0xa1, 0x89, 0x85, 0xbf, 0x92, 0x99, 0x30, 0xfb, 0x92, 0x54, 0xf9,
-	0x4b, 0x72, 0x6b, 0xf1, 0x25, 0xa9, 0x26, 0x7d, 0x79, 0xe6, 0x15, 0x19, 0x95, 0x59, 0x7d, 0x3e,
-	0xfc, 0x3d, 0x00, 0x00, 0xff, 0xff, 0x2b, 0xf7, 0xb1, 0xe3, 0x5b, 0x11, 0x00, 0x00,
+	// 1843 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x72, 0xdb, 0xc8,
+	0x11, 0x16, 0xff, 0xc1, 0x96, 0x44, 0x61, 0x67, 0xbd, 0x5e, 0x18, 0xde, 0xb5, 0x1d, 0x26, 0x9b,
+	0xb8, 0x9c, 0x98, 0x2a, 0xcb, 0xb1, 0xb3, 0xd1, 0xd6, 0x26, 0x45, 0x09, 0xd0, 0x0a, 0x5b, 0x24,
+	0xa5, 0x0c, 0xa9, 0xb5, 0x6b, 0x2f, 0xa8, 0x21, 0x30, 0x14, 0x11, 0x13, 0x00, 0x03, 0x0c, 0x6c,
+	0x2b, 0xb7, 0x5c, 0x73, 0xc8, 0x25, 0x87, 0xbc, 0x43, 0xaa, 0x72, 0xca, 0x2d, 0x87, 0x3c, 0x49,
+	0x1e, 0x20, 0x55, 0x79, 0x81, 0xbd, 0xa4, 0x2a, 0x35, 0x83, 0xc1, 0x0f, 0x45, 0xc9, 0x3f, 0x95,
+	0x43, 0x72, 0x61, 0xa1, 0xa7, 0xbf, 0x9e, 0x9f, 0x9e, 0xee, 0xaf, 0x7b, 0x08, 0xfb, 0xe7, 0x1e,
+	0x9b, 0x27, 0xd3, 0x9e, 0x13, 0xfa, 0xbb, 0x8b, 0xc4, 0xf1, 0xc4, 0xcf, 0xc3, 0xf3, 0x70, 0xd7,
+	0xf5, 0x77, 0xc9, 0xd2, 0xdb, 0x8d, 0x69, 0xf4, 0xd2, 0x73, 0xe8, 0xee, 0xcb, 0x47, 0xbb, 0xe7,
+	0x11, 0x59, 0xce, 0x6d, 0x97, 0x30, 0xd2, 0x5b, 0x46, 0x21, 0x0b, 0x51, 0xd5, 0xf5, 0xf5, 0x3b,
+	0xe7, 0x61, 0x78, 0xbe, 0xa0, 0xbb, 0x62, 0x64, 0x9a, 0xcc, 0x76, 0xdd, 0x24, 0x22, 0xcc, 0x0b,
+	0x83, 0x14, 0xa3, 0xdf, 0xbd, 0xac, 0x67, 0x9e, 0x4f, 0x63, 0x46, 0xfc, 0xa5, 0x04, 0x3c, 0x79,
+	0xf7, 0x0d, 0xb0, 0x8b, 0x25, 0x8d, 0x53, 0xb3, 0xee, 0x3f, 0x2b, 0xd0, 0xe9, 0x4f, 0x83, 0x30,
+	0xf2, 0xc9, 0xe2, 0xc8, 0x0b, 0xbc, 0x78, 0x8e, 0x1e, 0x41, 0x33, 0x66, 0x84, 0x25, 0xb1, 0x56,
+	0xb9, 0x57, 0xb9, 0xdf, 0xd9, 0xbb, 0xd5, 0x73, 0xfd, 0xde, 0x2a, 0xa6, 0x37, 0x16, 0x00, 0x2c,
+	0x81, 0xe8, 0x26, 0x34, 0x23, 0x4a, 0xe2, 0x30, 0xd0, 0xaa, 0xf7, 0x2a, 0xf7, 0xdb, 0x58, 0x4a,
+	0xdd, 0xdf, 0x57, 0xa0, 0x99, 0x42, 0xd1, 0x26, 0xb4, 0xac, 0xd1, 0x37, 0xfd, 0x81, 0x65, 0xa8,
+	0x1b, 0x08, 0xa0, 0x79, 0xd4, 0xb7, 0x06, 0xa6, 0xa1, 0x56, 0xb8, 0xe2, 0x10, 0xf7, 0xc7, 0xc7,
+	0xa6, 0xa1, 0x56, 0xb9, 0x60, 0x3e, 0x3f, 0xb5, 0xb0, 0x69, 0xa8, 0x35, 0xb4, 0x0d, 0xed, 0x89,
+	0x35, 0x34, 0x0d, 0xfb, 0xe4, 0x6c, 0xa2, 0xd6, 0xb9, 0x78, 0xd8, 0x1f, 0x1d, 0x9a, 0x03, 0x6e,
+	0xd7, 0x40, 0x5b, 0xa0, 0x60, 0xf3, 0x6b, 0xf3, 0x70, 0x62, 0x1a, 0x6a, 0x93, 0x1b, 0x0e, 0xad,
+	0xf1, 0xd8, 0x1a, 0x7d, 0xa5, 0xb6, 0xd0, 0x0d, 0x50, 0xb1, 0x39, 0x3e, 0x1b, 0x4c, 0xec, 0x61,
+	0x7f, 0x70, 0x74, 0x82, 0x87, 0xa6, 0xa1, 0x2a, 0xdd, 0xef, 0xda, 0xd0, 0xf8, 0x55, 0x42, 0x63,
+	0x86, 0x3e, 0x81, 0xaa, 0xe7, 0x8a, 0xd3, 0x6d, 0xee, 0x6d, 0xf1, 0xd3, 0x89, 0xe1, 0x9e, 0x65,
+	0xe0, 0xaa, 0xe7, 0x22, 0x15, 0x6a, 0xc6, 0xc8, 0x14, 0x27, 0x51, 0x30, 0xff, 0x44, 0x5d, 0xa8,
+	0xf3, 0xeb, 0xd2, 0x6a, 0xc2, 0xa2, 0x53, 0x58, 0x18, 0x84, 0x11, 0x2c, 0x74, 0xe8, 0x31, 0x28,
+	0x84, 0x31, 0xea, 0x2f, 0x59, 0xac, 0xd5, 0xef, 0xd5, 0xee, 0x6f, 0xee, 0x7d, 0x5c, 0xe0, 0xfa,
+	0x52, 0x63, 0x06, 0x2c, 0xba, 0xc0, 0x39, 0x10, 0x69, 0xd0, 0x5a, 0x92, 0x88, 0x79, 0x64, 0xa1,
+	0xa9, 0x62, 0xb9, 0x4c, 0xd4, 0x6f, 0x40, 0xd5, 0x32, 0x50, 0x27, 0xdf, 0x68, 0x9b, 0x6f, 0x4d,
+	0xff, 0x57, 0x03, 0xea, 0x06, 0x8d, 0x1d, 0xf4, 0x14, 0x3e, 0x76, 0xbd, 0x98, 0x45, 0xde, 0x34,
+	0x61, 0x61, 0x64, 0x3b, 0x61, 0x30, 0xf3, 0xce, 0xed, 0x80, 0xf8, 0x54, 0xa2, 0x3f, 0x2a, 0xa9,
+	0x0f, 0x85, 0x76, 0x44, 0x7c, 0x8a, 0xee, 0x00, 0x2c, 0x49, 0x44, 0x7c, 0xca, 0x68, 0x14, 0xcb,
+	0xcb, 0x2a, 0x8d, 0xa0, 0x27, 0x70, 0xb3, 0x3c, 0x6f, 0x09, 0x5b, 0x5b, 0x9b, 0xf6, 0xb4, 0x30,
+	0xfb, 0x11, 0xd4, 0x7d, 0xca, 0x88, 0x56, 0x17, 0x0e, 0xfa, 0xb0, 0xe4, 0x20, 0x1a, 0x3b, 0xbd,
+	0x21, 0xe5, 0x5e, 0xe2, 0x00, 0xfd, 0xcf, 0x75, 0xa8, 0x73, 0x11, 0x7d, 0x0a, 0x40, 0x62, 0x9b,
+	0x38, 0x4e, 0x98, 0x04, 0x4c, 0xee, 0xb9, 0x4d, 0xe2, 0x7e, 0x3a, 0x80, 0x76, 0xa1, 0x11, 0x51,
+	0x16, 0x5d, 0x88, 0x2d, 0x6e, 0xee, 0xdd, 0xba, 0x62, 0xc6, 0x1e, 0xe6, 0x00, 0x9c, 0xe2, 0xd0,
+	0xe7, 0xa0, 0xf0, 0x8c, 0x08, 0x13, 0x16, 0xcb, 0x6b, 0xfa, 0xe4, 0x2a, 0x9b, 0x89, 0xc4, 0xe0,
+	0x1c, 0xad, 0xff, 0xbb, 0x02, 0x0d, 0x31, 0x15, 0x8f, 0xe2, 0x19, 0xf1, 0x16, 0x34, 0xf5, 0xf8,
+	0x36, 0x96, 0x12, 0xbf, 0x25, 0x27, 0x22, 0xf1, 0x9c, 0xba, 0x62, 0x3b, 0xdb, 0x38, 0x13, 0xb9,
+	0x86, 0xbe, 0x5e, 0x7a, 0x11, 0x75, 0xc5, 0xa2, 0xdb, 0x38, 0x13, 0xd1, 0x6d, 0x68, 0xf3, 0x15,
+	0x5c, 0x3b, 0x4c, 0x98, 0x70, 0xcb, 0x76, 0xba, 0xa4, 0x7b, 0x92, 0x30, 0xa4, 0x83, 0x12, 0xd1,
+	0x5f, 0x53, 0x87, 0x51, 0x57, 0x6b, 0xa6, 0xba, 0x4c, 0x46, 0x8f, 0xa1, 0x35, 0x25, 0xce, 0x8b,
+	0x70, 0x36, 0xd3, 0x54, 0x79, 0xf6, 0x34, 0xf5, 0x7b, 0x59, 0xea, 0xf7, 0x0c, 0x49, 0x0d, 0x38,
+	0x43, 0xa2, 0x7d, 0xd8, 0xf4, 0xc9, 0x6b, 0x3b, 0x33, 0xfc, 0xe0, 0x6d, 0x86, 0xe0, 0x93, 0xd7,
+	0x07, 0x29, 0x58, 0xff, 0x53, 0x05, 0x94, 0xcc, 0x2d, 0xdc, 0xef, 0x31, 0x23, 0x11, 0xd3, 0x2a,
+	0x6f, 0x9b, 0x22, 0xc5, 0xa1, 0x1f, 0x43, 0x2d, 0x4a, 0x02, 0xad, 0xfa, 0x36, 0x38, 0x47, 0xa1,
+	0x87, 0x50, 0x8f, 0x59, 0xb8, 0xd4, 0x6a, 0x6f, 0x43, 0x0b, 0x98, 0x3e, 0x87, 0xad, 0x09, 0xf5,
+	0x97, 0x0b, 0xc2, 0xe8, 0x78, 0x49, 0x1d, 0x91, 0x2d, 0x51, 0xc8, 0xfd, 0x24, 0x03, 0x26, 0x13,
+	0x79, 0xca, 0x46, 0x74, 0x26, 0xe3, 0x99, 0x7f, 0x72, 0xec, 0x4b, 0x1a, 0xc5, 0x5e, 0x18, 0xc8,
+	0xc8, 0xcd, 0x44, 0x84, 0xa0, 0x2e, 0xf2, 0xa4, 0x2e, 0x86, 0xc5, 0xb7, 0xfe, 0xc7, 0x0a, 0xd4,
+	0x79, 0x2e, 0xa3, 0x9f, 0xf2, 0xab, 0xa6, 0x84, 0xd1, 0x8c, 0x1e, 0xf4, 0xb5, 0x4d, 0x4e, 0x32,
+	0xe2, 0xc5, 0x19, 0x54, 0xf0, 0x03, 0x8d, 0x1d, 0xad, 0xba, 0xc6, 0x0f, 0x34, 0x76, 0xb0, 0xd0,
+	0xa1, 0x47, 0xa0, 0x4c, 0x13, 0x6f, 0xc1, 0xec, 0xe9, 0x85, 0x56, 0x13, 0xfc, 0x70, 0xb3, 0xc0,
+	0x95, 0x8f, 0x89, 0x5b, 0x02, 0x77, 0x70, 0xa1, 0x1f, 0xc3, 0xf6, 0x0a, 0x71, 0xf0, 0x63, 0xbe,
+	0xa0, 0x17, 0x32, 0x3a, 0xf9, 0x27, 0xfa, 0x1e, 0x34, 0x5e, 0x92, 0x45, 0x42, 0xe5, 0xd2, 0x9b,
+	0x82, 0xaa, 0x53, 0x1b, 0x9c, 0x6a, 0xf6, 0xab, 0x9f, 0x57, 0xba, 0x0c, 0xe0, 0xeb, 0x38, 0x0c,
+	0x30, 0x8d, 0x93, 0x05, 0xe3, 0x71, 0x1e, 0x4e, 0x4b, 0x6e, 0x94, 0x12, 0xf7, 0x4c, 0xec, 0xfd,
+	0x96, 0xca, 0x20, 0x17, 0xdf, 0x68, 0x1f, 0x40, 0x84, 0x34, 0x61, 0x99, 0x2b, 0xdf, 0xec, 0x93,
+	0x12, 0xba, 0xeb, 0x41, 0x53, 0xae, 0x98, 0x11, 0x68, 0xa5, 0x70, 0x50, 0xb1, 0x1f, 0x49, 0xa0,
+	0x5f, 0xc0, 0x0e, 0x91, 0x45, 0xc6, 0x9e, 0x89, 0x2a, 0x23, 0x0f, 0x85, 0xd6, 0xeb, 0x0f, 0xee,
+	0x90, 0x15, 0xb9, 0xfb, 0xd7, 0x2d, 0x68, 0xc9, 0x73, 0xa3, 0x3b, 0x25, 0x76, 0xef, 0x94, 0x1c,
+	0x72, 0x3d, 0xbf, 0xff, 0x60, 0x85, 0xdf, 0xd5, 0xb2, 0x4d, 0x89, 0xe1, 0xbf, 0xe0, 0xae, 0xa0,
+	0x4e, 0xc2, 0xcf, 0x96, 0x71, 0xfc, 0xed, 0x32, 0xd6, 0xcc, 0xb5, 0x29, 0xcf, 0x97, 0xe0, 0xe8,
+	0x01, 0x28, 0xb3, 0x57, 0xae, 0xed, 0xd2, 0x65, 0xac, 0x35, 0xc4, 0x32, 0x3b, 0x25, 0xd3, 0x81,
+	0x17, 0x33, 0xdc, 0x9a, 0xbd, 0x72, 0x0d, 0xba, 0x8c, 0xd1, 0x4f, 0xa0, 0xcd, 0x33, 0x39, 0x05,
+	0x37, 0xaf, 0x06, 0x2b, 0x1c, 0x21, 0xd0, 0x0f, 0x57, 0x6b, 0x88, 0xa4, 0xdf, 0x6c, 0x4f, 0xa7,
+	0xa9, 0xaa, 0x28, 0x2c, 0x0f, 0x44, 0x61, 0xb9, 0x01, 0x8d, 0xdf, 0xf0, 0xc8, 0x93, 0x11, 0x90,
+	0x0a, 0xb2, 0xdc, 0xa4, 0xd7, 0xcf, 0xcb, 0xcd, 0xdf, 0x9a, 0xff, 0x55, 0x5a, 0x3c, 0x05, 0xc5,
+	0x0f, 0x5d, 0x6f, 0xe6, 0x49, 0xe2, 0x7c, 0xb3, 0x59, 0x8e, 0x45, 0x9f, 0x41, 0x27, 0x48, 0x7c,
+	0xbb, 0xe4, 0xec, 0x94, 0x5c, 0xb7, 0x83, 0xc4, 0x2f, 0x7c, 0x8c, 0xf6, 0xa0, 0xc5, 0xb9, 0xdf,
+	0xa3, 0xb1, 0xac, 0x3b, 0xda, 0xe5, 0x8b, 0x13, 0x25, 0xc2, 0xa3, 0x31, 0xce, 0x80, 0xe8, 0x4b,
+	0x80, 0xd8, 0x99, 0x53, 0x37, 0x59, 0x78, 0xc1, 0xb9, 0xbc, 0x88, 0xdb, 0x6b, 0x66, 0xe3, 0x1c,
+	0x72, 0xbc, 0x81, 0x4b, 0x06, 0x68, 0x1f, 0xda, 0x72, 0x57, 0xc1, 0xb9, 0xbc, 0x19, 0x7d, 0xcd,
+	0xda, 0xcc, 0x10, 0xc7, 0x1b, 0xb8, 0x80, 0x73, 0x1f, 0xbe, 0x22, 0x9e, 0xb0, 0x6c, 0x5d, 0xb3,
+	0xdd, 0x67, 0xa9, 0xfe, 0x78, 0x03, 0x67, 0x50, 0xf4, 0x33, 0x50, 0xd2, 0x64, 0xa0, 0xae, 0xa6,
+	0x14, 0xb5, 0x70, 0xc5, 0xec, 0x48, 0x02, 0x8e, 0x37, 0x70, 0x0e, 0x46, 0x5f, 0xae, 0xa7, 0x53,
+	0xfb, 0xba, 0x74, 0x3a, 0xde, 0xb8, 0x9c, 0x50, 0xfa, 0x16, 0x40, 0xe1, 0x05, 0xfd, 0x09, 0xb4,
+	0xf3, 0x53, 0xa1, 0xfb, 0xa0, 0x3a, 0x49, 0x54, 0x5c, 0x8f, 0xed, 0x65, 0x05, 0xb3, 0xe3, 0x24,
+	0x51, 0x7e, 0x41, 0x96, 0xab, 0x3f, 0x80, 0x96, 0x3c, 0x12, 0xba, 0x0b, 0x9b, 0xfc, 0x4e, 0x33,
+	0x0f, 0xa4, 0x78, 0x08, 0x12, 0x5f, 0x02, 0xf4, 0x1e, 0x28, 0xd9, 0x39, 0xde, 0x85, 0x2e, 0xf4,
+	0x3f, 0x54, 0xa0, 0x25, 0xaf, 0xf7, 0xff, 0xa2, 0x70, 0x1f, 0x74, 0x60, 0x4b, 0xf6, 0x75, 0x36,
+	0x6f, 0xb0, 0xf5, 0x01, 0xec, 0x5c, 0x22, 0x84, 0x2b, 0xf8, 0xfb, 0xfb, 0xab, 0xfc, 0xbd, 0xcd,
+	0x8f, 0x9a, 0x5b, 0x95, 0x18, 0x5c, 0xff, 0xae, 0x02, 0x2d, 0x99, 0xcb, 0x9c, 0xa7, 0x73, 0xf7,
+	0x28, 0x92, 0x9c, 0xee, 0xac, 0x90, 0x53, 0xca, 0x6d, 0xa5, 0x11, 0x74, 0xab, 0xc4, 0x3f, 0x35,
+	0xa1, 0xcd, 0xe9, 0xe6, 0x76, 0x99, 0x6e, 0xea, 0x42, 0x57, 0xb0, 0xcb, 0x1e, 0xef, 0xec, 0xb9,
+	0xdb, 0x45, 0xb2, 0x74, 0x56, 0xc3, 0x5d, 0x6e, 0xa8, 0x27, 0x2f, 0x46, 0x22, 0xbb, 0xc3, 0x9c,
+	0xf7, 0x01, 0x9a, 0x83, 0x93, 0xbe, 0x61, 0xf2, 0x9e, 0xbf, 0x03, 0x30, 0x3a, 0x99, 0xd8, 0x52,
+	0xae, 0x20, 0x04, 0x1d, 0x2e, 0xf7, 0xcf, 0x26, 0xc7, 0x27, 0xd8, 0xfa, 0x56, 0xb4, 0xff, 0x1f,
+	0xc2, 0x8e, 0xd1, 0x9f, 0xf4, 0xed, 0xb1, 0xf5, 0xad, 0x69, 0x0f, 0xac, 0xa1, 0x35, 0x51, 0x6b,
+	0xdd, 0xe7, 0xd0, 0xe0, 0x6f, 0x08, 0xca, 0x67, 0x18, 0x1f, 0x1e, 0x9b, 0xc6, 0xd9, 0x80, 0xb7,
+	0xf9, 0x1b, 0xfc, 0x41, 0x60, 0x3e, 0x37, 0x0f, 0xcf, 0x26, 0x5c, 0x14, 0x0f, 0x89, 0x67, 0x7d,
+	0x4b, 0x08, 0x55, 0xfe, 0x3a, 0x38, 0xb2, 0x46, 0x96, 0x78, 0x56, 0xd4, 0xd0, 0x47, 0xf0, 0x41,
+	0xff, 0x60, 0x74, 0x82, 0x87, 0xfd, 0x81, 0x9d, 0x0f, 0xd7, 0xbb, 0x7f, 0x51, 0xf2, 0xb8, 0x0e,
+	0x03, 0x74, 0xaf, 0x54, 0x37, 0xd4, 0x95, 0x8b, 0xc8, 0x2a, 0xc7, 0x0f, 0xa5, 0xe3, 0x4b, 0x75,
+	0xa9, 0xc0, 0x94, 0x2a, 0xc5, 0xf5, 0x6d, 0xfd, 0x2f, 0xa0, 0xde, 0x4f, 0xd8, 0xfc, 0x1d, 0xd6,
+	0xba, 0x01, 0x0d, 0x16, 0xbe, 0xa0, 0x69, 0x6b, 0xb5, 0x85, 0x53, 0x41, 0x37, 0xde, 0xc0, 0xde,
+	0x1a, 0xb4, 0x64, 0x00, 0x66, 0xd1, 0x2e, 0x45, 0xc9, 0xeb, 0xb5, 0x9c, 0xd7, 0xff, 0xd1, 0xf8,
+	0x9f, 0xf0, 0xfa, 0x10, 0xd4, 0xf2, 0xe3, 0xc2, 0x0b, 0x66, 0xa1, 0x2c, 0xb9, 0xdd, 0x75, 0x57,
+	0xf6, 0x8c, 0x02, 0x6a, 0x05, 0xb3, 0x10, 0xef, 0xb8, 0xab, 0x03, 0xe8, 0x97, 0x2b, 0x5c, 0x9e,
+	0x96, 0x80, 0x4f, 0xaf, 0x98, 0xe8, 0x5a, 0x36, 0x7f, 0x0a, 0xad, 0x28, 0x09, 0x82, 0xa2, 0x12,
+	0xe8, 0x57, 0x58, 0xe3, 0x14, 0xc1, 0x39, 0x59, 0x82, 0xd1, 0xcf, 0x41, 0xe1, 0xfd, 0xe9, 0xb2,
+	0x28, 0x02, 0xb7, 0xaf, 0x5a, 0x56, 0x42, 0x38, 0x2b, 0x67, 0x70, 0x6e, 0x9a, 0xd3, 0x79, 0xeb,
+	0x5a, 0xd3, 0x77, 0x25, 0x74, 0xe5, 0x3d, 0x08, 0xfd, 0x77, 0x15, 0xd8, 0xb9, 0xe4, 0x52, 0x4e,
+	0xca, 0xeb, 0x2f, 0x47, 0x70, 0x8a, 0xe7, 0xe2, 0x67, 0xd0, 0x91, 0x80, 0xac, 0x99, 0x4e, 0x5b,
+	0xec, 0xed, 0x74, 0xf4, 0x9b, 0x74, 0xb0, 0x88, 0xd5, 0xb4, 0xd5, 0x4e, 0x05, 0xce, 0x76, 0x49,
+	0xb4, 0x90, 0x7d, 0x36, 0xff, 0xbc, 0x54, 0x54, 0xda, 0xd0, 0x92, 0xce, 0xd5, 0x01, 0x94, 0xcc,
+	0x5d, 0xef, 0x5b, 0x08, 0x0e, 0x54, 0xe8, 0x14, 0xa5, 0x88, 0x33, 0x6f, 0xf7, 0xd9, 0x75, 0x84,
+	0xb1, 0x09, 0x2d, 0x7c, 0x36, 0x1a, 0xa5, 0x74, 0xb1, 0x05, 0xca, 0x78, 0x72, 0x72, 0x7a, 0xfa,
+	0x1e, 0x7c, 0xf1, 0xf7, 0x0a, 0xb4, 0xbf, 0xe2, 0xff, 0xde, 0x88, 0xe4, 0x79, 0x04, 0x4d, 0x91,
+	0x78, 0xfc, 0x7f, 0x92, 0x5a, 0x56, 0x98, 0x73, 0x75, 0xda, 0xd9, 0xcb, 0x4e, 0x50, 0x02, 0xf9,
+	0xab, 0x77, 0x4e, 0x5c, 0x9b, 0x46, 0x51, 0x18, 0x65, 0x2c, 0xdd, 0x9e, 0x13, 0xd7, 0x14, 0x03,
+	0x9c, 0xa4, 0xb9, 0xda, 0x0f, 0x23, 0x9a, 0x91, 0xf4, 0x9c, 0xb8, 0xc3, 0x30, 0xa2, 0xba, 0x01,
+	0x9b, 0xa5, 0x09, 0xcb, 0x95, 0xa4, 0x9d, 0x56, 0x92, 0xbb, 0xab, 0x95, 0xa4, 0x9d, 0x3f, 0x2e,
+	0x4a, 0x55, 0x64, 0xda, 0x14, 0xf9, 0xf9, 0xf8, 0x3f, 0x03, 0x00, 0xb4, 0xc5, 0x0c, 0x2d, 0xae,
+	0x12, 0x00, 0x00,
 }
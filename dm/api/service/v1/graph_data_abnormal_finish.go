@@ -8,7 +8,7 @@ package dm
 func (s AbnormalFinish_Status) CouldRetry() bool {
 	switch s {
 	case AbnormalFinish_FAILED, AbnormalFinish_CRASHED,
-		AbnormalFinish_EXPIRED, AbnormalFinish_TIMED_OUT:
+		AbnormalFinish_EXPIRED, AbnormalFinish_TIMED_OUT, AbnormalFinish_REJECTED:
 
 		return true
 	}
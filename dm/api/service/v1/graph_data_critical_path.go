@@ -0,0 +1,96 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dm
+
+import (
+	"time"
+)
+
+// CriticalPathStep is a single Attempt on the critical path of a GraphData.
+type CriticalPathStep struct {
+	ID *Attempt_ID
+
+	// Start is the time that the Attempt was created.
+	Start time.Time
+
+	// End is the time that the Attempt reached a terminal state, or the `now`
+	// passed to CriticalPath if it hasn't finished yet.
+	End time.Time
+
+	// Executing is the total time spent in this Attempt's Executions.
+	Executing time.Duration
+}
+
+// Elapsed returns the wall-clock time between Start and End.
+func (s *CriticalPathStep) Elapsed() time.Duration {
+	return s.End.Sub(s.Start)
+}
+
+// CriticalPath computes the critical path through the FwdDeps of g, starting
+// at root.
+//
+// At every Attempt, the path continues through the dependency that finished
+// last (that is, the dependency that the Attempt was waiting on the longest).
+// Dependencies which aren't present in g, or which have no Data, are ignored.
+// Attempts which haven't finished yet are considered to end at now.
+//
+// The returned steps are ordered from root to leaf. If root isn't present in g,
+// this returns nil.
+func (g *GraphData) CriticalPath(root *Attempt_ID, now time.Time) []*CriticalPathStep {
+	ret := []*CriticalPathStep(nil)
+	seen := map[string]map[uint32]struct{}{}
+
+	cur := g.critPathStep(root, now)
+	for cur != nil {
+		if _, ok := seen[cur.ID.Quest][cur.ID.Id]; ok {
+			// DM graphs are acyclic, but don't loop forever on bad data.
+			break
+		}
+		if seen[cur.ID.Quest] == nil {
+			seen[cur.ID.Quest] = map[uint32]struct{}{}
+		}
+		seen[cur.ID.Quest][cur.ID.Id] = struct{}{}
+		ret = append(ret, cur)
+
+		next := (*CriticalPathStep)(nil)
+		a := g.Quests[cur.ID.Quest].GetAttempts()[cur.ID.Id]
+		for qid, nums := range a.GetFwdDeps().GetTo() {
+			if nums == nil {
+				continue
+			}
+			for _, aid := range nums.Nums {
+				step := g.critPathStep(&Attempt_ID{Quest: qid, Id: aid}, now)
+				if step != nil && (next == nil || step.End.After(next.End)) {
+					next = step
+				}
+			}
+		}
+		cur = next
+	}
+	return ret
+}
+
+func (g *GraphData) critPathStep(aid *Attempt_ID, now time.Time) *CriticalPathStep {
+	a := g.Quests[aid.Quest].GetAttempts()[aid.Id]
+	if a == nil || a.DNE || a.Data == nil {
+		return nil
+	}
+
+	ret := &CriticalPathStep{ID: aid, Start: a.Data.Created.Time(), End: now}
+	if a.Data.State().Terminal() {
+		ret.End = a.Data.Modified.Time()
+	}
+	for _, e := range a.Executions {
+		if e.GetData() == nil {
+			continue
+		}
+		end := now
+		if e.Data.State().Terminal() {
+			end = e.Data.Modified.Time()
+		}
+		ret.Executing += end.Sub(e.Data.Created.Time())
+	}
+	return ret
+}
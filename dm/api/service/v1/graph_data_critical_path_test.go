@@ -0,0 +1,75 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dm
+
+import (
+	"testing"
+	"time"
+
+	google_pb "github.com/luci/luci-go/common/proto/google"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGraphDataCriticalPath(t *testing.T) {
+	t.Parallel()
+
+	Convey("GraphData.CriticalPath", t, func() {
+		base := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+		at := func(min int) *google_pb.Timestamp {
+			return google_pb.NewTimestamp(base.Add(time.Duration(min) * time.Minute))
+		}
+		now := base.Add(time.Hour)
+
+		g := testGraph()
+		setTimes := func(qid string, created, modified int) {
+			a := g.Quests[qid].Attempts[1]
+			a.Data.Created = at(created)
+			a.Data.Modified = at(modified)
+		}
+		setTimes("a", 0, 1)
+		setTimes("b", 1, 30)
+		setTimes("c", 1, 2)
+		setTimes("d", 2, 20)
+
+		g.Quests["d"].Attempts[1].Executions = map[uint32]*Execution{
+			1: {Data: &Execution_Data{
+				Created: at(2), Modified: at(10),
+				ExecutionType: &Execution_Data_AbnormalFinish{&AbnormalFinish{}},
+			}},
+			2: {Data: &Execution_Data{
+				Created: at(12), Modified: at(20),
+				ExecutionType: &Execution_Data_Finished_{&Execution_Data_Finished{}},
+			}},
+		}
+
+		Convey("follows the latest-finishing dependency", func() {
+			// c is still executing, so it ends at `now`.
+			path := g.CriticalPath(&Attempt_ID{Quest: "a", Id: 1}, now)
+			So(len(path), ShouldEqual, 2)
+			So(path[0].ID, ShouldResemble, &Attempt_ID{Quest: "a", Id: 1})
+			So(path[0].End, ShouldResemble, now)
+			So(path[1].ID, ShouldResemble, &Attempt_ID{Quest: "c", Id: 1})
+			So(path[1].Elapsed(), ShouldEqual, 59*time.Minute)
+
+			Convey("once c finishes, b becomes critical", func() {
+				g.Quests["c"].Attempts[1].Data = NewAttemptFinished(nil).Data
+				setTimes("c", 1, 2)
+
+				path := g.CriticalPath(&Attempt_ID{Quest: "a", Id: 1}, now)
+				So(len(path), ShouldEqual, 3)
+				So(path[1].ID, ShouldResemble, &Attempt_ID{Quest: "b", Id: 1})
+				So(path[2].ID, ShouldResemble, &Attempt_ID{Quest: "d", Id: 1})
+				So(path[2].Start, ShouldResemble, base.Add(2*time.Minute))
+				So(path[2].End, ShouldResemble, base.Add(20*time.Minute))
+				So(path[2].Executing, ShouldEqual, 16*time.Minute)
+			})
+		})
+
+		Convey("missing root", func() {
+			So(g.CriticalPath(&Attempt_ID{Quest: "nope", Id: 1}, now), ShouldBeNil)
+			So(g.CriticalPath(&Attempt_ID{Quest: "other", Id: 2}, now), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,230 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dm
+
+import (
+	"fmt"
+	"path"
+)
+
+// GraphFilter describes which Attempts to retain when filtering a GraphData.
+//
+// The zero value of each field means 'don't filter on this', except for
+// MaxDepth, which follows the same conventions as WalkGraphReq_Limit.MaxDepth.
+type GraphFilter struct {
+	// States, if non-empty, retains only Attempts in one of these states.
+	States []Attempt_State
+
+	// QuestPatterns, if non-empty, retains only Attempts whose Quest ID matches
+	// at least one of these patterns. Patterns use the `path.Match` syntax.
+	QuestPatterns []string
+
+	// MaxDepth is the number of FwdDeps hops from Roots to retain; 0 means
+	// 'just the roots', and -1 means 'no limit'.
+	MaxDepth int64
+
+	// Roots are the Attempts that MaxDepth is measured from. If empty, every
+	// Attempt in the graph which isn't a dependency of another Attempt in the
+	// graph is a root.
+	Roots *AttemptList
+}
+
+// Filter returns a new GraphData containing only the Attempts selected by f.
+//
+// FwdDeps and BackDeps of the returned Attempts are pruned so that they only
+// refer to other Attempts in the returned GraphData. Quests which have no
+// remaining Attempts are omitted. The returned GraphData shares Quest.Data,
+// Attempt.Data and Attempt.Executions with g.
+func (g *GraphData) Filter(f *GraphFilter) (*GraphData, error) {
+	for _, pat := range f.QuestPatterns {
+		if _, err := path.Match(pat, ""); err != nil {
+			return nil, fmt.Errorf("bad quest pattern %q: %s", pat, err)
+		}
+	}
+	if f.MaxDepth < -1 {
+		return nil, fmt.Errorf("bad MaxDepth: %d", f.MaxDepth)
+	}
+
+	var depths map[string]map[uint32]int64
+	if f.MaxDepth != -1 {
+		depths = g.depths(f.Roots, f.MaxDepth)
+	}
+
+	keep := map[string]map[uint32]struct{}{}
+	for qid, q := range g.Quests {
+		if !f.matchQuest(qid) {
+			continue
+		}
+		for aid, a := range q.Attempts {
+			if a.DNE || !f.matchState(a.Data.State()) {
+				continue
+			}
+			if depths != nil {
+				if _, ok := depths[qid][aid]; !ok {
+					continue
+				}
+			}
+			if keep[qid] == nil {
+				keep[qid] = map[uint32]struct{}{}
+			}
+			keep[qid][aid] = struct{}{}
+		}
+	}
+
+	ret := &GraphData{
+		Quests:    make(map[string]*Quest, len(keep)),
+		HadErrors: g.HadErrors,
+		HadMore:   g.HadMore,
+	}
+	for qid, aids := range keep {
+		q := g.Quests[qid]
+		newQ := &Quest{
+			Id:       q.Id,
+			Data:     q.Data,
+			Partial:  q.Partial,
+			Attempts: make(map[uint32]*Attempt, len(aids)),
+		}
+		for aid := range aids {
+			a := q.Attempts[aid]
+			newQ.Attempts[aid] = &Attempt{
+				Id:         a.Id,
+				Data:       a.Data,
+				Executions: a.Executions,
+				FwdDeps:    pruneAttemptList(a.FwdDeps, keep),
+				BackDeps:   pruneAttemptList(a.BackDeps, keep),
+				Partial:    a.Partial,
+			}
+		}
+		ret.Quests[qid] = newQ
+	}
+	return ret, nil
+}
+
+func (f *GraphFilter) matchQuest(qid string) bool {
+	if len(f.QuestPatterns) == 0 {
+		return true
+	}
+	for _, pat := range f.QuestPatterns {
+		// Patterns were validated in Filter.
+		if ok, _ := path.Match(pat, qid); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *GraphFilter) matchState(s Attempt_State) bool {
+	if len(f.States) == 0 {
+		return true
+	}
+	for _, st := range f.States {
+		if st == s {
+			return true
+		}
+	}
+	return false
+}
+
+// depths does a breadth-first walk of the FwdDeps in g starting at roots, and
+// returns the depth of every Attempt reached within maxDepth hops.
+func (g *GraphData) depths(roots *AttemptList, maxDepth int64) map[string]map[uint32]int64 {
+	ret := map[string]map[uint32]int64{}
+	queue := []*Attempt_ID(nil)
+	visit := func(qid string, aid uint32, depth int64) {
+		if _, ok := ret[qid][aid]; ok {
+			return
+		}
+		if ret[qid] == nil {
+			ret[qid] = map[uint32]int64{}
+		}
+		ret[qid][aid] = depth
+		queue = append(queue, &Attempt_ID{Quest: qid, Id: aid})
+	}
+
+	if len(roots.GetTo()) == 0 {
+		roots = g.roots()
+	}
+	for qid, nums := range roots.GetTo() {
+		if nums == nil {
+			continue
+		}
+		for _, aid := range nums.Nums {
+			visit(qid, aid, 0)
+		}
+	}
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		depth := ret[cur.Quest][cur.Id]
+		if depth >= maxDepth {
+			continue
+		}
+		a := g.Quests[cur.Quest].GetAttempts()[cur.Id]
+		for qid, nums := range a.GetFwdDeps().GetTo() {
+			if nums == nil {
+				continue
+			}
+			for _, aid := range nums.Nums {
+				visit(qid, aid, depth+1)
+			}
+		}
+	}
+	return ret
+}
+
+// roots returns all Attempts in g which aren't a FwdDep of any other Attempt
+// in g.
+func (g *GraphData) roots() *AttemptList {
+	isDep := map[string]map[uint32]struct{}{}
+	for _, q := range g.Quests {
+		for _, a := range q.Attempts {
+			for qid, nums := range a.GetFwdDeps().GetTo() {
+				if nums == nil {
+					continue
+				}
+				if isDep[qid] == nil {
+					isDep[qid] = map[uint32]struct{}{}
+				}
+				for _, aid := range nums.Nums {
+					isDep[qid][aid] = struct{}{}
+				}
+			}
+		}
+	}
+
+	ret := &AttemptList{}
+	for qid, q := range g.Quests {
+		for aid, a := range q.Attempts {
+			if a.DNE {
+				continue
+			}
+			if _, ok := isDep[qid][aid]; !ok {
+				ret.AddAIDs(&Attempt_ID{Quest: qid, Id: aid})
+			}
+		}
+	}
+	return ret
+}
+
+// pruneAttemptList returns a copy of al which only contains Attempts present
+// in keep. It returns nil if al is nil.
+func pruneAttemptList(al *AttemptList, keep map[string]map[uint32]struct{}) *AttemptList {
+	if al == nil {
+		return nil
+	}
+	ret := &AttemptList{}
+	for qid, nums := range al.To {
+		if nums == nil {
+			continue
+		}
+		for _, aid := range nums.Nums {
+			if _, ok := keep[qid][aid]; ok {
+				ret.AddAIDs(&Attempt_ID{Quest: qid, Id: aid})
+			}
+		}
+	}
+	return ret
+}
@@ -0,0 +1,136 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dm
+
+import (
+	"testing"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func testGraph() *GraphData {
+	mkAttempt := func(qid string, aid uint32, a *Attempt, deps map[string][]uint32) *Attempt {
+		a.Id = &Attempt_ID{Quest: qid, Id: aid}
+		if deps != nil {
+			a.FwdDeps = NewAttemptList(deps)
+		}
+		return a
+	}
+	mkQuest := func(qid string, atmpts ...*Attempt) *Quest {
+		ret := &Quest{Id: &Quest_ID{Id: qid}, Attempts: map[uint32]*Attempt{}}
+		for _, a := range atmpts {
+			ret.Attempts[a.Id.Id] = a
+		}
+		return ret
+	}
+
+	// a:1 -> b:1 -> d:1
+	//     -> c:1
+	// other:1
+	return &GraphData{Quests: map[string]*Quest{
+		"a": mkQuest("a", mkAttempt("a", 1, NewAttemptWaiting(2),
+			map[string][]uint32{"b": {1}, "c": {1}})),
+		"b": mkQuest("b", mkAttempt("b", 1, NewAttemptFinished(nil),
+			map[string][]uint32{"d": {1}})),
+		"c": mkQuest("c", mkAttempt("c", 1, NewAttemptExecuting(1), nil)),
+		"d": mkQuest("d", mkAttempt("d", 1, NewAttemptFinished(nil), nil)),
+		"other": mkQuest("other",
+			mkAttempt("other", 1, NewAttemptScheduling(), nil),
+			&Attempt{Id: &Attempt_ID{Quest: "other", Id: 2}, DNE: true}),
+	}}
+}
+
+func attemptsOf(g *GraphData) map[string][]uint32 {
+	ret := map[string][]uint32{}
+	for qid, q := range g.Quests {
+		for aid := range q.Attempts {
+			ret[qid] = append(ret[qid], aid)
+		}
+	}
+	return ret
+}
+
+func TestGraphDataFilter(t *testing.T) {
+	t.Parallel()
+
+	Convey("GraphData.Filter", t, func() {
+		g := testGraph()
+
+		Convey("no limits keeps everything but DNE", func() {
+			ret, err := g.Filter(&GraphFilter{MaxDepth: -1})
+			So(err, ShouldBeNil)
+			So(attemptsOf(ret), ShouldResemble, map[string][]uint32{
+				"a": {1}, "b": {1}, "c": {1}, "d": {1}, "other": {1},
+			})
+			So(ret.Quests["a"].Attempts[1].FwdDeps, ShouldResemble,
+				NewAttemptList(map[string][]uint32{"b": {1}, "c": {1}}))
+		})
+
+		Convey("states", func() {
+			ret, err := g.Filter(&GraphFilter{
+				MaxDepth: -1,
+				States:   []Attempt_State{Attempt_WAITING, Attempt_FINISHED},
+			})
+			So(err, ShouldBeNil)
+			So(attemptsOf(ret), ShouldResemble, map[string][]uint32{
+				"a": {1}, "b": {1}, "d": {1},
+			})
+
+			Convey("prunes deps on dropped attempts", func() {
+				So(ret.Quests["a"].Attempts[1].FwdDeps, ShouldResemble,
+					NewAttemptList(map[string][]uint32{"b": {1}}))
+				So(g.Quests["a"].Attempts[1].FwdDeps, ShouldResemble,
+					NewAttemptList(map[string][]uint32{"b": {1}, "c": {1}}))
+			})
+		})
+
+		Convey("quest patterns", func() {
+			ret, err := g.Filter(&GraphFilter{
+				MaxDepth:      -1,
+				QuestPatterns: []string{"[ab]", "oth*"},
+			})
+			So(err, ShouldBeNil)
+			So(attemptsOf(ret), ShouldResemble, map[string][]uint32{
+				"a": {1}, "b": {1}, "other": {1},
+			})
+		})
+
+		Convey("depth", func() {
+			Convey("default roots", func() {
+				ret, err := g.Filter(&GraphFilter{MaxDepth: 0})
+				So(err, ShouldBeNil)
+				So(attemptsOf(ret), ShouldResemble, map[string][]uint32{
+					"a": {1}, "other": {1},
+				})
+
+				ret, err = g.Filter(&GraphFilter{MaxDepth: 1})
+				So(err, ShouldBeNil)
+				So(attemptsOf(ret), ShouldResemble, map[string][]uint32{
+					"a": {1}, "b": {1}, "c": {1}, "other": {1},
+				})
+			})
+
+			Convey("explicit roots", func() {
+				ret, err := g.Filter(&GraphFilter{
+					MaxDepth: 1,
+					Roots:    NewAttemptList(map[string][]uint32{"b": {1}}),
+				})
+				So(err, ShouldBeNil)
+				So(attemptsOf(ret), ShouldResemble, map[string][]uint32{
+					"b": {1}, "d": {1},
+				})
+			})
+		})
+
+		Convey("errors", func() {
+			_, err := g.Filter(&GraphFilter{QuestPatterns: []string{"[a"}})
+			So(err, ShouldErrLike, "bad quest pattern")
+
+			_, err = g.Filter(&GraphFilter{MaxDepth: -2})
+			So(err, ShouldErrLike, "bad MaxDepth")
+		})
+	})
+}
@@ -12,1833 +12,1837 @@ func init() {
 			"dm.Deps",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 9, 110, 136, 0, 255, 236, 253, 11, 116, 156, 89,
-			117, 32, 10, 235, 123, 84, 169, 116, 244, 46, 61, 253, 201, 143,
-			207, 106, 187, 37, 181, 165, 178, 45, 55, 134, 118, 211, 221, 145,
-			45, 185, 173, 110, 219, 50, 37, 185, 159, 208, 246, 167, 170, 79,
-			82, 225, 82, 149, 168, 42, 89, 45, 160, 129, 0, 29, 6, 248,
-			89, 153, 16, 230, 39, 147, 16, 158, 179, 200, 192, 144, 12, 121,
-			144, 203, 172, 201, 131, 60, 97, 2, 185, 25, 242, 128, 112, 201,
-			107, 86, 114, 87, 30, 51, 220, 112, 215, 220, 76, 114, 47, 201,
-			229, 238, 189, 207, 62, 143, 175, 36, 217, 110, 30, 247, 174, 97,
-			218, 171, 219, 174, 179, 191, 243, 220, 231, 156, 125, 246, 217, 103,
-			63, 196, 127, 61, 44, 14, 174, 86, 171, 171, 229, 248, 248, 70,
-			173, 218, 168, 46, 111, 174, 28, 47, 110, 214, 162, 70, 169, 90,
-			201, 17, 36, 219, 45, 191, 231, 212, 247, 209, 51, 34, 51, 203,
-			89, 178, 195, 162, 181, 30, 23, 170, 149, 98, 125, 216, 9, 157,
-			113, 47, 175, 146, 217, 126, 145, 170, 68, 149, 106, 125, 216, 5,
-			120, 42, 47, 19, 103, 175, 136, 190, 66, 117, 61, 215, 84, 229,
-			217, 78, 85, 225, 21, 132, 92, 113, 62, 232, 56, 31, 118, 189,
-			135, 175, 156, 253, 184, 123, 240, 97, 153, 247, 10, 231, 205, 61,
-			30, 151, 203, 143, 86, 170, 91, 149, 165, 237, 141, 184, 254, 200,
-			199, 66, 145, 206, 250, 7, 91, 174, 57, 226, 11, 29, 194, 233,
-			200, 122, 7, 91, 178, 211, 191, 210, 17, 82, 129, 66, 181, 28,
-			158, 221, 92, 89, 137, 107, 245, 112, 42, 148, 85, 141, 213, 195,
-			98, 212, 136, 194, 82, 165, 17, 215, 10, 107, 81, 101, 53, 14,
-			87, 170, 181, 245, 168, 33, 194, 115, 213, 141, 237, 90, 105, 117,
-			173, 17, 78, 159, 56, 241, 50, 46, 16, 206, 87, 10, 185, 48,
-			156, 41, 151, 67, 250, 86, 15, 107, 113, 61, 174, 221, 140, 139,
-			57, 17, 174, 53, 26, 27, 245, 51, 199, 143, 23, 227, 155, 113,
-			185, 186, 1, 13, 169, 209, 193, 64, 37, 82, 161, 19, 83, 203,
-			178, 19, 199, 133, 8, 243, 113, 177, 84, 111, 212, 74, 203, 155,
-			56, 226, 48, 170, 20, 195, 205, 122, 12, 221, 9, 235, 213, 205,
-			90, 33, 38, 200, 114, 169, 18, 213, 182, 169, 95, 245, 201, 112,
-			171, 212, 88, 11, 171, 53, 250, 183, 186, 9, 253, 92, 175, 22,
-			75, 43, 165, 2, 225, 108, 50, 140, 106, 113, 8, 45, 175, 151,
-			26, 141, 184, 24, 66, 155, 55, 75, 69, 248, 209, 88, 139, 26,
-			240, 23, 142, 174, 92, 174, 110, 149, 42, 171, 33, 206, 77, 9,
-			11, 213, 177, 16, 212, 19, 55, 206, 64, 151, 240, 207, 61, 77,
-			29, 171, 135, 213, 21, 213, 163, 66, 181, 24, 135, 235, 155, 245,
-			6, 140, 188, 17, 65, 79, 177, 214, 104, 185, 122, 19, 63, 49,
-			198, 68, 88, 169, 54, 74, 133, 120, 18, 62, 150, 234, 97, 25,
-			170, 194, 26, 236, 22, 43, 197, 166, 238, 64, 123, 133, 114, 84,
-			90, 143, 107, 185, 189, 58, 1, 141, 89, 184, 80, 157, 128, 49,
-			22, 55, 161, 99, 186, 31, 194, 116, 228, 219, 234, 135, 8, 121,
-			116, 197, 106, 97, 115, 61, 174, 52, 34, 53, 73, 199, 1, 255,
-			85, 248, 82, 11, 97, 165, 196, 181, 82, 84, 174, 27, 84, 211,
-			4, 193, 71, 17, 218, 189, 215, 131, 186, 28, 151, 168, 36, 86,
-			92, 137, 214, 99, 236, 144, 189, 182, 42, 85, 243, 141, 240, 94,
-			106, 212, 113, 68, 21, 89, 85, 21, 86, 239, 122, 180, 29, 46,
-			199, 184, 82, 160, 243, 213, 48, 174, 20, 1, 26, 227, 162, 128,
-			78, 172, 87, 27, 113, 40, 113, 2, 171, 179, 8, 189, 131, 197,
-			25, 174, 192, 7, 33, 177, 80, 175, 174, 52, 182, 112, 153, 240,
-			10, 10, 235, 27, 113, 1, 87, 16, 148, 42, 225, 194, 170, 225,
-			218, 169, 200, 85, 84, 175, 83, 223, 69, 184, 116, 97, 126, 49,
-			92, 92, 56, 191, 244, 248, 76, 126, 46, 132, 223, 87, 242, 11,
-			143, 205, 207, 206, 205, 134, 103, 159, 132, 143, 115, 225, 185, 133,
-			43, 79, 230, 231, 31, 190, 176, 20, 94, 88, 184, 56, 59, 151,
-			95, 12, 103, 46, 207, 2, 244, 242, 82, 126, 254, 236, 213, 165,
-			133, 252, 162, 8, 71, 103, 22, 161, 232, 40, 125, 153, 185, 252,
-			100, 56, 247, 196, 149, 252, 220, 226, 98, 184, 144, 15, 231, 47,
-			93, 185, 56, 15, 181, 65, 245, 249, 153, 203, 75, 243, 115, 139,
-			147, 225, 252, 229, 115, 23, 175, 206, 206, 95, 126, 120, 50, 132,
-			26, 194, 203, 11, 75, 34, 188, 56, 127, 105, 126, 9, 242, 45,
-			45, 76, 82, 179, 59, 203, 133, 11, 231, 195, 75, 115, 249, 115,
-			23, 32, 57, 115, 118, 254, 226, 252, 210, 147, 212, 224, 249, 249,
-			165, 203, 216, 216, 249, 133, 188, 8, 103, 194, 43, 51, 249, 165,
-			249, 115, 87, 47, 206, 228, 195, 43, 87, 243, 87, 22, 22, 231,
-			66, 28, 217, 236, 252, 226, 185, 139, 51, 243, 151, 230, 102, 115,
-			208, 62, 180, 25, 206, 61, 54, 119, 121, 41, 92, 188, 48, 115,
-			241, 98, 114, 160, 34, 92, 120, 252, 242, 92, 30, 123, 111, 15,
-			51, 60, 59, 7, 189, 156, 57, 123, 113, 14, 155, 162, 113, 206,
-			206, 231, 231, 206, 45, 225, 128, 204, 175, 115, 128, 60, 232, 224,
-			197, 73, 17, 46, 94, 153, 59, 55, 15, 191, 0, 31, 115, 48,
-			156, 153, 252, 147, 147, 92, 233, 226, 220, 43, 174, 66, 46, 248,
-			24, 206, 206, 92, 154, 121, 24, 70, 55, 126, 59, 172, 192, 196,
-			156, 187, 154, 159, 187, 132, 189, 6, 84, 44, 94, 61, 187, 184,
-			52, 191, 116, 117, 105, 46, 124, 120, 97, 97, 150, 144, 189, 56,
-			151, 127, 108, 254, 220, 220, 226, 253, 225, 197, 133, 69, 66, 216,
-			213, 197, 57, 232, 200, 236, 204, 210, 12, 53, 13, 117, 0, 186,
-			224, 59, 252, 62, 123, 117, 113, 158, 16, 55, 127, 121, 105, 46,
-			159, 191, 122, 101, 105, 126, 225, 242, 4, 204, 242, 227, 128, 25,
-			232, 229, 12, 148, 157, 37, 12, 47, 92, 198, 209, 226, 90, 153,
-			91, 200, 63, 137, 213, 34, 30, 104, 6, 38, 195, 199, 47, 204,
-			1, 60, 143, 72, 37, 108, 205, 32, 26, 22, 1, 107, 231, 150,
-			236, 108, 208, 32, 32, 17, 134, 100, 198, 25, 94, 158, 123, 248,
-			226, 252, 195, 115, 151, 207, 205, 225, 231, 5, 172, 230, 241, 249,
-			197, 185, 9, 152, 176, 249, 69, 204, 48, 79, 13, 195, 26, 128,
-			70, 175, 210, 168, 113, 162, 160, 95, 66, 254, 182, 150, 238, 36,
-			205, 103, 56, 127, 62, 156, 153, 125, 108, 30, 123, 206, 185, 97,
-			5, 44, 206, 243, 114, 33, 180, 157, 187, 192, 56, 135, 229, 159,
-			17, 142, 155, 245, 194, 204, 16, 254, 202, 100, 189, 209, 150, 251,
-			69, 187, 240, 51, 127, 217, 218, 34, 19, 29, 34, 133, 9, 200,
-			52, 218, 58, 36, 58, 69, 154, 82, 45, 50, 217, 37, 90, 101,
-			210, 145, 105, 206, 220, 10, 169, 224, 12, 215, 120, 87, 203, 36,
-			215, 232, 200, 132, 204, 132, 205, 222, 213, 218, 199, 53, 58, 88,
-			35, 38, 101, 141, 14, 213, 136, 105, 206, 12, 53, 222, 53, 120,
-			140, 107, 60, 210, 146, 227, 26, 93, 153, 144, 153, 92, 76, 181,
-			142, 112, 141, 46, 214, 136, 73, 89, 163, 75, 53, 98, 154, 51,
-			67, 141, 71, 14, 78, 113, 141, 71, 91, 70, 185, 70, 79, 38,
-			100, 38, 15, 106, 60, 218, 26, 112, 141, 30, 214, 136, 73, 89,
-			163, 71, 53, 98, 154, 51, 99, 209, 3, 135, 185, 198, 187, 245,
-			168, 125, 153, 144, 153, 124, 168, 241, 238, 214, 35, 92, 163, 143,
-			53, 98, 82, 214, 232, 83, 141, 152, 230, 204, 80, 227, 221, 99,
-			106, 212, 99, 45, 135, 185, 198, 148, 76, 200, 76, 41, 168, 113,
-			172, 117, 152, 107, 76, 97, 141, 152, 148, 53, 166, 168, 70, 76,
-			115, 102, 24, 245, 216, 72, 40, 254, 92, 8, 215, 111, 201, 250,
-			121, 224, 40, 130, 47, 33, 209, 80, 188, 9, 29, 59, 112, 240,
-			87, 128, 196, 70, 97, 189, 180, 90, 137, 139, 147, 225, 74, 233,
-			217, 184, 56, 85, 142, 43, 171, 112, 2, 212, 55, 162, 10, 146,
-			239, 6, 28, 37, 38, 123, 92, 20, 97, 132, 101, 10, 213, 205,
-			10, 29, 70, 204, 36, 209, 73, 180, 82, 139, 10, 230, 188, 85,
-			31, 224, 20, 67, 134, 137, 146, 2, 249, 141, 106, 89, 30, 41,
-			225, 124, 35, 44, 225, 177, 88, 140, 55, 224, 16, 136, 101, 133,
-			81, 101, 59, 44, 68, 208, 139, 98, 84, 163, 90, 161, 92, 33,
-			222, 104, 224, 249, 119, 35, 14, 71, 139, 209, 246, 168, 192, 195,
-			98, 116, 29, 14, 149, 181, 81, 85, 77, 45, 46, 71, 13, 121,
-			162, 44, 65, 159, 235, 141, 104, 125, 67, 158, 128, 204, 58, 0,
-			155, 1, 124, 75, 12, 149, 193, 233, 211, 216, 138, 227, 10, 156,
-			40, 91, 118, 238, 155, 81, 121, 19, 14, 173, 18, 14, 80, 163,
-			10, 187, 80, 106, 64, 151, 42, 120, 104, 69, 69, 60, 35, 161,
-			245, 250, 230, 114, 3, 135, 139, 24, 193, 211, 9, 138, 232, 138,
-			114, 97, 158, 88, 49, 172, 104, 3, 206, 178, 103, 75, 120, 206,
-			150, 183, 195, 99, 83, 39, 79, 76, 158, 56, 113, 34, 220, 142,
-			35, 224, 174, 224, 92, 154, 123, 22, 242, 195, 217, 121, 242, 12,
-			48, 108, 235, 27, 155, 112, 248, 233, 150, 169, 218, 68, 15, 137,
-			135, 216, 168, 199, 155, 197, 42, 49, 50, 57, 102, 120, 204, 16,
-			224, 239, 90, 35, 124, 32, 204, 229, 114, 247, 55, 127, 3, 148,
-			38, 190, 232, 134, 20, 155, 172, 190, 202, 207, 154, 121, 86, 51,
-			249, 0, 214, 160, 83, 83, 178, 45, 149, 190, 191, 169, 16, 205,
-			57, 23, 145, 191, 85, 1, 74, 169, 70, 74, 43, 225, 248, 142,
-			134, 94, 30, 158, 8, 239, 190, 187, 185, 174, 7, 195, 19, 19,
-			225, 235, 100, 177, 93, 122, 119, 236, 129, 240, 228, 253, 59, 190,
-			114, 211, 240, 237, 132, 250, 195, 153, 158, 11, 227, 50, 242, 170,
-			86, 7, 234, 186, 178, 7, 119, 237, 193, 203, 111, 221, 131, 169,
-			91, 244, 224, 216, 110, 61, 176, 230, 127, 218, 204, 191, 153, 48,
-			90, 0, 38, 121, 204, 204, 216, 11, 95, 6, 123, 78, 246, 222,
-			139, 68, 126, 178, 231, 252, 129, 228, 156, 67, 143, 154, 145, 112,
-			191, 41, 164, 86, 128, 53, 235, 118, 129, 29, 203, 192, 148, 73,
-			226, 57, 177, 232, 108, 20, 155, 2, 187, 98, 215, 204, 175, 201,
-			248, 160, 157, 113, 143, 54, 142, 237, 222, 198, 174, 107, 72, 224,
-			31, 207, 71, 250, 155, 207, 244, 136, 13, 225, 251, 116, 108, 62,
-			230, 246, 7, 133, 112, 145, 8, 171, 166, 132, 72, 77, 129, 14,
-			217, 148, 53, 23, 94, 194, 91, 0, 16, 22, 154, 235, 169, 83,
-			39, 95, 50, 249, 146, 151, 158, 70, 26, 129, 255, 11, 164, 102,
-			199, 154, 128, 48, 249, 133, 242, 102, 29, 248, 98, 152, 247, 78,
-			145, 194, 22, 253, 172, 255, 152, 155, 207, 226, 1, 128, 201, 20,
-			246, 160, 85, 165, 160, 115, 143, 101, 186, 85, 10, 142, 155, 199,
-			178, 125, 226, 45, 30, 245, 21, 190, 61, 227, 102, 131, 191, 115,
-			85, 103, 19, 52, 60, 226, 190, 39, 137, 184, 69, 195, 237, 49,
-			9, 51, 40, 181, 216, 128, 102, 199, 245, 58, 146, 96, 200, 89,
-			137, 117, 109, 181, 196, 145, 34, 175, 28, 81, 8, 195, 189, 206,
-			184, 186, 14, 135, 81, 92, 46, 18, 241, 141, 194, 141, 106, 29,
-			238, 59, 55, 233, 138, 80, 137, 87, 35, 250, 125, 157, 58, 196,
-			25, 115, 225, 121, 248, 166, 247, 49, 117, 197, 106, 16, 190, 173,
-			87, 107, 112, 143, 138, 224, 126, 82, 153, 122, 109, 92, 171, 74,
-			98, 143, 55, 49, 26, 64, 162, 54, 121, 53, 91, 142, 133, 30,
-			30, 94, 116, 240, 152, 196, 195, 143, 178, 39, 251, 217, 60, 141,
-			247, 221, 119, 223, 36, 255, 47, 167, 208, 2, 236, 50, 125, 14,
-			76, 223, 51, 238, 99, 253, 60, 69, 78, 10, 39, 69, 77, 159,
-			131, 83, 148, 233, 84, 41, 152, 190, 103, 122, 122, 151, 211, 116,
-			29, 63, 37, 254, 217, 152, 56, 212, 44, 245, 104, 232, 115, 104,
-			15, 177, 199, 253, 162, 77, 111, 248, 23, 44, 247, 88, 218, 93,
-			238, 209, 165, 107, 52, 130, 143, 127, 184, 115, 217, 199, 167, 143,
-			74, 217, 199, 250, 139, 178, 143, 23, 101, 31, 47, 202, 62, 94,
-			148, 125, 188, 40, 251, 120, 81, 246, 241, 223, 141, 236, 227, 144,
-			45, 251, 56, 148, 144, 125, 12, 37, 101, 31, 67, 77, 178, 15,
-			85, 35, 158, 235, 119, 5, 7, 181, 236, 99, 210, 150, 125, 76,
-			38, 100, 31, 125, 73, 217, 71, 95, 147, 236, 163, 207, 150, 125,
-			104, 105, 202, 209, 150, 227, 182, 236, 227, 120, 66, 246, 49, 146,
-			148, 125, 140, 52, 201, 62, 148, 52, 197, 131, 26, 143, 30, 204,
-			105, 217, 199, 168, 45, 251, 24, 77, 200, 62, 130, 164, 236, 35,
-			104, 146, 125, 4, 182, 236, 67, 75, 83, 198, 244, 168, 83, 50,
-			97, 203, 62, 142, 36, 101, 31, 71, 154, 100, 31, 74, 154, 146,
-			130, 26, 199, 180, 52, 101, 92, 75, 83, 210, 50, 33, 51, 165,
-			161, 198, 113, 45, 77, 73, 99, 141, 227, 90, 154, 146, 166, 26,
-			199, 181, 52, 37, 13, 163, 30, 31, 9, 197, 187, 122, 164, 52,
-			37, 2, 30, 37, 120, 83, 15, 144, 33, 115, 105, 74, 136, 83,
-			54, 170, 192, 146, 208, 249, 131, 162, 147, 93, 164, 27, 4, 127,
-			45, 176, 167, 36, 198, 80, 178, 142, 201, 4, 75, 12, 124, 230,
-			29, 72, 86, 196, 30, 92, 57, 52, 126, 117, 233, 92, 56, 183,
-			81, 45, 172, 49, 75, 46, 133, 36, 113, 5, 121, 0, 228, 84,
-			240, 188, 164, 147, 14, 24, 172, 114, 188, 1, 71, 109, 248, 112,
-			45, 94, 173, 194, 137, 88, 9, 207, 41, 249, 203, 214, 90, 9,
-			106, 136, 159, 133, 67, 165, 40, 57, 95, 147, 73, 117, 92, 132,
-			203, 81, 225, 6, 28, 75, 152, 163, 74, 162, 13, 228, 189, 155,
-			155, 140, 234, 245, 205, 117, 108, 53, 2, 6, 12, 126, 192, 85,
-			151, 184, 151, 240, 52, 240, 253, 106, 72, 229, 106, 101, 117, 50,
-			44, 229, 160, 116, 57, 142, 54, 204, 80, 33, 223, 104, 125, 29,
-			170, 142, 139, 163, 112, 12, 74, 166, 168, 82, 181, 115, 193, 25,
-			25, 45, 151, 73, 234, 82, 137, 99, 108, 18, 153, 123, 98, 15,
-			55, 144, 223, 145, 50, 39, 45, 153, 145, 199, 42, 220, 167, 78,
-			78, 209, 127, 75, 39, 78, 156, 161, 255, 158, 194, 81, 0, 163,
-			126, 223, 212, 201, 105, 184, 144, 45, 77, 159, 58, 243, 146, 251,
-			224, 191, 220, 125, 234, 207, 83, 192, 17, 156, 221, 70, 132, 195,
-			193, 14, 243, 130, 168, 228, 46, 213, 176, 122, 224, 244, 98, 24,
-			119, 125, 179, 22, 75, 40, 36, 81, 126, 4, 189, 188, 25, 195,
-			189, 188, 81, 21, 60, 171, 112, 89, 8, 243, 231, 207, 133, 167,
-			78, 157, 186, 15, 217, 89, 184, 101, 64, 149, 149, 213, 58, 180,
-			176, 24, 199, 225, 211, 138, 47, 221, 218, 218, 202, 149, 226, 198,
-			74, 174, 90, 91, 61, 94, 91, 41, 224, 255, 88, 40, 215, 120,
-			182, 241, 170, 241, 59, 201, 53, 177, 135, 184, 169, 73, 220, 0,
-			180, 116, 254, 137, 240, 58, 46, 154, 241, 137, 235, 59, 37, 11,
-			250, 114, 193, 87, 96, 115, 217, 168, 199, 141, 107, 60, 95, 227,
-			84, 252, 242, 213, 139, 23, 39, 38, 118, 205, 71, 203, 118, 252,
-			196, 196, 253, 119, 36, 2, 225, 62, 173, 198, 13, 172, 165, 186,
-			82, 140, 182, 173, 190, 1, 198, 128, 95, 162, 6, 224, 78, 23,
-			54, 110, 114, 139, 137, 236, 119, 55, 110, 78, 134, 212, 161, 251,
-			191, 213, 33, 221, 204, 53, 110, 98, 234, 86, 35, 146, 153, 128,
-			187, 43, 0, 187, 136, 226, 130, 196, 8, 79, 237, 57, 194, 199,
-			75, 149, 83, 211, 225, 245, 135, 227, 198, 226, 118, 189, 17, 175,
-			227, 231, 153, 250, 249, 82, 57, 94, 74, 78, 196, 249, 249, 139,
-			115, 75, 192, 226, 132, 43, 13, 238, 198, 94, 101, 238, 94, 105,
-			168, 158, 94, 133, 227, 255, 244, 189, 208, 225, 194, 13, 20, 200,
-			140, 143, 143, 75, 200, 196, 74, 35, 87, 220, 186, 0, 220, 246,
-			44, 44, 61, 44, 53, 17, 190, 252, 229, 225, 169, 233, 137, 240,
-			245, 33, 125, 187, 88, 221, 82, 159, 20, 222, 142, 31, 7, 210,
-			7, 253, 45, 86, 183, 234, 84, 37, 110, 38, 24, 170, 69, 138,
-			234, 57, 157, 33, 38, 18, 116, 242, 244, 206, 93, 166, 107, 195,
-			226, 39, 79, 223, 123, 239, 189, 47, 61, 117, 26, 170, 81, 91,
-			126, 57, 134, 237, 27, 135, 87, 43, 165, 103, 85, 45, 247, 189,
-			244, 68, 115, 45, 185, 111, 109, 50, 199, 229, 248, 1, 21, 18,
-			41, 199, 181, 108, 103, 2, 46, 152, 86, 119, 110, 179, 130, 177,
-			30, 68, 151, 170, 231, 168, 85, 15, 45, 128, 137, 196, 2, 184,
-			119, 207, 5, 240, 72, 116, 51, 10, 175, 203, 137, 204, 21, 54,
-			107, 53, 56, 6, 48, 203, 165, 82, 25, 174, 62, 214, 2, 64,
-			10, 9, 228, 19, 161, 48, 149, 123, 23, 184, 197, 50, 135, 114,
-			70, 84, 93, 137, 183, 206, 110, 150, 202, 112, 217, 24, 159, 192,
-			129, 45, 50, 134, 184, 9, 137, 152, 9, 37, 14, 11, 67, 204,
-			115, 89, 142, 29, 72, 43, 142, 156, 115, 202, 161, 243, 176, 9,
-			3, 19, 185, 101, 172, 153, 250, 98, 112, 240, 146, 61, 113, 192,
-			163, 80, 231, 102, 120, 101, 27, 46, 57, 21, 53, 240, 74, 117,
-			11, 58, 78, 135, 153, 36, 78, 188, 251, 181, 104, 18, 186, 51,
-			14, 153, 24, 174, 164, 143, 8, 69, 48, 138, 159, 101, 86, 217,
-			197, 123, 238, 185, 111, 162, 105, 94, 109, 188, 140, 115, 230, 7,
-			248, 223, 73, 89, 225, 3, 244, 247, 132, 176, 228, 126, 81, 166,
-			87, 124, 200, 81, 130, 191, 21, 183, 63, 248, 97, 7, 174, 191,
-			154, 33, 176, 164, 127, 120, 38, 211, 224, 224, 244, 45, 216, 75,
-			91, 236, 190, 182, 147, 82, 37, 250, 107, 143, 243, 74, 236, 118,
-			96, 61, 181, 135, 164, 112, 197, 141, 250, 44, 73, 225, 74, 66,
-			82, 184, 146, 144, 20, 174, 100, 251, 196, 95, 56, 74, 82, 88,
-			118, 179, 193, 151, 156, 240, 114, 181, 50, 165, 197, 112, 47, 76,
-			94, 152, 131, 59, 180, 44, 168, 206, 124, 245, 232, 66, 87, 111,
-			83, 25, 9, 8, 234, 13, 88, 90, 225, 90, 4, 205, 84, 236,
-			54, 229, 220, 202, 130, 66, 158, 176, 242, 61, 10, 40, 6, 242,
-			33, 138, 249, 106, 66, 224, 9, 62, 216, 141, 104, 110, 119, 81,
-			92, 217, 93, 177, 69, 113, 229, 132, 40, 174, 156, 16, 197, 149,
-			45, 81, 220, 47, 14, 136, 145, 102, 81, 92, 188, 190, 209, 216,
-			222, 75, 12, 215, 42, 82, 115, 248, 125, 47, 85, 34, 65, 95,
-			191, 37, 113, 218, 199, 250, 165, 56, 237, 84, 207, 139, 226, 180,
-			23, 197, 105, 47, 138, 211, 94, 20, 167, 189, 40, 78, 123, 81,
-			156, 246, 223, 139, 56, 237, 59, 175, 74, 116, 204, 22, 167, 29,
-			251, 214, 85, 137, 238, 249, 31, 84, 149, 72, 137, 211, 14, 217,
-			226, 180, 67, 9, 113, 218, 80, 82, 156, 54, 212, 36, 78, 83,
-			115, 157, 134, 62, 142, 7, 7, 197, 55, 92, 18, 167, 121, 167,
-			90, 122, 130, 191, 117, 129, 10, 173, 198, 21, 160, 208, 133, 144,
-			88, 38, 56, 130, 235, 245, 104, 149, 197, 39, 219, 213, 77, 146,
-			159, 212, 226, 41, 228, 9, 128, 208, 71, 55, 171, 165, 34, 144,
-			244, 149, 82, 133, 78, 170, 205, 141, 50, 158, 251, 168, 140, 147,
-			40, 79, 39, 37, 20, 175, 133, 51, 87, 230, 225, 90, 58, 19,
-			54, 182, 55, 32, 103, 57, 140, 249, 58, 82, 34, 161, 21, 177,
-			26, 13, 245, 186, 91, 139, 95, 3, 156, 101, 131, 196, 115, 50,
-			93, 223, 128, 35, 50, 198, 194, 177, 148, 226, 97, 125, 200, 39,
-			172, 85, 249, 237, 185, 84, 129, 123, 3, 112, 245, 138, 113, 64,
-			86, 8, 206, 91, 248, 86, 53, 106, 6, 181, 141, 66, 120, 54,
-			170, 141, 55, 113, 122, 57, 98, 244, 38, 144, 141, 216, 172, 193,
-			81, 188, 199, 119, 75, 109, 100, 9, 122, 245, 200, 34, 80, 25,
-			45, 51, 100, 149, 33, 232, 201, 117, 202, 125, 157, 100, 111, 132,
-			11, 202, 88, 93, 126, 117, 92, 104, 132, 215, 95, 247, 28, 94,
-			37, 213, 229, 229, 84, 166, 83, 243, 175, 127, 149, 21, 47, 89,
-			133, 131, 112, 115, 153, 152, 180, 242, 102, 161, 68, 127, 77, 173,
-			86, 143, 23, 215, 143, 71, 27, 165, 227, 60, 168, 227, 55, 79,
-			30, 71, 84, 212, 153, 179, 117, 139, 235, 193, 237, 94, 161, 131,
-			91, 241, 198, 163, 15, 137, 236, 165, 205, 114, 163, 4, 236, 41,
-			28, 182, 141, 237, 199, 144, 183, 207, 78, 136, 180, 100, 242, 135,
-			157, 208, 27, 111, 159, 238, 205, 21, 215, 115, 137, 44, 121, 206,
-			48, 250, 63, 59, 162, 51, 89, 56, 43, 60, 224, 57, 232, 77,
-			187, 237, 66, 75, 30, 19, 8, 3, 38, 151, 222, 179, 59, 16,
-			6, 9, 132, 85, 54, 215, 135, 61, 124, 251, 70, 24, 36, 16,
-			6, 236, 213, 112, 10, 96, 25, 132, 65, 34, 123, 66, 248, 56,
-			164, 225, 52, 0, 219, 167, 131, 102, 110, 61, 167, 175, 142, 80,
-			128, 114, 102, 39, 133, 95, 217, 44, 151, 135, 91, 169, 196, 224,
-			142, 18, 52, 79, 152, 27, 115, 157, 133, 219, 0, 141, 229, 17,
-			63, 227, 247, 164, 70, 63, 226, 136, 246, 25, 224, 62, 32, 207,
-			69, 96, 158, 178, 99, 194, 109, 84, 161, 231, 136, 135, 33, 196,
-			131, 245, 49, 183, 84, 157, 3, 166, 104, 59, 15, 89, 96, 107,
-			249, 151, 55, 215, 235, 217, 65, 108, 125, 93, 162, 174, 243, 172,
-			219, 227, 228, 41, 29, 60, 42, 90, 57, 123, 182, 71, 120, 55,
-			226, 109, 137, 162, 60, 254, 204, 222, 195, 157, 32, 20, 181, 79,
-			247, 55, 55, 132, 53, 231, 101, 150, 51, 238, 203, 156, 71, 62,
-			209, 133, 247, 12, 191, 229, 148, 35, 62, 229, 208, 61, 3, 246,
-			244, 244, 199, 157, 196, 149, 225, 228, 105, 90, 175, 23, 175, 158,
-			155, 15, 103, 54, 97, 203, 212, 112, 39, 238, 122, 111, 184, 90,
-			143, 165, 206, 5, 113, 103, 134, 203, 134, 228, 42, 240, 179, 53,
-			212, 80, 217, 172, 20, 153, 105, 156, 217, 136, 10, 88, 49, 44,
-			73, 216, 157, 147, 225, 99, 112, 151, 192, 93, 48, 157, 59, 161,
-			174, 133, 82, 107, 111, 5, 110, 135, 69, 197, 195, 94, 132, 243,
-			30, 216, 137, 112, 165, 84, 198, 123, 95, 155, 112, 61, 160, 67,
-			233, 214, 113, 250, 9, 155, 162, 21, 8, 170, 60, 230, 218, 50,
-			184, 85, 72, 236, 223, 222, 210, 233, 232, 109, 211, 158, 9, 144,
-			16, 202, 43, 127, 135, 123, 68, 221, 152, 125, 76, 9, 149, 2,
-			34, 217, 209, 62, 108, 221, 173, 59, 246, 29, 178, 238, 214, 29,
-			163, 119, 81, 229, 78, 214, 239, 110, 25, 149, 149, 227, 13, 179,
-			59, 51, 32, 222, 237, 98, 162, 45, 235, 245, 183, 119, 6, 207,
-			187, 10, 69, 225, 189, 180, 195, 87, 202, 213, 168, 1, 56, 92,
-			66, 52, 193, 127, 85, 190, 227, 44, 199, 133, 8, 41, 25, 144,
-			175, 168, 92, 106, 108, 79, 193, 62, 222, 136, 106, 165, 58, 224,
-			4, 89, 92, 193, 37, 73, 68, 14, 139, 16, 152, 220, 205, 122,
-			121, 155, 52, 35, 75, 5, 216, 127, 192, 232, 73, 28, 1, 89,
-			109, 0, 47, 31, 150, 144, 70, 26, 134, 31, 166, 102, 246, 18,
-			112, 71, 27, 209, 54, 84, 196, 111, 19, 208, 55, 40, 170, 110,
-			240, 178, 129, 45, 188, 184, 47, 35, 229, 68, 170, 3, 83, 27,
-			94, 128, 189, 185, 2, 235, 124, 27, 235, 223, 30, 171, 225, 149,
-			30, 111, 71, 36, 169, 159, 164, 138, 182, 240, 126, 81, 25, 107,
-			16, 16, 169, 114, 125, 115, 99, 163, 90, 163, 219, 218, 58, 204,
-			20, 98, 220, 105, 107, 65, 164, 116, 16, 30, 33, 225, 36, 82,
-			174, 74, 65, 198, 12, 76, 218, 160, 123, 216, 147, 223, 50, 152,
-			115, 16, 238, 238, 84, 9, 78, 219, 144, 47, 133, 32, 14, 9,
-			65, 134, 124, 161, 82, 144, 113, 168, 189, 75, 165, 96, 162, 134,
-			122, 179, 92, 12, 62, 13, 235, 98, 40, 27, 24, 246, 219, 84,
-			10, 191, 233, 98, 40, 27, 24, 214, 197, 160, 91, 251, 116, 49,
-			55, 133, 41, 85, 12, 171, 220, 167, 139, 185, 80, 108, 159, 46,
-			6, 137, 64, 23, 243, 82, 152, 202, 168, 20, 20, 11, 116, 49,
-			228, 36, 2, 40, 246, 110, 135, 202, 193, 42, 60, 232, 143, 5,
-			111, 117, 72, 156, 21, 214, 225, 106, 83, 46, 134, 213, 74, 217,
-			220, 149, 212, 205, 76, 42, 231, 29, 71, 165, 191, 4, 245, 196,
-			115, 83, 132, 15, 215, 162, 141, 181, 87, 108, 198, 181, 237, 197,
-			56, 170, 21, 214, 96, 121, 172, 168, 59, 82, 76, 251, 41, 194,
-			93, 212, 160, 219, 223, 198, 36, 158, 160, 106, 226, 75, 171, 112,
-			123, 195, 45, 205, 93, 244, 211, 216, 169, 3, 42, 5, 221, 63,
-			120, 112, 84, 165, 160, 251, 7, 143, 222, 205, 163, 134, 113, 134,
-			254, 93, 252, 41, 149, 198, 212, 62, 149, 130, 98, 33, 16, 56,
-			78, 65, 177, 240, 240, 168, 248, 57, 7, 54, 145, 155, 245, 199,
-			128, 10, 5, 63, 225, 132, 22, 189, 194, 133, 94, 174, 174, 226,
-			129, 15, 131, 143, 232, 218, 139, 252, 2, 172, 227, 205, 74, 9,
-			14, 249, 48, 146, 153, 81, 154, 64, 79, 93, 107, 172, 221, 12,
-			251, 166, 208, 16, 205, 199, 43, 83, 16, 248, 188, 14, 169, 58,
-			144, 29, 216, 93, 85, 172, 78, 213, 188, 142, 167, 24, 242, 21,
-			170, 98, 86, 33, 19, 82, 135, 140, 248, 138, 241, 250, 4, 31,
-			193, 56, 251, 99, 153, 62, 90, 176, 72, 133, 252, 113, 247, 30,
-			185, 96, 33, 133, 108, 19, 48, 81, 192, 85, 97, 10, 151, 236,
-			132, 127, 12, 185, 42, 153, 244, 49, 221, 97, 210, 128, 183, 137,
-			206, 62, 147, 134, 226, 19, 253, 195, 38, 13, 216, 154, 24, 217,
-			111, 210, 192, 207, 77, 28, 184, 71, 244, 10, 193, 105, 226, 224,
-			39, 14, 78, 8, 88, 127, 6, 228, 34, 236, 136, 128, 62, 90,
-			176, 22, 9, 236, 23, 157, 54, 208, 145, 208, 68, 113, 108, 245,
-			232, 132, 248, 60, 174, 76, 226, 176, 167, 221, 145, 224, 23, 97,
-			101, 86, 165, 154, 53, 172, 27, 196, 16, 225, 165, 62, 213, 168,
-			78, 105, 188, 193, 254, 151, 47, 167, 33, 138, 13, 23, 171, 168,
-			201, 137, 140, 224, 86, 132, 34, 98, 245, 17, 120, 52, 150, 187,
-			200, 114, 225, 232, 74, 181, 250, 250, 147, 163, 147, 242, 199, 244,
-			40, 81, 150, 209, 229, 168, 70, 64, 58, 88, 182, 104, 63, 148,
-			171, 213, 27, 164, 82, 126, 6, 217, 42, 102, 209, 176, 208, 232,
-			153, 240, 233, 147, 147, 225, 244, 171, 38, 25, 6, 133, 9, 38,
-			1, 207, 73, 81, 161, 75, 162, 212, 105, 61, 95, 68, 238, 167,
-			221, 62, 149, 2, 100, 76, 247, 15, 170, 20, 160, 97, 122, 95,
-			160, 89, 173, 79, 252, 123, 71, 156, 185, 115, 94, 107, 21, 247,
-			225, 53, 20, 207, 89, 12, 215, 109, 140, 29, 111, 207, 144, 125,
-			107, 204, 222, 232, 95, 57, 162, 107, 102, 185, 130, 162, 193, 242,
-			121, 56, 39, 234, 107, 217, 147, 34, 13, 21, 55, 54, 165, 6,
-			97, 215, 244, 62, 98, 27, 18, 121, 114, 139, 148, 33, 207, 25,
-			129, 59, 73, 215, 226, 8, 14, 38, 226, 52, 218, 242, 156, 26,
-			125, 135, 35, 210, 50, 107, 182, 93, 180, 206, 95, 126, 108, 230,
-			226, 252, 108, 79, 75, 86, 136, 244, 249, 153, 249, 139, 115, 179,
-			61, 14, 126, 56, 151, 159, 89, 188, 0, 9, 23, 19, 115, 79,
-			92, 153, 207, 67, 194, 203, 118, 138, 54, 124, 28, 155, 189, 6,
-			119, 223, 30, 31, 147, 231, 102, 224, 174, 124, 17, 203, 165, 178,
-			29, 34, 147, 159, 123, 100, 238, 220, 18, 164, 210, 88, 240, 210,
-			252, 34, 94, 154, 123, 90, 179, 253, 162, 39, 63, 183, 120, 245,
-			226, 210, 181, 75, 51, 23, 207, 47, 228, 161, 142, 158, 204, 232,
-			223, 100, 68, 234, 21, 184, 50, 179, 251, 133, 91, 42, 210, 232,
-			218, 167, 59, 112, 116, 4, 206, 205, 207, 230, 1, 142, 124, 212,
-			236, 229, 57, 26, 73, 6, 152, 202, 203, 115, 217, 81, 225, 227,
-			116, 17, 87, 217, 62, 221, 101, 74, 204, 2, 52, 79, 223, 178,
-			167, 68, 70, 45, 246, 97, 223, 240, 117, 50, 31, 19, 177, 186,
-			228, 235, 116, 70, 212, 214, 132, 19, 29, 207, 230, 225, 30, 106,
-			78, 37, 131, 126, 225, 206, 207, 102, 187, 116, 71, 219, 176, 107,
-			193, 31, 251, 194, 159, 141, 235, 133, 236, 105, 49, 164, 133, 112,
-			213, 218, 181, 66, 181, 178, 82, 90, 189, 134, 162, 53, 206, 61,
-			96, 125, 62, 71, 95, 47, 195, 199, 236, 65, 33, 160, 5, 248,
-			5, 84, 190, 206, 147, 101, 65, 178, 47, 17, 131, 118, 189, 86,
-			94, 111, 71, 181, 87, 76, 177, 49, 225, 195, 175, 8, 6, 142,
-			8, 234, 179, 16, 4, 157, 205, 93, 138, 17, 75, 152, 33, 120,
-			159, 39, 124, 76, 102, 15, 8, 17, 213, 175, 69, 5, 146, 244,
-			115, 159, 219, 162, 250, 140, 4, 100, 143, 139, 20, 92, 159, 106,
-			219, 204, 185, 238, 219, 165, 198, 92, 30, 51, 228, 101, 190, 236,
-			203, 68, 134, 158, 135, 55, 27, 117, 158, 166, 253, 187, 149, 89,
-			226, 60, 121, 157, 59, 216, 16, 41, 170, 9, 23, 241, 74, 4,
-			92, 164, 68, 120, 103, 158, 83, 56, 73, 133, 90, 84, 95, 131,
-			15, 46, 125, 80, 73, 252, 18, 63, 187, 81, 130, 147, 145, 218,
-			132, 47, 156, 204, 142, 136, 54, 108, 160, 120, 13, 154, 32, 172,
-			116, 202, 22, 139, 11, 155, 141, 224, 189, 142, 200, 168, 142, 224,
-			72, 233, 212, 230, 229, 184, 111, 199, 189, 66, 105, 95, 231, 101,
-			190, 236, 49, 225, 213, 54, 43, 26, 49, 123, 102, 199, 92, 217,
-			41, 225, 215, 27, 213, 13, 70, 201, 45, 114, 83, 182, 96, 77,
-			116, 44, 193, 202, 68, 211, 155, 69, 96, 12, 104, 125, 214, 170,
-			200, 244, 241, 20, 169, 36, 110, 146, 90, 188, 194, 43, 8, 127,
-			98, 222, 155, 146, 99, 231, 181, 162, 146, 112, 255, 242, 105, 101,
-			250, 4, 166, 223, 193, 15, 195, 17, 130, 187, 39, 123, 47, 98,
-			55, 198, 203, 62, 99, 224, 22, 119, 177, 188, 202, 74, 59, 18,
-			38, 149, 177, 208, 149, 156, 234, 60, 125, 3, 58, 150, 193, 23,
-			203, 198, 181, 229, 109, 232, 145, 71, 151, 54, 157, 207, 30, 102,
-			190, 149, 242, 157, 221, 14, 46, 136, 206, 196, 86, 181, 239, 84,
-			157, 242, 78, 117, 56, 121, 167, 106, 183, 238, 84, 214, 85, 106,
-			180, 33, 196, 35, 64, 1, 243, 196, 77, 227, 210, 146, 55, 118,
-			70, 35, 167, 16, 51, 245, 210, 107, 99, 94, 87, 244, 59, 123,
-			70, 8, 90, 69, 52, 47, 60, 113, 183, 194, 137, 149, 123, 180,
-			36, 210, 220, 162, 34, 89, 142, 65, 144, 233, 15, 147, 172, 251,
-			69, 119, 196, 100, 253, 218, 10, 209, 117, 30, 84, 118, 39, 197,
-			207, 119, 69, 137, 244, 232, 255, 38, 68, 43, 143, 27, 168, 138,
-			161, 167, 93, 22, 66, 246, 166, 168, 71, 18, 20, 181, 199, 46,
-			99, 209, 212, 251, 17, 21, 113, 65, 190, 146, 48, 85, 29, 177,
-			243, 206, 233, 175, 146, 178, 90, 217, 225, 242, 155, 89, 217, 42,
-			94, 3, 198, 162, 78, 87, 255, 246, 233, 238, 166, 251, 111, 190,
-			21, 50, 204, 194, 119, 184, 219, 183, 161, 214, 147, 204, 156, 222,
-			61, 115, 6, 115, 80, 238, 169, 36, 213, 102, 130, 167, 250, 116,
-			69, 126, 50, 164, 252, 30, 34, 229, 253, 34, 69, 108, 17, 175,
-			0, 153, 96, 2, 47, 167, 31, 9, 252, 223, 249, 223, 214, 182,
-			56, 45, 50, 242, 213, 140, 105, 213, 173, 139, 233, 188, 217, 163,
-			162, 171, 178, 185, 126, 205, 66, 182, 164, 103, 157, 0, 53, 56,
-			206, 62, 32, 68, 29, 174, 232, 197, 205, 50, 48, 199, 140, 212,
-			145, 230, 185, 203, 45, 234, 44, 23, 90, 242, 86, 1, 88, 217,
-			109, 220, 2, 148, 86, 130, 151, 230, 210, 115, 42, 7, 20, 54,
-			217, 17, 31, 91, 81, 137, 74, 74, 1, 204, 240, 142, 146, 143,
-			203, 239, 80, 78, 101, 205, 190, 20, 214, 0, 45, 87, 192, 71,
-			198, 156, 36, 137, 98, 231, 57, 3, 148, 211, 153, 97, 164, 59,
-			182, 70, 219, 94, 91, 3, 10, 54, 109, 142, 160, 67, 8, 131,
-			133, 224, 37, 162, 77, 143, 42, 59, 46, 122, 10, 155, 53, 131,
-			234, 107, 37, 117, 222, 116, 1, 92, 35, 123, 190, 8, 235, 166,
-			149, 135, 148, 61, 36, 218, 113, 126, 20, 6, 100, 126, 1, 32,
-			206, 16, 228, 68, 70, 141, 227, 78, 182, 254, 89, 184, 107, 50,
-			19, 114, 13, 185, 193, 224, 162, 232, 110, 218, 75, 187, 144, 190,
-			187, 146, 164, 175, 19, 107, 214, 165, 44, 226, 23, 252, 131, 35,
-			90, 121, 27, 32, 137, 211, 189, 201, 240, 190, 62, 152, 216, 215,
-			146, 44, 216, 91, 119, 159, 181, 117, 61, 201, 23, 169, 157, 58,
-			98, 239, 84, 159, 190, 153, 141, 57, 141, 108, 40, 142, 146, 214,
-			102, 87, 114, 117, 113, 135, 114, 140, 7, 206, 57, 122, 73, 147,
-			76, 96, 74, 47, 46, 204, 204, 206, 33, 131, 218, 37, 196, 229,
-			133, 165, 107, 156, 118, 96, 20, 93, 152, 158, 185, 186, 116, 97,
-			33, 63, 255, 20, 241, 170, 125, 162, 27, 223, 149, 174, 45, 66,
-			250, 26, 61, 84, 245, 120, 163, 79, 136, 20, 50, 188, 49, 214,
-			176, 120, 14, 152, 218, 171, 23, 145, 39, 109, 65, 238, 117, 238,
-			137, 185, 115, 87, 151, 48, 73, 92, 239, 227, 51, 243, 148, 112,
-			145, 149, 61, 63, 127, 121, 158, 120, 96, 47, 59, 32, 122, 103,
-			206, 94, 6, 174, 117, 230, 226, 53, 13, 246, 71, 127, 50, 163,
-			151, 17, 156, 169, 161, 69, 114, 123, 18, 19, 161, 136, 238, 221,
-			140, 120, 139, 164, 155, 60, 22, 145, 221, 155, 7, 125, 80, 248,
-			40, 224, 187, 131, 182, 128, 184, 53, 170, 55, 98, 201, 149, 116,
-			228, 101, 34, 152, 189, 5, 225, 131, 86, 121, 1, 42, 166, 138,
-			147, 76, 18, 61, 77, 18, 255, 40, 245, 255, 9, 73, 188, 36,
-			122, 108, 78, 184, 84, 89, 169, 242, 105, 53, 186, 19, 149, 185,
-			89, 147, 117, 30, 114, 230, 187, 139, 73, 64, 246, 161, 4, 233,
-			148, 124, 242, 129, 93, 42, 218, 147, 120, 158, 22, 173, 192, 208,
-			85, 12, 225, 13, 118, 41, 157, 151, 57, 144, 4, 114, 230, 236,
-			125, 34, 131, 172, 221, 134, 161, 185, 35, 187, 53, 203, 89, 144,
-			8, 170, 236, 88, 84, 83, 207, 214, 61, 139, 222, 41, 253, 204,
-			188, 0, 250, 249, 253, 14, 236, 173, 38, 12, 2, 13, 220, 121,
-			205, 17, 5, 115, 183, 129, 67, 140, 51, 40, 62, 84, 114, 167,
-			157, 18, 202, 226, 100, 179, 86, 37, 151, 42, 19, 72, 237, 54,
-			107, 101, 102, 81, 241, 103, 19, 13, 111, 19, 173, 140, 220, 64,
-			136, 140, 66, 215, 11, 166, 187, 61, 162, 203, 80, 126, 164, 188,
-			163, 143, 239, 69, 48, 128, 66, 228, 175, 94, 190, 44, 201, 5,
-			80, 136, 197, 165, 133, 43, 87, 94, 0, 189, 248, 37, 71, 180,
-			145, 200, 143, 54, 15, 92, 234, 165, 84, 134, 31, 95, 232, 28,
-			212, 159, 37, 83, 204, 76, 20, 103, 196, 43, 218, 90, 84, 188,
-			22, 215, 106, 213, 154, 162, 210, 109, 0, 153, 35, 0, 18, 105,
-			252, 140, 150, 152, 138, 72, 67, 250, 18, 36, 97, 227, 183, 91,
-			21, 238, 242, 48, 113, 40, 121, 146, 180, 105, 190, 220, 126, 141,
-			248, 237, 31, 129, 99, 36, 155, 242, 91, 254, 212, 251, 30, 120,
-			143, 24, 51, 239, 17, 242, 105, 2, 31, 36, 90, 31, 228, 167,
-			137, 118, 243, 52, 209, 217, 82, 48, 79, 19, 157, 153, 65, 18,
-			39, 182, 224, 151, 46, 247, 154, 20, 79, 181, 208, 183, 174, 214,
-			78, 241, 47, 29, 145, 198, 36, 202, 226, 178, 126, 79, 240, 118,
-			71, 62, 35, 72, 185, 140, 244, 199, 0, 24, 45, 73, 219, 91,
-			150, 29, 87, 170, 13, 45, 58, 70, 5, 177, 10, 174, 70, 148,
-			169, 162, 62, 140, 84, 243, 143, 66, 184, 29, 21, 226, 181, 106,
-			153, 176, 81, 13, 75, 104, 242, 80, 90, 217, 14, 79, 76, 241,
-			107, 2, 220, 85, 138, 97, 114, 39, 179, 242, 246, 38, 160, 28,
-			170, 66, 217, 100, 11, 191, 149, 100, 253, 118, 147, 134, 241, 102,
-			187, 186, 197, 15, 186, 220, 119, 248, 126, 192, 239, 14, 190, 201,
-			125, 199, 134, 26, 219, 44, 187, 149, 119, 112, 126, 149, 144, 58,
-			239, 138, 77, 56, 195, 126, 32, 52, 153, 64, 249, 110, 181, 214,
-			176, 181, 194, 26, 81, 253, 70, 40, 119, 92, 44, 113, 32, 43,
-			68, 125, 12, 177, 71, 121, 192, 222, 102, 161, 128, 150, 206, 91,
-			107, 144, 151, 114, 233, 86, 37, 74, 9, 198, 187, 83, 16, 178,
-			201, 106, 95, 221, 29, 101, 207, 202, 81, 189, 97, 21, 132, 205,
-			1, 227, 145, 98, 175, 80, 10, 197, 114, 164, 41, 7, 91, 4,
-			45, 29, 44, 140, 57, 132, 17, 43, 13, 24, 59, 208, 217, 37,
-			254, 179, 154, 109, 124, 244, 135, 217, 254, 106, 18, 99, 140, 43,
-			18, 228, 47, 67, 107, 91, 209, 118, 51, 198, 150, 154, 70, 220,
-			128, 9, 134, 133, 96, 16, 246, 234, 234, 50, 100, 128, 90, 110,
-			150, 170, 101, 168, 183, 188, 205, 88, 192, 23, 4, 204, 96, 104,
-			212, 164, 194, 0, 62, 172, 43, 234, 244, 130, 144, 193, 114, 191,
-			219, 96, 131, 196, 234, 214, 250, 161, 209, 195, 250, 249, 152, 90,
-			63, 40, 142, 5, 108, 252, 75, 55, 68, 118, 152, 30, 155, 170,
-			82, 179, 90, 217, 131, 227, 168, 240, 5, 138, 124, 23, 220, 102,
-			17, 21, 112, 143, 224, 187, 149, 244, 186, 160, 138, 179, 18, 42,
-			41, 242, 204, 94, 98, 92, 147, 57, 248, 106, 220, 64, 85, 89,
-			185, 227, 54, 55, 200, 238, 67, 58, 251, 104, 170, 89, 25, 17,
-			141, 199, 185, 213, 156, 122, 175, 129, 204, 91, 81, 93, 88, 120,
-			149, 189, 230, 33, 76, 220, 25, 42, 43, 33, 139, 77, 111, 131,
-			75, 36, 64, 211, 22, 46, 145, 10, 77, 3, 46, 255, 157, 194,
-			165, 159, 245, 30, 240, 179, 193, 199, 92, 90, 42, 56, 112, 66,
-			3, 238, 24, 24, 4, 118, 235, 134, 238, 219, 237, 48, 201, 37,
-			21, 10, 101, 13, 165, 134, 193, 48, 62, 204, 148, 227, 6, 47,
-			174, 29, 216, 253, 86, 144, 43, 118, 195, 174, 94, 168, 223, 18,
-			102, 181, 12, 250, 54, 184, 197, 103, 176, 7, 252, 78, 147, 6,
-			220, 62, 208, 211, 43, 222, 169, 112, 155, 202, 122, 231, 1, 183,
-			223, 112, 52, 110, 177, 111, 5, 212, 51, 41, 227, 112, 151, 183,
-			177, 57, 52, 207, 170, 85, 80, 167, 69, 238, 234, 241, 181, 205,
-			245, 8, 21, 96, 97, 152, 235, 228, 19, 167, 78, 102, 2, 19,
-			183, 67, 63, 178, 172, 53, 178, 209, 2, 140, 38, 54, 56, 54,
-			187, 81, 139, 113, 228, 165, 155, 113, 121, 91, 152, 78, 220, 233,
-			206, 85, 130, 120, 198, 201, 164, 84, 174, 1, 90, 196, 133, 69,
-			211, 216, 110, 150, 34, 232, 134, 133, 45, 124, 253, 59, 111, 97,
-			11, 21, 153, 206, 3, 182, 254, 163, 162, 113, 233, 172, 119, 217,
-			239, 13, 62, 147, 196, 22, 116, 251, 166, 180, 169, 163, 85, 192,
-			92, 46, 162, 174, 121, 248, 227, 175, 217, 172, 54, 162, 73, 75,
-			221, 179, 62, 41, 194, 184, 81, 200, 77, 220, 246, 24, 89, 81,
-			42, 168, 80, 191, 28, 22, 180, 127, 135, 168, 81, 143, 18, 102,
-			181, 168, 33, 166, 29, 28, 82, 135, 73, 195, 144, 47, 119, 247,
-			136, 159, 87, 67, 110, 205, 122, 143, 1, 33, 251, 168, 25, 50,
-			180, 188, 89, 169, 197, 133, 234, 106, 5, 207, 219, 219, 77, 121,
-			177, 26, 215, 113, 99, 221, 64, 19, 137, 104, 25, 149, 96, 85,
-			239, 105, 134, 240, 76, 133, 69, 177, 90, 69, 69, 88, 193, 57,
-			74, 141, 59, 28, 25, 63, 176, 236, 28, 24, 170, 246, 61, 102,
-			81, 21, 84, 204, 123, 12, 168, 202, 59, 212, 202, 207, 100, 189,
-			103, 252, 125, 193, 255, 229, 236, 56, 128, 106, 81, 37, 73, 31,
-			164, 214, 20, 96, 127, 53, 170, 45, 71, 171, 241, 237, 207, 124,
-			206, 143, 206, 64, 96, 181, 211, 219, 48, 31, 224, 43, 155, 101,
-			230, 28, 168, 110, 121, 128, 33, 191, 27, 226, 57, 111, 57, 60,
-			145, 153, 112, 129, 33, 246, 200, 238, 16, 38, 127, 25, 181, 26,
-			144, 187, 81, 168, 191, 163, 217, 79, 190, 59, 237, 68, 86, 6,
-			61, 128, 248, 253, 38, 13, 200, 122, 102, 104, 216, 104, 160, 68,
-			110, 191, 101, 222, 17, 105, 166, 143, 222, 36, 35, 55, 99, 169,
-			160, 68, 109, 182, 121, 71, 148, 237, 227, 90, 224, 211, 178, 174,
-			5, 141, 32, 150, 221, 200, 54, 130, 88, 214, 181, 32, 171, 177,
-			172, 107, 65, 69, 135, 101, 170, 5, 169, 89, 42, 110, 249, 43,
-			199, 104, 178, 196, 100, 43, 225, 251, 14, 62, 109, 175, 184, 107,
-			172, 139, 65, 79, 219, 43, 240, 27, 159, 182, 29, 249, 180, 189,
-			234, 103, 69, 55, 140, 207, 145, 79, 219, 254, 170, 191, 210, 69,
-			3, 118, 248, 109, 123, 149, 185, 27, 135, 223, 182, 87, 219, 59,
-			77, 26, 58, 177, 10, 148, 64, 235, 118, 148, 220, 14, 26, 138,
-			67, 117, 149, 116, 203, 132, 144, 146, 235, 91, 170, 30, 165, 84,
-			171, 165, 234, 81, 130, 58, 222, 168, 84, 61, 214, 221, 238, 160,
-			22, 206, 94, 158, 195, 77, 85, 143, 233, 213, 25, 216, 85, 242,
-			41, 68, 155, 132, 46, 32, 180, 133, 136, 67, 142, 159, 69, 21,
-			41, 52, 143, 137, 213, 50, 209, 202, 244, 66, 58, 138, 145, 150,
-			172, 146, 191, 86, 181, 36, 43, 103, 75, 20, 135, 38, 97, 221,
-			45, 117, 88, 218, 38, 235, 110, 218, 210, 54, 89, 111, 21, 150,
-			182, 201, 58, 112, 123, 255, 220, 35, 100, 195, 60, 84, 221, 223,
-			117, 188, 209, 55, 121, 225, 210, 194, 236, 194, 120, 41, 170, 84,
-			96, 125, 151, 38, 206, 72, 99, 154, 40, 28, 171, 147, 102, 207,
-			53, 96, 210, 215, 55, 170, 200, 208, 143, 201, 55, 120, 180, 161,
-			136, 165, 46, 4, 189, 139, 223, 3, 221, 163, 21, 142, 227, 136,
-			139, 199, 227, 103, 55, 72, 161, 7, 187, 139, 90, 21, 100, 208,
-			108, 116, 33, 235, 225, 248, 76, 1, 142, 8, 56, 117, 244, 58,
-			167, 87, 243, 57, 178, 134, 213, 215, 71, 162, 45, 143, 71, 229,
-			27, 4, 153, 144, 45, 145, 134, 10, 27, 15, 176, 53, 10, 10,
-			234, 0, 75, 181, 234, 230, 234, 90, 243, 70, 158, 210, 218, 250,
-			99, 116, 110, 143, 97, 45, 210, 29, 143, 49, 55, 35, 253, 11,
-			212, 137, 90, 81, 199, 141, 124, 253, 95, 142, 121, 120, 18, 17,
-			97, 3, 199, 78, 38, 21, 165, 152, 153, 91, 160, 228, 199, 129,
-			218, 109, 16, 19, 131, 231, 236, 252, 226, 194, 197, 153, 37, 121,
-			178, 207, 206, 231, 101, 121, 44, 136, 231, 201, 241, 48, 42, 78,
-			173, 85, 11, 208, 101, 188, 214, 20, 106, 37, 216, 246, 60, 65,
-			30, 78, 87, 21, 150, 235, 55, 28, 90, 241, 180, 70, 223, 224,
-			143, 5, 255, 153, 217, 113, 105, 225, 16, 25, 251, 131, 36, 123,
-			137, 194, 8, 229, 181, 107, 28, 32, 176, 124, 162, 250, 4, 223,
-			42, 132, 82, 53, 69, 11, 30, 83, 172, 158, 43, 172, 172, 202,
-			59, 165, 108, 67, 105, 230, 168, 131, 138, 244, 31, 54, 55, 154,
-			236, 51, 170, 53, 168, 166, 73, 235, 139, 157, 240, 85, 226, 2,
-			43, 193, 72, 187, 32, 252, 189, 181, 22, 87, 52, 145, 67, 28,
-			11, 123, 103, 224, 90, 159, 227, 171, 20, 204, 137, 220, 223, 114,
-			79, 190, 193, 175, 246, 240, 254, 149, 10, 88, 111, 208, 251, 91,
-			238, 203, 55, 180, 143, 154, 52, 172, 239, 55, 28, 189, 91, 252,
-			150, 199, 232, 115, 178, 254, 219, 29, 63, 8, 254, 157, 23, 206,
-			36, 212, 91, 165, 18, 79, 161, 90, 147, 42, 187, 210, 34, 93,
-			42, 180, 225, 60, 154, 215, 105, 155, 209, 207, 145, 62, 109, 61,
-			214, 40, 218, 136, 234, 117, 117, 45, 218, 117, 193, 193, 61, 73,
-			85, 140, 229, 149, 34, 158, 192, 235, 39, 112, 73, 100, 147, 99,
-			117, 75, 170, 183, 145, 145, 14, 244, 0, 26, 138, 232, 198, 184,
-			38, 207, 47, 50, 159, 217, 42, 213, 99, 117, 24, 163, 86, 28,
-			30, 70, 176, 22, 203, 219, 106, 199, 146, 72, 162, 54, 21, 35,
-			242, 11, 200, 210, 21, 214, 226, 245, 40, 199, 186, 192, 9, 46,
-			46, 57, 123, 48, 10, 224, 230, 104, 104, 72, 164, 72, 129, 154,
-			116, 152, 232, 30, 175, 244, 147, 234, 49, 221, 76, 214, 163, 27,
-			49, 109, 105, 35, 71, 12, 139, 48, 104, 98, 134, 118, 107, 236,
-			210, 204, 147, 97, 97, 173, 90, 149, 106, 217, 68, 216, 200, 138,
-			189, 138, 138, 103, 212, 69, 133, 233, 122, 108, 161, 31, 170, 234,
-			225, 201, 5, 58, 151, 130, 201, 124, 195, 152, 94, 31, 64, 234,
-			112, 122, 205, 130, 113, 104, 190, 219, 7, 12, 192, 67, 192, 240,
-			62, 241, 37, 151, 87, 132, 155, 245, 223, 237, 248, 119, 7, 191,
-			225, 222, 233, 138, 216, 117, 98, 77, 15, 69, 114, 133, 236, 28,
-			57, 41, 159, 199, 82, 5, 82, 141, 91, 170, 143, 55, 143, 155,
-			181, 27, 113, 6, 54, 9, 207, 107, 177, 178, 122, 132, 153, 143,
-			113, 27, 51, 230, 53, 170, 153, 209, 90, 3, 166, 172, 97, 24,
-			59, 160, 72, 202, 171, 2, 110, 220, 234, 78, 121, 4, 236, 71,
-			101, 82, 85, 215, 141, 144, 33, 156, 92, 210, 213, 138, 181, 110,
-			101, 191, 74, 104, 207, 70, 6, 94, 91, 72, 245, 112, 237, 194,
-			112, 123, 25, 211, 112, 189, 75, 3, 98, 223, 238, 4, 26, 249,
-			112, 43, 65, 92, 155, 233, 193, 237, 248, 110, 167, 253, 176, 1,
-			120, 8, 56, 114, 20, 230, 152, 102, 7, 142, 255, 244, 123, 28,
-			255, 11, 78, 74, 101, 65, 22, 192, 127, 143, 211, 209, 35, 62,
-			233, 136, 140, 132, 0, 81, 244, 127, 196, 73, 239, 15, 126, 60,
-			65, 21, 177, 179, 128, 182, 154, 210, 71, 10, 89, 5, 132, 240,
-			139, 39, 144, 38, 63, 85, 230, 188, 233, 32, 203, 133, 79, 86,
-			55, 133, 220, 116, 180, 133, 12, 87, 175, 20, 254, 41, 179, 170,
-			141, 40, 25, 61, 1, 176, 103, 12, 69, 195, 200, 198, 205, 112,
-			114, 128, 155, 62, 209, 166, 122, 12, 216, 129, 46, 191, 199, 201,
-			2, 194, 52, 48, 69, 227, 232, 176, 65, 14, 130, 58, 135, 108,
-			144, 135, 160, 96, 68, 252, 147, 70, 0, 162, 233, 3, 78, 250,
-			223, 58, 173, 193, 95, 57, 242, 194, 168, 181, 45, 37, 30, 42,
-			155, 235, 203, 48, 187, 236, 103, 175, 46, 41, 84, 141, 22, 9,
-			76, 29, 108, 115, 150, 215, 213, 226, 41, 166, 187, 130, 108, 21,
-			88, 55, 174, 184, 25, 171, 233, 215, 54, 122, 74, 142, 110, 9,
-			204, 46, 47, 44, 205, 73, 193, 15, 189, 119, 132, 141, 104, 149,
-			91, 174, 91, 155, 249, 210, 213, 197, 37, 242, 136, 90, 150, 142,
-			3, 141, 177, 95, 12, 153, 99, 62, 174, 120, 9, 66, 143, 119,
-			213, 7, 59, 44, 12, 74, 104, 77, 124, 192, 233, 234, 19, 219,
-			66, 40, 16, 46, 138, 15, 59, 153, 32, 136, 169, 67, 123, 35,
-			160, 26, 146, 90, 143, 197, 249, 107, 141, 78, 164, 159, 112, 119,
-			217, 33, 242, 86, 66, 2, 168, 77, 10, 217, 96, 240, 3, 162,
-			221, 52, 13, 179, 11, 109, 127, 192, 25, 128, 41, 183, 192, 41,
-			234, 82, 87, 18, 232, 32, 176, 187, 41, 167, 135, 64, 32, 83,
-			175, 181, 6, 4, 25, 63, 234, 100, 70, 130, 149, 239, 234, 128,
-			88, 80, 214, 52, 34, 160, 181, 105, 104, 252, 195, 176, 155, 237,
-			126, 34, 193, 253, 104, 243, 136, 28, 234, 104, 247, 96, 18, 232,
-			33, 112, 95, 144, 24, 17, 208, 222, 143, 127, 247, 71, 196, 226,
-			170, 166, 17, 33, 125, 130, 198, 63, 234, 140, 36, 250, 137, 52,
-			234, 227, 205, 35, 66, 212, 127, 188, 121, 68, 72, 171, 62, 142,
-			35, 122, 189, 53, 34, 128, 253, 148, 147, 57, 16, 172, 125, 87,
-			71, 164, 197, 68, 77, 99, 242, 96, 76, 208, 252, 199, 155, 198,
-			228, 165, 168, 87, 201, 49, 1, 179, 15, 192, 238, 225, 36, 144,
-			250, 63, 178, 95, 148, 52, 109, 133, 108, 63, 235, 164, 7, 131,
-			199, 37, 105, 141, 86, 86, 98, 52, 138, 197, 3, 70, 113, 7,
-			114, 40, 234, 184, 97, 21, 127, 28, 236, 205, 136, 12, 5, 224,
-			126, 131, 126, 152, 73, 242, 47, 194, 66, 169, 86, 216, 92, 151,
-			54, 79, 73, 162, 136, 139, 236, 103, 137, 140, 89, 228, 206, 73,
-			83, 7, 218, 109, 16, 245, 169, 163, 215, 6, 121, 8, 234, 31,
-			16, 255, 167, 175, 137, 162, 147, 77, 255, 134, 147, 254, 15, 64,
-			20, 255, 194, 71, 133, 118, 201, 146, 32, 131, 189, 204, 84, 49,
-			90, 87, 206, 168, 73, 88, 74, 180, 208, 154, 17, 205, 249, 191,
-			66, 26, 117, 49, 141, 196, 41, 154, 84, 218, 195, 198, 232, 25,
-			171, 0, 182, 71, 94, 15, 230, 80, 114, 116, 38, 212, 15, 112,
-			97, 61, 102, 229, 99, 115, 230, 74, 37, 225, 103, 194, 81, 18,
-			94, 142, 134, 55, 247, 42, 8, 172, 199, 142, 187, 145, 46, 12,
-			71, 251, 173, 139, 174, 69, 101, 58, 153, 242, 87, 206, 133, 227,
-			108, 56, 189, 163, 186, 80, 117, 167, 233, 186, 53, 97, 117, 178,
-			186, 145, 108, 200, 102, 31, 86, 75, 55, 227, 186, 213, 52, 75,
-			57, 228, 45, 172, 10, 43, 5, 159, 123, 228, 205, 22, 179, 74,
-			1, 42, 49, 42, 165, 134, 246, 216, 66, 7, 85, 252, 44, 220,
-			135, 177, 1, 245, 246, 161, 240, 58, 137, 181, 200, 5, 183, 30,
-			213, 110, 52, 189, 149, 0, 99, 172, 119, 197, 164, 54, 73, 39,
-			39, 218, 27, 181, 18, 114, 150, 114, 149, 110, 84, 203, 165, 194,
-			182, 208, 28, 59, 228, 40, 151, 164, 200, 101, 30, 221, 80, 36,
-			123, 71, 50, 177, 50, 44, 29, 146, 74, 151, 99, 220, 156, 196,
-			57, 169, 75, 213, 102, 5, 111, 224, 128, 128, 19, 57, 251, 64,
-			194, 21, 250, 27, 78, 215, 160, 200, 106, 218, 128, 87, 55, 255,
-			55, 157, 204, 49, 107, 199, 210, 141, 38, 13, 192, 223, 112, 236,
-			125, 72, 210, 6, 204, 27, 38, 129, 14, 2, 15, 223, 157, 4,
-			122, 8, 156, 184, 39, 209, 18, 100, 252, 172, 147, 153, 72, 180,
-			132, 155, 11, 128, 191, 233, 28, 75, 148, 199, 237, 245, 217, 230,
-			150, 28, 170, 224, 240, 145, 36, 208, 67, 224, 216, 120, 162, 37,
-			160, 224, 159, 115, 50, 247, 36, 90, 66, 202, 10, 192, 207, 58,
-			19, 137, 242, 110, 154, 242, 38, 91, 194, 174, 126, 206, 57, 124,
-			52, 9, 244, 16, 56, 62, 1, 92, 160, 162, 66, 208, 206, 231,
-			129, 195, 179, 136, 5, 182, 242, 121, 218, 222, 22, 25, 192, 54,
-			0, 216, 101, 131, 28, 4, 117, 219, 28, 20, 214, 255, 121, 228,
-			160, 126, 74, 221, 170, 1, 240, 69, 64, 144, 102, 32, 225, 63,
-			212, 33, 38, 73, 130, 164, 203, 74, 220, 41, 233, 159, 220, 250,
-			155, 149, 130, 36, 106, 40, 59, 103, 185, 13, 83, 191, 73, 65,
-			55, 52, 146, 240, 112, 17, 166, 152, 228, 38, 35, 42, 40, 179,
-			167, 93, 248, 240, 138, 117, 135, 178, 5, 146, 22, 115, 141, 132,
-			254, 139, 9, 190, 216, 245, 210, 52, 134, 140, 1, 56, 8, 104,
-			235, 52, 0, 26, 101, 79, 47, 220, 142, 81, 216, 227, 102, 211,
-			191, 239, 184, 95, 113, 60, 41, 54, 242, 16, 81, 191, 239, 136,
-			65, 248, 140, 72, 65, 155, 11, 255, 15, 28, 127, 136, 27, 37,
-			179, 133, 52, 0, 126, 31, 214, 106, 183, 2, 165, 40, 143, 48,
-			0, 7, 1, 237, 89, 3, 240, 16, 48, 96, 106, 133, 12, 95,
-			114, 252, 62, 93, 43, 174, 75, 0, 252, 129, 51, 164, 203, 32,
-			87, 241, 37, 187, 86, 135, 10, 181, 119, 25, 128, 135, 128, 222,
-			172, 174, 21, 214, 199, 151, 237, 190, 226, 234, 0, 192, 151, 156,
-			62, 93, 6, 79, 246, 47, 219, 181, 98, 87, 190, 108, 247, 21,
-			151, 193, 151, 237, 190, 66, 250, 15, 29, 191, 95, 215, 138, 104,
-			7, 192, 151, 173, 190, 226, 217, 250, 135, 118, 173, 136, 246, 63,
-			116, 218, 187, 13, 128, 106, 201, 246, 49, 218, 189, 108, 250, 171,
-			142, 251, 167, 26, 237, 152, 255, 171, 142, 232, 226, 70, 209, 244,
-			219, 255, 35, 199, 191, 135, 27, 245, 8, 237, 0, 248, 170, 211,
-			195, 85, 122, 68, 30, 0, 116, 192, 0, 28, 4, 28, 60, 106,
-			0, 30, 2, 96, 11, 169, 90, 33, 195, 31, 59, 254, 136, 174,
-			21, 209, 14, 128, 63, 114, 238, 209, 101, 144, 20, 0, 168, 215,
-			0, 168, 80, 118, 208, 0, 60, 4, 236, 11, 116, 173, 128, 246,
-			63, 113, 252, 19, 58, 3, 160, 29, 1, 29, 6, 144, 70, 64,
-			231, 168, 1, 56, 8, 184, 235, 152, 1, 120, 8, 200, 29, 151,
-			242, 94, 172, 241, 207, 28, 23, 135, 74, 102, 109, 208, 203, 63,
-			179, 176, 69, 245, 1, 32, 173, 146, 14, 38, 91, 219, 85, 210,
-			195, 100, 87, 183, 120, 41, 155, 193, 249, 127, 238, 184, 71, 130,
-			137, 240, 70, 188, 77, 246, 218, 232, 128, 187, 84, 84, 206, 186,
-			121, 203, 26, 13, 98, 193, 205, 226, 60, 67, 201, 63, 3, 148,
-			203, 138, 113, 115, 1, 96, 80, 37, 29, 76, 14, 29, 82, 73,
-			106, 104, 244, 46, 81, 102, 43, 58, 255, 47, 29, 183, 63, 120,
-			38, 100, 61, 64, 106, 90, 138, 127, 87, 108, 51, 113, 56, 180,
-			110, 176, 179, 65, 226, 48, 164, 124, 19, 233, 70, 242, 117, 64,
-			232, 215, 203, 242, 54, 10, 230, 202, 242, 29, 69, 246, 213, 135,
-			190, 66, 115, 127, 238, 28, 225, 222, 248, 41, 106, 95, 161, 8,
-			173, 68, 255, 210, 105, 237, 82, 73, 15, 147, 189, 125, 226, 183,
-			164, 241, 91, 250, 107, 78, 203, 63, 58, 78, 240, 239, 157, 208,
-			104, 16, 37, 221, 82, 162, 96, 106, 170, 89, 50, 69, 226, 4,
-			169, 55, 177, 30, 61, 91, 90, 223, 92, 15, 81, 165, 28, 112,
-			42, 194, 233, 151, 156, 126, 244, 172, 245, 158, 17, 142, 87, 170,
-			248, 214, 88, 35, 98, 218, 136, 235, 27, 81, 33, 158, 200, 89,
-			210, 37, 206, 137, 110, 149, 240, 97, 5, 200, 30, 54, 181, 64,
-			45, 105, 246, 160, 22, 23, 162, 114, 97, 179, 172, 47, 218, 165,
-			26, 181, 73, 6, 158, 100, 18, 231, 127, 205, 201, 100, 197, 255,
-			79, 153, 140, 249, 95, 199, 105, 120, 67, 248, 240, 102, 84, 139,
-			128, 236, 74, 241, 37, 30, 250, 77, 150, 237, 185, 92, 238, 185,
-			235, 202, 102, 95, 154, 190, 75, 111, 138, 225, 184, 37, 107, 71,
-			93, 69, 33, 157, 85, 169, 137, 101, 54, 135, 222, 7, 233, 45,
-			56, 50, 178, 105, 100, 184, 38, 120, 154, 36, 241, 132, 238, 124,
-			205, 233, 87, 70, 96, 41, 234, 95, 70, 37, 29, 76, 182, 117,
-			171, 164, 135, 73, 32, 26, 95, 115, 105, 48, 240, 245, 239, 29,
-			55, 27, 252, 137, 124, 44, 231, 80, 43, 208, 23, 178, 237, 146,
-			172, 21, 29, 90, 210, 249, 1, 153, 233, 86, 166, 42, 165, 178,
-			178, 92, 211, 254, 176, 34, 246, 95, 143, 60, 150, 64, 25, 14,
-			99, 65, 27, 253, 203, 71, 109, 146, 145, 132, 27, 137, 113, 78,
-			24, 195, 225, 205, 58, 218, 228, 82, 174, 215, 160, 153, 167, 48,
-			198, 188, 40, 153, 34, 123, 59, 230, 53, 171, 27, 196, 248, 162,
-			8, 145, 238, 5, 116, 226, 225, 193, 58, 78, 78, 180, 138, 113,
-			238, 158, 28, 165, 31, 120, 64, 132, 43, 81, 185, 30, 79, 76,
-			238, 240, 159, 128, 55, 136, 152, 29, 137, 210, 58, 35, 166, 44,
-			28, 175, 87, 181, 143, 135, 122, 28, 211, 25, 187, 92, 98, 255,
-			164, 242, 244, 214, 44, 59, 76, 60, 28, 173, 37, 185, 3, 101,
-			113, 222, 102, 178, 242, 184, 104, 102, 11, 169, 35, 224, 251, 235,
-			122, 182, 240, 72, 250, 123, 51, 91, 14, 205, 7, 156, 173, 156,
-			244, 48, 9, 39, 235, 59, 228, 210, 3, 34, 246, 13, 199, 61,
-			22, 188, 158, 38, 203, 184, 137, 131, 249, 162, 243, 157, 166, 196,
-			108, 183, 177, 186, 17, 170, 74, 171, 30, 154, 81, 54, 206, 70,
-			235, 88, 125, 247, 192, 197, 139, 238, 80, 109, 197, 35, 93, 182,
-			2, 115, 90, 83, 53, 168, 161, 32, 9, 133, 206, 252, 189, 147,
-			229, 206, 34, 9, 5, 192, 136, 74, 58, 152, 220, 127, 183, 74,
-			122, 152, 4, 126, 242, 130, 64, 90, 145, 254, 166, 211, 242, 102,
-			215, 9, 206, 132, 76, 25, 80, 77, 171, 174, 166, 150, 48, 188,
-			144, 55, 162, 32, 169, 127, 105, 11, 250, 121, 115, 34, 197, 252,
-			38, 94, 65, 145, 202, 211, 25, 247, 38, 215, 29, 164, 46, 202,
-			19, 14, 146, 223, 100, 114, 43, 207, 55, 0, 116, 168, 164, 131,
-			201, 206, 94, 149, 244, 48, 9, 119, 61, 89, 23, 124, 252, 126,
-			215, 61, 202, 117, 225, 204, 65, 242, 77, 76, 171, 229, 169, 6,
-			0, 85, 181, 67, 217, 123, 67, 149, 244, 48, 121, 23, 218, 225,
-			19, 37, 125, 171, 219, 242, 223, 92, 135, 186, 140, 132, 243, 173,
-			110, 166, 155, 206, 109, 31, 69, 108, 207, 187, 238, 63, 115, 229,
-			73, 228, 147, 204, 233, 121, 87, 116, 208, 89, 232, 75, 121, 211,
-			15, 184, 254, 0, 157, 176, 62, 11, 129, 0, 240, 188, 43, 217,
-			22, 159, 5, 64, 0, 18, 6, 224, 32, 160, 189, 199, 0, 60,
-			4, 244, 245, 235, 90, 33, 195, 219, 96, 10, 117, 173, 56, 62,
-			0, 252, 128, 59, 160, 203, 224, 218, 124, 155, 93, 171, 67, 133,
-			218, 59, 13, 192, 67, 0, 172, 79, 196, 24, 186, 130, 241, 223,
-			142, 216, 69, 140, 249, 212, 207, 183, 91, 35, 35, 236, 3, 192,
-			87, 73, 148, 161, 187, 169, 86, 149, 68, 9, 186, 11, 24, 122,
-			179, 67, 149, 193, 215, 119, 185, 110, 119, 208, 184, 213, 123, 167,
-			150, 35, 126, 167, 95, 60, 229, 16, 16, 41, 208, 137, 183, 243,
-			138, 241, 9, 37, 0, 72, 171, 36, 117, 178, 85, 168, 164, 135,
-			201, 206, 46, 57, 179, 62, 204, 236, 15, 186, 238, 251, 213, 248,
-			81, 225, 209, 255, 65, 183, 181, 67, 188, 12, 231, 128, 30, 202,
-			253, 31, 114, 253, 190, 96, 156, 182, 179, 26, 11, 82, 85, 105,
-			18, 193, 167, 74, 211, 131, 149, 47, 31, 206, 177, 104, 151, 1,
-			184, 8, 0, 198, 245, 113, 174, 27, 165, 217, 48, 191, 193, 195,
-			205, 117, 179, 79, 75, 84, 138, 227, 86, 200, 41, 113, 67, 127,
-			177, 46, 191, 208, 190, 220, 121, 86, 211, 142, 172, 185, 211, 0,
-			92, 4, 192, 34, 88, 224, 166, 33, 253, 94, 215, 239, 9, 30,
-			218, 107, 88, 43, 244, 88, 161, 124, 80, 207, 88, 246, 213, 228,
-			160, 161, 94, 45, 223, 76, 140, 22, 7, 3, 53, 182, 27, 0,
-			53, 1, 252, 216, 189, 220, 36, 32, 254, 71, 93, 191, 55, 56,
-			210, 220, 36, 220, 245, 113, 104, 43, 168, 84, 161, 245, 250, 84,
-			53, 72, 60, 160, 88, 135, 1, 184, 8, 232, 238, 17, 47, 231,
-			122, 129, 229, 250, 113, 215, 15, 130, 201, 93, 234, 133, 163, 193,
-			166, 79, 187, 212, 143, 59, 253, 199, 113, 235, 106, 128, 139, 128,
-			225, 125, 188, 245, 157, 108, 250, 131, 174, 251, 155, 122, 235, 35,
-			106, 63, 232, 50, 203, 238, 203, 155, 253, 135, 92, 102, 217, 125,
-			190, 213, 3, 224, 131, 174, 218, 216, 242, 70, 15, 160, 3, 6,
-			224, 32, 128, 89, 118, 159, 111, 242, 31, 114, 153, 101, 247, 229,
-			45, 254, 195, 174, 127, 76, 215, 138, 171, 28, 0, 31, 114, 239,
-			209, 101, 144, 184, 125, 216, 174, 213, 161, 66, 7, 239, 54, 0,
-			148, 36, 187, 64, 207, 85, 173, 48, 180, 143, 184, 254, 65, 93,
-			43, 158, 15, 0, 248, 176, 123, 76, 151, 193, 155, 210, 71, 12,
-			65, 145, 108, 246, 71, 220, 246, 125, 6, 224, 33, 96, 255, 1,
-			17, 203, 90, 81, 77, 227, 39, 92, 127, 95, 112, 149, 165, 132,
-			102, 6, 106, 113, 84, 220, 110, 218, 33, 146, 201, 93, 147, 167,
-			47, 29, 203, 74, 60, 214, 252, 166, 28, 110, 199, 141, 156, 238,
-			8, 17, 221, 159, 112, 59, 6, 197, 27, 185, 93, 152, 154, 127,
-			227, 250, 63, 233, 166, 130, 245, 100, 203, 146, 53, 45, 163, 211,
-			79, 179, 79, 198, 141, 247, 10, 237, 139, 98, 126, 22, 216, 154,
-			115, 107, 49, 169, 11, 196, 214, 125, 155, 117, 250, 86, 56, 4,
-			80, 211, 161, 166, 122, 132, 8, 255, 55, 110, 199, 0, 201, 42,
-			8, 128, 171, 225, 19, 110, 122, 148, 100, 21, 12, 1, 28, 3,
-			232, 223, 184, 82, 10, 193, 192, 20, 229, 235, 176, 65, 14, 130,
-			58, 15, 216, 32, 15, 65, 225, 97, 241, 10, 30, 49, 176, 236,
-			159, 116, 253, 159, 129, 17, 63, 148, 24, 49, 48, 17, 77, 90,
-			66, 100, 92, 193, 186, 126, 197, 162, 148, 126, 202, 205, 92, 40,
-			145, 228, 85, 141, 1, 167, 247, 147, 110, 71, 159, 30, 3, 49,
-			207, 63, 237, 166, 15, 232, 49, 72, 6, 22, 64, 159, 116, 7,
-			116, 239, 36, 19, 251, 211, 246, 24, 36, 35, 251, 211, 110, 231,
-			176, 13, 242, 16, 52, 178, 95, 76, 241, 24, 128, 175, 248, 148,
-			235, 255, 34, 140, 97, 100, 199, 122, 81, 87, 29, 171, 127, 184,
-			255, 63, 229, 118, 244, 139, 55, 185, 220, 65, 226, 32, 126, 193,
-			77, 7, 193, 215, 164, 158, 23, 51, 226, 77, 55, 58, 116, 22,
-			81, 93, 214, 30, 54, 19, 10, 163, 234, 225, 86, 150, 52, 143,
-			182, 132, 68, 45, 200, 156, 148, 207, 171, 210, 33, 123, 53, 100,
-			142, 21, 189, 212, 150, 99, 204, 136, 231, 151, 165, 176, 13, 28,
-			53, 249, 12, 35, 158, 24, 101, 138, 11, 168, 153, 2, 167, 215,
-			117, 197, 235, 242, 72, 21, 199, 75, 231, 216, 245, 73, 201, 159,
-			39, 56, 94, 46, 32, 217, 216, 28, 95, 186, 132, 158, 16, 201,
-			53, 1, 10, 62, 5, 156, 78, 175, 1, 166, 9, 47, 61, 54,
-			200, 65, 80, 239, 128, 13, 242, 16, 4, 244, 173, 71, 78, 72,
-			6, 142, 192, 95, 118, 253, 223, 112, 83, 10, 231, 232, 245, 198,
-			255, 101, 151, 165, 65, 190, 20, 145, 125, 198, 77, 135, 154, 4,
-			224, 149, 248, 51, 216, 146, 6, 56, 8, 232, 29, 49, 0, 42,
-			114, 240, 144, 174, 2, 168, 244, 175, 184, 233, 131, 58, 131, 159,
-			38, 64, 183, 1, 56, 8, 232, 49, 116, 6, 111, 171, 191, 130,
-			116, 70, 85, 1, 235, 237, 87, 97, 218, 117, 134, 84, 154, 0,
-			157, 6, 224, 32, 160, 107, 192, 0, 60, 4, 16, 45, 151, 85,
-			64, 137, 95, 115, 211, 251, 117, 134, 180, 4, 116, 25, 128, 131,
-			128, 238, 33, 3, 240, 16, 16, 140, 232, 42, 90, 179, 254, 175,
-			187, 233, 9, 157, 161, 53, 77, 128, 126, 3, 112, 16, 48, 112,
-			196, 0, 60, 4, 140, 141, 51, 7, 6, 68, 248, 179, 46, 75,
-			57, 124, 34, 193, 159, 181, 14, 24, 98, 209, 63, 107, 216, 23,
-			146, 231, 186, 44, 229, 240, 137, 248, 126, 22, 79, 213, 251, 168,
-			46, 72, 253, 150, 235, 142, 7, 199, 110, 45, 229, 176, 13, 233,
-			20, 223, 132, 114, 14, 40, 251, 89, 230, 142, 125, 154, 84, 0,
-			12, 171, 164, 131, 201, 125, 119, 169, 36, 53, 117, 247, 24, 15,
-			2, 166, 243, 11, 174, 123, 144, 235, 66, 238, 25, 146, 191, 229,
-			142, 115, 110, 156, 221, 47, 184, 186, 106, 156, 219, 47, 184, 189,
-			251, 84, 210, 195, 228, 254, 3, 92, 23, 204, 235, 111, 187, 238,
-			33, 174, 43, 5, 117, 65, 242, 11, 80, 185, 204, 141, 211, 252,
-			219, 166, 46, 156, 228, 223, 118, 123, 3, 149, 244, 48, 121, 224,
-			32, 31, 215, 64, 93, 126, 199, 117, 255, 119, 125, 92, 227, 40,
-			126, 199, 133, 229, 255, 24, 77, 31, 209, 142, 47, 34, 207, 117,
-			94, 42, 104, 54, 75, 103, 152, 122, 208, 55, 235, 201, 71, 30,
-			84, 40, 126, 81, 7, 152, 188, 62, 10, 62, 74, 229, 142, 132,
-			138, 127, 199, 237, 227, 121, 247, 136, 64, 2, 40, 99, 0, 40,
-			149, 117, 219, 58, 13, 0, 165, 178, 200, 150, 173, 115, 239, 80,
-			130, 234, 250, 195, 193, 171, 236, 167, 168, 230, 62, 218, 239, 134,
-			113, 77, 75, 148, 84, 207, 144, 197, 70, 13, 7, 148, 198, 64,
-			246, 117, 169, 7, 143, 71, 48, 94, 193, 137, 105, 83, 157, 70,
-			174, 2, 218, 251, 162, 155, 213, 93, 66, 238, 249, 15, 236, 78,
-			59, 212, 167, 54, 51, 44, 228, 42, 254, 192, 29, 28, 18, 5,
-			238, 52, 44, 232, 175, 184, 254, 96, 176, 24, 158, 151, 214, 179,
-			137, 30, 43, 216, 173, 187, 154, 236, 169, 216, 217, 85, 220, 39,
-			208, 202, 31, 184, 195, 186, 35, 184, 112, 190, 98, 119, 21, 209,
-			247, 21, 183, 173, 215, 0, 60, 4, 192, 109, 49, 230, 174, 162,
-			12, 211, 245, 135, 128, 85, 57, 203, 198, 188, 137, 190, 106, 224,
-			183, 217, 89, 220, 91, 208, 206, 87, 220, 65, 221, 21, 148, 21,
-			255, 177, 221, 89, 92, 153, 127, 236, 182, 25, 204, 123, 212, 185,
-			129, 65, 38, 204, 104, 131, 150, 254, 83, 215, 255, 154, 38, 204,
-			100, 170, 229, 255, 169, 219, 214, 13, 23, 244, 140, 4, 224, 122,
-			254, 79, 110, 186, 55, 56, 29, 74, 35, 98, 82, 228, 210, 218,
-			136, 73, 165, 227, 73, 100, 137, 87, 34, 84, 45, 179, 186, 220,
-			166, 106, 114, 168, 170, 14, 27, 228, 34, 8, 120, 237, 183, 57,
-			186, 65, 200, 245, 191, 186, 233, 129, 96, 51, 52, 150, 203, 234,
-			110, 86, 74, 168, 57, 27, 235, 10, 146, 253, 40, 63, 115, 13,
-			18, 18, 145, 49, 74, 4, 168, 143, 42, 245, 18, 50, 128, 100,
-			255, 103, 100, 63, 82, 72, 138, 106, 219, 248, 22, 167, 98, 68,
-			218, 253, 117, 100, 79, 122, 108, 144, 139, 32, 184, 65, 63, 111,
-			250, 11, 160, 191, 118, 211, 251, 130, 122, 152, 180, 172, 110, 234,
-			51, 157, 218, 164, 250, 31, 109, 66, 186, 210, 144, 158, 65, 57,
-			74, 111, 37, 180, 30, 95, 183, 214, 80, 113, 205, 190, 214, 34,
-			43, 171, 221, 78, 201, 219, 42, 198, 178, 177, 123, 139, 120, 251,
-			107, 60, 38, 44, 16, 117, 109, 104, 88, 228, 117, 103, 97, 9,
-			252, 23, 228, 108, 190, 47, 108, 50, 249, 110, 234, 237, 122, 244,
-			44, 121, 118, 186, 102, 201, 195, 176, 239, 192, 112, 227, 243, 81,
-			162, 101, 92, 104, 80, 233, 128, 13, 114, 17, 4, 231, 226, 123,
-			29, 222, 24, 64, 207, 191, 142, 123, 248, 7, 28, 53, 123, 166,
-			65, 229, 118, 68, 9, 249, 154, 246, 134, 126, 111, 181, 12, 253,
-			73, 173, 81, 89, 172, 202, 237, 131, 60, 22, 41, 248, 73, 159,
-			77, 246, 66, 177, 164, 222, 59, 182, 18, 30, 45, 95, 79, 236,
-			2, 58, 92, 190, 110, 174, 40, 30, 29, 47, 95, 119, 219, 205,
-			190, 199, 3, 230, 235, 184, 239, 191, 42, 229, 20, 80, 224, 239,
-			92, 119, 40, 248, 130, 163, 165, 183, 150, 150, 219, 109, 196, 243,
-			214, 145, 48, 105, 209, 94, 242, 243, 138, 196, 98, 55, 169, 253,
-			14, 161, 61, 82, 12, 18, 236, 89, 207, 2, 74, 198, 39, 208,
-			88, 105, 61, 134, 141, 16, 170, 0, 69, 74, 246, 74, 156, 34,
-			201, 75, 216, 28, 208, 200, 83, 180, 0, 36, 13, 24, 250, 59,
-			235, 204, 35, 166, 6, 0, 109, 42, 233, 96, 82, 244, 170, 164,
-			135, 73, 114, 50, 134, 207, 5, 233, 127, 112, 91, 126, 209, 147,
-			82, 47, 60, 90, 255, 193, 205, 244, 138, 119, 33, 218, 82, 40,
-			246, 250, 134, 235, 254, 223, 174, 23, 60, 103, 6, 126, 13, 141,
-			95, 165, 237, 102, 99, 19, 149, 160, 171, 9, 196, 225, 85, 195,
-			120, 151, 152, 53, 171, 131, 181, 14, 138, 246, 45, 107, 9, 109,
-			162, 195, 113, 148, 222, 210, 225, 187, 67, 51, 161, 182, 81, 152,
-			96, 221, 246, 20, 93, 0, 191, 161, 174, 222, 41, 41, 117, 251,
-			71, 151, 223, 181, 82, 44, 117, 3, 192, 55, 248, 234, 77, 160,
-			52, 229, 233, 51, 0, 7, 1, 253, 131, 6, 224, 33, 128, 223,
-			181, 82, 82, 234, 246, 79, 46, 63, 252, 165, 88, 234, 6, 128,
-			127, 116, 71, 116, 25, 60, 36, 1, 212, 102, 0, 84, 72, 116,
-			27, 128, 135, 0, 126, 248, 75, 225, 85, 245, 77, 158, 251, 86,
-			207, 227, 161, 96, 254, 55, 121, 44, 64, 76, 201, 123, 227, 247,
-			123, 44, 64, 76, 241, 173, 17, 0, 111, 242, 186, 184, 74, 121,
-			103, 4, 144, 48, 0, 20, 103, 122, 237, 61, 6, 128, 2, 77,
-			143, 5, 136, 41, 41, 69, 120, 179, 199, 47, 163, 41, 150, 34,
-			0, 224, 251, 189, 1, 93, 6, 135, 242, 102, 187, 86, 135, 10,
-			241, 203, 104, 138, 165, 8, 111, 246, 248, 101, 52, 37, 165, 8,
-			111, 241, 88, 44, 153, 98, 41, 2, 0, 222, 236, 13, 233, 50,
-			120, 52, 191, 197, 174, 21, 187, 242, 22, 143, 197, 146, 41, 150,
-			34, 188, 197, 99, 177, 36, 250, 147, 246, 159, 247, 88, 44, 153,
-			162, 209, 63, 111, 225, 139, 166, 17, 0, 190, 74, 162, 252, 213,
-			99, 177, 100, 138, 198, 253, 188, 39, 218, 37, 178, 241, 220, 124,
-			155, 231, 254, 136, 42, 76, 167, 230, 219, 188, 214, 14, 241, 12,
-			14, 64, 158, 153, 239, 240, 252, 190, 224, 50, 93, 31, 99, 203,
-			180, 166, 46, 25, 189, 168, 128, 58, 75, 242, 250, 220, 116, 121,
-			100, 235, 66, 41, 201, 100, 179, 48, 97, 73, 42, 216, 100, 6,
-			27, 232, 50, 0, 23, 1, 189, 89, 113, 142, 123, 128, 50, 73,
-			207, 239, 9, 78, 53, 245, 64, 170, 235, 146, 169, 217, 56, 73,
-			49, 212, 174, 145, 10, 3, 179, 151, 38, 172, 102, 28, 89, 75,
-			187, 1, 184, 8, 128, 203, 65, 153, 155, 65, 129, 163, 231, 247,
-			6, 175, 220, 107, 160, 100, 151, 75, 22, 171, 213, 13, 28, 236,
-			236, 37, 166, 102, 49, 177, 189, 72, 209, 214, 98, 140, 141, 162,
-			226, 31, 196, 123, 8, 104, 82, 124, 196, 65, 115, 29, 6, 64,
-			237, 3, 251, 112, 154, 251, 3, 243, 244, 30, 236, 207, 221, 59,
-			135, 189, 167, 16, 48, 197, 71, 216, 123, 236, 154, 241, 0, 123,
-			15, 214, 252, 0, 215, 12, 231, 215, 123, 61, 63, 8, 166, 118,
-			173, 121, 15, 49, 160, 105, 0, 79, 144, 247, 122, 44, 5, 76,
-			177, 20, 240, 189, 30, 75, 1, 83, 40, 120, 249, 81, 207, 253,
-			159, 244, 254, 197, 161, 254, 168, 167, 73, 17, 201, 76, 126, 204,
-			99, 41, 96, 138, 37, 38, 0, 248, 81, 79, 237, 78, 114, 245,
-			136, 121, 14, 24, 128, 131, 0, 150, 2, 166, 88, 86, 242, 99,
-			30, 75, 1, 83, 146, 159, 125, 159, 199, 82, 192, 20, 235, 75,
-			0, 224, 199, 188, 123, 116, 25, 148, 2, 190, 207, 174, 213, 161,
-			66, 44, 5, 76, 177, 190, 196, 251, 188, 137, 123, 136, 175, 4,
-			0, 146, 245, 247, 123, 254, 71, 188, 148, 202, 66, 148, 245, 253,
-			94, 199, 8, 9, 129, 8, 128, 3, 250, 128, 199, 66, 32, 134,
-			64, 227, 0, 122, 191, 39, 69, 84, 12, 76, 81, 190, 14, 27,
-			132, 10, 185, 30, 11, 129, 24, 228, 33, 104, 100, 191, 85, 63,
-			138, 81, 189, 116, 104, 213, 143, 131, 3, 208, 7, 18, 245, 35,
-			121, 250, 96, 178, 126, 146, 192, 122, 157, 35, 54, 200, 67, 208,
-			193, 67, 86, 253, 48, 127, 31, 242, 210, 67, 86, 253, 72, 166,
-			0, 244, 65, 47, 180, 74, 34, 161, 250, 80, 178, 126, 236, 218,
-			135, 188, 206, 172, 13, 66, 241, 172, 71, 172, 185, 170, 31, 69,
-			171, 94, 122, 192, 170, 31, 153, 126, 0, 125, 200, 27, 178, 74,
-			34, 219, 255, 225, 100, 253, 184, 152, 63, 236, 117, 246, 216, 32,
-			170, 77, 19, 110, 218, 56, 255, 202, 243, 39, 244, 196, 99, 223,
-			255, 85, 98, 206, 72, 78, 0, 160, 62, 3, 112, 16, 208, 127,
-			196, 0, 60, 4, 140, 141, 171, 90, 177, 225, 143, 122, 254, 62,
-			61, 237, 164, 172, 235, 117, 12, 234, 12, 208, 236, 191, 246, 252,
-			65, 157, 1, 171, 252, 215, 94, 71, 159, 206, 128, 106, 175, 120,
-			160, 168, 12, 88, 229, 199, 189, 142, 126, 189, 182, 80, 216, 233,
-			249, 63, 101, 173, 45, 220, 90, 159, 144, 89, 36, 238, 232, 13,
-			234, 39, 189, 116, 160, 113, 39, 223, 161, 0, 244, 9, 111, 80,
-			99, 69, 190, 69, 1, 176, 199, 6, 57, 8, 98, 121, 22, 131,
-			60, 4, 177, 60, 11, 64, 40, 207, 250, 164, 231, 255, 188, 233,
-			2, 201, 179, 62, 233, 137, 97, 141, 94, 148, 73, 122, 44, 207,
-			162, 116, 154, 0, 102, 175, 226, 184, 126, 218, 235, 29, 49, 0,
-			42, 194, 242, 172, 148, 84, 118, 249, 25, 143, 133, 81, 148, 78,
-			19, 160, 211, 0, 28, 4, 116, 13, 24, 128, 135, 0, 22, 70,
-			165, 228, 245, 245, 103, 61, 22, 70, 81, 58, 77, 128, 46, 3,
-			64, 93, 87, 175, 123, 200, 0, 80, 211, 213, 99, 97, 84, 74,
-			106, 216, 252, 156, 93, 69, 90, 2, 76, 21, 200, 252, 253, 156,
-			93, 5, 178, 127, 63, 103, 87, 209, 154, 245, 63, 229, 177, 60,
-			139, 210, 105, 2, 244, 27, 0, 74, 96, 189, 1, 179, 174, 80,
-			158, 245, 41, 143, 229, 89, 41, 92, 35, 159, 246, 88, 158, 149,
-			162, 93, 252, 105, 139, 84, 18, 129, 2, 64, 90, 37, 41, 59,
-			203, 179, 82, 180, 115, 63, 141, 71, 86, 149, 234, 130, 5, 248,
-			11, 158, 219, 31, 68, 47, 64, 125, 198, 176, 228, 119, 166, 63,
-			211, 144, 126, 193, 101, 119, 113, 99, 65, 139, 159, 246, 122, 184,
-			67, 56, 43, 191, 96, 186, 139, 163, 251, 5, 143, 53, 104, 82,
-			180, 114, 126, 193, 235, 237, 19, 127, 227, 9, 156, 242, 244, 231,
-			60, 116, 27, 19, 124, 213, 11, 181, 218, 172, 177, 241, 209, 194,
-			2, 212, 118, 33, 119, 188, 146, 161, 39, 46, 159, 131, 16, 105,
-			187, 98, 186, 104, 146, 233, 122, 120, 78, 63, 255, 145, 113, 163,
-			209, 42, 81, 161, 30, 44, 139, 72, 226, 70, 88, 29, 121, 19,
-			93, 162, 112, 96, 37, 140, 92, 65, 146, 101, 129, 74, 1, 101,
-			165, 98, 43, 123, 1, 5, 100, 174, 173, 104, 187, 174, 111, 112,
-			219, 99, 82, 159, 27, 154, 144, 55, 13, 114, 62, 77, 61, 214,
-			14, 87, 180, 77, 83, 92, 47, 173, 86, 180, 151, 27, 75, 247,
-			132, 60, 189, 20, 229, 189, 159, 236, 63, 195, 66, 185, 68, 42,
-			10, 24, 106, 41, 170, 163, 117, 26, 240, 87, 155, 235, 168, 204,
-			19, 11, 173, 39, 110, 240, 71, 152, 64, 239, 242, 49, 61, 117,
-			74, 15, 58, 116, 142, 87, 107, 236, 38, 102, 61, 130, 102, 34,
-			121, 178, 151, 42, 83, 235, 241, 122, 181, 70, 238, 5, 208, 147,
-			14, 107, 198, 72, 52, 155, 203, 19, 242, 54, 82, 65, 133, 62,
-			20, 89, 252, 135, 241, 170, 208, 41, 15, 90, 181, 20, 106, 213,
-			122, 93, 24, 119, 220, 249, 43, 231, 234, 172, 196, 128, 27, 233,
-			115, 30, 220, 141, 80, 207, 11, 163, 149, 248, 159, 247, 220, 48,
-			120, 70, 106, 111, 213, 149, 236, 21, 59, 134, 30, 21, 72, 219,
-			184, 100, 217, 27, 25, 228, 147, 76, 95, 220, 90, 96, 75, 149,
-			42, 89, 109, 154, 200, 35, 52, 247, 57, 175, 143, 214, 97, 154,
-			72, 35, 0, 250, 85, 18, 245, 92, 189, 129, 17, 149, 68, 29,
-			87, 36, 86, 63, 239, 82, 103, 225, 235, 239, 121, 238, 96, 240,
-			19, 110, 120, 65, 249, 85, 218, 245, 181, 158, 103, 219, 14, 41,
-			76, 8, 147, 158, 122, 212, 205, 185, 20, 111, 137, 166, 91, 31,
-			100, 164, 146, 108, 43, 68, 122, 174, 228, 91, 130, 218, 202, 241,
-			51, 13, 219, 10, 171, 133, 191, 17, 203, 251, 32, 203, 124, 228,
-			50, 161, 207, 133, 53, 54, 13, 148, 15, 48, 192, 182, 149, 99,
-			249, 176, 82, 162, 133, 160, 204, 145, 164, 117, 189, 241, 150, 46,
-			17, 76, 211, 139, 23, 123, 178, 220, 146, 254, 67, 224, 230, 189,
-			99, 232, 23, 164, 27, 41, 249, 254, 18, 149, 235, 244, 76, 89,
-			143, 213, 205, 58, 77, 180, 12, 16, 247, 121, 47, 100, 196, 34,
-			55, 242, 123, 138, 56, 164, 137, 150, 253, 158, 215, 218, 171, 146,
-			30, 38, 251, 7, 196, 47, 121, 132, 118, 84, 144, 68, 98, 246,
-			111, 61, 221, 212, 238, 72, 87, 152, 81, 143, 118, 245, 53, 140,
-			95, 160, 84, 35, 54, 161, 119, 9, 61, 43, 170, 66, 91, 101,
-			97, 220, 95, 227, 145, 252, 30, 20, 13, 153, 135, 36, 35, 30,
-			50, 31, 201, 220, 129, 85, 168, 72, 221, 75, 211, 151, 124, 252,
-			154, 220, 165, 232, 89, 25, 210, 117, 57, 198, 91, 200, 154, 42,
-			137, 42, 101, 13, 52, 134, 51, 211, 74, 65, 154, 55, 113, 170,
-			113, 250, 55, 107, 202, 4, 78, 13, 103, 220, 96, 92, 163, 88,
-			154, 20, 44, 43, 161, 11, 19, 154, 66, 84, 143, 201, 207, 200,
-			101, 12, 190, 198, 146, 75, 169, 242, 97, 232, 0, 128, 37, 157,
-			83, 71, 195, 114, 181, 90, 70, 169, 201, 25, 249, 142, 88, 139,
-			87, 200, 2, 83, 189, 238, 145, 135, 6, 188, 219, 148, 26, 155,
-			145, 50, 179, 129, 59, 207, 43, 120, 25, 21, 171, 234, 14, 103,
-			9, 108, 212, 3, 47, 206, 39, 158, 13, 48, 129, 191, 231, 13,
-			242, 4, 227, 217, 240, 39, 102, 250, 73, 157, 213, 107, 237, 86,
-			73, 84, 102, 245, 178, 125, 218, 95, 251, 219, 123, 197, 217, 59,
-			247, 150, 174, 174, 122, 198, 101, 167, 229, 182, 253, 219, 112, 251,
-			62, 186, 42, 250, 119, 8, 86, 96, 166, 209, 113, 35, 138, 58,
-			217, 143, 92, 147, 227, 70, 20, 242, 228, 233, 123, 118, 76, 116,
-			91, 190, 228, 44, 247, 139, 198, 197, 28, 201, 113, 30, 249, 161,
-			110, 25, 214, 101, 242, 123, 192, 141, 218, 57, 118, 152, 150, 201,
-			8, 241, 215, 25, 233, 49, 237, 48, 140, 44, 248, 163, 204, 78,
-			49, 21, 96, 83, 30, 117, 20, 5, 66, 29, 222, 83, 234, 38,
-			159, 208, 213, 81, 211, 140, 183, 221, 184, 188, 2, 195, 156, 173,
-			202, 141, 83, 170, 171, 74, 208, 143, 78, 85, 58, 87, 209, 82,
-			252, 185, 196, 229, 93, 59, 75, 194, 163, 155, 72, 87, 92, 65,
-			134, 167, 46, 154, 50, 83, 139, 228, 100, 204, 98, 11, 144, 90,
-			152, 93, 63, 25, 206, 20, 139, 82, 144, 25, 97, 104, 4, 41,
-			182, 53, 82, 198, 93, 196, 114, 235, 28, 32, 21, 247, 152, 29,
-			23, 164, 89, 240, 199, 37, 101, 71, 110, 196, 21, 161, 77, 78,
-			77, 180, 71, 169, 19, 105, 247, 121, 203, 12, 112, 87, 59, 137,
-			156, 177, 39, 74, 98, 69, 58, 156, 35, 249, 13, 139, 77, 38,
-			155, 171, 102, 203, 31, 52, 34, 162, 232, 242, 34, 28, 227, 204,
-			48, 9, 99, 204, 9, 41, 157, 89, 230, 133, 96, 217, 44, 60,
-			74, 75, 15, 86, 42, 83, 38, 58, 220, 39, 229, 131, 191, 60,
-			184, 4, 157, 92, 202, 80, 126, 46, 177, 53, 228, 48, 208, 66,
-			30, 57, 10, 137, 4, 54, 246, 51, 57, 73, 174, 170, 61, 81,
-			16, 73, 67, 179, 45, 172, 85, 118, 132, 9, 60, 244, 69, 74,
-			57, 18, 206, 226, 200, 19, 143, 26, 118, 78, 230, 156, 151, 62,
-			249, 102, 106, 171, 235, 20, 127, 147, 203, 41, 250, 140, 120, 134,
-			163, 21, 53, 91, 48, 214, 14, 153, 14, 227, 82, 212, 86, 255,
-			107, 113, 121, 131, 171, 186, 162, 237, 160, 103, 227, 10, 170, 206,
-			76, 41, 83, 95, 54, 8, 110, 154, 105, 170, 28, 53, 186, 97,
-			51, 160, 238, 2, 145, 253, 91, 182, 48, 83, 38, 109, 161, 57,
-			201, 175, 201, 234, 119, 173, 149, 235, 148, 172, 47, 189, 40, 200,
-			165, 45, 253, 88, 201, 150, 34, 89, 153, 37, 63, 163, 89, 51,
-			244, 141, 170, 103, 142, 131, 223, 121, 11, 192, 156, 66, 93, 27,
-			108, 132, 92, 191, 77, 167, 5, 81, 45, 110, 90, 158, 114, 70,
-			79, 23, 237, 205, 74, 246, 242, 141, 159, 133, 174, 72, 134, 122,
-			55, 242, 193, 154, 30, 38, 196, 218, 225, 204, 126, 169, 80, 76,
-			126, 110, 142, 184, 7, 130, 231, 66, 37, 120, 79, 172, 47, 41,
-			142, 71, 53, 224, 72, 70, 181, 179, 4, 233, 90, 196, 62, 25,
-			70, 38, 192, 170, 216, 197, 151, 193, 94, 219, 144, 189, 219, 38,
-			2, 65, 31, 113, 15, 31, 180, 60, 5, 29, 113, 251, 44, 79,
-			65, 71, 250, 135, 45, 79, 65, 71, 70, 246, 139, 95, 117, 149,
-			171, 160, 99, 238, 254, 224, 231, 220, 230, 173, 97, 33, 13, 118,
-			95, 117, 29, 14, 109, 10, 234, 23, 89, 242, 215, 117, 32, 237,
-			112, 165, 210, 178, 81, 105, 48, 110, 244, 116, 96, 0, 187, 120,
-			230, 82, 166, 255, 232, 52, 67, 238, 57, 228, 183, 182, 170, 208,
-			80, 33, 65, 151, 129, 10, 44, 87, 113, 131, 242, 147, 30, 224,
-			71, 77, 146, 21, 243, 8, 231, 110, 111, 2, 199, 120, 78, 170,
-			145, 169, 205, 15, 11, 101, 28, 208, 191, 12, 36, 122, 27, 57,
-			43, 139, 171, 97, 213, 163, 117, 88, 201, 178, 143, 116, 229, 67,
-			213, 34, 121, 121, 219, 42, 85, 18, 97, 166, 143, 185, 71, 14,
-			88, 30, 150, 142, 37, 194, 76, 31, 203, 12, 89, 30, 150, 142,
-			5, 35, 154, 23, 121, 62, 39, 230, 246, 226, 33, 100, 96, 162,
-			227, 184, 2, 143, 55, 226, 103, 27, 199, 27, 28, 180, 128, 202,
-			234, 20, 179, 35, 157, 137, 175, 183, 14, 208, 247, 127, 56, 34,
-			165, 227, 234, 149, 56, 0, 7, 197, 203, 131, 68, 182, 95, 248,
-			155, 8, 68, 110, 194, 199, 136, 118, 152, 202, 14, 138, 20, 133,
-			3, 35, 103, 175, 14, 128, 101, 18, 115, 35, 195, 39, 157, 113,
-			99, 110, 76, 169, 120, 125, 41, 59, 94, 31, 212, 176, 188, 221,
-			136, 165, 135, 125, 140, 216, 39, 147, 217, 97, 29, 29, 161, 149,
-			179, 171, 248, 8, 80, 34, 170, 213, 162, 109, 114, 30, 140, 31,
-			100, 82, 199, 226, 107, 123, 65, 177, 248, 70, 63, 158, 18, 233,
-			69, 114, 231, 145, 205, 153, 113, 163, 43, 229, 4, 238, 114, 50,
-			79, 110, 166, 81, 93, 87, 56, 57, 97, 225, 228, 118, 5, 36,
-			190, 166, 109, 124, 221, 174, 8, 227, 242, 132, 133, 203, 219, 182,
-			66, 120, 158, 54, 120, 110, 159, 62, 184, 123, 129, 69, 60, 87,
-			42, 133, 88, 205, 195, 105, 123, 30, 238, 164, 20, 207, 211, 113,
-			225, 163, 51, 8, 246, 5, 189, 111, 175, 98, 13, 236, 28, 102,
-			204, 222, 171, 39, 54, 115, 171, 1, 161, 221, 143, 53, 233, 211,
-			106, 210, 219, 238, 160, 144, 204, 26, 172, 11, 15, 26, 206, 190,
-			68, 164, 232, 142, 207, 78, 142, 15, 237, 217, 197, 156, 116, 117,
-			44, 115, 7, 199, 69, 74, 123, 42, 46, 86, 11, 202, 83, 49,
-			252, 76, 122, 54, 87, 222, 162, 131, 163, 194, 199, 246, 209, 69,
-			50, 190, 248, 75, 203, 31, 118, 149, 223, 6, 144, 139, 4, 8,
-			38, 68, 70, 97, 241, 118, 89, 211, 194, 199, 121, 61, 155, 17,
-			105, 233, 112, 102, 244, 7, 125, 225, 159, 7, 238, 55, 251, 128,
-			200, 168, 97, 240, 184, 14, 55, 141, 11, 179, 233, 136, 38, 28,
-			99, 72, 229, 8, 254, 139, 43, 50, 234, 219, 46, 3, 204, 226,
-			146, 43, 110, 243, 248, 232, 55, 180, 152, 34, 215, 57, 28, 54,
-			101, 236, 86, 205, 229, 40, 12, 16, 163, 147, 74, 5, 63, 226,
-			136, 54, 29, 28, 104, 151, 38, 115, 162, 181, 24, 175, 68, 232,
-			167, 95, 5, 166, 76, 54, 32, 131, 129, 170, 76, 217, 64, 100,
-			112, 31, 35, 31, 205, 158, 166, 117, 58, 59, 165, 240, 197, 123,
-			102, 96, 215, 41, 207, 115, 166, 224, 186, 16, 166, 191, 187, 56,
-			166, 126, 121, 210, 49, 245, 221, 183, 31, 57, 142, 209, 142, 125,
-			240, 164, 232, 76, 204, 196, 46, 141, 76, 39, 27, 217, 127, 171,
-			70, 236, 152, 50, 191, 12, 120, 93, 100, 29, 233, 90, 246, 46,
-			161, 41, 190, 237, 17, 189, 67, 1, 201, 39, 250, 203, 69, 154,
-			230, 164, 206, 177, 70, 143, 52, 163, 71, 85, 39, 7, 163, 60,
-			128, 203, 50, 193, 130, 104, 183, 192, 119, 18, 96, 116, 183, 121,
-			180, 92, 122, 255, 96, 40, 111, 162, 175, 249, 30, 184, 137, 30,
-			209, 55, 209, 1, 177, 34, 47, 162, 29, 45, 35, 78, 240, 84,
-			72, 195, 54, 210, 102, 163, 214, 44, 141, 252, 180, 21, 159, 92,
-			60, 236, 183, 169, 46, 93, 185, 65, 39, 212, 212, 147, 128, 24,
-			181, 176, 170, 21, 165, 100, 165, 184, 208, 14, 118, 197, 216, 130,
-			97, 49, 59, 221, 128, 61, 68, 102, 164, 203, 240, 78, 227, 75,
-			178, 203, 31, 80, 108, 95, 10, 83, 109, 22, 75, 216, 197, 17,
-			39, 37, 75, 216, 213, 215, 111, 156, 71, 118, 235, 98, 200, 203,
-			116, 251, 194, 226, 101, 186, 219, 109, 111, 145, 221, 186, 24, 160,
-			162, 71, 23, 67, 95, 183, 61, 186, 24, 86, 217, 211, 222, 163,
-			82, 80, 172, 71, 23, 131, 68, 175, 46, 134, 97, 49, 123, 125,
-			229, 155, 18, 157, 21, 247, 234, 214, 48, 44, 102, 175, 46, 230,
-			103, 189, 62, 93, 204, 79, 97, 74, 181, 134, 126, 120, 251, 244,
-			216, 48, 28, 101, 159, 46, 6, 25, 251, 117, 177, 20, 165, 20,
-			74, 82, 20, 124, 84, 117, 18, 195, 81, 246, 235, 98, 192, 78,
-			15, 250, 131, 252, 41, 157, 194, 148, 106, 13, 157, 186, 14, 182,
-			247, 170, 20, 20, 27, 236, 31, 224, 98, 173, 24, 137, 84, 21,
-			107, 181, 226, 146, 74, 151, 169, 67, 186, 53, 140, 59, 62, 164,
-			139, 101, 48, 164, 232, 93, 252, 41, 147, 198, 212, 62, 149, 194,
-			0, 163, 129, 98, 243, 51, 24, 96, 244, 240, 168, 138, 59, 123,
-			160, 229, 188, 241, 214, 121, 128, 237, 250, 200, 12, 228, 160, 123,
-			183, 237, 173, 19, 53, 120, 165, 181, 56, 122, 169, 242, 15, 249,
-			119, 165, 148, 247, 77, 250, 126, 168, 163, 87, 60, 66, 110, 30,
-			164, 207, 38, 239, 112, 122, 32, 184, 47, 188, 4, 151, 243, 98,
-			117, 171, 50, 37, 109, 46, 72, 184, 92, 45, 108, 174, 39, 226,
-			85, 203, 189, 40, 125, 166, 209, 233, 170, 125, 112, 176, 19, 38,
-			255, 112, 250, 16, 90, 53, 104, 80, 10, 235, 239, 176, 33, 120,
-			207, 234, 236, 177, 33, 48, 208, 195, 125, 248, 244, 165, 58, 133,
-			17, 231, 211, 67, 86, 205, 200, 131, 143, 166, 15, 15, 88, 165,
-			112, 237, 142, 38, 106, 70, 196, 140, 118, 102, 109, 8, 212, 60,
-			58, 48, 40, 46, 24, 231, 165, 71, 253, 3, 48, 88, 249, 86,
-			80, 166, 75, 175, 148, 105, 195, 45, 91, 62, 93, 1, 7, 80,
-			183, 132, 161, 40, 245, 69, 194, 129, 130, 131, 156, 229, 214, 212,
-			199, 170, 58, 76, 26, 38, 242, 104, 103, 54, 225, 246, 244, 104,
-			223, 112, 194, 237, 233, 81, 184, 151, 117, 176, 215, 79, 127, 220,
-			205, 121, 150, 199, 73, 124, 46, 126, 149, 113, 56, 121, 204, 15,
-			2, 12, 24, 142, 215, 170, 66, 67, 61, 114, 72, 163, 111, 227,
-			66, 135, 24, 55, 22, 188, 51, 93, 210, 147, 36, 31, 54, 214,
-			35, 184, 154, 55, 162, 27, 113, 147, 75, 199, 99, 254, 120, 210,
-			165, 227, 177, 38, 151, 142, 199, 218, 7, 18, 46, 29, 143, 13,
-			239, 227, 190, 187, 89, 255, 132, 123, 175, 234, 59, 78, 213, 9,
-			145, 229, 190, 83, 212, 208, 83, 223, 181, 190, 203, 56, 158, 167,
-			252, 19, 253, 220, 55, 50, 135, 129, 6, 133, 73, 99, 160, 116,
-			221, 119, 25, 205, 243, 20, 244, 189, 157, 61, 65, 120, 167, 221,
-			94, 238, 58, 18, 160, 211, 162, 203, 68, 32, 126, 153, 59, 103,
-			71, 32, 126, 25, 236, 49, 237, 165, 246, 62, 191, 215, 114, 75,
-			123, 159, 14, 238, 139, 25, 239, 107, 235, 176, 220, 210, 222, 215,
-			221, 99, 34, 16, 159, 241, 179, 202, 239, 107, 26, 83, 25, 203,
-			39, 236, 153, 182, 78, 203, 39, 236, 25, 237, 19, 23, 72, 237,
-			253, 38, 2, 113, 26, 83, 25, 43, 2, 241, 253, 109, 118, 4,
-			226, 251, 237, 8, 196, 47, 215, 173, 121, 105, 76, 217, 17, 136,
-			95, 174, 91, 67, 44, 188, 92, 183, 6, 235, 248, 65, 166, 153,
-			50, 242, 239, 131, 126, 135, 21, 249, 247, 193, 206, 30, 43, 242,
-			239, 131, 76, 51, 41, 242, 239, 67, 254, 144, 21, 249, 247, 33,
-			93, 12, 73, 237, 67, 157, 89, 43, 242, 239, 67, 176, 5, 199,
-			168, 24, 100, 156, 241, 123, 71, 3, 216, 112, 27, 244, 18, 201,
-			194, 26, 90, 0, 215, 22, 27, 53, 229, 38, 55, 77, 57, 149,
-			187, 95, 36, 195, 51, 25, 213, 0, 146, 225, 25, 141, 101, 32,
-			195, 231, 252, 126, 254, 212, 154, 198, 148, 26, 55, 146, 225, 115,
-			236, 254, 152, 12, 66, 188, 115, 236, 68, 217, 65, 50, 60, 171,
-			177, 140, 100, 120, 86, 23, 67, 50, 60, 171, 177, 140, 100, 120,
-			22, 176, 124, 86, 70, 46, 158, 111, 41, 59, 193, 233, 16, 217,
-			184, 38, 159, 13, 8, 161, 103, 35, 228, 89, 248, 148, 151, 124,
-			66, 73, 57, 147, 81, 113, 132, 231, 97, 44, 167, 84, 28, 225,
-			71, 221, 87, 123, 193, 81, 205, 24, 216, 172, 69, 169, 178, 74,
-			254, 110, 153, 37, 21, 86, 184, 225, 71, 97, 211, 93, 48, 225,
-			134, 47, 249, 125, 223, 14, 13, 199, 253, 197, 218, 70, 254, 37,
-			255, 209, 254, 68, 168, 226, 75, 188, 191, 84, 168, 226, 75, 237,
-			93, 137, 80, 197, 151, 0, 59, 223, 116, 184, 47, 240, 125, 209,
-			239, 15, 254, 214, 9, 241, 178, 145, 120, 160, 149, 30, 32, 164,
-			151, 94, 221, 17, 61, 56, 244, 110, 97, 60, 64, 74, 243, 228,
-			218, 58, 61, 121, 74, 139, 228, 87, 215, 201, 56, 172, 90, 220,
-			44, 75, 73, 90, 125, 115, 185, 222, 40, 53, 164, 220, 204, 242,
-			87, 138, 129, 209, 229, 155, 181, 106, 69, 24, 236, 74, 179, 72,
-			170, 113, 82, 118, 49, 233, 235, 85, 182, 133, 93, 5, 214, 109,
-			5, 195, 101, 71, 40, 16, 178, 219, 170, 91, 198, 41, 198, 153,
-			149, 66, 33, 30, 85, 139, 254, 37, 19, 221, 25, 15, 170, 69,
-			11, 133, 184, 247, 23, 219, 187, 77, 26, 80, 184, 152, 149, 218,
-			62, 174, 60, 179, 175, 250, 81, 74, 125, 167, 217, 190, 218, 49,
-			64, 103, 182, 171, 206, 236, 199, 191, 253, 51, 219, 213, 103, 246,
-			227, 233, 171, 67, 116, 106, 186, 250, 204, 126, 156, 79, 86, 87,
-			159, 217, 143, 243, 153, 237, 234, 51, 251, 113, 62, 179, 93, 117,
-			102, 63, 153, 30, 182, 106, 70, 68, 60, 153, 126, 124, 192, 42,
-			133, 68, 240, 201, 116, 187, 13, 193, 98, 29, 125, 54, 4, 106,
-			126, 114, 112, 72, 252, 186, 163, 171, 70, 31, 234, 233, 225, 224,
-			103, 156, 80, 221, 12, 129, 125, 47, 146, 169, 64, 29, 165, 139,
-			99, 8, 30, 147, 239, 229, 114, 254, 110, 38, 15, 20, 189, 56,
-			164, 59, 13, 161, 174, 2, 202, 191, 114, 81, 70, 4, 223, 68,
-			79, 10, 244, 56, 59, 58, 42, 245, 33, 42, 213, 198, 20, 70,
-			182, 167, 57, 198, 231, 15, 190, 181, 146, 166, 61, 17, 173, 215,
-			97, 219, 103, 194, 215, 61, 247, 28, 251, 253, 80, 175, 52, 108,
-			46, 111, 240, 129, 202, 75, 207, 164, 159, 28, 182, 198, 138, 100,
-			244, 153, 180, 176, 33, 232, 63, 190, 221, 198, 7, 18, 246, 103,
-			6, 135, 44, 76, 3, 224, 122, 2, 211, 30, 212, 124, 61, 253,
-			140, 93, 51, 30, 0, 215, 19, 115, 136, 135, 192, 245, 78, 187,
-			102, 60, 8, 174, 67, 205, 159, 112, 121, 239, 2, 158, 75, 254,
-			209, 224, 125, 174, 68, 152, 68, 168, 21, 135, 221, 82, 173, 209,
-			24, 37, 111, 51, 203, 165, 134, 14, 156, 163, 119, 26, 110, 46,
-			233, 174, 153, 180, 46, 8, 47, 176, 72, 11, 107, 252, 92, 189,
-			26, 63, 27, 30, 127, 229, 145, 215, 61, 253, 204, 115, 175, 58,
-			246, 220, 113, 138, 27, 62, 122, 228, 117, 43, 213, 234, 115, 163,
-			218, 243, 119, 88, 189, 193, 222, 253, 41, 220, 247, 36, 228, 144,
-			255, 194, 212, 66, 102, 157, 19, 38, 10, 31, 7, 228, 213, 214,
-			60, 144, 144, 30, 133, 154, 17, 38, 214, 216, 209, 179, 184, 241,
-			45, 147, 210, 66, 172, 21, 93, 140, 93, 234, 110, 52, 195, 218,
-			232, 56, 159, 37, 107, 163, 210, 89, 93, 242, 3, 147, 70, 151,
-			245, 35, 161, 73, 163, 211, 250, 187, 142, 208, 1, 68, 156, 210,
-			13, 247, 168, 21, 159, 252, 6, 156, 3, 86, 124, 242, 27, 238,
-			144, 21, 159, 252, 198, 112, 104, 197, 39, 191, 1, 181, 8, 242,
-			22, 226, 87, 240, 186, 45, 164, 191, 15, 175, 146, 145, 199, 58,
-			26, 220, 121, 85, 118, 56, 66, 86, 114, 126, 213, 173, 200, 211,
-			142, 44, 228, 224, 99, 70, 165, 208, 223, 121, 219, 160, 74, 65,
-			237, 213, 125, 1, 215, 2, 159, 54, 92, 237, 184, 3, 106, 217,
-			112, 171, 35, 156, 19, 247, 242, 134, 219, 175, 82, 152, 117, 64,
-			127, 131, 90, 54, 14, 30, 210, 114, 240, 119, 206, 137, 153, 59,
-			127, 75, 143, 201, 33, 226, 181, 93, 35, 169, 127, 103, 196, 233,
-			223, 206, 203, 254, 183, 26, 139, 253, 251, 29, 49, 160, 86, 212,
-			124, 5, 157, 113, 54, 74, 68, 164, 95, 80, 28, 230, 211, 162,
-			77, 25, 210, 215, 88, 40, 54, 188, 151, 212, 39, 111, 178, 62,
-			226, 103, 224, 198, 62, 250, 81, 95, 100, 155, 220, 77, 162, 78,
-			194, 17, 19, 196, 209, 219, 37, 224, 50, 7, 117, 60, 102, 197,
-			64, 119, 247, 136, 162, 171, 99, 159, 127, 159, 232, 210, 242, 43,
-			89, 183, 103, 34, 211, 237, 138, 134, 188, 22, 120, 201, 48, 238,
-			15, 137, 30, 93, 131, 10, 38, 41, 131, 4, 247, 55, 53, 155,
-			187, 188, 185, 94, 207, 119, 171, 220, 42, 110, 241, 75, 69, 39,
-			236, 100, 163, 216, 193, 98, 244, 221, 84, 46, 58, 32, 163, 137,
-			190, 57, 45, 82, 164, 168, 195, 18, 116, 10, 53, 190, 19, 107,
-			185, 139, 152, 39, 47, 179, 102, 95, 38, 90, 217, 124, 156, 5,
-			232, 7, 247, 40, 53, 47, 115, 229, 85, 246, 224, 165, 34, 69,
-			53, 101, 71, 69, 103, 194, 192, 140, 163, 100, 182, 3, 16, 11,
-			47, 2, 8, 166, 209, 233, 113, 225, 111, 183, 199, 11, 158, 18,
-			173, 92, 25, 6, 71, 84, 118, 96, 28, 34, 85, 6, 79, 237,
-			140, 108, 35, 50, 187, 180, 92, 16, 240, 119, 170, 39, 13, 127,
-			167, 123, 90, 225, 239, 214, 158, 12, 252, 157, 233, 105, 27, 253,
-			143, 206, 206, 133, 82, 223, 64, 89, 173, 50, 210, 224, 144, 175,
-			58, 157, 61, 46, 180, 112, 242, 90, 169, 168, 68, 146, 201, 0,
-			252, 237, 42, 199, 124, 177, 142, 157, 214, 5, 232, 41, 151, 150,
-			72, 155, 89, 7, 164, 197, 4, 217, 210, 214, 152, 56, 48, 173,
-			233, 21, 127, 196, 0, 146, 242, 77, 243, 26, 122, 95, 165, 185,
-			206, 228, 133, 4, 93, 0, 200, 35, 223, 188, 95, 198, 27, 252,
-			128, 243, 61, 32, 158, 188, 108, 226, 13, 158, 83, 241, 6, 51,
-			58, 222, 160, 48, 241, 6, 59, 90, 246, 59, 150, 96, 241, 128,
-			184, 164, 133, 135, 238, 64, 240, 125, 33, 83, 26, 197, 167, 19,
-			41, 147, 65, 44, 244, 39, 246, 198, 201, 183, 18, 204, 101, 93,
-			72, 204, 3, 117, 151, 219, 113, 200, 150, 70, 38, 66, 217, 116,
-			181, 245, 52, 73, 35, 255, 193, 81, 226, 200, 126, 183, 55, 248,
-			27, 52, 128, 92, 81, 189, 0, 2, 75, 73, 197, 107, 112, 71,
-			164, 2, 41, 245, 163, 184, 227, 242, 176, 98, 25, 246, 25, 93,
-			18, 165, 24, 178, 203, 125, 204, 4, 26, 227, 250, 167, 182, 128,
-			101, 72, 70, 240, 16, 36, 189, 69, 237, 3, 246, 250, 101, 5,
-			247, 40, 87, 165, 169, 42, 122, 194, 149, 90, 154, 232, 40, 143,
-			226, 75, 133, 227, 168, 235, 35, 194, 49, 248, 93, 63, 190, 22,
-			71, 197, 250, 241, 117, 96, 52, 226, 218, 216, 68, 226, 89, 185,
-			31, 221, 38, 24, 81, 108, 127, 34, 112, 79, 63, 139, 21, 36,
-			75, 220, 15, 23, 222, 227, 56, 143, 109, 40, 90, 236, 12, 238,
-			82, 75, 49, 60, 69, 12, 139, 61, 115, 28, 33, 85, 72, 137,
-			99, 91, 11, 150, 224, 186, 218, 90, 156, 68, 202, 149, 169, 183,
-			57, 74, 202, 59, 226, 142, 7, 175, 213, 206, 90, 106, 77, 78,
-			237, 163, 66, 99, 51, 42, 27, 116, 226, 211, 4, 169, 57, 108,
-			197, 168, 106, 88, 151, 241, 40, 172, 187, 146, 176, 227, 120, 200,
-			149, 36, 101, 107, 170, 134, 117, 218, 120, 168, 28, 35, 93, 168,
-			73, 228, 32, 111, 53, 226, 14, 117, 42, 161, 114, 26, 59, 182,
-			207, 18, 56, 143, 4, 119, 89, 2, 231, 145, 187, 199, 196, 207,
-			167, 165, 156, 52, 215, 82, 114, 130, 143, 165, 155, 221, 42, 91,
-			218, 98, 232, 190, 14, 53, 180, 234, 128, 63, 214, 101, 97, 133,
-			78, 21, 84, 75, 69, 151, 131, 5, 56, 123, 105, 172, 206, 42,
-			174, 172, 9, 180, 132, 253, 63, 17, 42, 39, 53, 82, 171, 71,
-			122, 185, 222, 144, 215, 67, 210, 126, 14, 199, 73, 201, 88, 134,
-			91, 189, 46, 149, 188, 240, 207, 245, 228, 185, 200, 42, 200, 19,
-			185, 93, 43, 87, 231, 105, 162, 74, 5, 84, 69, 101, 189, 92,
-			182, 65, 74, 188, 86, 30, 84, 201, 181, 189, 208, 72, 119, 138,
-			214, 130, 213, 7, 36, 57, 79, 161, 65, 203, 78, 99, 47, 116,
-			7, 84, 8, 1, 197, 90, 163, 143, 188, 177, 58, 133, 180, 35,
-			19, 114, 173, 81, 129, 204, 114, 181, 177, 38, 48, 179, 212, 74,
-			8, 103, 96, 81, 236, 64, 147, 117, 217, 79, 248, 118, 49, 225,
-			63, 240, 214, 161, 3, 206, 177, 99, 247, 241, 82, 14, 118, 59,
-			206, 32, 142, 11, 119, 38, 197, 138, 104, 112, 152, 163, 168, 136,
-			97, 186, 100, 52, 8, 20, 44, 225, 116, 146, 162, 30, 187, 227,
-			137, 160, 38, 211, 148, 50, 38, 135, 17, 151, 26, 164, 9, 59,
-			95, 209, 90, 188, 147, 218, 89, 102, 3, 215, 54, 54, 185, 165,
-			212, 2, 231, 103, 235, 234, 62, 164, 110, 135, 172, 145, 126, 92,
-			249, 236, 18, 216, 193, 156, 210, 152, 179, 230, 131, 12, 185, 165,
-			154, 47, 235, 249, 224, 190, 177, 103, 136, 99, 144, 96, 62, 214,
-			168, 18, 234, 60, 80, 198, 221, 114, 125, 242, 141, 83, 105, 150,
-			225, 1, 66, 131, 101, 255, 143, 202, 117, 14, 105, 199, 52, 153,
-			147, 23, 75, 168, 205, 69, 65, 97, 213, 155, 66, 46, 19, 72,
-			71, 158, 36, 240, 60, 233, 222, 21, 188, 145, 49, 169, 239, 129,
-			122, 109, 232, 137, 164, 243, 81, 33, 74, 50, 238, 90, 123, 82,
-			52, 205, 37, 235, 236, 179, 35, 58, 137, 153, 237, 176, 88, 173,
-			140, 53, 38, 181, 37, 4, 234, 55, 106, 245, 39, 150, 170, 250,
-			216, 29, 97, 201, 95, 79, 182, 15, 91, 242, 215, 147, 251, 14,
-			90, 242, 215, 147, 135, 71, 197, 23, 29, 37, 128, 61, 237, 30,
-			12, 126, 205, 49, 158, 212, 204, 80, 164, 232, 153, 104, 128, 229,
-			182, 193, 56, 151, 215, 43, 95, 242, 16, 66, 245, 123, 105, 77,
-			237, 121, 224, 114, 180, 46, 53, 85, 73, 43, 154, 29, 53, 194,
-			56, 80, 149, 180, 34, 125, 177, 115, 124, 190, 155, 50, 162, 76,
-			169, 150, 164, 23, 60, 81, 250, 212, 231, 237, 39, 197, 224, 64,
-			147, 110, 38, 35, 141, 157, 118, 79, 222, 101, 73, 149, 79, 187,
-			61, 150, 84, 249, 116, 239, 62, 75, 170, 124, 122, 255, 1, 241,
-			38, 71, 137, 149, 207, 184, 247, 6, 117, 125, 173, 229, 152, 41,
-			146, 38, 18, 113, 135, 61, 162, 226, 230, 113, 23, 36, 197, 150,
-			36, 164, 86, 186, 25, 43, 147, 84, 85, 71, 125, 82, 46, 94,
-			121, 38, 203, 163, 48, 174, 77, 169, 19, 123, 57, 170, 151, 234,
-			122, 30, 81, 214, 124, 70, 207, 35, 210, 244, 51, 237, 161, 37,
-			217, 62, 115, 248, 184, 37, 217, 62, 51, 125, 74, 124, 197, 81,
-			162, 237, 135, 160, 235, 159, 119, 116, 187, 106, 231, 238, 209, 123,
-			61, 119, 183, 237, 63, 251, 87, 85, 34, 2, 216, 73, 104, 253,
-			84, 81, 238, 94, 81, 103, 47, 65, 174, 129, 76, 207, 1, 35,
-			199, 15, 72, 82, 188, 16, 109, 212, 195, 147, 103, 78, 90, 106,
-			135, 112, 152, 221, 140, 48, 192, 174, 90, 41, 149, 29, 213, 40,
-			97, 188, 143, 99, 19, 150, 216, 254, 161, 246, 131, 150, 216, 254,
-			161, 67, 199, 45, 177, 253, 67, 128, 147, 143, 123, 74, 110, 255,
-			136, 123, 119, 240, 62, 47, 60, 111, 221, 93, 104, 125, 87, 108,
-			103, 26, 82, 54, 10, 43, 110, 220, 40, 47, 206, 207, 78, 74,
-			13, 197, 9, 173, 212, 203, 254, 118, 149, 220, 4, 139, 163, 127,
-			4, 218, 214, 146, 205, 96, 31, 29, 213, 90, 233, 181, 182, 108,
-			145, 183, 171, 68, 188, 160, 243, 95, 159, 121, 106, 93, 179, 194,
-			52, 126, 50, 116, 80, 73, 240, 138, 90, 61, 24, 159, 218, 80,
-			55, 56, 113, 90, 169, 86, 140, 126, 190, 213, 101, 237, 175, 181,
-			161, 20, 41, 181, 11, 19, 201, 80, 212, 229, 241, 78, 49, 137,
-			150, 209, 147, 76, 2, 49, 147, 66, 119, 76, 118, 249, 184, 94,
-			56, 70, 83, 90, 235, 46, 23, 137, 43, 168, 20, 163, 50, 158,
-			29, 210, 136, 3, 14, 160, 53, 165, 165, 42, 18, 206, 72, 100,
-			8, 87, 246, 0, 13, 155, 246, 17, 247, 161, 123, 173, 87, 150,
-			71, 220, 62, 235, 149, 229, 145, 254, 195, 214, 43, 203, 35, 71,
-			142, 154, 199, 221, 139, 238, 147, 246, 227, 238, 69, 209, 45, 222,
-			227, 200, 36, 112, 130, 11, 157, 217, 224, 121, 135, 5, 84, 104,
-			76, 1, 227, 66, 241, 40, 45, 197, 132, 133, 9, 221, 49, 39,
-			149, 222, 231, 22, 122, 93, 107, 72, 215, 181, 74, 251, 90, 132,
-			148, 71, 89, 149, 229, 194, 11, 213, 45, 116, 111, 59, 41, 239,
-			62, 164, 235, 12, 229, 22, 46, 95, 124, 146, 248, 234, 196, 101,
-			149, 198, 74, 143, 172, 200, 110, 46, 116, 234, 128, 144, 196, 112,
-			38, 210, 174, 76, 171, 236, 248, 153, 60, 228, 203, 207, 78, 115,
-			218, 149, 233, 119, 121, 230, 13, 247, 9, 255, 64, 240, 79, 110,
-			120, 201, 92, 140, 113, 237, 236, 245, 210, 56, 134, 153, 198, 208,
-			247, 177, 124, 116, 156, 176, 175, 91, 66, 155, 2, 78, 162, 36,
-			185, 201, 103, 220, 181, 132, 87, 22, 227, 244, 89, 218, 241, 192,
-			143, 181, 146, 60, 186, 56, 124, 182, 29, 252, 98, 140, 45, 183,
-			198, 88, 24, 109, 175, 114, 185, 168, 81, 237, 148, 99, 206, 45,
-			51, 219, 9, 4, 19, 186, 164, 194, 161, 209, 84, 1, 43, 45,
-			11, 54, 159, 18, 38, 8, 212, 142, 110, 157, 152, 12, 35, 45,
-			170, 150, 80, 64, 196, 201, 211, 151, 206, 38, 66, 11, 54, 13,
-			72, 104, 159, 207, 167, 78, 92, 58, 59, 169, 247, 63, 105, 86,
-			23, 55, 11, 146, 197, 194, 111, 250, 69, 86, 94, 253, 158, 240,
-			23, 178, 137, 0, 160, 79, 52, 5, 0, 125, 162, 61, 249, 18,
-			254, 196, 200, 126, 243, 142, 248, 148, 155, 229, 125, 146, 130, 186,
-			158, 210, 235, 157, 158, 21, 159, 114, 91, 173, 103, 197, 167, 50,
-			157, 214, 179, 226, 83, 236, 199, 130, 222, 211, 95, 233, 174, 218,
-			239, 233, 175, 20, 189, 24, 62, 159, 146, 176, 79, 174, 117, 30,
-			14, 222, 113, 71, 251, 132, 69, 42, 183, 217, 41, 156, 235, 142,
-			247, 74, 114, 61, 233, 205, 226, 224, 102, 185, 166, 55, 135, 67,
-			155, 37, 145, 118, 101, 90, 101, 199, 207, 122, 115, 56, 180, 89,
-			18, 105, 87, 166, 85, 118, 76, 246, 245, 235, 207, 120, 200, 38,
-			210, 252, 93, 101, 7, 156, 94, 27, 28, 210, 159, 241, 252, 73,
-			164, 93, 153, 86, 217, 225, 8, 186, 182, 47, 208, 159, 145, 144,
-			37, 210, 174, 76, 171, 236, 48, 213, 215, 200, 175, 160, 252, 140,
-			243, 153, 72, 187, 50, 173, 178, 195, 228, 95, 59, 20, 234, 207,
-			248, 4, 156, 72, 187, 50, 253, 97, 215, 104, 77, 172, 248, 251,
-			131, 119, 187, 73, 86, 87, 46, 97, 139, 21, 214, 92, 63, 239,
-			109, 14, 31, 34, 79, 26, 138, 144, 170, 57, 120, 169, 189, 222,
-			208, 6, 192, 54, 75, 190, 59, 71, 110, 25, 201, 104, 123, 15,
-			142, 242, 70, 70, 45, 235, 210, 34, 24, 72, 15, 237, 184, 92,
-			210, 163, 20, 155, 208, 178, 62, 123, 45, 94, 103, 227, 130, 40,
-			209, 99, 219, 181, 188, 100, 38, 217, 52, 47, 39, 237, 120, 137,
-			107, 108, 246, 217, 108, 84, 63, 86, 252, 107, 135, 19, 170, 31,
-			43, 126, 38, 161, 250, 177, 210, 54, 148, 80, 253, 88, 9, 70,
-			120, 179, 194, 148, 172, 233, 136, 12, 105, 168, 107, 77, 111, 58,
-			122, 176, 95, 115, 219, 172, 7, 251, 53, 209, 107, 61, 216, 175,
-			145, 2, 20, 62, 161, 167, 110, 144, 12, 78, 189, 133, 223, 128,
-			107, 199, 95, 165, 212, 147, 200, 182, 219, 31, 252, 47, 41, 227,
-			10, 70, 25, 162, 147, 165, 4, 222, 183, 42, 241, 150, 109, 212,
-			141, 230, 18, 175, 174, 110, 162, 217, 43, 206, 129, 142, 12, 13,
-			55, 27, 126, 234, 179, 92, 60, 41, 198, 100, 210, 226, 67, 38,
-			119, 92, 200, 109, 8, 103, 147, 183, 54, 65, 29, 64, 73, 199,
-			148, 180, 222, 145, 220, 67, 45, 86, 209, 99, 76, 180, 131, 34,
-			186, 211, 194, 171, 194, 182, 156, 172, 49, 138, 175, 180, 137, 198,
-			145, 99, 124, 234, 68, 69, 122, 200, 196, 208, 233, 150, 145, 216,
-			120, 210, 74, 108, 130, 141, 235, 153, 189, 217, 198, 214, 204, 93,
-			73, 95, 40, 232, 66, 90, 139, 87, 202, 50, 222, 175, 186, 148,
-			168, 171, 44, 175, 74, 139, 249, 34, 171, 205, 26, 25, 135, 51,
-			167, 47, 133, 107, 85, 233, 156, 191, 36, 131, 41, 210, 168, 17,
-			162, 185, 33, 116, 183, 106, 176, 40, 101, 13, 234, 170, 192, 71,
-			5, 222, 132, 100, 31, 174, 203, 245, 122, 221, 48, 198, 232, 175,
-			124, 165, 28, 173, 178, 189, 47, 117, 170, 89, 28, 130, 145, 182,
-			217, 178, 103, 156, 77, 137, 53, 243, 12, 216, 128, 175, 242, 145,
-			111, 82, 70, 123, 231, 205, 18, 91, 22, 175, 228, 115, 81, 178,
-			150, 216, 139, 164, 144, 249, 58, 29, 122, 214, 75, 166, 250, 92,
-			151, 58, 0, 69, 145, 88, 69, 147, 230, 66, 70, 247, 61, 121,
-			114, 227, 176, 107, 200, 163, 162, 13, 238, 142, 17, 108, 233, 3,
-			91, 88, 31, 161, 244, 117, 230, 8, 229, 123, 223, 182, 123, 99,
-			191, 122, 211, 75, 225, 202, 79, 91, 239, 125, 219, 173, 221, 214,
-			123, 223, 118, 182, 79, 252, 169, 195, 97, 41, 188, 55, 184, 227,
-			193, 23, 157, 208, 174, 217, 132, 195, 77, 234, 213, 104, 171, 246,
-			156, 18, 89, 0, 129, 51, 35, 230, 139, 155, 32, 59, 158, 196,
-			40, 20, 121, 35, 97, 41, 133, 163, 224, 72, 157, 243, 48, 213,
-			124, 57, 66, 65, 119, 34, 206, 7, 189, 238, 202, 5, 105, 194,
-			101, 36, 235, 157, 100, 235, 107, 52, 0, 143, 73, 130, 163, 172,
-			213, 89, 185, 5, 111, 184, 48, 66, 157, 74, 99, 120, 225, 65,
-			149, 194, 209, 15, 221, 165, 82, 24, 106, 248, 238, 49, 241, 67,
-			58, 4, 196, 155, 29, 247, 104, 240, 102, 11, 53, 82, 54, 85,
-			210, 225, 19, 72, 32, 181, 179, 11, 90, 26, 41, 251, 162, 130,
-			97, 136, 61, 135, 65, 126, 244, 167, 98, 43, 110, 137, 116, 106,
-			167, 199, 194, 161, 29, 124, 234, 147, 78, 162, 215, 47, 167, 189,
-			215, 138, 3, 241, 102, 39, 27, 90, 113, 32, 222, 236, 220, 117,
-			68, 252, 171, 180, 96, 159, 179, 255, 194, 113, 131, 224, 95, 164,
-			213, 81, 37, 35, 65, 36, 125, 26, 224, 125, 91, 27, 161, 85,
-			237, 147, 103, 210, 182, 79, 131, 121, 87, 134, 250, 82, 180, 41,
-			197, 31, 124, 150, 209, 142, 151, 250, 201, 180, 37, 208, 86, 157,
-			183, 7, 26, 155, 74, 9, 30, 82, 94, 108, 145, 207, 69, 114,
-			218, 88, 100, 151, 19, 166, 79, 178, 246, 113, 69, 192, 31, 120,
-			0, 201, 247, 4, 155, 162, 111, 108, 196, 81, 141, 86, 38, 236,
-			12, 32, 6, 242, 43, 110, 11, 21, 159, 157, 104, 186, 52, 91,
-			71, 47, 25, 73, 95, 29, 124, 64, 191, 194, 150, 77, 72, 169,
-			12, 199, 146, 211, 141, 82, 80, 147, 73, 29, 168, 164, 84, 65,
-			49, 54, 107, 116, 162, 190, 71, 44, 141, 234, 241, 92, 217, 102,
-			177, 46, 215, 58, 174, 196, 134, 216, 149, 137, 16, 85, 105, 224,
-			166, 104, 140, 226, 154, 165, 175, 138, 112, 226, 85, 80, 10, 98,
-			37, 249, 52, 103, 116, 211, 64, 165, 247, 109, 20, 211, 53, 41,
-			161, 200, 120, 206, 59, 221, 134, 163, 34, 24, 34, 181, 28, 163,
-			151, 12, 169, 19, 54, 165, 29, 178, 80, 60, 52, 30, 130, 150,
-			114, 25, 255, 16, 227, 20, 187, 68, 89, 40, 238, 34, 143, 99,
-			87, 29, 19, 124, 133, 197, 63, 188, 108, 152, 185, 80, 177, 15,
-			36, 127, 131, 104, 102, 230, 55, 215, 196, 103, 69, 242, 168, 102,
-			130, 140, 127, 18, 252, 74, 130, 56, 89, 110, 225, 141, 43, 77,
-			131, 235, 82, 226, 50, 47, 43, 75, 40, 54, 153, 184, 49, 232,
-			251, 8, 182, 201, 155, 29, 165, 74, 129, 94, 133, 0, 208, 165,
-			146, 14, 38, 187, 7, 84, 146, 118, 213, 240, 62, 241, 51, 158,
-			144, 126, 160, 253, 247, 59, 238, 80, 240, 17, 143, 2, 15, 93,
-			79, 188, 24, 95, 151, 70, 209, 202, 113, 253, 68, 184, 168, 159,
-			19, 45, 5, 36, 203, 229, 43, 119, 151, 182, 134, 229, 204, 14,
-			229, 127, 202, 35, 163, 21, 43, 82, 57, 58, 134, 218, 198, 77,
-			96, 207, 41, 153, 35, 41, 182, 149, 28, 64, 9, 101, 84, 112,
-			123, 137, 49, 70, 226, 50, 156, 113, 55, 48, 130, 142, 45, 192,
-			209, 19, 80, 73, 54, 134, 111, 24, 40, 251, 96, 223, 143, 106,
-			5, 214, 226, 155, 213, 27, 204, 199, 176, 161, 45, 28, 157, 149,
-			85, 244, 81, 83, 173, 221, 160, 29, 183, 178, 169, 184, 19, 162,
-			5, 117, 117, 110, 239, 130, 41, 139, 77, 72, 176, 192, 218, 190,
-			27, 117, 123, 229, 206, 84, 190, 55, 133, 65, 159, 193, 12, 57,
-			35, 129, 69, 88, 170, 108, 106, 48, 57, 20, 145, 83, 142, 206,
-			73, 97, 210, 254, 133, 19, 240, 164, 98, 252, 173, 247, 171, 248,
-			91, 210, 7, 212, 251, 157, 86, 21, 125, 7, 181, 100, 222, 239,
-			12, 12, 106, 109, 148, 143, 165, 197, 131, 119, 174, 195, 33, 195,
-			124, 40, 78, 240, 59, 228, 29, 226, 25, 209, 147, 48, 248, 127,
-			33, 158, 33, 70, 133, 143, 53, 177, 14, 6, 41, 107, 152, 192,
-			70, 121, 250, 246, 200, 47, 249, 210, 20, 39, 251, 61, 240, 214,
-			109, 59, 133, 56, 39, 95, 181, 219, 97, 96, 193, 75, 195, 102,
-			20, 26, 41, 144, 116, 55, 104, 66, 20, 152, 192, 218, 150, 157,
-			77, 123, 102, 88, 12, 169, 231, 240, 78, 247, 64, 32, 148, 216,
-			176, 104, 63, 108, 119, 186, 237, 129, 101, 121, 221, 153, 176, 188,
-			238, 76, 88, 94, 119, 142, 104, 251, 113, 180, 110, 113, 7, 131,
-			231, 236, 88, 9, 116, 186, 105, 165, 68, 19, 50, 1, 143, 21,
-			164, 131, 234, 72, 33, 214, 93, 69, 94, 195, 237, 137, 122, 168,
-			236, 7, 210, 92, 241, 200, 253, 128, 10, 248, 38, 9, 12, 59,
-			249, 97, 177, 104, 226, 169, 185, 23, 131, 92, 240, 115, 114, 26,
-			251, 214, 97, 61, 53, 247, 118, 246, 90, 79, 205, 189, 253, 3,
-			122, 175, 252, 197, 148, 184, 255, 133, 174, 115, 25, 138, 193, 108,
-			148, 111, 81, 97, 234, 29, 105, 33, 232, 18, 132, 174, 99, 208,
-			174, 174, 67, 73, 81, 240, 138, 193, 219, 100, 135, 10, 82, 123,
-			100, 18, 217, 239, 19, 157, 90, 242, 18, 1, 89, 99, 165, 148,
-			17, 173, 66, 66, 85, 235, 131, 12, 179, 228, 85, 43, 148, 34,
-			11, 68, 224, 89, 10, 107, 172, 191, 52, 208, 84, 116, 145, 62,
-			230, 57, 83, 240, 136, 232, 176, 43, 67, 3, 83, 165, 89, 69,
-			6, 166, 82, 147, 170, 71, 120, 229, 234, 22, 109, 224, 206, 60,
-			254, 68, 59, 205, 53, 216, 116, 172, 242, 67, 191, 131, 119, 192,
-			30, 150, 213, 163, 169, 109, 177, 138, 210, 0, 170, 167, 75, 170,
-			36, 237, 232, 69, 110, 150, 242, 228, 57, 111, 118, 76, 164, 200,
-			161, 7, 219, 40, 247, 98, 161, 43, 53, 116, 181, 208, 216, 102,
-			227, 61, 250, 158, 189, 75, 120, 192, 109, 176, 126, 205, 46, 217,
-			240, 107, 118, 65, 116, 145, 120, 243, 217, 107, 176, 65, 241, 57,
-			127, 56, 69, 24, 25, 223, 189, 47, 51, 148, 247, 188, 204, 42,
-			13, 15, 59, 35, 27, 150, 189, 40, 58, 201, 63, 131, 174, 47,
-			205, 246, 168, 187, 214, 55, 135, 89, 19, 213, 117, 196, 22, 40,
-			120, 66, 100, 119, 54, 185, 139, 81, 227, 100, 210, 168, 113, 16,
-			91, 187, 132, 158, 199, 154, 48, 99, 108, 62, 243, 162, 119, 71,
-			227, 187, 84, 60, 150, 172, 120, 55, 108, 27, 99, 207, 80, 164,
-			229, 100, 101, 219, 68, 234, 21, 87, 231, 22, 151, 122, 90, 178,
-			237, 162, 117, 102, 105, 105, 238, 210, 149, 165, 30, 71, 106, 105,
-			229, 91, 235, 213, 90, 227, 218, 242, 246, 35, 191, 127, 183, 212,
-			94, 122, 254, 123, 65, 123, 233, 65, 139, 162, 191, 221, 33, 146,
-			158, 234, 192, 161, 5, 175, 15, 205, 204, 39, 205, 43, 216, 22,
-			66, 58, 140, 215, 190, 200, 18, 106, 26, 134, 107, 222, 150, 151,
-			17, 24, 230, 60, 41, 41, 104, 186, 110, 180, 81, 136, 14, 39,
-			36, 191, 182, 1, 102, 86, 252, 7, 237, 6, 164, 199, 29, 197,
-			88, 157, 22, 145, 105, 82, 36, 145, 47, 227, 168, 57, 193, 90,
-			27, 218, 36, 84, 63, 39, 69, 117, 75, 100, 175, 221, 184, 73,
-			66, 41, 163, 96, 243, 19, 190, 100, 189, 249, 69, 147, 121, 63,
-			86, 125, 56, 65, 215, 82, 21, 52, 82, 198, 40, 148, 194, 173,
-			49, 20, 103, 232, 182, 244, 1, 67, 85, 142, 37, 148, 180, 122,
-			48, 166, 143, 57, 203, 122, 220, 30, 235, 44, 235, 233, 61, 96,
-			157, 101, 61, 225, 97, 146, 174, 147, 65, 66, 214, 29, 100, 43,
-			84, 122, 133, 202, 138, 65, 146, 211, 178, 49, 66, 31, 187, 240,
-			85, 246, 4, 125, 126, 86, 202, 251, 149, 53, 65, 31, 191, 7,
-			40, 91, 130, 190, 246, 30, 147, 102, 19, 78, 85, 31, 106, 62,
-			177, 127, 85, 101, 69, 0, 233, 1, 157, 159, 20, 165, 172, 250,
-			72, 85, 138, 45, 82, 148, 253, 64, 127, 111, 86, 215, 7, 235,
-			108, 192, 239, 215, 245, 33, 199, 15, 233, 62, 157, 31, 159, 32,
-			6, 172, 250, 176, 3, 3, 108, 158, 161, 244, 239, 7, 178, 125,
-			226, 173, 218, 163, 202, 136, 123, 79, 240, 223, 156, 48, 113, 178,
-			236, 182, 36, 162, 80, 126, 178, 31, 164, 209, 121, 162, 181, 150,
-			233, 65, 25, 189, 53, 170, 7, 232, 7, 233, 237, 6, 182, 16,
-			30, 1, 22, 20, 242, 176, 63, 63, 170, 18, 102, 247, 105, 0,
-			77, 82, 54, 184, 2, 92, 160, 220, 104, 48, 7, 185, 17, 70,
-			251, 150, 61, 183, 81, 26, 251, 35, 59, 33, 235, 167, 70, 37,
-			187, 0, 63, 237, 236, 152, 196, 91, 140, 90, 126, 227, 42, 12,
-			199, 137, 137, 156, 54, 21, 246, 17, 13, 194, 98, 40, 70, 218,
-			135, 44, 134, 98, 100, 248, 168, 197, 80, 140, 140, 79, 136, 247,
-			182, 209, 90, 114, 178, 169, 57, 247, 45, 142, 23, 188, 173, 45,
-			156, 9, 37, 109, 111, 194, 157, 220, 226, 85, 142, 68, 43, 195,
-			62, 108, 72, 66, 138, 183, 97, 105, 66, 96, 4, 120, 194, 92,
-			59, 248, 32, 201, 133, 76, 170, 201, 122, 48, 84, 166, 68, 82,
-			241, 169, 88, 109, 76, 21, 99, 14, 48, 15, 228, 160, 177, 150,
-			195, 23, 116, 124, 251, 138, 208, 23, 221, 100, 88, 143, 182, 89,
-			194, 17, 147, 48, 50, 169, 22, 194, 253, 58, 35, 240, 86, 74,
-			55, 232, 241, 82, 241, 129, 98, 28, 21, 151, 227, 120, 101, 226,
-			12, 95, 161, 89, 179, 231, 129, 240, 229, 58, 22, 233, 131, 97,
-			120, 23, 146, 115, 190, 206, 106, 5, 154, 156, 229, 61, 231, 154,
-			212, 224, 35, 147, 127, 40, 76, 54, 15, 59, 242, 163, 33, 212,
-			53, 126, 182, 128, 76, 175, 147, 25, 194, 112, 20, 142, 161, 209,
-			51, 225, 168, 116, 135, 50, 169, 193, 228, 88, 19, 62, 60, 61,
-			138, 143, 225, 163, 147, 225, 73, 88, 99, 50, 87, 29, 82, 120,
-			103, 124, 149, 201, 93, 223, 92, 198, 188, 175, 27, 93, 175, 175,
-			194, 143, 147, 39, 159, 155, 12, 117, 98, 250, 57, 149, 243, 57,
-			66, 0, 19, 69, 27, 5, 175, 63, 121, 231, 72, 72, 4, 175,
-			120, 64, 191, 165, 200, 143, 43, 156, 202, 81, 120, 85, 169, 98,
-			144, 168, 170, 41, 27, 161, 133, 57, 109, 27, 43, 181, 122, 185,
-			129, 104, 217, 198, 209, 106, 104, 245, 6, 192, 232, 186, 108, 198,
-			179, 132, 247, 102, 146, 25, 209, 205, 92, 46, 68, 124, 55, 222,
-			168, 199, 155, 197, 234, 20, 241, 165, 114, 116, 146, 197, 58, 99,
-			244, 211, 194, 48, 201, 29, 157, 81, 61, 24, 181, 7, 73, 179,
-			160, 134, 57, 170, 112, 57, 122, 68, 146, 130, 61, 230, 56, 167,
-			39, 80, 94, 239, 79, 158, 184, 211, 130, 48, 151, 57, 57, 115,
-			79, 159, 60, 153, 40, 184, 27, 214, 114, 132, 147, 167, 205, 122,
-			64, 156, 44, 212, 228, 82, 223, 101, 192, 9, 238, 205, 140, 247,
-			78, 6, 243, 244, 201, 87, 81, 167, 94, 200, 72, 158, 62, 241,
-			42, 51, 24, 211, 193, 139, 37, 140, 238, 95, 14, 199, 114, 210,
-			145, 220, 216, 211, 99, 97, 97, 45, 194, 128, 255, 72, 2, 152,
-			198, 65, 181, 209, 134, 146, 138, 71, 225, 114, 84, 184, 81, 47,
-			71, 20, 190, 83, 30, 107, 72, 181, 230, 68, 15, 89, 225, 65,
-			10, 111, 153, 231, 253, 249, 20, 31, 3, 14, 241, 5, 231, 219,
-			186, 201, 12, 139, 210, 120, 240, 61, 156, 150, 54, 113, 12, 112,
-			16, 210, 110, 67, 224, 240, 121, 184, 203, 46, 4, 89, 46, 164,
-			179, 86, 22, 135, 32, 157, 54, 4, 10, 93, 232, 233, 21, 159,
-			115, 101, 87, 176, 161, 43, 254, 96, 240, 105, 55, 148, 60, 163,
-			37, 123, 146, 50, 208, 2, 140, 132, 164, 251, 138, 104, 154, 136,
-			120, 108, 160, 136, 82, 79, 121, 126, 200, 64, 175, 64, 221, 111,
-			70, 165, 178, 138, 155, 194, 89, 107, 177, 86, 229, 82, 210, 78,
-			188, 111, 234, 107, 105, 101, 167, 16, 81, 88, 30, 132, 213, 99,
-			56, 58, 105, 99, 101, 44, 242, 6, 122, 125, 70, 189, 162, 201,
-			16, 145, 196, 30, 81, 76, 0, 172, 245, 8, 63, 147, 18, 157,
-			135, 105, 54, 145, 104, 133, 244, 61, 203, 246, 126, 44, 35, 210,
-			14, 221, 182, 20, 153, 134, 10, 229, 49, 66, 103, 43, 203, 145,
-			37, 162, 80, 136, 165, 194, 46, 182, 240, 251, 231, 21, 107, 86,
-			137, 9, 186, 162, 15, 127, 249, 254, 121, 165, 189, 215, 164, 225,
-			244, 186, 210, 63, 32, 254, 147, 35, 23, 137, 200, 122, 87, 59,
-			135, 130, 223, 117, 194, 105, 121, 75, 151, 252, 52, 135, 217, 33,
-			86, 157, 142, 19, 82, 0, 64, 133, 128, 109, 163, 31, 68, 25,
-			16, 177, 163, 76, 24, 71, 217, 178, 173, 164, 189, 243, 90, 110,
-			8, 148, 190, 129, 150, 160, 29, 175, 214, 118, 60, 89, 39, 92,
-			80, 11, 237, 204, 140, 94, 10, 145, 33, 143, 241, 65, 16, 206,
-			74, 242, 11, 12, 140, 50, 246, 96, 138, 172, 220, 180, 98, 213,
-			122, 61, 46, 223, 140, 235, 172, 146, 128, 67, 108, 193, 49, 14,
-			170, 109, 209, 150, 245, 30, 235, 236, 86, 31, 81, 95, 225, 177,
-			78, 197, 108, 73, 125, 133, 68, 218, 149, 233, 47, 59, 188, 118,
-			225, 251, 211, 254, 161, 224, 179, 78, 184, 136, 87, 77, 218, 161,
-			115, 252, 106, 90, 221, 144, 75, 68, 251, 10, 144, 94, 133, 121,
-			76, 165, 26, 250, 230, 69, 148, 241, 225, 191, 205, 7, 117, 5,
-			249, 30, 200, 130, 106, 21, 80, 203, 171, 55, 73, 221, 107, 103,
-			13, 99, 140, 230, 49, 43, 28, 56, 14, 90, 62, 5, 9, 243,
-			54, 22, 154, 112, 67, 147, 124, 135, 160, 147, 94, 86, 35, 121,
-			20, 185, 243, 172, 165, 132, 124, 233, 211, 254, 99, 221, 122, 169,
-			32, 19, 244, 180, 223, 111, 210, 56, 244, 129, 192, 164, 97, 41,
-			61, 125, 224, 160, 194, 36, 242, 165, 175, 228, 0, 18, 45, 50,
-			156, 139, 255, 74, 255, 233, 67, 58, 63, 190, 182, 191, 210, 170,
-			15, 81, 249, 202, 129, 97, 147, 134, 250, 94, 57, 178, 95, 252,
-			165, 207, 21, 162, 205, 157, 127, 38, 248, 178, 31, 38, 46, 194,
-			77, 220, 149, 36, 215, 56, 184, 49, 121, 96, 149, 128, 161, 138,
-			199, 152, 46, 228, 184, 44, 1, 19, 81, 161, 17, 21, 72, 145,
-			137, 101, 82, 79, 62, 196, 67, 76, 26, 77, 235, 10, 18, 145,
-			90, 36, 77, 0, 136, 142, 215, 155, 185, 171, 210, 138, 164, 78,
-			74, 156, 15, 236, 38, 137, 160, 165, 47, 101, 36, 234, 234, 28,
-			33, 102, 5, 207, 75, 100, 166, 71, 145, 9, 169, 174, 80, 26,
-			105, 18, 90, 130, 194, 90, 194, 127, 234, 141, 205, 149, 149, 209,
-			87, 61, 103, 142, 132, 37, 37, 255, 182, 180, 121, 205, 176, 20,
-			155, 200, 154, 25, 196, 79, 210, 153, 46, 91, 4, 222, 129, 155,
-			124, 149, 6, 230, 160, 101, 130, 83, 211, 187, 192, 177, 43, 187,
-			128, 185, 107, 123, 125, 224, 49, 220, 238, 59, 252, 195, 237, 242,
-			235, 145, 116, 39, 180, 178, 89, 150, 2, 122, 26, 14, 189, 26,
-			49, 78, 235, 218, 227, 122, 204, 134, 240, 112, 54, 24, 59, 12,
-			33, 41, 165, 188, 182, 214, 112, 174, 232, 103, 137, 28, 248, 147,
-			210, 50, 221, 52, 85, 136, 53, 50, 44, 175, 89, 75, 31, 205,
-			141, 55, 252, 87, 30, 208, 75, 17, 213, 86, 55, 252, 187, 77,
-			26, 141, 65, 199, 94, 98, 210, 184, 52, 95, 118, 159, 120, 147,
-			90, 170, 244, 44, 234, 223, 27, 252, 173, 23, 218, 162, 149, 189,
-			151, 42, 209, 125, 179, 72, 169, 80, 211, 234, 20, 123, 46, 79,
-			169, 252, 67, 171, 96, 183, 197, 169, 214, 166, 90, 154, 226, 255,
-			237, 181, 25, 243, 104, 110, 177, 42, 129, 247, 193, 133, 33, 155,
-			51, 208, 147, 175, 130, 21, 163, 190, 81, 211, 205, 223, 78, 202,
-			111, 216, 157, 230, 79, 211, 242, 147, 236, 226, 119, 119, 105, 201,
-			37, 132, 135, 76, 15, 47, 9, 223, 207, 166, 96, 9, 108, 156,
-			209, 171, 10, 67, 121, 0, 228, 176, 1, 208, 243, 248, 232, 113,
-			3, 160, 7, 242, 233, 83, 82, 44, 129, 239, 253, 111, 117, 220,
-			3, 150, 197, 208, 91, 213, 83, 59, 89, 12, 65, 146, 159, 218,
-			101, 116, 153, 183, 58, 217, 97, 149, 244, 48, 57, 178, 95, 139,
-			185, 127, 245, 1, 241, 2, 158, 115, 182, 162, 242, 13, 105, 158,
-			108, 73, 185, 15, 54, 187, 228, 44, 178, 165, 24, 155, 13, 31,
-			106, 254, 174, 79, 165, 239, 128, 77, 242, 183, 35, 163, 255, 86,
-			229, 243, 255, 50, 35, 58, 108, 1, 218, 29, 63, 96, 73, 115,
-			227, 218, 182, 253, 130, 101, 196, 126, 121, 249, 49, 59, 33, 252,
-			245, 106, 49, 102, 225, 53, 201, 221, 147, 46, 243, 225, 99, 158,
-			178, 160, 76, 87, 26, 236, 250, 70, 166, 187, 83, 249, 89, 153,
-			234, 78, 27, 83, 221, 20, 27, 80, 55, 231, 111, 54, 210, 197,
-			50, 241, 179, 178, 76, 122, 143, 50, 115, 207, 114, 25, 206, 24,
-			188, 211, 17, 62, 118, 146, 124, 36, 174, 212, 217, 96, 22, 127,
-			102, 31, 16, 109, 210, 0, 7, 205, 146, 93, 18, 233, 31, 218,
-			117, 128, 185, 89, 149, 45, 111, 74, 140, 78, 139, 54, 13, 207,
-			118, 136, 204, 249, 133, 252, 227, 51, 249, 217, 197, 158, 150, 108,
-			167, 104, 59, 59, 115, 238, 81, 153, 116, 178, 25, 225, 159, 93,
-			88, 186, 208, 227, 6, 111, 80, 102, 198, 35, 162, 141, 180, 14,
-			227, 13, 158, 42, 47, 159, 65, 19, 99, 76, 103, 239, 21, 248,
-			251, 26, 46, 77, 158, 157, 125, 59, 220, 175, 206, 242, 186, 206,
-			183, 174, 203, 168, 5, 119, 98, 185, 28, 252, 152, 103, 204, 149,
-			79, 219, 175, 34, 237, 211, 225, 94, 248, 207, 45, 72, 3, 25,
-			245, 110, 114, 70, 180, 42, 75, 112, 247, 14, 75, 170, 2, 217,
-			7, 69, 155, 177, 4, 247, 238, 176, 180, 41, 130, 214, 202, 149,
-			205, 117, 243, 226, 93, 167, 197, 214, 153, 239, 4, 168, 113, 105,
-			156, 221, 39, 50, 43, 91, 69, 196, 110, 157, 109, 144, 91, 87,
-			100, 40, 94, 68, 60, 94, 59, 229, 183, 180, 180, 158, 94, 230,
-			208, 183, 193, 115, 162, 149, 27, 197, 229, 130, 246, 211, 188, 92,
-			224, 39, 190, 14, 233, 23, 223, 140, 124, 225, 205, 14, 106, 179,
-			104, 233, 52, 83, 217, 65, 163, 137, 54, 7, 93, 99, 35, 112,
-			157, 70, 71, 3, 36, 75, 137, 139, 170, 111, 156, 12, 46, 139,
-			86, 94, 191, 88, 177, 212, 232, 35, 111, 0, 109, 121, 78, 189,
-			32, 243, 255, 71, 254, 102, 90, 62, 87, 252, 240, 247, 194, 115,
-			197, 152, 49, 182, 30, 87, 198, 214, 109, 108, 119, 13, 156, 141,
-			104, 157, 165, 159, 126, 214, 107, 215, 79, 27, 157, 25, 33, 126,
-			215, 149, 79, 27, 131, 136, 134, 224, 215, 221, 196, 115, 67, 19,
-			175, 131, 71, 138, 180, 72, 182, 31, 18, 94, 161, 116, 51, 107,
-			213, 205, 213, 53, 169, 144, 163, 117, 66, 198, 232, 14, 183, 133,
-			113, 2, 109, 117, 33, 124, 199, 32, 180, 174, 193, 161, 143, 1,
-			92, 164, 105, 30, 242, 66, 200, 252, 90, 154, 143, 28, 217, 101,
-			210, 168, 88, 208, 133, 16, 209, 129, 39, 122, 185, 28, 151, 1,
-			243, 196, 33, 161, 201, 147, 146, 7, 87, 107, 124, 41, 5, 138,
-			32, 173, 2, 88, 88, 64, 116, 219, 152, 13, 64, 114, 19, 218,
-			163, 161, 9, 227, 225, 221, 24, 63, 84, 170, 168, 103, 143, 178,
-			4, 36, 101, 172, 162, 84, 101, 155, 56, 84, 96, 30, 215, 132,
-			138, 149, 61, 173, 55, 154, 193, 76, 191, 152, 85, 79, 52, 195,
-			238, 129, 224, 165, 225, 2, 223, 39, 115, 112, 103, 139, 247, 208,
-			82, 90, 145, 183, 104, 125, 89, 76, 188, 142, 12, 187, 131, 131,
-			214, 235, 200, 112, 226, 165, 127, 56, 241, 210, 63, 12, 55, 175,
-			15, 121, 234, 69, 32, 116, 135, 130, 31, 246, 66, 249, 108, 101,
-			44, 166, 19, 70, 147, 53, 41, 133, 145, 49, 22, 44, 133, 185,
-			70, 45, 66, 123, 109, 184, 6, 179, 131, 124, 97, 129, 8, 145,
-			213, 66, 1, 46, 76, 36, 58, 217, 172, 176, 133, 155, 198, 183,
-			82, 178, 194, 72, 2, 69, 88, 204, 17, 198, 68, 208, 193, 88,
-			76, 248, 5, 20, 224, 203, 72, 208, 145, 150, 205, 96, 148, 201,
-			155, 219, 36, 37, 185, 95, 74, 43, 184, 79, 56, 51, 172, 16,
-			38, 77, 126, 81, 31, 22, 38, 154, 56, 85, 114, 148, 94, 160,
-			153, 91, 133, 197, 87, 142, 165, 236, 105, 141, 30, 20, 80, 189,
-			20, 249, 26, 92, 5, 82, 221, 79, 73, 41, 68, 115, 127, 141,
-			201, 4, 119, 188, 176, 109, 25, 64, 18, 186, 168, 231, 58, 126,
-			181, 52, 68, 171, 81, 120, 49, 233, 234, 159, 98, 57, 20, 41,
-			152, 20, 110, 3, 218, 51, 165, 138, 116, 134, 37, 77, 197, 140,
-			2, 68, 232, 14, 219, 10, 16, 97, 66, 1, 34, 100, 127, 117,
-			242, 189, 34, 28, 24, 52, 79, 95, 163, 238, 73, 251, 233, 107,
-			20, 110, 53, 63, 233, 154, 183, 175, 49, 191, 55, 248, 113, 55,
-			156, 61, 191, 40, 181, 79, 128, 61, 110, 200, 215, 151, 18, 91,
-			155, 210, 201, 58, 37, 229, 25, 227, 165, 250, 218, 4, 217, 85,
-			135, 103, 81, 245, 178, 233, 3, 137, 58, 69, 184, 88, 66, 135,
-			68, 242, 45, 38, 42, 90, 91, 135, 44, 21, 203, 141, 210, 20,
-			80, 132, 56, 42, 170, 72, 27, 44, 255, 146, 252, 120, 9, 149,
-			38, 241, 253, 38, 94, 1, 68, 176, 109, 145, 84, 169, 44, 72,
-			13, 116, 178, 66, 66, 179, 184, 181, 237, 229, 90, 9, 189, 44,
-			53, 182, 208, 151, 25, 142, 1, 209, 121, 246, 252, 34, 155, 69,
-			194, 136, 26, 36, 228, 195, 119, 201, 90, 181, 44, 223, 37, 151,
-			75, 145, 50, 32, 182, 86, 35, 87, 134, 61, 166, 171, 86, 121,
-			181, 90, 3, 190, 114, 93, 95, 28, 229, 30, 27, 243, 71, 123,
-			18, 111, 131, 99, 108, 126, 160, 222, 6, 199, 218, 58, 18, 111,
-			131, 99, 221, 61, 20, 243, 30, 210, 40, 148, 61, 230, 31, 79,
-			5, 139, 161, 230, 127, 154, 21, 241, 18, 253, 74, 168, 81, 54,
-			235, 233, 105, 195, 94, 36, 80, 57, 253, 0, 72, 212, 229, 24,
-			69, 136, 207, 200, 52, 78, 243, 100, 186, 143, 69, 180, 45, 76,
-			15, 38, 211, 93, 54, 4, 104, 254, 100, 175, 93, 8, 178, 76,
-			165, 251, 173, 44, 14, 65, 186, 109, 8, 20, 154, 202, 246, 89,
-			133, 0, 144, 75, 100, 193, 106, 114, 236, 33, 140, 33, 152, 135,
-			77, 130, 248, 189, 244, 132, 191, 63, 241, 94, 122, 2, 112, 100,
-			222, 67, 211, 152, 161, 51, 241, 94, 122, 162, 107, 40, 241, 94,
-			122, 130, 77, 60, 168, 3, 211, 110, 143, 117, 167, 154, 214, 203,
-			159, 36, 82, 211, 172, 165, 46, 123, 54, 221, 218, 110, 121, 97,
-			152, 238, 234, 230, 109, 3, 183, 173, 123, 221, 139, 158, 37, 90,
-			191, 23, 58, 248, 25, 199, 8, 180, 239, 243, 135, 131, 79, 58,
-			100, 194, 135, 219, 195, 104, 110, 241, 99, 183, 253, 102, 74, 118,
-			44, 68, 17, 227, 251, 195, 19, 234, 205, 187, 180, 190, 30, 23,
-			209, 210, 110, 76, 132, 227, 149, 106, 98, 174, 39, 38, 195, 169,
-			147, 42, 35, 234, 52, 34, 231, 59, 38, 31, 62, 31, 60, 161,
-			140, 192, 209, 0, 7, 101, 213, 164, 219, 188, 10, 59, 236, 38,
-			139, 12, 112, 3, 149, 138, 176, 100, 160, 146, 146, 241, 113, 208,
-			36, 72, 190, 207, 191, 215, 18, 20, 167, 112, 76, 109, 9, 65,
-			242, 125, 162, 47, 33, 72, 190, 111, 112, 72, 252, 158, 107, 4,
-			163, 51, 254, 61, 193, 175, 145, 25, 35, 114, 209, 183, 50, 97,
-			36, 133, 108, 189, 126, 37, 29, 101, 170, 140, 183, 108, 94, 199,
-			228, 43, 21, 5, 120, 101, 69, 249, 21, 199, 132, 111, 115, 101,
-			84, 119, 134, 223, 99, 203, 164, 245, 75, 196, 97, 108, 146, 95,
-			16, 148, 145, 76, 172, 98, 158, 161, 64, 154, 94, 79, 35, 116,
-			238, 166, 148, 66, 249, 141, 24, 21, 77, 161, 71, 82, 228, 167,
-			44, 20, 173, 48, 30, 108, 236, 196, 193, 85, 180, 96, 97, 151,
-			160, 98, 171, 181, 141, 130, 174, 11, 29, 165, 224, 121, 32, 187,
-			196, 166, 199, 40, 223, 95, 3, 226, 46, 204, 32, 148, 73, 176,
-			178, 73, 72, 10, 101, 103, 252, 251, 134, 19, 66, 217, 25, 216,
-			29, 182, 80, 118, 230, 192, 209, 132, 80, 118, 102, 124, 66, 124,
-			198, 51, 82, 217, 71, 253, 3, 193, 39, 189, 239, 166, 117, 41,
-			117, 251, 154, 52, 245, 106, 182, 56, 149, 80, 41, 239, 23, 123,
-			217, 163, 146, 205, 209, 173, 13, 82, 229, 145, 251, 63, 152, 65,
-			170, 18, 164, 63, 234, 207, 220, 99, 4, 229, 41, 156, 83, 145,
-			16, 164, 63, 218, 158, 20, 164, 63, 202, 6, 169, 228, 92, 241,
-			18, 27, 164, 74, 199, 138, 151, 52, 33, 35, 57, 231, 37, 29,
-			250, 5, 111, 4, 151, 50, 202, 69, 13, 222, 4, 46, 145, 233,
-			37, 18, 64, 55, 155, 90, 112, 127, 192, 81, 20, 16, 155, 92,
-			16, 189, 252, 184, 72, 94, 95, 175, 248, 85, 69, 163, 165, 67,
-			215, 43, 29, 125, 226, 111, 29, 58, 9, 216, 167, 235, 19, 233,
-			190, 224, 143, 29, 84, 102, 224, 131, 119, 172, 84, 28, 51, 177,
-			170, 44, 181, 111, 163, 84, 173, 240, 99, 84, 64, 19, 209, 253,
-			96, 251, 141, 97, 4, 65, 186, 78, 88, 156, 18, 29, 240, 241,
-			179, 204, 31, 144, 133, 4, 186, 75, 224, 103, 150, 18, 62, 24,
-			242, 115, 136, 138, 222, 84, 42, 146, 162, 185, 172, 72, 223, 167,
-			240, 206, 65, 229, 181, 19, 87, 230, 217, 248, 174, 32, 228, 13,
-			3, 151, 27, 92, 124, 216, 61, 102, 139, 10, 116, 237, 63, 145,
-			190, 50, 192, 71, 157, 114, 62, 251, 132, 62, 252, 92, 109, 76,
-			220, 101, 67, 208, 156, 152, 220, 243, 42, 204, 225, 187, 75, 186,
-			63, 56, 25, 162, 123, 57, 12, 160, 90, 79, 156, 250, 73, 199,
-			40, 161, 177, 153, 180, 59, 67, 175, 59, 233, 39, 250, 172, 134,
-			80, 239, 232, 233, 68, 103, 232, 133, 167, 189, 219, 134, 224, 27,
-			15, 28, 232, 31, 243, 116, 111, 128, 160, 172, 165, 7, 131, 247,
-			122, 86, 119, 212, 227, 187, 126, 175, 109, 234, 147, 186, 171, 36,
-			102, 90, 143, 64, 42, 129, 225, 161, 100, 66, 49, 153, 99, 161,
-			92, 93, 45, 21, 248, 222, 128, 49, 141, 18, 78, 96, 148, 57,
-			251, 74, 179, 117, 128, 182, 51, 144, 167, 40, 189, 161, 33, 37,
-			33, 203, 196, 173, 18, 174, 1, 189, 182, 224, 78, 80, 142, 42,
-			55, 118, 118, 12, 170, 225, 0, 179, 184, 116, 108, 157, 103, 237,
-			205, 83, 219, 191, 138, 219, 26, 192, 90, 246, 175, 123, 216, 189,
-			10, 109, 248, 154, 55, 17, 58, 209, 221, 202, 204, 210, 204, 181,
-			197, 249, 167, 230, 174, 93, 156, 191, 52, 191, 132, 29, 189, 64,
-			113, 234, 240, 212, 67, 58, 134, 203, 79, 94, 173, 19, 43, 16,
-			41, 199, 90, 250, 233, 126, 107, 66, 145, 118, 172, 37, 38, 29,
-			151, 215, 90, 123, 175, 13, 97, 235, 214, 39, 245, 156, 163, 135,
-			206, 244, 112, 112, 1, 241, 99, 25, 226, 78, 26, 165, 38, 25,
-			152, 206, 132, 199, 231, 24, 159, 137, 104, 121, 138, 188, 38, 250,
-			136, 20, 233, 70, 122, 109, 208, 106, 31, 195, 42, 220, 72, 244,
-			17, 41, 211, 141, 118, 123, 241, 34, 117, 186, 1, 236, 199, 172,
-			238, 163, 159, 245, 42, 233, 161, 224, 212, 142, 62, 178, 72, 200,
-			234, 235, 45, 186, 131, 158, 45, 42, 233, 27, 195, 86, 83, 24,
-			174, 161, 146, 232, 14, 26, 134, 87, 218, 179, 54, 4, 186, 83,
-			25, 80, 58, 132, 100, 248, 187, 193, 1, 208, 91, 216, 203, 251,
-			134, 69, 31, 233, 54, 190, 225, 183, 155, 52, 62, 48, 117, 244,
-			154, 52, 62, 48, 245, 15, 232, 250, 224, 243, 107, 252, 97, 93,
-			31, 238, 230, 215, 248, 27, 131, 58, 63, 178, 5, 175, 177, 234,
-			195, 157, 252, 154, 142, 62, 147, 134, 250, 94, 195, 150, 238, 45,
-			20, 24, 222, 171, 249, 129, 174, 15, 23, 74, 205, 127, 205, 176,
-			206, 143, 156, 113, 205, 170, 15, 59, 80, 235, 24, 48, 105, 168,
-			175, 54, 188, 79, 252, 255, 93, 174, 16, 109, 58, 253, 131, 193,
-			15, 88, 113, 217, 116, 48, 99, 195, 1, 91, 223, 44, 10, 177,
-			17, 215, 140, 237, 0, 31, 2, 130, 15, 217, 157, 150, 63, 150,
-			73, 157, 57, 22, 172, 232, 220, 184, 59, 236, 62, 168, 51, 29,
-			89, 12, 146, 71, 145, 34, 34, 154, 152, 162, 90, 35, 220, 241,
-			75, 248, 0, 183, 88, 85, 119, 195, 80, 217, 212, 93, 63, 121,
-			93, 36, 141, 221, 73, 166, 54, 179, 184, 148, 176, 236, 41, 198,
-			59, 45, 32, 132, 198, 44, 46, 239, 109, 191, 22, 104, 204, 225,
-			226, 222, 214, 135, 55, 153, 115, 121, 219, 237, 251, 76, 26, 49,
-			185, 255, 128, 120, 167, 194, 44, 172, 235, 55, 250, 131, 193, 55,
-			224, 220, 148, 114, 87, 52, 254, 99, 186, 107, 66, 122, 91, 232,
-			220, 33, 67, 219, 182, 15, 70, 97, 220, 4, 50, 123, 35, 217,
-			89, 162, 173, 133, 53, 84, 198, 228, 8, 18, 164, 170, 92, 32,
-			26, 102, 31, 172, 74, 248, 33, 75, 223, 175, 104, 47, 235, 49,
-			215, 233, 242, 137, 104, 84, 221, 136, 18, 132, 88, 57, 4, 147,
-			250, 136, 36, 144, 145, 236, 151, 148, 9, 161, 85, 185, 172, 209,
-			226, 3, 44, 116, 226, 246, 124, 163, 191, 125, 80, 163, 11, 55,
-			231, 27, 245, 133, 155, 76, 165, 188, 55, 182, 153, 141, 132, 27,
-			243, 141, 176, 145, 222, 170, 208, 153, 202, 250, 207, 59, 254, 80,
-			240, 95, 157, 240, 44, 203, 170, 111, 135, 80, 201, 54, 22, 110,
-			224, 112, 108, 157, 108, 145, 16, 205, 124, 75, 104, 84, 88, 20,
-			123, 162, 81, 53, 124, 59, 60, 74, 52, 138, 219, 224, 177, 135,
-			209, 146, 242, 179, 41, 64, 195, 27, 13, 137, 74, 73, 196, 100,
-			12, 192, 65, 0, 137, 13, 24, 224, 33, 0, 136, 220, 243, 14,
-			199, 180, 241, 223, 238, 184, 131, 193, 150, 118, 30, 210, 228, 142,
-			16, 93, 215, 25, 209, 156, 61, 120, 109, 215, 43, 239, 18, 182,
-			206, 149, 188, 43, 225, 14, 220, 222, 224, 224, 203, 120, 45, 222,
-			92, 159, 106, 84, 167, 202, 81, 109, 85, 90, 88, 22, 217, 120,
-			142, 40, 118, 26, 250, 129, 188, 169, 228, 113, 241, 221, 21, 0,
-			109, 42, 233, 96, 82, 168, 135, 83, 124, 115, 125, 187, 211, 143,
-			164, 12, 249, 90, 47, 155, 126, 167, 227, 254, 144, 42, 140, 49,
-			52, 252, 119, 98, 238, 43, 180, 94, 208, 239, 180, 255, 46, 199,
-			63, 20, 124, 95, 56, 43, 3, 61, 171, 85, 81, 212, 49, 230,
-			147, 143, 223, 236, 61, 192, 204, 175, 242, 88, 32, 241, 40, 157,
-			87, 67, 149, 29, 6, 144, 66, 64, 103, 159, 1, 56, 8, 232,
-			15, 12, 192, 67, 192, 129, 131, 34, 207, 189, 130, 12, 255, 220,
-			241, 195, 224, 236, 157, 246, 202, 214, 215, 110, 234, 87, 47, 55,
-			130, 97, 202, 161, 210, 119, 57, 135, 116, 187, 112, 172, 96, 59,
-			89, 3, 160, 134, 251, 70, 12, 192, 67, 192, 193, 67, 252, 132,
-			13, 35, 121, 55, 172, 9, 158, 26, 88, 101, 233, 119, 91, 216,
-			69, 7, 56, 248, 93, 77, 13, 46, 177, 119, 155, 169, 193, 5,
-			246, 110, 199, 182, 213, 106, 23, 47, 189, 243, 167, 92, 254, 105,
-			189, 96, 223, 42, 168, 228, 183, 245, 58, 253, 237, 251, 254, 14,
-			190, 3, 33, 189, 131, 111, 211, 232, 243, 133, 160, 160, 89, 67,
-			96, 250, 159, 128, 8, 208, 43, 224, 140, 232, 110, 242, 76, 154,
-			29, 220, 221, 85, 116, 176, 43, 188, 190, 145, 157, 19, 189, 59,
-			162, 217, 102, 233, 65, 122, 183, 32, 183, 193, 30, 1, 52, 179,
-			15, 136, 206, 132, 1, 101, 150, 252, 106, 55, 219, 84, 238, 89,
-			124, 82, 180, 105, 242, 159, 237, 105, 126, 74, 13, 146, 110, 154,
-			31, 249, 76, 90, 26, 164, 30, 252, 30, 120, 15, 60, 162, 222,
-			3, 51, 198, 249, 178, 104, 125, 68, 189, 7, 182, 183, 62, 170,
-			222, 3, 59, 90, 31, 166, 159, 112, 234, 118, 106, 59, 214, 238,
-			140, 16, 19, 194, 77, 163, 89, 13, 224, 35, 56, 32, 141, 151,
-			254, 31, 185, 32, 218, 31, 193, 144, 62, 179, 99, 128, 39, 100,
-			194, 139, 13, 188, 231, 134, 131, 135, 43, 144, 139, 133, 13, 60,
-			52, 32, 194, 228, 43, 229, 130, 191, 2, 1, 23, 109, 208, 154,
-			3, 182, 135, 31, 115, 131, 20, 104, 128, 130, 13, 210, 175, 22,
-			97, 19, 133, 241, 128, 174, 20, 17, 211, 134, 241, 64, 27, 106,
-			204, 188, 65, 39, 71, 179, 129, 123, 215, 226, 76, 129, 192, 242,
-			29, 24, 210, 208, 139, 200, 147, 42, 145, 238, 47, 134, 140, 15,
-			20, 163, 92, 186, 142, 88, 102, 10, 233, 249, 184, 248, 194, 45,
-			6, 181, 188, 197, 217, 196, 97, 60, 208, 113, 196, 18, 250, 48,
-			30, 232, 222, 52, 43, 127, 174, 48, 176, 197, 64, 41, 41, 38,
-			79, 41, 79, 34, 44, 134, 77, 9, 64, 215, 7, 195, 247, 254,
-			130, 171, 118, 216, 194, 119, 152, 11, 64, 62, 146, 98, 19, 134,
-			241, 64, 214, 136, 168, 195, 120, 64, 23, 72, 25, 185, 3, 187,
-			118, 32, 23, 0, 57, 178, 76, 22, 82, 62, 160, 11, 145, 139,
-			17, 179, 167, 224, 205, 243, 192, 164, 15, 90, 190, 150, 15, 157,
-			197, 68, 15, 104, 68, 29, 131, 58, 9, 6, 115, 4, 40, 53,
-			201, 178, 241, 195, 120, 64, 71, 200, 10, 200, 195, 120, 32, 123,
-			181, 204, 96, 197, 60, 32, 0, 0, 255, 255, 75, 101, 131, 115,
-			61, 23, 1, 0},
+			8, 0, 0, 0, 0, 0, 2, 255, 236, 189, 9, 120, 28, 217,
+			121, 24, 136, 58, 186, 209, 120, 184, 11, 39, 139, 215, 35, 134,
+			28, 0, 67, 160, 73, 130, 163, 145, 200, 17, 103, 12, 18, 224,
+			16, 28, 146, 160, 26, 224, 28, 26, 105, 200, 66, 247, 107, 160,
+			196, 238, 170, 158, 170, 106, 98, 32, 121, 36, 89, 150, 236, 149,
+			180, 190, 36, 203, 215, 218, 74, 124, 196, 142, 252, 41, 27, 199,
+			241, 234, 139, 189, 177, 226, 216, 78, 54, 235, 120, 115, 56, 182,
+			20, 203, 182, 178, 142, 21, 31, 242, 161, 47, 241, 218, 241, 174,
+			227, 79, 251, 253, 255, 59, 171, 1, 12, 57, 146, 149, 221, 40,
+			194, 55, 71, 191, 191, 222, 249, 191, 235, 127, 255, 73, 254, 244,
+			24, 57, 178, 25, 199, 155, 13, 118, 170, 149, 196, 89, 188, 209,
+			174, 159, 170, 181, 147, 32, 11, 227, 168, 140, 16, 111, 144, 127,
+			47, 203, 239, 83, 231, 73, 105, 73, 100, 241, 38, 73, 119, 202,
+			170, 113, 84, 75, 39, 45, 106, 205, 56, 21, 153, 244, 70, 73,
+			33, 10, 162, 56, 157, 180, 169, 53, 83, 168, 240, 196, 197, 155,
+			100, 164, 26, 55, 203, 29, 85, 94, 236, 151, 21, 222, 4, 200,
+			77, 235, 111, 90, 214, 15, 217, 206, 83, 55, 47, 254, 184, 125,
+			228, 41, 158, 247, 166, 200, 91, 126, 150, 53, 26, 79, 71, 241,
+			118, 180, 190, 211, 98, 233, 213, 143, 81, 82, 244, 220, 35, 93,
+			183, 45, 242, 203, 125, 196, 234, 243, 156, 35, 93, 222, 194, 63,
+			238, 163, 88, 160, 26, 55, 232, 197, 118, 189, 206, 146, 148, 206,
+			83, 94, 213, 116, 74, 107, 65, 22, 208, 48, 202, 88, 82, 221,
+			10, 162, 77, 70, 235, 113, 210, 12, 50, 66, 47, 197, 173, 157,
+			36, 220, 220, 202, 232, 194, 233, 211, 111, 16, 5, 232, 74, 84,
+			45, 83, 186, 216, 104, 80, 252, 150, 210, 132, 165, 44, 185, 199,
+			106, 101, 66, 183, 178, 172, 149, 158, 63, 117, 170, 198, 238, 177,
+			70, 220, 98, 73, 42, 71, 87, 141, 155, 28, 169, 213, 184, 49,
+			191, 193, 59, 113, 138, 16, 90, 97, 181, 48, 205, 146, 112, 163,
+			13, 35, 166, 65, 84, 163, 237, 148, 209, 48, 162, 105, 220, 78,
+			170, 12, 33, 27, 97, 20, 36, 59, 216, 175, 116, 142, 110, 135,
+			217, 22, 141, 19, 252, 127, 220, 206, 8, 109, 198, 181, 176, 30,
+			86, 17, 103, 115, 52, 72, 24, 109, 177, 164, 25, 102, 25, 171,
+			209, 86, 18, 223, 11, 107, 172, 70, 179, 173, 32, 163, 217, 22,
+			140, 174, 209, 136, 183, 195, 104, 147, 194, 220, 132, 80, 40, 133,
+			66, 132, 54, 89, 118, 158, 16, 10, 127, 143, 116, 116, 44, 165,
+			113, 93, 246, 168, 26, 215, 24, 109, 182, 211, 140, 38, 44, 11,
+			194, 8, 107, 13, 54, 226, 123, 240, 73, 96, 140, 208, 40, 206,
+			194, 42, 155, 163, 217, 86, 152, 210, 70, 152, 102, 80, 131, 217,
+			98, 84, 235, 232, 78, 45, 76, 171, 141, 32, 108, 178, 164, 188,
+			95, 39, 194, 200, 196, 133, 236, 68, 43, 137, 107, 237, 42, 211,
+			253, 32, 186, 35, 95, 86, 63, 8, 21, 163, 171, 197, 213, 118,
+			147, 69, 89, 32, 39, 233, 84, 156, 208, 56, 219, 98, 9, 109,
+			6, 25, 75, 194, 160, 145, 106, 84, 227, 4, 101, 91, 140, 80,
+			179, 247, 106, 80, 55, 88, 136, 37, 161, 226, 40, 104, 50, 232,
+			144, 185, 182, 162, 88, 127, 67, 188, 135, 89, 10, 35, 138, 120,
+			85, 113, 146, 210, 102, 176, 67, 55, 24, 172, 148, 26, 205, 98,
+			202, 162, 90, 156, 164, 12, 22, 69, 43, 137, 155, 113, 198, 40,
+			199, 73, 150, 210, 26, 75, 194, 123, 172, 70, 235, 73, 220, 36,
+			28, 11, 105, 92, 207, 182, 97, 153, 136, 21, 68, 211, 22, 171,
+			194, 10, 162, 173, 36, 132, 133, 149, 192, 218, 137, 248, 42, 74,
+			83, 236, 59, 161, 235, 87, 86, 214, 232, 218, 234, 229, 245, 103,
+			23, 43, 203, 116, 101, 141, 222, 172, 172, 62, 179, 178, 180, 188,
+			68, 47, 62, 79, 215, 175, 44, 211, 75, 171, 55, 159, 175, 172,
+			60, 117, 101, 157, 94, 89, 189, 182, 180, 92, 89, 163, 139, 55,
+			150, 232, 165, 213, 27, 235, 149, 149, 139, 183, 214, 87, 43, 107,
+			132, 78, 45, 174, 209, 149, 181, 41, 252, 178, 120, 227, 121, 186,
+			252, 220, 205, 202, 242, 218, 26, 93, 173, 208, 149, 235, 55, 175,
+			173, 44, 47, 209, 103, 23, 43, 149, 197, 27, 235, 43, 203, 107,
+			115, 116, 229, 198, 165, 107, 183, 150, 86, 110, 60, 53, 71, 47,
+			222, 90, 167, 55, 86, 215, 9, 189, 182, 114, 125, 101, 125, 121,
+			137, 174, 175, 206, 97, 179, 187, 203, 209, 213, 203, 244, 250, 114,
+			229, 210, 149, 197, 27, 235, 139, 23, 87, 174, 173, 172, 63, 143,
+			13, 94, 94, 89, 191, 1, 141, 93, 94, 173, 16, 186, 72, 111,
+			46, 86, 214, 87, 46, 221, 186, 182, 88, 161, 55, 111, 85, 110,
+			174, 174, 45, 83, 24, 217, 210, 202, 218, 165, 107, 139, 43, 215,
+			151, 151, 202, 116, 229, 6, 189, 177, 74, 151, 159, 89, 190, 177,
+			78, 215, 174, 44, 94, 187, 150, 31, 40, 161, 171, 207, 222, 88,
+			174, 64, 239, 205, 97, 210, 139, 203, 244, 218, 202, 226, 197, 107,
+			203, 208, 20, 142, 115, 105, 165, 178, 124, 105, 29, 6, 164, 127,
+			93, 90, 89, 90, 190, 177, 190, 120, 109, 142, 208, 181, 155, 203,
+			151, 86, 22, 175, 205, 209, 229, 231, 150, 175, 223, 188, 182, 88,
+			121, 126, 78, 84, 186, 182, 252, 166, 91, 203, 55, 214, 87, 22,
+			175, 209, 165, 197, 235, 139, 79, 45, 175, 209, 153, 251, 97, 229,
+			102, 101, 245, 210, 173, 202, 242, 117, 232, 245, 234, 101, 186, 118,
+			235, 226, 218, 250, 202, 250, 173, 245, 101, 250, 212, 234, 234, 18,
+			34, 123, 109, 185, 242, 204, 202, 165, 229, 181, 199, 233, 181, 213,
+			53, 68, 216, 173, 181, 229, 57, 66, 151, 22, 215, 23, 177, 233,
+			155, 149, 213, 203, 43, 235, 107, 143, 195, 239, 139, 183, 214, 86,
+			16, 113, 43, 55, 214, 151, 43, 149, 91, 55, 215, 87, 86, 111,
+			204, 210, 43, 171, 207, 46, 63, 179, 92, 161, 151, 22, 111, 173,
+			45, 47, 33, 134, 87, 111, 192, 104, 97, 173, 44, 175, 86, 158,
+			135, 106, 175, 173, 136, 25, 152, 163, 207, 94, 89, 94, 191, 178,
+			92, 1, 164, 34, 182, 22, 1, 13, 107, 235, 149, 149, 75, 235,
+			102, 182, 213, 10, 93, 95, 173, 172, 19, 99, 156, 244, 198, 242,
+			83, 215, 86, 158, 90, 190, 113, 105, 25, 62, 175, 66, 53, 207,
+			174, 172, 45, 207, 210, 197, 202, 202, 26, 100, 88, 193, 134, 233,
+			179, 139, 207, 211, 213, 91, 56, 106, 152, 168, 91, 107, 203, 132,
+			255, 54, 150, 238, 28, 206, 39, 93, 185, 76, 23, 151, 158, 89,
+			129, 158, 139, 220, 55, 87, 215, 214, 86, 196, 114, 65, 180, 93,
+			186, 34, 112, 94, 38, 164, 68, 44, 219, 115, 104, 105, 2, 126,
+			149, 60, 103, 170, 235, 113, 210, 75, 220, 210, 239, 117, 119, 241,
+			68, 31, 41, 64, 194, 246, 156, 169, 238, 9, 210, 79, 138, 152,
+			234, 226, 201, 1, 210, 205, 147, 22, 79, 139, 204, 221, 158, 51,
+			229, 159, 23, 53, 62, 212, 53, 39, 106, 180, 120, 130, 103, 130,
+			102, 31, 234, 30, 17, 53, 90, 118, 23, 79, 242, 26, 45, 172,
+			17, 210, 34, 115, 183, 231, 60, 52, 126, 82, 212, 120, 188, 171,
+			44, 106, 180, 121, 130, 103, 178, 33, 213, 125, 80, 212, 104, 219,
+			93, 60, 201, 107, 180, 177, 70, 72, 139, 204, 221, 158, 115, 252,
+			200, 188, 168, 241, 68, 215, 148, 168, 209, 225, 9, 158, 201, 177,
+			61, 231, 68, 183, 47, 106, 116, 236, 46, 158, 228, 53, 58, 88,
+			35, 164, 69, 102, 40, 122, 248, 152, 168, 241, 97, 53, 106, 151,
+			39, 120, 38, 215, 246, 156, 135, 187, 143, 139, 26, 93, 187, 139,
+			39, 121, 141, 46, 214, 8, 105, 145, 217, 241, 156, 135, 167, 229,
+			168, 167, 187, 142, 137, 26, 11, 60, 193, 51, 21, 108, 207, 153,
+			238, 158, 20, 53, 22, 236, 46, 158, 228, 53, 22, 176, 70, 72,
+			139, 204, 221, 158, 51, 125, 144, 146, 223, 33, 196, 118, 187, 60,
+			183, 210, 117, 219, 242, 63, 5, 135, 134, 164, 77, 240, 218, 97,
+			41, 139, 178, 148, 6, 52, 13, 55, 35, 86, 155, 163, 245, 240,
+			101, 86, 155, 111, 176, 104, 51, 219, 162, 105, 43, 136, 224, 248,
+			206, 194, 38, 211, 217, 89, 141, 208, 0, 202, 84, 227, 118, 132,
+			151, 145, 32, 146, 240, 38, 170, 39, 65, 85, 223, 183, 242, 67,
+			70, 145, 96, 194, 36, 1, 122, 35, 110, 240, 43, 133, 174, 100,
+			52, 132, 107, 177, 198, 90, 44, 170, 49, 94, 97, 16, 237, 208,
+			106, 208, 96, 81, 45, 72, 176, 214, 106, 28, 85, 89, 43, 131,
+			251, 239, 46, 163, 83, 181, 96, 103, 138, 192, 101, 49, 213, 140,
+			163, 108, 107, 74, 86, 147, 176, 70, 144, 241, 27, 101, 61, 108,
+			178, 52, 11, 154, 45, 126, 3, 10, 210, 161, 22, 2, 221, 194,
+			162, 42, 163, 27, 44, 219, 102, 44, 34, 52, 219, 54, 115, 223,
+			11, 26, 109, 150, 66, 101, 129, 70, 21, 116, 33, 204, 104, 53,
+			136, 224, 210, 10, 106, 112, 71, 198, 9, 77, 219, 27, 25, 12,
+			23, 48, 2, 183, 19, 13, 116, 69, 101, 90, 65, 82, 12, 42,
+			106, 181, 146, 248, 229, 16, 238, 217, 198, 14, 61, 57, 127, 230,
+			244, 220, 233, 211, 167, 233, 14, 11, 146, 20, 238, 165, 229, 151,
+			131, 102, 171, 193, 232, 153, 243, 244, 82, 220, 108, 181, 51, 166,
+			91, 198, 106, 115, 61, 68, 26, 162, 149, 178, 118, 45, 70, 66,
+			166, 44, 8, 30, 61, 132, 52, 11, 146, 140, 94, 160, 229, 114,
+			249, 241, 206, 111, 44, 170, 229, 190, 168, 134, 36, 153, 44, 191,
+			242, 207, 18, 90, 150, 51, 121, 1, 106, 80, 169, 121, 222, 150,
+			76, 63, 222, 81, 8, 231, 92, 20, 225, 191, 101, 1, 76, 201,
+			70, 194, 58, 157, 217, 213, 208, 27, 233, 105, 250, 240, 195, 157,
+			117, 61, 65, 79, 207, 210, 119, 240, 98, 123, 244, 238, 228, 5,
+			122, 230, 241, 93, 95, 69, 211, 23, 232, 153, 211, 242, 79, 100,
+			122, 133, 178, 70, 202, 114, 29, 72, 85, 101, 79, 236, 217, 131,
+			55, 190, 122, 15, 230, 95, 165, 7, 39, 247, 234, 129, 49, 255,
+			11, 122, 254, 245, 132, 225, 2, 208, 201, 147, 122, 198, 94, 251,
+			50, 216, 119, 178, 247, 95, 36, 252, 147, 57, 231, 23, 242, 115,
+			78, 79, 238, 66, 194, 227, 186, 144, 92, 1, 198, 172, 155, 5,
+			118, 45, 3, 93, 38, 143, 231, 220, 162, 51, 81, 172, 11, 156,
+			124, 245, 249, 213, 25, 159, 48, 51, 238, 211, 198, 201, 189, 219,
+			152, 223, 123, 6, 9, 33, 142, 11, 231, 111, 165, 52, 68, 90,
+			196, 117, 241, 218, 124, 198, 30, 245, 171, 116, 13, 15, 86, 117,
+			18, 194, 105, 186, 197, 114, 39, 107, 153, 94, 135, 87, 192, 6,
+			227, 115, 61, 127, 246, 204, 235, 230, 94, 247, 250, 199, 224, 140,
+			128, 127, 9, 156, 102, 39, 59, 128, 52, 140, 170, 141, 118, 26,
+			222, 131, 121, 239, 39, 5, 104, 209, 245, 220, 103, 236, 138, 71,
+			250, 120, 178, 0, 61, 232, 150, 41, 203, 115, 158, 41, 13, 202,
+			148, 227, 57, 207, 120, 35, 228, 27, 29, 236, 171, 229, 57, 47,
+			218, 158, 255, 103, 182, 236, 108, 238, 12, 15, 68, 223, 243, 135,
+			184, 113, 134, 155, 99, 34, 122, 80, 114, 177, 165, 180, 193, 210,
+			20, 142, 224, 136, 198, 17, 83, 181, 37, 185, 43, 133, 63, 57,
+			2, 122, 154, 208, 59, 2, 87, 119, 104, 61, 100, 141, 26, 30,
+			190, 1, 109, 197, 105, 152, 133, 247, 240, 137, 16, 177, 205, 0,
+			127, 223, 193, 14, 137, 140, 101, 122, 57, 78, 212, 218, 74, 177,
+			43, 70, 131, 113, 66, 155, 113, 194, 230, 104, 64, 163, 56, 154,
+			127, 59, 75, 98, 126, 216, 195, 75, 12, 7, 144, 171, 141, 63,
+			205, 54, 24, 81, 195, 131, 135, 14, 92, 147, 112, 249, 97, 246,
+			124, 63, 59, 167, 241, 220, 185, 115, 115, 226, 95, 62, 133, 6,
+			96, 143, 233, 179, 92, 207, 125, 209, 126, 102, 84, 76, 145, 85,
+			128, 73, 145, 211, 103, 193, 20, 149, 250, 101, 202, 241, 156, 23,
+			135, 134, 55, 138, 248, 28, 63, 75, 254, 135, 105, 114, 180, 147,
+			235, 145, 169, 123, 104, 31, 182, 199, 227, 164, 71, 109, 248, 215,
+			204, 247, 88, 223, 155, 239, 49, 160, 106, 212, 140, 143, 191, 120,
+			112, 222, 199, 63, 56, 193, 121, 31, 205, 175, 241, 62, 190, 198,
+			251, 248, 26, 239, 227, 107, 188, 143, 175, 241, 62, 190, 198, 251,
+			248, 111, 134, 247, 113, 212, 228, 125, 28, 205, 241, 62, 38, 242,
+			188, 143, 137, 14, 222, 135, 172, 17, 238, 245, 135, 252, 35, 138,
+			247, 49, 103, 242, 62, 230, 114, 188, 143, 145, 60, 239, 99, 164,
+			131, 247, 49, 98, 242, 62, 20, 55, 229, 68, 215, 41, 147, 247,
+			113, 42, 199, 251, 56, 152, 231, 125, 28, 236, 224, 125, 72, 110,
+			138, 211, 237, 57, 39, 142, 148, 21, 239, 99, 202, 228, 125, 76,
+			229, 120, 31, 126, 158, 247, 225, 119, 240, 62, 124, 147, 247, 161,
+			184, 41, 211, 93, 115, 38, 239, 99, 46, 199, 251, 56, 158, 231,
+			125, 28, 239, 224, 125, 72, 110, 74, 193, 241, 156, 105, 197, 77,
+			153, 81, 220, 148, 34, 79, 240, 76, 69, 219, 115, 102, 20, 55,
+			165, 104, 119, 241, 36, 175, 177, 136, 53, 206, 40, 110, 74, 177,
+			219, 115, 102, 14, 82, 242, 45, 67, 156, 155, 18, 116, 53, 45,
+			255, 221, 67, 116, 209, 120, 52, 229, 216, 41, 173, 56, 140, 50,
+			188, 127, 128, 117, 178, 7, 119, 3, 225, 111, 143, 35, 134, 108,
+			12, 201, 235, 152, 203, 145, 196, 65, 250, 32, 156, 21, 178, 15,
+			85, 30, 70, 244, 214, 250, 37, 186, 220, 138, 171, 91, 130, 36,
+			231, 76, 18, 22, 1, 13, 0, 148, 10, 220, 151, 120, 211, 221,
+			76, 226, 6, 107, 101, 97, 149, 62, 149, 176, 205, 56, 9, 131,
+			136, 94, 18, 125, 162, 219, 91, 97, 117, 139, 178, 151, 51, 6,
+			13, 194, 221, 166, 51, 201, 142, 19, 186, 17, 84, 239, 110, 7,
+			9, 228, 136, 145, 181, 1, 180, 119, 103, 147, 65, 154, 182, 155,
+			208, 106, 208, 104, 208, 102, 24, 181, 51, 134, 212, 11, 125, 236,
+			52, 81, 67, 106, 196, 209, 230, 28, 13, 203, 172, 76, 27, 44,
+			104, 233, 161, 38, 140, 78, 165, 77, 22, 36, 172, 54, 69, 211,
+			152, 19, 69, 81, 108, 230, 34, 52, 11, 54, 26, 200, 117, 137,
+			24, 131, 38, 129, 184, 71, 242, 176, 5, 244, 14, 231, 57, 41,
+			206, 12, 191, 86, 79, 159, 62, 125, 102, 30, 255, 89, 63, 125,
+			250, 60, 254, 243, 102, 24, 197, 185, 115, 231, 206, 205, 159, 89,
+			152, 63, 123, 102, 125, 225, 236, 249, 215, 157, 59, 255, 186, 115,
+			229, 115, 242, 239, 205, 101, 66, 47, 238, 0, 194, 179, 36, 172,
+			102, 136, 74, 209, 165, 4, 170, 159, 163, 219, 140, 178, 40, 109,
+			39, 140, 67, 183, 25, 242, 143, 170, 113, 116, 143, 37, 25, 205,
+			98, 34, 102, 53, 110, 82, 90, 185, 124, 137, 158, 61, 123, 246,
+			28, 144, 179, 140, 66, 149, 209, 102, 90, 38, 116, 141, 49, 250,
+			130, 164, 75, 183, 183, 183, 203, 33, 203, 234, 229, 56, 217, 60,
+			149, 212, 171, 240, 47, 20, 42, 103, 47, 103, 111, 157, 121, 144,
+			92, 179, 251, 176, 155, 58, 216, 13, 55, 87, 215, 86, 158, 163,
+			119, 96, 209, 204, 204, 222, 217, 205, 89, 80, 143, 11, 241, 4,
+			86, 233, 114, 202, 178, 219, 98, 190, 102, 176, 248, 141, 91, 215,
+			174, 205, 206, 238, 153, 15, 151, 237, 204, 233, 217, 199, 31, 136,
+			5, 34, 250, 180, 201, 50, 168, 37, 174, 215, 130, 29, 163, 111,
+			105, 150, 180, 171, 25, 54, 112, 47, 104, 208, 236, 158, 104, 49,
+			151, 253, 225, 236, 222, 28, 197, 14, 61, 254, 165, 14, 233, 94,
+			57, 187, 7, 169, 87, 27, 17, 207, 212, 78, 89, 149, 62, 130,
+			236, 130, 220, 8, 207, 238, 59, 194, 103, 195, 232, 236, 2, 189,
+			243, 20, 203, 214, 118, 210, 140, 53, 225, 243, 98, 122, 57, 108,
+			176, 245, 252, 68, 92, 94, 185, 182, 188, 190, 114, 125, 153, 214,
+			51, 209, 141, 253, 202, 60, 92, 207, 100, 79, 111, 173, 220, 88,
+			127, 236, 81, 154, 133, 213, 187, 192, 144, 153, 153, 153, 225, 144,
+			217, 122, 86, 174, 109, 95, 9, 55, 183, 150, 130, 12, 75, 205,
+			210, 55, 190, 145, 158, 93, 152, 165, 95, 79, 241, 219, 181, 120,
+			91, 126, 146, 120, 59, 117, 138, 46, 66, 127, 107, 241, 118, 138,
+			85, 194, 102, 58, 115, 250, 180, 113, 20, 165, 101, 149, 129, 225,
+			17, 116, 230, 177, 221, 187, 76, 213, 6, 197, 207, 60, 246, 232,
+			163, 143, 190, 254, 236, 99, 167, 79, 171, 45, 191, 193, 234, 113,
+			194, 232, 173, 40, 124, 89, 214, 114, 238, 245, 167, 59, 107, 41,
+			127, 105, 147, 57, 195, 199, 79, 103, 102, 56, 82, 78, 41, 222,
+			206, 44, 157, 55, 187, 115, 159, 21, 12, 245, 156, 93, 208, 245,
+			156, 48, 234, 193, 5, 48, 155, 91, 0, 143, 238, 187, 0, 174,
+			6, 247, 2, 122, 135, 79, 100, 185, 218, 78, 18, 22, 101, 144,
+			229, 122, 216, 104, 132, 169, 177, 0, 224, 132, 164, 77, 132, 210,
+			11, 116, 255, 2, 175, 178, 204, 233, 5, 13, 45, 71, 108, 251,
+			98, 59, 108, 212, 88, 50, 51, 11, 3, 91, 19, 24, 18, 77,
+			112, 196, 204, 74, 118, 24, 165, 144, 231, 6, 31, 123, 24, 101,
+			48, 114, 145, 147, 15, 93, 12, 27, 49, 48, 91, 222, 128, 154,
+			177, 47, 26, 7, 175, 219, 23, 7, 98, 20, 242, 222, 164, 55,
+			119, 178, 45, 254, 130, 129, 191, 40, 222, 166, 23, 240, 91, 153,
+			31, 78, 28, 172, 89, 147, 97, 148, 205, 68, 241, 182, 128, 75,
+			238, 35, 64, 1, 76, 231, 101, 86, 222, 197, 71, 30, 57, 55,
+			219, 49, 175, 38, 94, 102, 68, 230, 11, 226, 255, 115, 188, 194,
+			11, 248, 223, 89, 98, 240, 253, 130, 210, 48, 249, 65, 75, 50,
+			254, 234, 246, 168, 255, 97, 139, 86, 52, 65, 96, 112, 255, 224,
+			78, 198, 193, 165, 97, 84, 53, 151, 54, 217, 123, 109, 231, 185,
+			74, 248, 159, 125, 238, 43, 178, 215, 133, 245, 230, 125, 56, 133,
+			117, 59, 24, 49, 56, 133, 245, 28, 167, 176, 158, 227, 20, 214,
+			189, 17, 242, 57, 75, 114, 10, 27, 182, 231, 127, 202, 162, 55,
+			226, 104, 94, 177, 225, 94, 27, 191, 176, 76, 111, 136, 130, 242,
+			206, 151, 66, 23, 124, 122, 235, 202, 144, 65, 144, 102, 97, 163,
+			65, 183, 130, 123, 140, 70, 102, 155, 124, 110, 121, 65, 194, 111,
+			88, 46, 143, 170, 199, 9, 208, 33, 146, 248, 234, 64, 224, 105,
+			113, 177, 107, 214, 220, 222, 172, 184, 134, 93, 55, 89, 113, 141,
+			28, 43, 174, 145, 99, 197, 53, 12, 86, 220, 207, 142, 145, 131,
+			157, 172, 56, 214, 108, 101, 59, 251, 177, 225, 186, 73, 97, 25,
+			190, 239, 167, 74, 68, 240, 235, 151, 196, 78, 251, 216, 40, 103,
+			167, 157, 29, 250, 26, 59, 237, 107, 236, 180, 175, 177, 211, 190,
+			198, 78, 251, 26, 59, 237, 107, 236, 180, 255, 126, 85, 137, 78,
+			154, 236, 180, 147, 95, 186, 42, 209, 35, 255, 157, 170, 18, 73,
+			118, 218, 81, 147, 157, 118, 52, 199, 78, 155, 200, 179, 211, 38,
+			58, 216, 105, 114, 174, 139, 142, 231, 204, 248, 71, 200, 95, 218,
+			200, 78, 115, 206, 118, 13, 249, 95, 176, 233, 34, 221, 100, 17,
+			75, 194, 42, 69, 146, 137, 54, 89, 154, 6, 155, 130, 125, 178,
+			19, 183, 145, 127, 146, 176, 121, 160, 9, 178, 152, 6, 247, 226,
+			176, 70, 107, 172, 30, 70, 120, 83, 181, 91, 13, 184, 247, 89,
+			141, 228, 203, 227, 77, 185, 19, 183, 19, 186, 120, 115, 37, 45,
+			211, 69, 154, 237, 180, 194, 106, 208, 160, 76, 60, 71, 66, 100,
+			90, 65, 181, 97, 38, 165, 187, 9, 123, 169, 205, 210, 12, 217,
+			115, 60, 157, 182, 226, 8, 90, 222, 105, 49, 206, 197, 131, 250,
+			128, 78, 216, 138, 133, 236, 57, 140, 210, 44, 136, 170, 76, 18,
+			14, 64, 10, 133, 85, 70, 47, 199, 177, 86, 51, 72, 90, 85,
+			122, 49, 72, 102, 58, 40, 189, 50, 18, 122, 179, 52, 97, 89,
+			59, 137, 82, 186, 207, 119, 67, 109, 100, 125, 139, 209, 171, 107,
+			171, 55, 52, 207, 80, 168, 12, 197, 9, 189, 131, 185, 239, 192,
+			200, 56, 46, 48, 99, 188, 241, 54, 86, 205, 232, 157, 119, 188,
+			114, 167, 172, 31, 47, 103, 75, 253, 138, 126, 253, 125, 143, 188,
+			110, 51, 204, 182, 218, 27, 72, 164, 53, 218, 213, 16, 255, 51,
+			191, 25, 159, 170, 53, 79, 5, 173, 240, 148, 24, 212, 169, 123,
+			103, 78, 1, 42, 82, 65, 217, 218, 181, 166, 127, 63, 41, 180,
+			255, 106, 180, 241, 212, 147, 196, 187, 222, 110, 100, 225, 205, 4,
+			136, 197, 108, 231, 25, 160, 237, 189, 89, 82, 228, 68, 254, 164,
+			69, 157, 153, 222, 133, 225, 114, 173, 89, 206, 101, 169, 136, 12,
+			83, 255, 194, 34, 253, 249, 194, 30, 113, 210, 44, 65, 153, 118,
+			207, 149, 174, 10, 36, 0, 86, 11, 50, 148, 103, 247, 1, 172,
+			22, 100, 0, 139, 218, 205, 73, 7, 100, 223, 0, 139, 218, 77,
+			128, 109, 132, 209, 100, 129, 90, 51, 37, 128, 109, 132, 145, 119,
+			154, 184, 48, 164, 201, 34, 181, 102, 122, 23, 252, 78, 106, 189,
+			172, 158, 142, 87, 186, 42, 152, 211, 155, 35, 110, 212, 110, 52,
+			38, 187, 177, 196, 120, 121, 207, 89, 133, 220, 144, 235, 98, 55,
+			41, 224, 88, 174, 186, 37, 119, 168, 48, 245, 195, 22, 233, 93,
+			204, 50, 64, 210, 181, 48, 205, 188, 105, 98, 103, 241, 164, 141,
+			120, 152, 0, 60, 24, 31, 203, 235, 241, 114, 148, 37, 59, 21,
+			59, 139, 253, 35, 196, 189, 209, 110, 166, 222, 56, 180, 222, 228,
+			168, 235, 191, 104, 15, 89, 21, 76, 251, 79, 147, 110, 145, 221,
+			27, 34, 206, 93, 182, 195, 81, 84, 129, 159, 222, 35, 162, 19,
+			136, 162, 222, 133, 209, 206, 134, 160, 230, 10, 207, 114, 222, 126,
+			131, 117, 245, 227, 3, 240, 206, 112, 187, 206, 90, 228, 127, 177,
+			240, 157, 225, 118, 121, 11, 63, 110, 229, 158, 12, 103, 30, 195,
+			245, 122, 237, 214, 165, 21, 186, 216, 206, 182, 226, 36, 45, 239,
+			243, 110, 184, 149, 50, 174, 115, 17, 166, 57, 42, 59, 76, 233,
+			102, 124, 143, 37, 17, 240, 174, 163, 154, 32, 26, 23, 91, 65,
+			21, 42, 14, 171, 44, 74, 217, 28, 125, 134, 37, 64, 164, 209,
+			133, 242, 105, 249, 44, 228, 90, 123, 245, 184, 29, 213, 36, 13,
+			123, 109, 229, 210, 242, 141, 181, 101, 90, 15, 27, 240, 238, 235,
+			33, 182, 211, 229, 57, 197, 238, 25, 252, 105, 121, 78, 119, 247,
+			113, 113, 205, 245, 148, 96, 171, 32, 219, 191, 183, 171, 223, 82,
+			219, 166, 183, 228, 195, 65, 200, 159, 252, 125, 246, 113, 249, 98,
+			118, 33, 69, 100, 170, 232, 57, 125, 189, 147, 198, 219, 186, 239,
+			192, 81, 227, 109, 221, 55, 245, 16, 86, 110, 121, 238, 96, 215,
+			20, 175, 28, 94, 152, 131, 165, 49, 242, 33, 27, 18, 61, 158,
+			51, 218, 219, 239, 191, 207, 150, 40, 162, 143, 226, 14, 175, 55,
+			226, 32, 75, 203, 116, 29, 208, 20, 166, 52, 22, 111, 156, 13,
+			86, 13, 224, 36, 99, 47, 181, 131, 70, 152, 237, 204, 87, 227,
+			102, 43, 72, 194, 52, 142, 144, 196, 37, 162, 36, 148, 137, 226,
+			44, 78, 194, 184, 157, 54, 118, 80, 51, 50, 172, 182, 27, 89,
+			153, 174, 112, 28, 133, 81, 152, 133, 65, 131, 134, 112, 70, 106,
+			130, 63, 174, 211, 165, 235, 115, 132, 182, 130, 157, 70, 28, 8,
+			217, 68, 194, 210, 118, 35, 147, 47, 120, 222, 192, 54, 60, 220,
+			55, 224, 228, 132, 83, 135, 213, 202, 244, 74, 220, 98, 245, 118,
+			163, 177, 3, 245, 239, 76, 39, 240, 164, 207, 136, 224, 212, 207,
+			97, 69, 219, 240, 190, 136, 166, 51, 4, 194, 169, 156, 182, 91,
+			173, 56, 193, 215, 90, 179, 76, 16, 227, 86, 79, 23, 32, 165,
+			15, 241, 104, 245, 116, 89, 185, 148, 45, 83, 174, 107, 149, 186,
+			60, 119, 220, 62, 230, 240, 111, 37, 200, 57, 94, 234, 231, 149,
+			192, 180, 77, 184, 156, 9, 98, 33, 19, 100, 194, 37, 50, 101,
+			121, 206, 68, 239, 128, 76, 57, 158, 51, 49, 236, 137, 98, 150,
+			231, 76, 170, 98, 192, 27, 152, 116, 123, 100, 10, 190, 169, 98,
+			192, 27, 152, 84, 197, 108, 207, 57, 160, 138, 217, 5, 72, 201,
+			98, 80, 229, 1, 85, 204, 118, 60, 231, 128, 42, 230, 120, 142,
+			175, 138, 57, 5, 72, 149, 100, 202, 242, 28, 95, 21, 3, 74,
+			194, 31, 246, 200, 135, 44, 44, 231, 122, 206, 17, 119, 218, 127,
+			175, 133, 236, 44, 154, 110, 197, 237, 70, 141, 198, 81, 67, 191,
+			149, 228, 203, 140, 43, 231, 157, 98, 81, 141, 230, 78, 79, 184,
+			55, 9, 125, 42, 9, 90, 91, 111, 106, 179, 100, 103, 141, 5,
+			73, 117, 171, 76, 87, 234, 242, 141, 196, 112, 63, 5, 176, 139,
+			50, 124, 253, 181, 230, 224, 6, 149, 19, 31, 110, 70, 113, 2,
+			91, 90, 116, 209, 45, 66, 167, 14, 203, 148, 229, 57, 71, 142,
+			76, 201, 148, 227, 57, 71, 78, 60, 44, 70, 93, 240, 28, 234,
+			62, 36, 62, 21, 138, 144, 58, 32, 83, 150, 231, 80, 255, 136,
+			76, 57, 158, 67, 143, 77, 145, 159, 178, 136, 237, 218, 158, 59,
+			221, 117, 214, 242, 127, 204, 162, 198, 121, 5, 11, 189, 17, 111,
+			194, 133, 223, 216, 161, 1, 62, 123, 129, 94, 136, 235, 180, 29,
+			133, 47, 181, 25, 13, 120, 230, 116, 78, 136, 186, 182, 132, 118,
+			115, 179, 21, 84, 51, 210, 121, 189, 138, 19, 164, 26, 55, 155,
+			113, 68, 211, 42, 139, 130, 36, 140, 161, 58, 89, 115, 19, 110,
+			177, 86, 67, 87, 44, 84, 200, 8, 215, 33, 67, 186, 98, 38,
+			157, 21, 87, 48, 204, 254, 116, 105, 4, 23, 44, 156, 66, 238,
+			140, 253, 8, 95, 176, 182, 131, 100, 19, 25, 0, 170, 10, 82,
+			176, 100, 103, 221, 147, 64, 85, 241, 164, 11, 233, 62, 157, 46,
+			120, 206, 108, 255, 136, 78, 91, 158, 51, 59, 58, 169, 211, 142,
+			231, 204, 30, 60, 164, 211, 37, 207, 153, 61, 252, 8, 25, 38,
+			68, 164, 145, 130, 159, 61, 50, 75, 60, 210, 171, 65, 54, 192,
+			142, 147, 17, 210, 103, 192, 186, 56, 112, 148, 244, 155, 64, 139,
+			67, 115, 197, 161, 213, 19, 179, 228, 159, 195, 202, 68, 10, 123,
+			193, 62, 232, 255, 172, 69, 215, 99, 174, 102, 221, 12, 90, 128,
+			33, 196, 75, 58, 159, 197, 243, 10, 111, 89, 76, 185, 228, 148,
+			2, 219, 112, 45, 6, 77, 78, 32, 4, 183, 131, 40, 211, 31,
+			9, 141, 5, 223, 133, 151, 163, 83, 245, 56, 254, 250, 51, 83,
+			115, 252, 199, 194, 20, 158, 44, 83, 27, 65, 130, 64, 188, 88,
+			182, 113, 63, 52, 226, 248, 46, 170, 148, 159, 39, 148, 74, 18,
+			13, 10, 77, 157, 167, 47, 156, 153, 163, 11, 111, 157, 19, 176,
+			141, 32, 65, 24, 7, 188, 194, 89, 133, 54, 178, 82, 23, 212,
+			124, 225, 113, 191, 96, 143, 200, 148, 229, 57, 11, 163, 227, 50,
+			229, 120, 206, 194, 1, 95, 145, 90, 127, 242, 143, 44, 114, 254,
+			193, 105, 173, 77, 216, 135, 183, 129, 61, 103, 16, 92, 247, 49,
+			118, 188, 63, 65, 246, 165, 17, 123, 83, 191, 111, 145, 129, 197,
+			141, 8, 88, 131, 141, 203, 97, 20, 166, 91, 222, 25, 82, 76,
+			179, 32, 107, 115, 13, 194, 129, 133, 3, 72, 54, 228, 242, 148,
+			215, 48, 67, 69, 100, 244, 198, 73, 49, 97, 65, 26, 71, 72,
+			105, 244, 84, 68, 106, 234, 3, 22, 41, 242, 172, 94, 47, 233,
+			94, 185, 241, 204, 226, 181, 149, 165, 161, 46, 143, 144, 226, 229,
+			197, 149, 107, 203, 75, 67, 22, 124, 184, 84, 89, 92, 187, 178,
+			188, 52, 100, 67, 98, 249, 185, 155, 43, 149, 229, 165, 33, 199,
+			235, 39, 61, 32, 28, 91, 186, 189, 122, 107, 125, 200, 133, 228,
+			165, 197, 27, 151, 150, 175, 65, 185, 130, 215, 71, 74, 149, 229,
+			171, 203, 151, 214, 151, 151, 134, 138, 80, 240, 250, 202, 26, 60,
+			154, 135, 186, 189, 81, 50, 84, 89, 94, 187, 117, 109, 253, 246,
+			245, 197, 107, 151, 87, 43, 215, 151, 151, 134, 74, 83, 127, 209,
+			67, 10, 111, 130, 149, 233, 29, 34, 118, 88, 195, 209, 245, 46,
+			244, 193, 232, 16, 92, 94, 89, 170, 216, 97, 13, 232, 168, 165,
+			27, 203, 56, 146, 82, 5, 126, 122, 83, 196, 133, 233, 66, 170,
+			178, 119, 97, 64, 151, 88, 10, 178, 160, 130, 223, 188, 179, 164,
+			36, 23, 251, 164, 171, 233, 58, 158, 79, 28, 98, 41, 167, 235,
+			84, 70, 208, 214, 108, 5, 9, 220, 205, 147, 67, 216, 156, 76,
+			250, 163, 196, 94, 89, 242, 6, 84, 71, 123, 160, 107, 254, 31,
+			22, 136, 187, 196, 210, 170, 247, 24, 153, 80, 76, 184, 56, 185,
+			93, 141, 163, 122, 184, 121, 27, 88, 107, 34, 247, 152, 241, 249,
+			18, 126, 189, 17, 52, 153, 119, 132, 144, 86, 144, 4, 77, 150,
+			177, 36, 21, 147, 101, 64, 188, 215, 145, 113, 179, 94, 35, 175,
+			179, 171, 218, 155, 186, 216, 52, 113, 155, 44, 11, 38, 93, 68,
+			208, 136, 129, 32, 150, 86, 203, 215, 25, 96, 9, 50, 248, 63,
+			238, 18, 23, 146, 222, 97, 66, 130, 244, 118, 80, 69, 78, 191,
+			232, 115, 79, 144, 46, 114, 128, 119, 138, 20, 18, 150, 37, 59,
+			130, 114, 61, 176, 71, 141, 229, 10, 100, 168, 240, 124, 222, 27,
+			72, 9, 197, 195, 237, 44, 21, 211, 116, 104, 175, 50, 235, 34,
+			79, 69, 229, 246, 255, 202, 34, 5, 172, 10, 86, 113, 61, 8,
+			27, 140, 99, 188, 191, 34, 82, 48, 75, 213, 36, 72, 183, 88,
+			13, 187, 211, 95, 145, 73, 248, 194, 94, 110, 133, 9, 171, 97,
+			163, 253, 21, 153, 244, 14, 146, 30, 104, 161, 118, 59, 110, 103,
+			136, 150, 126, 222, 100, 109, 181, 157, 121, 62, 41, 73, 82, 10,
+			31, 32, 253, 21, 149, 246, 206, 146, 110, 80, 192, 136, 235, 245,
+			201, 33, 49, 246, 206, 151, 134, 212, 199, 174, 200, 156, 222, 121,
+			210, 219, 12, 94, 190, 45, 11, 14, 223, 175, 32, 105, 6, 47,
+			95, 228, 153, 253, 239, 182, 72, 73, 162, 5, 240, 142, 52, 196,
+			164, 117, 191, 42, 120, 62, 239, 36, 113, 146, 118, 52, 105, 223,
+			47, 59, 228, 242, 230, 137, 155, 102, 113, 107, 210, 185, 95, 110,
+			204, 230, 111, 145, 190, 117, 214, 108, 53, 130, 140, 173, 181, 88,
+			21, 119, 75, 18, 3, 158, 196, 130, 145, 73, 216, 178, 9, 171,
+			139, 245, 12, 63, 33, 239, 61, 254, 126, 16, 43, 87, 38, 61,
+			143, 184, 184, 79, 92, 4, 227, 111, 255, 195, 22, 113, 97, 47,
+			123, 143, 194, 84, 51, 96, 61, 76, 90, 247, 123, 25, 86, 100,
+			86, 60, 31, 88, 90, 157, 180, 119, 157, 15, 44, 173, 86, 240,
+			155, 119, 134, 148, 64, 126, 154, 221, 222, 216, 153, 116, 240, 124,
+			24, 215, 249, 204, 97, 86, 186, 49, 223, 197, 29, 255, 10, 233,
+			207, 29, 28, 230, 11, 175, 159, 191, 240, 142, 229, 95, 120, 189,
+			198, 11, 207, 120, 216, 77, 101, 132, 92, 77, 227, 168, 130, 180,
+			61, 172, 115, 206, 63, 16, 104, 20, 41, 192, 76, 26, 190, 157,
+			137, 69, 142, 191, 189, 243, 132, 224, 146, 14, 50, 137, 202, 87,
+			199, 137, 145, 123, 42, 36, 69, 209, 162, 60, 64, 45, 141, 32,
+			221, 31, 113, 128, 62, 78, 6, 3, 113, 201, 220, 174, 227, 45,
+			35, 6, 229, 237, 190, 127, 42, 3, 65, 46, 61, 245, 247, 251,
+			72, 183, 24, 183, 119, 196, 56, 221, 7, 12, 132, 236, 127, 190,
+			31, 207, 157, 239, 67, 102, 25, 227, 132, 127, 28, 80, 193, 170,
+			92, 102, 35, 206, 248, 131, 102, 222, 101, 245, 149, 159, 243, 70,
+			118, 239, 17, 82, 170, 111, 215, 110, 215, 88, 43, 69, 70, 68,
+			239, 194, 96, 199, 107, 188, 210, 93, 223, 174, 45, 177, 86, 234,
+			205, 145, 30, 216, 201, 60, 115, 113, 239, 204, 37, 200, 129, 185,
+			231, 243, 119, 136, 56, 126, 101, 159, 110, 242, 79, 250, 98, 121,
+			4, 47, 150, 81, 82, 64, 34, 77, 172, 0, 158, 16, 215, 13,
+			159, 126, 184, 110, 126, 166, 248, 101, 109, 139, 199, 72, 137, 203,
+			240, 196, 193, 249, 234, 197, 84, 94, 239, 4, 25, 136, 218, 205,
+			219, 6, 178, 249, 225, 218, 31, 181, 155, 26, 199, 222, 2, 233,
+			134, 179, 63, 100, 169, 184, 119, 38, 59, 39, 14, 175, 136, 144,
+			165, 21, 153, 209, 187, 64, 72, 90, 221, 98, 181, 118, 35, 140,
+			54, 197, 68, 28, 220, 85, 108, 77, 101, 185, 210, 85, 49, 10,
+			120, 231, 73, 143, 232, 85, 180, 169, 88, 71, 157, 165, 151, 101,
+			142, 43, 93, 21, 157, 29, 112, 184, 29, 132, 88, 178, 123, 159,
+			238, 62, 203, 191, 95, 233, 170, 200, 172, 222, 235, 73, 137, 111,
+			6, 86, 155, 44, 233, 187, 48, 87, 236, 178, 200, 112, 165, 171,
+			162, 50, 123, 23, 118, 111, 167, 158, 253, 182, 211, 149, 174, 206,
+			13, 229, 247, 17, 162, 177, 224, 191, 142, 244, 168, 81, 121, 51,
+			100, 168, 218, 78, 244, 244, 220, 14, 229, 133, 57, 80, 109, 39,
+			106, 130, 86, 106, 254, 35, 164, 91, 12, 201, 59, 74, 122, 97,
+			78, 37, 6, 120, 126, 18, 181, 155, 34, 131, 95, 38, 37, 57,
+			142, 7, 57, 46, 252, 111, 181, 72, 183, 152, 222, 255, 95, 92,
+			220, 23, 7, 72, 159, 160, 235, 110, 3, 129, 237, 95, 35, 131,
+			29, 7, 194, 30, 231, 247, 67, 249, 243, 187, 31, 134, 170, 74,
+			25, 39, 184, 255, 23, 22, 233, 22, 123, 25, 206, 105, 133, 158,
+			146, 56, 156, 142, 228, 14, 39, 126, 182, 25, 16, 239, 128, 113,
+			254, 56, 248, 85, 29, 55, 7, 205, 227, 198, 197, 111, 250, 116,
+			89, 0, 202, 30, 208, 142, 155, 101, 32, 191, 220, 69, 135, 202,
+			98, 98, 68, 206, 169, 235, 234, 220, 39, 164, 120, 109, 117, 113,
+			105, 25, 104, 254, 1, 66, 110, 172, 174, 223, 22, 105, 203, 243,
+			200, 0, 164, 23, 111, 173, 95, 89, 173, 172, 188, 25, 201, 255,
+			17, 50, 8, 162, 186, 219, 107, 43, 111, 94, 190, 141, 178, 191,
+			33, 103, 234, 57, 82, 128, 55, 4, 131, 26, 214, 46, 93, 89,
+			94, 186, 117, 13, 200, 252, 46, 120, 16, 44, 63, 183, 124, 233,
+			214, 58, 36, 241, 33, 241, 236, 226, 10, 38, 108, 120, 29, 92,
+			94, 185, 177, 130, 207, 10, 199, 27, 35, 195, 139, 23, 111, 172,
+			86, 174, 47, 94, 187, 173, 192, 238, 212, 223, 41, 169, 117, 29,
+			71, 30, 53, 238, 141, 161, 220, 68, 200, 155, 227, 97, 129, 120,
+			227, 94, 210, 121, 140, 155, 98, 127, 178, 254, 9, 226, 2, 207,
+			244, 1, 218, 26, 37, 133, 44, 190, 203, 56, 105, 213, 87, 225,
+			9, 127, 233, 85, 78, 239, 73, 210, 45, 22, 160, 92, 237, 34,
+			41, 206, 117, 71, 157, 235, 191, 89, 248, 255, 228, 92, 191, 78,
+			134, 204, 199, 69, 24, 213, 99, 113, 229, 78, 237, 70, 101, 121,
+			73, 103, 93, 137, 234, 113, 101, 176, 150, 7, 120, 79, 230, 206,
+			114, 126, 5, 28, 222, 163, 162, 125, 79, 243, 199, 72, 119, 210,
+			142, 34, 125, 19, 248, 123, 148, 174, 240, 28, 112, 38, 139, 204,
+			222, 57, 82, 2, 250, 180, 165, 47, 129, 131, 123, 53, 43, 178,
+			192, 169, 44, 179, 67, 81, 117, 156, 119, 239, 91, 244, 65, 15,
+			244, 210, 107, 56, 208, 191, 193, 34, 131, 29, 40, 133, 67, 121,
+			247, 203, 145, 84, 245, 115, 241, 4, 25, 16, 25, 36, 49, 205,
+			73, 236, 126, 14, 21, 28, 122, 189, 86, 57, 169, 205, 19, 112,
+			218, 181, 147, 134, 160, 179, 225, 103, 199, 165, 210, 67, 186, 5,
+			114, 125, 66, 74, 18, 93, 175, 245, 34, 184, 56, 68, 6, 244,
+			85, 4, 39, 239, 212, 179, 251, 29, 24, 189, 164, 187, 114, 235,
+			198, 13, 126, 92, 244, 145, 210, 218, 250, 234, 205, 155, 175, 225,
+			188, 248, 164, 69, 122, 144, 139, 138, 155, 231, 12, 41, 114, 70,
+			151, 144, 103, 225, 197, 172, 62, 115, 202, 94, 80, 130, 34, 35,
+			188, 122, 183, 130, 218, 109, 150, 36, 113, 34, 79, 233, 158, 173,
+			160, 182, 140, 0, 56, 164, 225, 51, 24, 183, 202, 67, 122, 43,
+			168, 93, 143, 19, 230, 47, 145, 94, 163, 194, 61, 100, 61, 71,
+			243, 55, 73, 143, 122, 92, 152, 2, 158, 127, 248, 125, 22, 233,
+			246, 10, 110, 215, 55, 184, 255, 237, 139, 120, 186, 180, 136, 167,
+			107, 22, 127, 130, 140, 167, 235, 73, 33, 237, 233, 237, 234, 149,
+			210, 158, 254, 46, 166, 165, 61, 253, 165, 113, 228, 208, 118, 193,
+			151, 1, 59, 224, 28, 191, 46, 252, 54, 208, 221, 79, 190, 215,
+			34, 69, 72, 2, 123, 211, 115, 135, 252, 247, 91, 92, 50, 195,
+			89, 93, 220, 197, 197, 189, 160, 17, 114, 115, 102, 193, 142, 143,
+			226, 76, 113, 227, 195, 40, 99, 17, 172, 70, 96, 83, 151, 9,
+			17, 150, 19, 1, 109, 53, 130, 42, 219, 138, 27, 136, 141, 152,
+			134, 53, 200, 86, 223, 161, 167, 231, 133, 128, 38, 124, 59, 171,
+			209, 252, 78, 22, 250, 240, 237, 132, 165, 101, 130, 236, 222, 46,
+			33, 126, 242, 220, 94, 157, 182, 61, 199, 27, 24, 36, 223, 102,
+			139, 190, 91, 158, 115, 216, 29, 244, 191, 40, 250, 14, 13, 101,
+			59, 130, 29, 206, 137, 35, 33, 232, 225, 102, 4, 146, 76, 56,
+			47, 92, 107, 168, 99, 2, 88, 230, 113, 146, 153, 138, 118, 89,
+			144, 222, 165, 124, 199, 49, 142, 3, 94, 33, 168, 184, 144, 125,
+			202, 167, 52, 109, 87, 171, 44, 77, 129, 47, 223, 224, 42, 109,
+			170, 85, 142, 82, 132, 137, 221, 73, 16, 217, 48, 215, 146, 253,
+			47, 122, 214, 8, 210, 204, 40, 184, 21, 212, 104, 64, 57, 39,
+			145, 114, 62, 99, 25, 149, 15, 179, 100, 7, 140, 71, 12, 140,
+			89, 136, 17, 35, 109, 123, 206, 225, 254, 1, 242, 135, 114, 182,
+			65, 143, 194, 29, 242, 127, 35, 143, 49, 129, 43, 148, 141, 108,
+			4, 53, 186, 29, 236, 116, 98, 108, 189, 99, 196, 89, 220, 0,
+			101, 71, 141, 176, 183, 197, 27, 180, 6, 18, 150, 123, 97, 220,
+			96, 81, 214, 216, 17, 88, 8, 35, 142, 47, 125, 70, 205, 73,
+			12, 208, 56, 161, 242, 116, 122, 77, 200, 16, 172, 212, 251, 96,
+			3, 37, 21, 198, 250, 193, 209, 15, 12, 146, 143, 201, 245, 3,
+			28, 110, 119, 200, 255, 94, 155, 2, 125, 142, 242, 187, 152, 43,
+			171, 75, 19, 123, 24, 85, 22, 11, 119, 16, 247, 89, 68, 85,
+			216, 35, 32, 10, 196, 220, 170, 184, 208, 235, 133, 133, 67, 151,
+			174, 11, 92, 163, 133, 253, 38, 203, 104, 32, 119, 92, 187, 133,
+			166, 52, 220, 127, 74, 71, 205, 162, 10, 58, 195, 202, 155, 101,
+			41, 2, 203, 24, 221, 14, 82, 98, 224, 149, 247, 90, 12, 97,
+			246, 193, 80, 25, 81, 193, 137, 190, 15, 46, 225, 0, 90, 48,
+			112, 9, 167, 208, 194, 192, 32, 249, 105, 137, 75, 215, 115, 46,
+			184, 158, 255, 49, 155, 174, 139, 129, 35, 26, 96, 199, 108, 180,
+			51, 232, 214, 93, 213, 183, 251, 97, 82, 148, 148, 40, 228, 53,
+			132, 153, 198, 48, 200, 186, 26, 44, 19, 139, 107, 23, 118, 191,
+			20, 228, 146, 189, 176, 171, 22, 234, 151, 132, 89, 197, 214, 191,
+			15, 110, 65, 178, 120, 193, 237, 215, 105, 219, 115, 46, 12, 13,
+			147, 15, 74, 220, 22, 60, 231, 178, 235, 249, 127, 105, 41, 220,
+			66, 223, 170, 160, 186, 211, 128, 225, 110, 236, 64, 115, 96, 241,
+			150, 68, 65, 67, 238, 234, 153, 173, 118, 51, 0, 157, 226, 118,
+			22, 55, 209, 205, 80, 138, 150, 23, 179, 247, 67, 63, 144, 172,
+			9, 154, 189, 45, 93, 207, 111, 112, 104, 182, 149, 48, 24, 121,
+			120, 143, 53, 118, 136, 238, 196, 131, 238, 92, 41, 219, 16, 56,
+			153, 227, 250, 74, 97, 42, 11, 147, 142, 177, 221, 11, 3, 186,
+			116, 221, 192, 22, 8, 84, 47, 27, 216, 2, 221, 176, 203, 67,
+			195, 228, 183, 228, 25, 87, 244, 156, 155, 238, 176, 255, 127, 228,
+			177, 213, 74, 216, 61, 110, 166, 136, 171, 64, 80, 185, 128, 186,
+			206, 225, 207, 188, 212, 142, 179, 96, 206, 208, 160, 77, 231, 8,
+			101, 89, 181, 60, 123, 223, 107, 164, 46, 181, 122, 147, 118, 196,
+			135, 245, 182, 120, 227, 1, 81, 35, 229, 60, 247, 89, 45, 69,
+			11, 198, 215, 167, 211, 182, 231, 220, 28, 28, 34, 159, 144, 227,
+			239, 246, 156, 231, 220, 33, 255, 71, 245, 248, 195, 148, 182, 163,
+			132, 85, 227, 205, 8, 46, 223, 251, 205, 127, 45, 102, 41, 236,
+			178, 187, 96, 130, 18, 108, 196, 237, 76, 13, 5, 167, 11, 46,
+			216, 122, 156, 108, 198, 89, 198, 34, 34, 114, 132, 217, 3, 14,
+			83, 8, 176, 244, 40, 229, 64, 64, 117, 242, 57, 227, 136, 1,
+			197, 199, 231, 6, 6, 201, 7, 228, 54, 40, 121, 206, 29, 247,
+			128, 255, 255, 88, 187, 110, 163, 36, 136, 242, 135, 5, 215, 74,
+			99, 53, 186, 25, 36, 27, 193, 38, 187, 63, 1, 32, 242, 7,
+			52, 138, 155, 33, 18, 53, 242, 54, 175, 183, 27, 130, 140, 192,
+			186, 249, 109, 6, 196, 47, 133, 75, 223, 112, 40, 195, 51, 193,
+			106, 3, 236, 193, 180, 193, 74, 216, 96, 148, 147, 58, 18, 245,
+			15, 180, 20, 242, 114, 189, 221, 200, 42, 89, 128, 140, 81, 157,
+			182, 61, 231, 206, 196, 164, 214, 240, 169, 218, 163, 134, 78, 79,
+			213, 46, 25, 58, 61, 213, 30, 211, 94, 166, 234, 141, 136, 98,
+			150, 231, 212, 108, 211, 140, 164, 166, 138, 1, 101, 81, 83, 197,
+			64, 85, 164, 134, 197, 224, 240, 42, 108, 118, 253, 103, 75, 235,
+			2, 109, 162, 181, 137, 235, 90, 160, 28, 176, 101, 191, 77, 104,
+			179, 160, 114, 192, 22, 233, 67, 229, 0, 139, 43, 7, 132, 174,
+			135, 35, 176, 132, 50, 64, 232, 18, 157, 182, 60, 39, 236, 237,
+			215, 105, 199, 115, 194, 161, 97, 173, 12, 115, 215, 238, 147, 10,
+			47, 69, 72, 185, 134, 50, 204, 221, 66, 183, 161, 12, 115, 151,
+			244, 146, 119, 73, 101, 152, 216, 30, 244, 19, 186, 116, 99, 25,
+			182, 69, 202, 80, 46, 159, 37, 109, 244, 186, 132, 203, 28, 223,
+			19, 184, 9, 144, 224, 101, 47, 131, 18, 25, 24, 16, 49, 57,
+			209, 202, 220, 128, 112, 87, 58, 220, 214, 151, 147, 203, 178, 150,
+			124, 229, 74, 167, 4, 176, 26, 219, 69, 67, 1, 39, 238, 38,
+			134, 2, 78, 220, 63, 64, 190, 221, 65, 236, 89, 94, 225, 37,
+			251, 183, 45, 103, 234, 221, 14, 93, 95, 93, 90, 157, 9, 131,
+			40, 106, 87, 171, 225, 236, 121, 110, 95, 20, 208, 233, 20, 149,
+			157, 110, 135, 53, 214, 108, 197, 25, 139, 178, 105, 174, 150, 0,
+			102, 37, 140, 171, 135, 160, 170, 192, 35, 52, 228, 139, 18, 58,
+			206, 106, 167, 216, 203, 45, 228, 231, 65, 255, 64, 209, 4, 109,
+			188, 181, 122, 104, 74, 103, 22, 171, 89, 120, 47, 200, 152, 90,
+			154, 168, 72, 176, 140, 6, 194, 234, 249, 135, 199, 193, 179, 65,
+			227, 46, 66, 102, 121, 75, 168, 180, 35, 236, 41, 132, 129, 14,
+			48, 218, 104, 182, 149, 196, 237, 205, 173, 206, 189, 55, 175, 12,
+			24, 166, 241, 222, 157, 134, 90, 16, 173, 134, 241, 47, 170, 164,
+			180, 83, 233, 251, 72, 41, 68, 108, 48, 49, 60, 142, 8, 154,
+			193, 216, 209, 202, 36, 100, 130, 56, 77, 218, 209, 169, 184, 157,
+			181, 144, 8, 129, 123, 114, 101, 109, 245, 218, 226, 58, 191, 153,
+			151, 86, 42, 188, 60, 20, 132, 251, 224, 20, 13, 106, 243, 91,
+			113, 149, 110, 4, 240, 44, 169, 38, 97, 43, 75, 197, 4, 57,
+			48, 93, 47, 145, 1, 242, 95, 44, 92, 194, 176, 10, 221, 119,
+			91, 238, 180, 255, 135, 130, 158, 230, 86, 31, 129, 182, 201, 200,
+			211, 135, 192, 77, 144, 158, 204, 102, 226, 132, 6, 141, 48, 72,
+			103, 197, 179, 128, 72, 245, 219, 233, 212, 44, 150, 150, 171, 245,
+			77, 254, 40, 228, 109, 72, 109, 37, 121, 211, 160, 78, 72, 187,
+			213, 97, 179, 18, 39, 211, 105, 167, 38, 156, 112, 76, 24, 49,
+			180, 87, 19, 215, 60, 255, 180, 189, 197, 34, 117, 48, 1, 146,
+			137, 185, 23, 96, 117, 47, 139, 183, 80, 153, 144, 65, 220, 145,
+			168, 131, 6, 195, 55, 0, 22, 0, 122, 167, 52, 192, 1, 192,
+			137, 135, 201, 47, 57, 2, 99, 150, 231, 126, 208, 114, 125, 255,
+			167, 29, 186, 152, 83, 242, 197, 181, 74, 171, 113, 194, 21, 151,
+			185, 93, 62, 87, 235, 131, 169, 211, 50, 122, 147, 54, 47, 163,
+			86, 113, 202, 20, 82, 90, 65, 154, 202, 151, 204, 158, 107, 108,
+			59, 216, 145, 21, 67, 121, 169, 142, 72, 224, 197, 152, 48, 48,
+			223, 109, 154, 221, 226, 74, 126, 104, 170, 212, 10, 146, 148, 161,
+			143, 131, 118, 117, 139, 223, 50, 104, 68, 180, 29, 166, 76, 94,
+			153, 132, 70, 12, 174, 140, 32, 9, 27, 59, 114, 147, 194, 180,
+			178, 100, 158, 1, 186, 171, 64, 133, 85, 183, 88, 51, 40, 11,
+			141, 232, 28, 225, 149, 159, 175, 48, 2, 2, 12, 135, 6, 7,
+			17, 44, 127, 174, 201, 133, 79, 111, 169, 165, 149, 50, 124, 76,
+			52, 131, 187, 12, 119, 177, 102, 253, 209, 26, 171, 134, 72, 191,
+			236, 213, 216, 245, 197, 231, 105, 117, 43, 142, 185, 114, 58, 30,
+			94, 104, 203, 31, 55, 153, 232, 162, 196, 116, 202, 12, 244, 27,
+			243, 111, 21, 112, 50, 13, 0, 206, 110, 239, 152, 6, 56, 0,
+			152, 60, 64, 62, 101, 139, 249, 183, 61, 247, 59, 45, 247, 97,
+			255, 159, 216, 15, 58, 255, 123, 78, 163, 238, 15, 201, 175, 135,
+			221, 227, 68, 133, 123, 198, 213, 62, 229, 40, 185, 202, 124, 231,
+			40, 133, 70, 39, 224, 187, 141, 88, 221, 98, 210, 210, 115, 139,
+			37, 44, 140, 20, 158, 21, 98, 5, 241, 179, 21, 111, 235, 247,
+			94, 10, 71, 142, 244, 36, 1, 27, 51, 222, 205, 48, 216, 222,
+			98, 210, 140, 44, 85, 141, 4, 137, 90, 192, 113, 100, 172, 82,
+			222, 175, 16, 108, 248, 208, 168, 109, 27, 142, 53, 88, 169, 198,
+			92, 216, 5, 68, 172, 1, 176, 0, 208, 123, 76, 3, 28, 0,
+			28, 63, 65, 134, 248, 84, 56, 93, 94, 241, 187, 44, 247, 55,
+			172, 130, 204, 2, 55, 180, 251, 93, 86, 223, 16, 249, 9, 139,
+			148, 56, 4, 142, 184, 239, 179, 138, 135, 252, 31, 200, 29, 113,
+			208, 179, 118, 202, 18, 169, 112, 69, 133, 142, 11, 34, 19, 238,
+			19, 117, 150, 196, 130, 14, 198, 107, 169, 76, 159, 143, 219, 132,
+			239, 39, 220, 29, 154, 198, 150, 22, 13, 152, 89, 214, 134, 199,
+			18, 50, 228, 133, 235, 15, 121, 32, 161, 17, 159, 38, 165, 202,
+			132, 12, 147, 30, 217, 227, 2, 118, 185, 207, 4, 89, 0, 234,
+			159, 48, 65, 14, 128, 252, 131, 228, 175, 212, 88, 1, 35, 63,
+			104, 21, 63, 105, 117, 251, 191, 111, 113, 218, 91, 105, 142, 242,
+			33, 71, 237, 230, 6, 75, 164, 207, 192, 148, 159, 51, 9, 78,
+			126, 144, 193, 102, 21, 140, 178, 132, 205, 139, 243, 146, 160, 221,
+			5, 239, 38, 173, 181, 153, 156, 86, 101, 111, 40, 25, 216, 6,
+			167, 234, 198, 234, 250, 50, 231, 184, 160, 160, 129, 102, 193, 166,
+			104, 57, 53, 182, 228, 245, 91, 107, 235, 232, 221, 181, 193, 157,
+			32, 106, 195, 69, 22, 181, 155, 76, 92, 51, 98, 105, 197, 117,
+			186, 167, 110, 219, 49, 3, 113, 56, 253, 63, 104, 13, 140, 144,
+			29, 66, 36, 8, 230, 255, 71, 172, 146, 239, 51, 236, 208, 254,
+			8, 136, 41, 170, 40, 25, 84, 182, 210, 78, 133, 83, 48, 34,
+			116, 23, 175, 89, 190, 206, 227, 186, 224, 110, 149, 9, 25, 33,
+			189, 186, 233, 2, 182, 61, 144, 7, 90, 0, 28, 28, 203, 3,
+			29, 0, 78, 30, 32, 111, 55, 122, 110, 121, 238, 223, 182, 74,
+			7, 253, 250, 87, 180, 231, 130, 21, 213, 209, 117, 56, 26, 255,
+			118, 103, 215, 45, 236, 209, 224, 120, 30, 232, 0, 240, 128, 159,
+			235, 186, 237, 185, 31, 255, 202, 119, 93, 112, 126, 58, 186, 14,
+			39, 201, 199, 59, 187, 14, 200, 252, 120, 103, 215, 225, 68, 249,
+			56, 116, 253, 235, 141, 174, 59, 158, 251, 19, 86, 233, 176, 191,
+			245, 21, 237, 186, 98, 173, 116, 116, 222, 41, 96, 243, 249, 206,
+			59, 22, 0, 7, 39, 243, 64, 236, 232, 193, 67, 228, 29, 70,
+			231, 93, 207, 253, 41, 171, 116, 200, 223, 252, 138, 118, 94, 62,
+			244, 59, 250, 238, 22, 176, 245, 124, 223, 193, 210, 226, 167, 172,
+			193, 137, 60, 208, 1, 160, 127, 144, 252, 123, 203, 232, 124, 193,
+			115, 127, 218, 42, 149, 37, 227, 35, 104, 74, 119, 215, 208, 123,
+			232, 51, 168, 37, 72, 23, 37, 234, 132, 66, 239, 70, 250, 136,
+			10, 234, 25, 75, 184, 209, 114, 61, 76, 224, 168, 149, 231, 147,
+			150, 222, 241, 139, 160, 22, 183, 55, 26, 140, 159, 72, 44, 168,
+			110, 209, 122, 59, 193, 59, 141, 163, 6, 144, 69, 0, 91, 115,
+			252, 14, 164, 134, 234, 30, 234, 228, 183, 163, 148, 101, 240, 126,
+			56, 61, 7, 39, 167, 62, 50, 129, 110, 109, 178, 90, 136, 238,
+			167, 59, 112, 84, 40, 226, 32, 105, 30, 104, 1, 240, 216, 76,
+			30, 232, 0, 240, 228, 60, 105, 24, 40, 42, 122, 238, 207, 90,
+			165, 5, 255, 57, 196, 80, 187, 213, 98, 9, 221, 64, 33, 140,
+			36, 34, 106, 172, 17, 236, 72, 95, 219, 84, 40, 212, 236, 234,
+			47, 167, 181, 49, 43, 242, 86, 176, 14, 164, 141, 205, 46, 20,
+			121, 115, 249, 206, 22, 45, 0, 30, 155, 203, 3, 29, 0, 158,
+			58, 67, 66, 117, 239, 90, 158, 251, 243, 86, 113, 220, 127, 150,
+			99, 59, 168, 215, 89, 53, 75, 145, 210, 144, 68, 33, 71, 180,
+			164, 59, 132, 125, 11, 32, 254, 94, 128, 86, 50, 148, 189, 92,
+			101, 45, 46, 163, 33, 180, 26, 38, 213, 118, 147, 27, 252, 229,
+			47, 76, 171, 136, 109, 245, 154, 32, 108, 190, 111, 216, 4, 57,
+			0, 26, 29, 35, 255, 183, 171, 46, 76, 203, 43, 254, 170, 85,
+			252, 183, 86, 183, 255, 57, 23, 12, 55, 56, 209, 9, 175, 166,
+			13, 113, 99, 118, 174, 66, 184, 39, 141, 93, 163, 158, 115, 111,
+			226, 198, 139, 226, 254, 132, 109, 52, 39, 181, 228, 181, 113, 63,
+			84, 209, 8, 35, 254, 230, 91, 6, 118, 222, 121, 170, 164, 162,
+			52, 101, 66, 201, 94, 211, 89, 4, 149, 225, 95, 164, 83, 200,
+			81, 158, 162, 247, 246, 43, 88, 75, 233, 174, 7, 175, 42, 156,
+			180, 163, 87, 47, 186, 21, 52, 112, 31, 85, 110, 94, 162, 51,
+			194, 65, 192, 174, 234, 168, 236, 78, 199, 27, 122, 214, 232, 100,
+			220, 202, 55, 100, 12, 133, 110, 134, 247, 88, 106, 52, 45, 184,
+			77, 252, 105, 29, 211, 165, 235, 32, 131, 227, 252, 9, 200, 202,
+			185, 218, 72, 156, 134, 153, 242, 76, 4, 95, 35, 246, 114, 70,
+			145, 25, 42, 5, 82, 18, 175, 176, 17, 9, 95, 91, 205, 32,
+			185, 219, 33, 192, 10, 82, 125, 236, 206, 41, 215, 11, 232, 44,
+			190, 149, 192, 110, 21, 11, 178, 21, 55, 194, 234, 14, 81, 111,
+			178, 160, 213, 106, 132, 156, 245, 181, 82, 167, 65, 71, 239, 112,
+			255, 52, 194, 102, 136, 162, 130, 6, 131, 3, 20, 55, 162, 124,
+			40, 235, 125, 103, 46, 90, 120, 139, 187, 191, 106, 13, 140, 19,
+			79, 237, 111, 124, 143, 255, 154, 85, 58, 105, 236, 45, 100, 14,
+			185, 191, 150, 223, 133, 252, 165, 250, 107, 214, 177, 135, 243, 64,
+			7, 128, 179, 143, 228, 170, 180, 60, 247, 83, 86, 105, 54, 151,
+			17, 54, 204, 167, 58, 171, 180, 48, 231, 177, 227, 121, 160, 3,
+			192, 233, 153, 92, 149, 182, 231, 126, 218, 42, 61, 146, 203, 8,
+			71, 211, 167, 59, 171, 132, 198, 63, 109, 29, 59, 145, 7, 58,
+			0, 156, 153, 37, 67, 234, 172, 176, 61, 247, 51, 86, 241, 144,
+			177, 89, 161, 186, 207, 88, 197, 1, 19, 100, 1, 104, 208, 164,
+			129, 161, 170, 207, 192, 61, 242, 63, 75, 134, 134, 227, 185, 255,
+			167, 229, 122, 138, 218, 15, 83, 218, 100, 89, 128, 76, 28, 126,
+			195, 73, 230, 48, 63, 144, 248, 6, 109, 71, 85, 126, 202, 128,
+			216, 65, 240, 200, 196, 113, 52, 71, 240, 165, 12, 165, 100, 17,
+			113, 132, 161, 211, 150, 160, 42, 141, 240, 246, 120, 33, 69, 198,
+			91, 214, 100, 223, 26, 207, 30, 167, 136, 29, 46, 105, 128, 5,
+			128, 158, 126, 13, 192, 33, 13, 13, 147, 1, 100, 170, 217, 94,
+			241, 119, 44, 251, 247, 45, 7, 13, 98, 44, 7, 176, 242, 59,
+			22, 25, 39, 3, 136, 1, 48, 247, 113, 63, 103, 185, 19, 162,
+			188, 141, 68, 232, 231, 244, 195, 10, 173, 100, 220, 207, 89, 189,
+			158, 6, 56, 0, 24, 211, 85, 88, 158, 251, 31, 44, 119, 68,
+			101, 0, 98, 240, 63, 152, 85, 88, 152, 163, 119, 64, 3, 28,
+			0, 12, 123, 170, 10, 219, 115, 127, 215, 236, 5, 92, 238, 191,
+			107, 86, 1, 141, 252, 174, 217, 11, 152, 186, 223, 53, 123, 225,
+			120, 238, 239, 89, 238, 168, 202, 0, 164, 209, 239, 153, 85, 0,
+			170, 126, 207, 234, 29, 212, 0, 44, 226, 141, 8, 84, 57, 94,
+			241, 243, 150, 253, 39, 10, 85, 144, 255, 243, 22, 25, 16, 45,
+			128, 167, 0, 247, 15, 45, 247, 17, 81, 222, 193, 157, 246, 135,
+			150, 123, 88, 3, 44, 0, 28, 57, 161, 1, 14, 0, 102, 102,
+			85, 21, 150, 231, 254, 145, 229, 30, 84, 25, 96, 103, 253, 145,
+			229, 14, 107, 0, 230, 240, 198, 53, 192, 1, 192, 1, 95, 85,
+			97, 123, 238, 31, 91, 238, 105, 149, 1, 136, 184, 63, 182, 220,
+			62, 13, 40, 2, 160, 127, 74, 3, 44, 0, 60, 116, 82, 3,
+			28, 0, 148, 79, 113, 182, 53, 212, 248, 5, 203, 30, 226, 195,
+			198, 226, 95, 176, 236, 162, 76, 90, 144, 236, 238, 149, 73, 7,
+			146, 3, 131, 228, 245, 194, 252, 209, 253, 79, 150, 125, 220, 159,
+			165, 119, 25, 210, 6, 232, 120, 61, 172, 73, 39, 237, 98, 115,
+			104, 93, 109, 34, 234, 129, 133, 252, 159, 44, 123, 92, 38, 45,
+			72, 78, 28, 149, 73, 172, 119, 234, 33, 210, 16, 198, 146, 238,
+			159, 91, 246, 168, 255, 34, 21, 186, 137, 216, 18, 231, 97, 215,
+			77, 111, 0, 52, 72, 239, 10, 159, 146, 120, 193, 114, 158, 237,
+			70, 187, 83, 72, 65, 148, 68, 181, 177, 3, 188, 198, 6, 23,
+			231, 240, 198, 129, 46, 253, 115, 141, 0, 160, 72, 255, 220, 234,
+			30, 144, 73, 7, 146, 195, 35, 228, 151, 184, 73, 99, 241, 191,
+			88, 93, 31, 180, 45, 255, 127, 181, 168, 86, 98, 202, 59, 27,
+			5, 70, 219, 124, 39, 167, 13, 25, 38, 92, 117, 163, 25, 188,
+			28, 54, 219, 77, 10, 170, 249, 52, 174, 19, 186, 240, 186, 199,
+			158, 190, 104, 72, 81, 232, 76, 20, 131, 184, 51, 193, 67, 41,
+			99, 105, 43, 168, 178, 217, 178, 193, 45, 19, 57, 105, 24, 85,
+			65, 156, 179, 73, 176, 169, 85, 108, 41, 213, 52, 112, 53, 104,
+			84, 219, 13, 197, 93, 8, 19, 108, 19, 205, 118, 209, 208, 209,
+			253, 47, 86, 201, 35, 255, 163, 52, 4, 116, 191, 8, 88, 127,
+			39, 125, 170, 29, 36, 65, 148, 9, 139, 95, 184, 226, 58, 252,
+			21, 148, 203, 229, 87, 238, 72, 79, 12, 220, 161, 1, 247, 145,
+			73, 103, 12, 249, 0, 168, 75, 18, 238, 130, 76, 206, 163, 184,
+			212, 81, 68, 137, 226, 232, 64, 179, 215, 129, 188, 152, 45, 43,
+			75, 190, 2, 118, 167, 36, 147, 22, 36, 123, 6, 101, 210, 129,
+			164, 55, 66, 254, 216, 198, 190, 91, 158, 251, 77, 182, 237, 249,
+			159, 229, 226, 121, 17, 47, 39, 174, 35, 123, 190, 204, 233, 134,
+			48, 149, 30, 40, 184, 173, 117, 52, 31, 133, 13, 105, 126, 168,
+			156, 154, 5, 34, 8, 1, 16, 16, 4, 152, 82, 98, 208, 202,
+			115, 3, 23, 163, 35, 31, 136, 182, 114, 195, 154, 213, 214, 223,
+			237, 20, 12, 171, 49, 215, 75, 96, 171, 75, 180, 69, 54, 176,
+			218, 208, 104, 82, 16, 82, 113, 11, 169, 186, 40, 206, 40, 210,
+			183, 120, 81, 192, 125, 52, 131, 158, 208, 106, 172, 252, 72, 25,
+			211, 23, 46, 16, 90, 15, 26, 41, 155, 157, 219, 229, 4, 3,
+			40, 97, 38, 188, 193, 226, 178, 66, 138, 131, 206, 164, 177, 114,
+			212, 145, 50, 134, 87, 211, 70, 40, 156, 204, 242, 75, 79, 209,
+			163, 116, 131, 177, 136, 139, 119, 100, 113, 177, 137, 120, 229, 172,
+			166, 39, 7, 78, 252, 111, 178, 213, 228, 88, 136, 254, 158, 126,
+			153, 116, 32, 57, 52, 76, 62, 192, 23, 150, 237, 185, 31, 176,
+			237, 147, 254, 215, 227, 220, 104, 215, 126, 113, 157, 223, 130, 56,
+			3, 122, 51, 77, 167, 154, 5, 204, 245, 185, 113, 2, 133, 65,
+			61, 88, 52, 43, 58, 122, 131, 113, 23, 182, 166, 102, 147, 42,
+			27, 177, 123, 44, 145, 53, 200, 158, 195, 105, 247, 1, 219, 62,
+			40, 147, 22, 36, 15, 61, 44, 147, 14, 36, 103, 31, 33, 87,
+			8, 108, 252, 226, 183, 218, 93, 223, 97, 91, 254, 121, 42, 182,
+			57, 168, 125, 165, 114, 226, 16, 127, 171, 21, 253, 130, 228, 250,
+			156, 166, 220, 65, 236, 52, 56, 237, 190, 213, 46, 13, 224, 249,
+			139, 247, 202, 183, 217, 226, 40, 228, 183, 202, 183, 217, 118, 159,
+			76, 90, 144, 236, 31, 150, 73, 7, 146, 163, 99, 162, 168, 229,
+			185, 223, 110, 219, 39, 196, 71, 184, 77, 190, 221, 22, 39, 57,
+			191, 75, 190, 221, 30, 166, 50, 233, 64, 242, 33, 112, 132, 96,
+			187, 174, 87, 252, 176, 221, 245, 61, 142, 133, 29, 130, 51, 238,
+			195, 118, 105, 16, 111, 66, 23, 248, 130, 223, 105, 219, 223, 109,
+			243, 155, 208, 69, 70, 217, 119, 218, 164, 15, 239, 32, 151, 51,
+			201, 62, 98, 187, 156, 239, 237, 10, 206, 213, 71, 108, 151, 104,
+			128, 5, 128, 222, 33, 13, 112, 0, 48, 50, 170, 170, 0, 214,
+			171, 237, 122, 42, 3, 44, 161, 239, 50, 171, 176, 48, 71, 111,
+			191, 6, 56, 0, 24, 26, 198, 161, 131, 151, 29, 247, 123, 36,
+			154, 92, 196, 218, 247, 216, 182, 43, 147, 22, 36, 11, 221, 50,
+			233, 64, 146, 244, 146, 247, 88, 88, 214, 242, 220, 239, 183, 237,
+			65, 63, 123, 53, 185, 168, 226, 98, 254, 117, 75, 70, 121, 159,
+			96, 192, 223, 111, 139, 107, 198, 197, 225, 126, 191, 221, 173, 190,
+			58, 144, 236, 31, 224, 51, 226, 118, 121, 197, 143, 218, 246, 223,
+			145, 51, 2, 106, 141, 238, 71, 237, 238, 62, 242, 6, 64, 39,
+			74, 192, 221, 191, 105, 187, 35, 254, 12, 238, 41, 217, 117, 56,
+			201, 184, 37, 134, 56, 184, 59, 164, 90, 46, 23, 144, 67, 209,
+			1, 13, 176, 1, 48, 236, 145, 103, 69, 221, 150, 231, 254, 176,
+			237, 122, 254, 83, 157, 117, 11, 103, 160, 141, 29, 213, 10, 122,
+			115, 206, 212, 23, 227, 53, 149, 197, 98, 63, 24, 77, 91, 188,
+			230, 126, 13, 176, 1, 48, 52, 76, 86, 69, 211, 182, 231, 254,
+			168, 237, 14, 249, 79, 238, 55, 172, 122, 156, 8, 43, 115, 104,
+			110, 209, 48, 76, 71, 207, 22, 105, 220, 184, 151, 27, 45, 12,
+			230, 71, 109, 183, 87, 3, 176, 137, 129, 65, 242, 168, 104, 210,
+			241, 220, 143, 217, 238, 176, 127, 188, 179, 201, 48, 194, 161, 213,
+			65, 91, 66, 105, 239, 201, 106, 96, 75, 127, 204, 118, 251, 52,
+			192, 6, 192, 224, 16, 121, 163, 168, 215, 245, 220, 143, 219, 174,
+			239, 207, 237, 81, 111, 16, 229, 78, 141, 61, 234, 135, 29, 250,
+			113, 181, 229, 184, 210, 148, 251, 113, 123, 242, 128, 216, 178, 150,
+			87, 252, 187, 182, 253, 251, 106, 203, 2, 106, 255, 174, 45, 136,
+			87, 151, 63, 21, 127, 194, 22, 196, 171, 43, 158, 137, 63, 97,
+			187, 135, 53, 0, 184, 134, 246, 145, 19, 26, 0, 28, 67, 91,
+			16, 175, 46, 127, 26, 254, 61, 219, 61, 169, 50, 192, 113, 243,
+			247, 204, 42, 44, 204, 113, 228, 97, 13, 112, 0, 48, 251, 136,
+			170, 194, 246, 220, 159, 180, 221, 35, 42, 3, 208, 249, 63, 169,
+			119, 61, 39, 55, 127, 210, 238, 61, 160, 1, 14, 0, 14, 29,
+			38, 239, 20, 85, 56, 158, 251, 9, 219, 157, 244, 163, 251, 240,
+			44, 249, 249, 111, 236, 99, 32, 182, 54, 56, 151, 75, 178, 222,
+			106, 32, 162, 32, 66, 78, 222, 41, 153, 64, 231, 222, 194, 24,
+			169, 172, 123, 8, 148, 235, 39, 212, 10, 18, 79, 176, 79, 216,
+			125, 35, 26, 128, 93, 28, 159, 32, 140, 119, 25, 244, 66, 126,
+			198, 118, 15, 248, 183, 4, 111, 75, 207, 125, 194, 130, 218, 78,
+			199, 222, 228, 4, 235, 22, 191, 107, 177, 195, 146, 211, 211, 41,
+			242, 166, 59, 44, 211, 61, 195, 99, 250, 103, 236, 190, 113, 242,
+			46, 209, 174, 229, 21, 63, 105, 187, 63, 103, 23, 252, 102, 190,
+			101, 78, 119, 54, 194, 123, 178, 85, 148, 208, 107, 135, 35, 202,
+			125, 200, 202, 210, 108, 153, 94, 218, 98, 168, 206, 192, 140, 71,
+			41, 95, 160, 184, 253, 64, 177, 189, 227, 146, 147, 61, 130, 5,
+			241, 73, 187, 111, 12, 223, 238, 8, 128, 117, 248, 143, 236, 226,
+			20, 190, 202, 5, 164, 128, 160, 62, 19, 100, 1, 168, 255, 176,
+			9, 114, 0, 68, 143, 145, 55, 137, 193, 217, 94, 241, 23, 108,
+			247, 159, 216, 5, 255, 201, 220, 224, 166, 211, 78, 29, 35, 180,
+			211, 16, 106, 131, 181, 26, 231, 217, 241, 19, 163, 154, 155, 90,
+			188, 78, 127, 1, 102, 82, 118, 23, 137, 224, 95, 180, 139, 186,
+			35, 156, 16, 253, 69, 179, 187, 156, 24, 253, 69, 187, 127, 210,
+			4, 57, 0, 58, 120, 136, 204, 139, 238, 58, 94, 241, 159, 217,
+			238, 191, 178, 11, 254, 193, 93, 171, 64, 62, 70, 140, 174, 192,
+			162, 250, 103, 118, 223, 40, 121, 183, 45, 250, 130, 116, 194, 191,
+			180, 139, 190, 255, 199, 156, 225, 45, 104, 231, 142, 39, 22, 93,
+			143, 105, 188, 161, 92, 157, 230, 212, 76, 165, 52, 153, 151, 212,
+			146, 100, 196, 151, 226, 180, 205, 113, 153, 47, 247, 140, 31, 83,
+			65, 117, 210, 32, 3, 110, 85, 154, 97, 204, 46, 141, 220, 48,
+			34, 220, 121, 27, 210, 181, 101, 66, 232, 42, 232, 195, 132, 117,
+			122, 71, 148, 44, 139, 145, 74, 170, 21, 175, 193, 59, 115, 156,
+			198, 206, 81, 173, 162, 0, 39, 69, 203, 226, 157, 68, 20, 86,
+			57, 109, 244, 47, 237, 226, 144, 9, 178, 0, 52, 60, 102, 130,
+			28, 0, 77, 30, 32, 159, 181, 5, 242, 93, 175, 248, 235, 182,
+			251, 239, 236, 130, 255, 207, 109, 185, 165, 5, 241, 150, 125, 105,
+			135, 8, 233, 60, 69, 246, 62, 68, 230, 232, 70, 2, 102, 51,
+			180, 22, 111, 71, 82, 249, 82, 33, 111, 58, 37, 123, 139, 41,
+			203, 66, 205, 131, 7, 97, 75, 153, 144, 12, 7, 145, 46, 43,
+			110, 83, 150, 18, 241, 252, 107, 236, 252, 215, 151, 165, 202, 213,
+			10, 183, 211, 175, 155, 27, 7, 201, 179, 207, 216, 69, 189, 37,
+			92, 220, 56, 159, 49, 55, 14, 39, 211, 62, 99, 247, 143, 152,
+			32, 224, 209, 193, 225, 169, 171, 178, 60, 247, 55, 236, 226, 1,
+			35, 19, 208, 78, 191, 145, 175, 202, 194, 92, 253, 163, 38, 200,
+			1, 208, 196, 164, 81, 149, 237, 185, 191, 153, 175, 10, 110, 160,
+			223, 204, 87, 5, 13, 254, 102, 190, 42, 184, 117, 126, 51, 95,
+			149, 227, 185, 191, 101, 23, 15, 26, 153, 128, 227, 244, 91, 249,
+			170, 96, 27, 255, 150, 221, 63, 110, 130, 176, 224, 1, 223, 168,
+			202, 245, 220, 207, 218, 69, 223, 200, 4, 60, 136, 207, 230, 171,
+			2, 28, 127, 214, 238, 31, 51, 65, 14, 128, 38, 15, 160, 138,
+			131, 235, 128, 59, 173, 226, 111, 219, 238, 239, 217, 5, 57, 51,
+			224, 82, 203, 253, 109, 91, 240, 251, 224, 114, 114, 61, 247, 223,
+			219, 69, 42, 51, 128, 211, 39, 0, 12, 105, 128, 5, 128, 225,
+			131, 26, 224, 0, 224, 200, 81, 85, 69, 193, 115, 127, 199, 46,
+			234, 75, 28, 132, 84, 191, 99, 23, 7, 53, 0, 152, 140, 246,
+			144, 190, 196, 65, 56, 245, 59, 112, 137, 203, 42, 138, 158, 251,
+			57, 24, 177, 204, 80, 228, 128, 126, 13, 0, 174, 163, 61, 48,
+			166, 1, 192, 117, 228, 244, 14, 175, 162, 219, 115, 255, 131, 93,
+			60, 164, 50, 116, 23, 17, 48, 160, 1, 192, 117, 180, 7, 39,
+			52, 0, 184, 142, 182, 127, 80, 85, 81, 242, 220, 223, 181, 139,
+			179, 42, 67, 169, 136, 128, 81, 13, 0, 174, 163, 61, 118, 92,
+			3, 128, 235, 104, 79, 207, 136, 55, 136, 237, 185, 127, 32, 223,
+			91, 46, 190, 37, 255, 64, 83, 244, 176, 140, 254, 192, 22, 156,
+			51, 190, 132, 254, 0, 8, 205, 115, 88, 20, 216, 122, 182, 61,
+			227, 159, 124, 117, 206, 153, 105, 65, 42, 223, 6, 64, 129, 252,
+			145, 109, 79, 202, 36, 176, 12, 237, 3, 15, 201, 36, 214, 252,
+			240, 180, 232, 162, 235, 185, 127, 98, 219, 71, 196, 71, 152, 237,
+			63, 209, 61, 134, 185, 254, 19, 123, 248, 128, 76, 58, 144, 60,
+			116, 88, 20, 45, 120, 238, 23, 108, 251, 168, 248, 8, 179, 252,
+			5, 93, 20, 230, 248, 11, 246, 176, 47, 147, 192, 23, 180, 15,
+			31, 17, 228, 168, 227, 21, 255, 163, 109, 127, 216, 145, 228, 40,
+			244, 241, 63, 218, 100, 152, 60, 131, 168, 199, 187, 236, 79, 225,
+			77, 113, 153, 107, 22, 119, 242, 243, 196, 113, 139, 223, 12, 25,
+			25, 39, 135, 128, 97, 39, 201, 36, 206, 146, 144, 39, 145, 131,
+			135, 204, 159, 218, 110, 73, 3, 44, 0, 244, 244, 107, 128, 3,
+			128, 161, 97, 210, 20, 93, 177, 60, 247, 63, 3, 65, 249, 86,
+			83, 80, 215, 217, 33, 227, 211, 54, 75, 20, 195, 81, 118, 3,
+			158, 135, 160, 6, 4, 220, 187, 108, 139, 53, 185, 233, 6, 28,
+			177, 192, 195, 209, 47, 16, 104, 174, 128, 237, 233, 30, 90, 216,
+			129, 158, 17, 13, 112, 0, 48, 62, 65, 170, 162, 135, 182, 231,
+			254, 165, 237, 142, 251, 107, 244, 50, 183, 238, 206, 117, 79, 194,
+			94, 189, 95, 249, 110, 145, 221, 253, 130, 233, 254, 75, 179, 95,
+			128, 152, 191, 180, 123, 134, 53, 192, 1, 192, 232, 152, 160, 107,
+			29, 72, 127, 209, 118, 39, 252, 91, 244, 162, 176, 44, 207, 117,
+			76, 1, 191, 204, 158, 193, 185, 250, 69, 179, 103, 176, 154, 190,
+			104, 247, 120, 26, 128, 61, 25, 27, 23, 7, 33, 88, 63, 22,
+			191, 193, 113, 191, 205, 145, 7, 33, 26, 9, 186, 223, 224, 244,
+			12, 146, 43, 120, 236, 58, 252, 205, 252, 141, 78, 113, 216, 127,
+			140, 114, 243, 117, 212, 71, 84, 122, 180, 121, 13, 247, 57, 32,
+			10, 234, 1, 104, 72, 170, 254, 241, 131, 216, 17, 111, 232, 111,
+			116, 138, 125, 38, 200, 6, 208, 224, 16, 249, 102, 75, 53, 104,
+			121, 238, 55, 59, 197, 49, 191, 77, 181, 205, 188, 100, 15, 132,
+			57, 157, 122, 109, 215, 3, 173, 41, 167, 145, 25, 50, 11, 209,
+			12, 42, 160, 89, 18, 68, 105, 200, 162, 140, 162, 229, 169, 230,
+			1, 34, 158, 209, 70, 0, 4, 142, 50, 224, 171, 217, 95, 139,
+			247, 100, 200, 4, 217, 0, 26, 25, 37, 239, 211, 253, 181, 61,
+			247, 131, 78, 241, 128, 159, 210, 188, 77, 127, 71, 159, 145, 242,
+			131, 94, 209, 160, 157, 109, 177, 40, 227, 110, 126, 69, 200, 109,
+			131, 102, 33, 116, 123, 43, 78, 89, 142, 179, 2, 143, 28, 229,
+			67, 142, 211, 90, 113, 148, 239, 45, 42, 209, 58, 197, 81, 19,
+			132, 93, 155, 152, 36, 21, 213, 89, 199, 115, 191, 213, 41, 250,
+			254, 215, 209, 14, 103, 3, 29, 189, 5, 189, 12, 160, 47, 111,
+			27, 124, 81, 232, 123, 2, 74, 29, 29, 243, 138, 140, 58, 167,
+			56, 102, 130, 108, 0, 77, 30, 32, 223, 109, 137, 93, 224, 122,
+			238, 135, 28, 119, 220, 255, 38, 75, 206, 158, 110, 80, 140, 180,
+			34, 153, 189, 29, 27, 65, 9, 149, 13, 23, 19, 168, 157, 43,
+			109, 165, 249, 94, 1, 194, 13, 245, 84, 185, 3, 54, 115, 161,
+			24, 178, 141, 93, 251, 6, 14, 251, 15, 57, 174, 1, 176, 0,
+			208, 171, 119, 52, 28, 248, 31, 114, 70, 199, 200, 111, 112, 190,
+			88, 209, 115, 191, 219, 177, 39, 252, 95, 182, 20, 135, 222, 208,
+			213, 188, 143, 196, 197, 56, 179, 231, 140, 243, 18, 61, 52, 195,
+			49, 176, 151, 32, 102, 151, 28, 6, 221, 136, 2, 123, 215, 144,
+			244, 72, 78, 47, 161, 97, 70, 155, 44, 136, 82, 21, 90, 76,
+			50, 220, 241, 105, 129, 252, 57, 97, 117, 170, 73, 118, 197, 112,
+			43, 242, 209, 245, 200, 164, 5, 73, 50, 44, 147, 14, 36, 209,
+			27, 32, 80, 95, 197, 239, 115, 186, 254, 157, 224, 142, 194, 85,
+			247, 125, 78, 105, 152, 124, 11, 96, 169, 0, 236, 209, 31, 112,
+			236, 191, 233, 56, 254, 43, 122, 156, 183, 193, 164, 154, 91, 4,
+			103, 109, 80, 205, 143, 115, 120, 130, 87, 167, 202, 187, 178, 164,
+			103, 94, 168, 77, 212, 204, 183, 245, 58, 62, 25, 102, 82, 198,
+			248, 101, 184, 75, 181, 34, 105, 85, 37, 243, 189, 128, 207, 254,
+			31, 112, 4, 171, 167, 192, 185, 179, 31, 117, 132, 144, 17, 211,
+			69, 4, 140, 104, 0, 112, 15, 97, 172, 10, 224, 0, 64, 8,
+			25, 11, 156, 59, 251, 55, 28, 33, 76, 45, 8, 238, 236, 223,
+			112, 220, 30, 13, 192, 28, 100, 80, 3, 28, 0, 8, 97, 106,
+			1, 88, 15, 63, 228, 216, 63, 42, 8, 128, 2, 114, 2, 126,
+			200, 17, 44, 228, 2, 231, 3, 252, 176, 35, 248, 89, 5, 193,
+			5, 248, 97, 185, 88, 11, 130, 7, 240, 195, 78, 239, 144, 6,
+			56, 0, 16, 44, 228, 2, 231, 71, 253, 136, 35, 132, 198, 5,
+			161, 159, 253, 35, 102, 21, 22, 230, 232, 245, 52, 0, 244, 38,
+			29, 33, 52, 46, 112, 126, 212, 223, 114, 92, 157, 1, 46, 193,
+			191, 101, 86, 1, 141, 252, 45, 167, 183, 95, 3, 28, 0, 8,
+			46, 52, 120, 102, 119, 127, 204, 17, 92, 232, 2, 98, 250, 199,
+			28, 193, 133, 70, 63, 237, 238, 143, 57, 130, 11, 93, 192, 1,
+			252, 152, 67, 122, 57, 138, 224, 142, 250, 152, 99, 255, 140, 68,
+			17, 222, 80, 31, 115, 186, 251, 200, 139, 208, 57, 126, 63, 125,
+			220, 113, 71, 252, 27, 248, 158, 99, 134, 205, 148, 96, 100, 5,
+			85, 208, 119, 226, 156, 141, 142, 199, 190, 176, 33, 229, 140, 107,
+			97, 252, 71, 12, 126, 145, 48, 141, 130, 6, 6, 52, 0, 120,
+			138, 206, 176, 71, 46, 137, 30, 0, 19, 209, 113, 135, 252, 179,
+			29, 61, 8, 83, 89, 39, 157, 129, 222, 168, 85, 204, 95, 146,
+			75, 215, 103, 141, 102, 44, 94, 75, 175, 6, 216, 0, 24, 24,
+			36, 13, 209, 140, 237, 185, 127, 223, 113, 135, 253, 183, 236, 55,
+			80, 180, 190, 70, 187, 228, 184, 5, 131, 93, 186, 46, 14, 19,
+			134, 100, 33, 28, 40, 91, 44, 72, 116, 224, 16, 182, 15, 155,
+			172, 32, 174, 147, 191, 239, 184, 125, 26, 128, 237, 15, 14, 145,
+			199, 68, 127, 128, 127, 7, 253, 121, 120, 247, 176, 247, 101, 2,
+			23, 196, 117, 241, 9, 179, 102, 184, 44, 62, 1, 53, 95, 16,
+			53, 187, 158, 251, 211, 142, 235, 251, 243, 123, 214, 188, 15, 27,
+			88, 55, 0, 7, 248, 79, 171, 93, 35, 184, 192, 63, 237, 8,
+			46, 112, 1, 120, 98, 255, 208, 177, 127, 93, 237, 58, 24, 234,
+			63, 212, 71, 3, 178, 179, 126, 214, 17, 92, 96, 76, 23, 17,
+			112, 88, 3, 64, 93, 207, 57, 114, 66, 3, 64, 85, 207, 17,
+			92, 224, 2, 167, 10, 63, 233, 8, 46, 48, 166, 139, 8, 208,
+			85, 32, 211, 207, 57, 242, 176, 6, 56, 0, 152, 125, 4, 169,
+			179, 130, 3, 126, 30, 138, 63, 231, 184, 255, 68, 80, 103, 8,
+			177, 60, 247, 231, 156, 190, 131, 248, 40, 70, 0, 116, 245, 31,
+			59, 130, 243, 38, 32, 5, 4, 245, 153, 32, 11, 64, 253, 147,
+			38, 200, 1, 208, 193, 67, 70, 85, 160, 220, 231, 20, 169, 145,
+			9, 142, 138, 159, 207, 87, 133, 42, 128, 78, 255, 65, 19, 4,
+			42, 128, 206, 145, 163, 70, 85, 182, 231, 254, 130, 83, 156, 48,
+			50, 193, 145, 241, 11, 249, 170, 144, 143, 232, 244, 123, 38, 200,
+			1, 208, 216, 184, 81, 21, 112, 8, 37, 109, 33, 32, 5, 4,
+			153, 85, 193, 146, 250, 69, 167, 127, 200, 4, 97, 65, 117, 14,
+			226, 242, 253, 167, 142, 59, 171, 240, 13, 215, 248, 63, 213, 231,
+			61, 151, 106, 254, 83, 103, 244, 184, 6, 56, 0, 152, 158, 145,
+			85, 32, 183, 209, 113, 15, 168, 249, 176, 16, 208, 55, 174, 50,
+			216, 158, 251, 75, 142, 59, 174, 50, 64, 149, 191, 228, 244, 141,
+			168, 12, 142, 231, 254, 178, 62, 140, 109, 124, 241, 253, 178, 211,
+			55, 170, 38, 221, 245, 138, 255, 194, 113, 255, 149, 49, 233, 176,
+			154, 255, 5, 207, 194, 113, 130, 92, 163, 127, 233, 8, 78, 136,
+			128, 20, 17, 52, 100, 130, 128, 229, 231, 12, 143, 153, 32, 96,
+			249, 57, 130, 19, 82, 112, 108, 224, 132, 252, 138, 227, 254, 91,
+			221, 26, 114, 66, 126, 197, 33, 147, 10, 109, 142, 231, 254, 27,
+			71, 112, 66, 48, 93, 68, 192, 144, 6, 88, 0, 24, 62, 168,
+			1, 88, 68, 112, 66, 10, 92, 245, 230, 87, 29, 193, 198, 192,
+			116, 17, 1, 253, 26, 0, 90, 123, 206, 192, 152, 6, 56, 0,
+			16, 108, 140, 2, 127, 117, 253, 154, 35, 216, 24, 152, 46, 34,
+			96, 64, 3, 64, 117, 207, 25, 156, 208, 0, 80, 219, 115, 4,
+			27, 163, 192, 245, 125, 62, 101, 86, 81, 228, 0, 93, 5, 144,
+			58, 159, 50, 171, 0, 98, 231, 83, 102, 21, 221, 158, 251, 105,
+			167, 168, 151, 16, 48, 83, 62, 237, 20, 71, 53, 0, 84, 243,
+			156, 49, 189, 132, 128, 153, 242, 105, 71, 112, 66, 10, 168, 108,
+			231, 8, 230, 64, 1, 207, 132, 207, 56, 130, 19, 82, 192, 173,
+			245, 25, 71, 112, 66, 10, 184, 173, 62, 3, 231, 127, 140, 69,
+			109, 207, 253, 172, 99, 143, 250, 193, 107, 208, 238, 209, 228, 229,
+			131, 169, 247, 8, 239, 244, 188, 125, 192, 249, 103, 117, 239, 160,
+			239, 159, 117, 132, 130, 79, 1, 215, 197, 103, 157, 225, 17, 242,
+			121, 135, 192, 132, 22, 63, 239, 128, 99, 29, 255, 55, 28, 170,
+			116, 88, 181, 145, 149, 122, 193, 130, 50, 14, 250, 128, 230, 180,
+			40, 18, 168, 34, 242, 149, 50, 182, 198, 7, 17, 26, 247, 211,
+			75, 74, 116, 138, 230, 163, 90, 233, 133, 103, 78, 169, 97, 115,
+			138, 55, 185, 208, 13, 110, 103, 192, 150, 231, 209, 188, 32, 92,
+			10, 114, 209, 9, 104, 53, 52, 164, 190, 43, 239, 69, 24, 137,
+			92, 219, 193, 78, 170, 94, 26, 59, 211, 92, 143, 186, 157, 8,
+			34, 25, 61, 158, 99, 143, 149, 75, 26, 101, 84, 198, 210, 112,
+			51, 162, 241, 110, 93, 25, 244, 133, 83, 227, 239, 83, 180, 176,
+			165, 213, 70, 136, 58, 22, 89, 76, 89, 144, 130, 49, 96, 80,
+			173, 182, 155, 160, 107, 196, 136, 210, 207, 214, 248, 67, 76, 64,
+			72, 3, 6, 88, 16, 62, 134, 82, 236, 116, 34, 28, 233, 52,
+			131, 48, 66, 49, 70, 0, 66, 134, 249, 38, 107, 198, 9, 58,
+			96, 168, 110, 49, 169, 201, 195, 209, 172, 233, 126, 160, 11, 184,
+			66, 13, 126, 168, 9, 214, 18, 4, 73, 3, 183, 69, 172, 70,
+			131, 106, 18, 167, 41, 209, 62, 224, 43, 55, 47, 165, 66, 45,
+			3, 182, 201, 231, 129, 206, 7, 173, 51, 8, 145, 227, 254, 177,
+			99, 83, 255, 69, 174, 75, 150, 74, 38, 29, 116, 140, 178, 136,
+			171, 254, 134, 134, 13, 152, 70, 62, 52, 92, 38, 175, 206, 217,
+			195, 74, 37, 83, 175, 136, 231, 220, 31, 59, 246, 168, 76, 130,
+			206, 158, 51, 118, 80, 38, 65, 99, 15, 78, 158, 79, 216, 216,
+			55, 203, 115, 255, 204, 177, 199, 253, 31, 179, 233, 21, 233, 104,
+			106, 79, 61, 6, 49, 185, 102, 216, 106, 196, 15, 119, 93, 36,
+			223, 120, 33, 219, 38, 29, 15, 22, 86, 227, 37, 133, 104, 35,
+			140, 164, 179, 13, 108, 171, 76, 215, 117, 86, 189, 206, 91, 140,
+			63, 101, 4, 43, 130, 175, 10, 252, 92, 221, 18, 134, 151, 92,
+			182, 4, 242, 10, 198, 101, 70, 33, 206, 187, 52, 19, 227, 30,
+			6, 180, 71, 126, 142, 79, 156, 77, 120, 130, 162, 241, 28, 119,
+			168, 178, 82, 223, 61, 244, 43, 220, 175, 22, 23, 45, 5, 141,
+			20, 229, 170, 41, 147, 111, 192, 34, 222, 251, 127, 38, 183, 126,
+			17, 15, 166, 63, 115, 186, 135, 101, 210, 129, 228, 232, 24, 249,
+			164, 131, 88, 182, 61, 247, 221, 174, 61, 234, 255, 93, 71, 213,
+			188, 55, 142, 37, 34, 164, 164, 49, 221, 138, 19, 37, 153, 131,
+			136, 26, 121, 173, 47, 172, 66, 25, 199, 65, 40, 105, 237, 228,
+			254, 17, 96, 80, 104, 145, 152, 102, 82, 232, 143, 104, 89, 32,
+			20, 186, 80, 249, 76, 157, 30, 21, 246, 82, 249, 122, 240, 50,
+			143, 18, 188, 193, 128, 62, 223, 146, 37, 65, 193, 45, 3, 243,
+			67, 61, 139, 24, 247, 187, 13, 51, 139, 50, 172, 68, 26, 29,
+			202, 225, 204, 104, 4, 43, 140, 114, 237, 253, 13, 201, 13, 16,
+			199, 72, 53, 72, 25, 250, 89, 185, 17, 103, 204, 16, 155, 133,
+			169, 177, 203, 179, 45, 225, 182, 66, 158, 243, 27, 113, 220, 128,
+			231, 252, 121, 46, 17, 77, 88, 29, 13, 92, 165, 156, 18, 157,
+			82, 0, 213, 31, 102, 237, 64, 90, 29, 177, 136, 190, 73, 172,
+			154, 90, 44, 95, 55, 6, 39, 129, 11, 160, 249, 124, 194, 65,
+			255, 110, 87, 205, 54, 236, 154, 119, 187, 221, 131, 50, 9, 198,
+			218, 174, 55, 162, 60, 254, 191, 127, 152, 92, 124, 112, 127, 251,
+			242, 205, 163, 93, 166, 26, 142, 255, 191, 140, 192, 1, 83, 155,
+			100, 116, 215, 139, 191, 194, 94, 2, 63, 149, 192, 95, 155, 180,
+			180, 127, 64, 245, 189, 12, 220, 135, 10, 126, 247, 166, 201, 160,
+			225, 58, 207, 240, 54, 169, 61, 234, 33, 131, 225, 234, 119, 12,
+			242, 192, 64, 115, 95, 5, 129, 129, 46, 9, 255, 112, 165, 18,
+			33, 127, 80, 226, 14, 226, 142, 117, 205, 89, 254, 111, 150, 232,
+			94, 216, 228, 247, 22, 198, 17, 145, 55, 241, 188, 124, 210, 230,
+			148, 150, 228, 52, 195, 179, 143, 53, 234, 101, 66, 151, 98, 190,
+			79, 194, 84, 86, 2, 110, 131, 98, 238, 62, 70, 177, 142, 151,
+			115, 175, 88, 229, 27, 10, 238, 97, 60, 152, 88, 4, 196, 74,
+			74, 58, 50, 99, 139, 232, 83, 205, 184, 227, 225, 112, 208, 155,
+			124, 142, 46, 214, 106, 156, 161, 22, 68, 53, 201, 43, 212, 220,
+			174, 221, 227, 229, 6, 200, 27, 12, 93, 95, 152, 145, 101, 58,
+			57, 82, 162, 36, 239, 200, 93, 22, 17, 101, 232, 171, 227, 133,
+			114, 13, 77, 179, 207, 219, 122, 128, 123, 218, 54, 148, 181, 165,
+			78, 30, 43, 220, 191, 30, 50, 50, 4, 255, 96, 174, 179, 106,
+			97, 83, 3, 230, 57, 112, 123, 167, 132, 78, 139, 204, 97, 180,
+			57, 45, 200, 26, 169, 176, 43, 8, 155, 32, 162, 171, 79, 227,
+			210, 43, 211, 69, 113, 16, 225, 77, 61, 199, 53, 21, 248, 181,
+			68, 240, 94, 146, 78, 6, 150, 115, 91, 131, 15, 3, 188, 11,
+			0, 121, 192, 145, 32, 76, 29, 117, 78, 100, 248, 41, 199, 29,
+			120, 130, 129, 65, 20, 212, 202, 59, 34, 206, 243, 213, 167, 41,
+			127, 238, 231, 124, 227, 193, 98, 81, 195, 46, 243, 156, 43, 220,
+			5, 225, 98, 178, 217, 196, 8, 174, 162, 156, 60, 142, 1, 207,
+			205, 160, 1, 138, 54, 172, 86, 230, 6, 219, 176, 20, 149, 199,
+			132, 45, 214, 104, 137, 170, 110, 42, 67, 243, 37, 22, 133, 172,
+			38, 170, 82, 211, 24, 116, 204, 52, 86, 14, 218, 227, 73, 130,
+			74, 23, 120, 202, 191, 106, 11, 139, 13, 84, 94, 90, 230, 196,
+			23, 175, 126, 207, 90, 69, 157, 156, 142, 69, 54, 54, 95, 218,
+			220, 109, 23, 111, 41, 224, 149, 105, 140, 240, 89, 203, 217, 96,
+			42, 122, 66, 8, 4, 171, 44, 128, 3, 167, 37, 212, 21, 210,
+			251, 116, 154, 224, 169, 37, 154, 230, 151, 154, 214, 26, 230, 134,
+			143, 198, 242, 101, 47, 7, 213, 140, 83, 199, 123, 29, 31, 66,
+			69, 69, 7, 233, 59, 86, 58, 196, 213, 155, 209, 207, 207, 113,
+			251, 176, 255, 10, 149, 28, 225, 220, 250, 226, 124, 98, 80, 74,
+			14, 120, 92, 68, 131, 195, 171, 120, 191, 115, 52, 208, 33, 122,
+			201, 30, 158, 33, 246, 219, 134, 194, 153, 111, 46, 148, 248, 113,
+			251, 216, 17, 195, 53, 210, 113, 123, 196, 112, 141, 116, 124, 116,
+			210, 112, 141, 116, 252, 224, 33, 242, 243, 182, 244, 141, 116, 210,
+			62, 228, 255, 148, 221, 185, 53, 12, 164, 5, 81, 45, 110, 54,
+			118, 120, 88, 200, 192, 96, 68, 54, 131, 234, 86, 24, 49, 197,
+			36, 228, 170, 37, 90, 193, 40, 78, 246, 114, 68, 38, 46, 69,
+			116, 56, 194, 247, 28, 144, 87, 219, 49, 77, 130, 106, 238, 92,
+			78, 9, 221, 136, 97, 131, 10, 57, 82, 22, 171, 73, 210, 51,
+			136, 115, 183, 255, 1, 39, 240, 156, 215, 106, 147, 155, 191, 209,
+			160, 51, 173, 36, 222, 8, 54, 26, 59, 64, 72, 25, 68, 140,
+			80, 162, 105, 210, 52, 230, 125, 196, 247, 91, 28, 49, 241, 18,
+			219, 14, 163, 92, 160, 242, 147, 246, 241, 195, 134, 135, 169, 147,
+			185, 64, 229, 39, 75, 19, 134, 135, 169, 147, 254, 65, 69, 139,
+			188, 175, 76, 150, 247, 163, 33, 120, 104, 171, 83, 176, 2, 79,
+			101, 236, 229, 236, 84, 38, 2, 77, 96, 89, 149, 18, 228, 72,
+			127, 238, 235, 171, 135, 120, 252, 191, 44, 82, 80, 145, 25, 67,
+			17, 194, 5, 35, 46, 134, 81, 230, 141, 18, 183, 13, 64, 160,
+			38, 92, 136, 137, 8, 41, 111, 156, 20, 48, 160, 28, 250, 182,
+			181, 174, 116, 85, 120, 18, 114, 3, 125, 199, 125, 143, 67, 110,
+			72, 201, 136, 143, 5, 51, 226, 227, 56, 41, 108, 236, 100, 140,
+			71, 69, 128, 152, 143, 60, 233, 77, 170, 136, 22, 221, 34, 187,
+			72, 67, 137, 32, 73, 130, 157, 201, 146, 248, 192, 147, 42, 154,
+			99, 207, 107, 138, 230, 56, 245, 227, 5, 82, 92, 67, 231, 40,
+			94, 89, 143, 27, 60, 71, 231, 112, 87, 230, 121, 202, 139, 89,
+			220, 148, 56, 57, 109, 224, 228, 126, 5, 56, 190, 22, 76, 124,
+			221, 175, 136, 192, 229, 105, 3, 151, 247, 109, 5, 241, 188, 160,
+			241, 220, 187, 112, 100, 239, 2, 107, 112, 175, 68, 85, 38, 231,
+			225, 49, 115, 30, 30, 164, 148, 152, 167, 83, 196, 5, 181, 49,
+			225, 250, 250, 192, 126, 197, 50, 232, 28, 100, 244, 30, 85, 19,
+			91, 122, 181, 1, 129, 141, 145, 49, 233, 11, 114, 210, 123, 30,
+			160, 16, 207, 234, 55, 137, 179, 198, 50, 239, 117, 164, 128, 15,
+			118, 225, 211, 249, 232, 190, 93, 44, 115, 207, 206, 60, 183, 127,
+			138, 20, 148, 99, 230, 90, 92, 149, 142, 153, 107, 113, 53, 239,
+			200, 93, 58, 199, 246, 79, 16, 23, 218, 7, 143, 208, 32, 102,
+			230, 102, 71, 34, 50, 64, 79, 51, 120, 249, 26, 2, 252, 89,
+			82, 146, 88, 188, 95, 214, 34, 113, 97, 94, 47, 150, 72, 145,
+			187, 239, 153, 250, 54, 151, 184, 151, 195, 6, 243, 46, 144, 146,
+			28, 134, 24, 215, 177, 142, 113, 65, 54, 21, 133, 134, 143, 76,
+			21, 241, 255, 200, 38, 37, 249, 109, 143, 1, 122, 176, 228, 106,
+			59, 98, 124, 248, 219, 187, 64, 10, 232, 136, 72, 132, 186, 153,
+			126, 181, 230, 202, 24, 72, 74, 160, 19, 75, 249, 223, 99, 145,
+			30, 21, 94, 106, 143, 38, 203, 164, 187, 198, 234, 1, 132, 37,
+			144, 161, 77, 243, 13, 240, 112, 178, 50, 19, 196, 109, 128, 125,
+			12, 116, 180, 112, 172, 173, 210, 222, 188, 196, 151, 216, 51, 99,
+			123, 78, 121, 69, 100, 242, 239, 16, 162, 251, 187, 135, 31, 238,
+			55, 230, 253, 112, 63, 124, 255, 145, 195, 24, 205, 80, 15, 207,
+			147, 254, 220, 76, 236, 209, 200, 66, 190, 145, 67, 175, 214, 136,
+			25, 7, 232, 31, 89, 164, 103, 77, 168, 108, 39, 222, 67, 68,
+			157, 248, 166, 3, 248, 62, 9, 68, 23, 240, 111, 36, 69, 156,
+			147, 84, 68, 171, 61, 222, 137, 30, 89, 29, 31, 140, 116, 120,
+			206, 203, 248, 171, 164, 215, 0, 63, 72, 136, 218, 189, 230, 209,
+			240, 96, 254, 109, 148, 191, 68, 95, 250, 42, 120, 137, 30, 87,
+			47, 209, 49, 82, 231, 15, 209, 190, 174, 131, 150, 255, 102, 138,
+			195, 214, 172, 99, 173, 143, 141, 136, 208, 38, 132, 124, 241, 8,
+			47, 88, 41, 119, 124, 23, 100, 84, 78, 61, 114, 123, 65, 245,
+			39, 142, 164, 102, 143, 164, 66, 251, 132, 43, 202, 46, 8, 172,
+			218, 111, 251, 194, 11, 122, 137, 123, 72, 239, 215, 222, 50, 7,
+			220, 49, 73, 246, 21, 32, 213, 99, 144, 132, 3, 189, 3, 6,
+			73, 56, 48, 50, 170, 189, 101, 14, 186, 99, 6, 45, 51, 232,
+			18, 131, 150, 25, 236, 53, 189, 101, 14, 170, 98, 182, 231, 12,
+			169, 98, 224, 218, 119, 72, 21, 131, 42, 135, 122, 135, 100, 202,
+			241, 156, 33, 85, 204, 241, 156, 97, 85, 12, 2, 171, 14, 187,
+			210, 55, 39, 248, 102, 30, 86, 173, 65, 96, 213, 97, 85, 204,
+			245, 156, 17, 85, 204, 45, 64, 74, 182, 6, 110, 135, 71, 212,
+			216, 32, 160, 233, 136, 42, 86, 240, 156, 81, 85, 172, 128, 41,
+			137, 146, 2, 134, 175, 149, 157, 132, 128, 166, 163, 170, 88, 209,
+			115, 198, 221, 113, 241, 169, 88, 128, 148, 108, 13, 220, 214, 142,
+			247, 14, 203, 148, 227, 57, 227, 163, 99, 162, 88, 55, 196, 178,
+			149, 197, 186, 141, 200, 182, 220, 41, 236, 132, 106, 13, 34, 215,
+			79, 168, 98, 37, 8, 74, 251, 144, 248, 84, 42, 66, 234, 128,
+			76, 65, 136, 90, 95, 146, 249, 37, 8, 81, 123, 108, 74, 70,
+			46, 62, 220, 117, 89, 123, 43, 61, 44, 204, 14, 209, 42, 229,
+			136, 253, 176, 233, 173, 20, 180, 68, 185, 153, 57, 248, 6, 115,
+			143, 186, 15, 21, 164, 59, 82, 252, 126, 180, 111, 152, 92, 69,
+			47, 12, 220, 83, 150, 115, 172, 56, 230, 159, 163, 215, 131, 228,
+			46, 232, 183, 207, 115, 19, 16, 100, 29, 199, 213, 118, 51, 23,
+			241, 156, 239, 69, 60, 109, 57, 243, 92, 121, 183, 232, 226, 145,
+			82, 221, 99, 197, 163, 160, 64, 174, 64, 5, 168, 191, 207, 132,
+			192, 59, 171, 127, 200, 132, 56, 158, 115, 108, 4, 164, 84, 178,
+			83, 150, 231, 76, 21, 39, 140, 154, 129, 6, 159, 42, 30, 27,
+			51, 74, 193, 218, 157, 202, 213, 12, 136, 153, 234, 247, 76, 136,
+			227, 57, 83, 99, 227, 228, 138, 118, 222, 122, 194, 61, 236, 159,
+			227, 125, 199, 80, 178, 169, 224, 88, 167, 105, 200, 197, 78, 119,
+			89, 148, 26, 188, 207, 148, 241, 131, 3, 24, 7, 101, 195, 207,
+			171, 11, 85, 245, 233, 116, 209, 115, 78, 244, 123, 57, 63, 176,
+			39, 70, 38, 115, 126, 96, 79, 28, 60, 36, 38, 205, 130, 248,
+			179, 101, 199, 112, 208, 9, 66, 220, 183, 42, 255, 156, 206, 73,
+			215, 247, 111, 192, 89, 152, 37, 97, 53, 147, 18, 11, 110, 96,
+			174, 157, 211, 32, 225, 198, 251, 26, 136, 115, 73, 77, 18, 151,
+			82, 52, 131, 29, 66, 179, 224, 46, 51, 29, 96, 194, 123, 198,
+			157, 25, 18, 125, 227, 81, 153, 79, 42, 31, 182, 220, 21, 237,
+			201, 222, 49, 157, 134, 87, 205, 228, 1, 209, 119, 219, 115, 79,
+			219, 143, 202, 190, 195, 84, 157, 38, 158, 232, 59, 198, 157, 61,
+			251, 21, 235, 59, 143, 4, 123, 214, 61, 61, 42, 250, 134, 118,
+			60, 206, 89, 213, 119, 30, 13, 246, 172, 234, 59, 143, 7, 123,
+			118, 242, 0, 238, 61, 48, 223, 113, 30, 179, 135, 69, 215, 225,
+			0, 122, 140, 12, 232, 24, 214, 111, 176, 151, 205, 24, 214, 111,
+			40, 13, 104, 183, 189, 231, 220, 97, 195, 109, 239, 57, 21, 30,
+			26, 50, 158, 235, 233, 51, 220, 246, 158, 27, 28, 210, 49, 172,
+			207, 187, 158, 248, 100, 21, 33, 85, 50, 92, 232, 158, 239, 233,
+			55, 92, 232, 158, 87, 78, 130, 109, 207, 121, 92, 199, 176, 46,
+			66, 170, 100, 196, 176, 126, 188, 199, 140, 97, 253, 184, 25, 195,
+			250, 141, 170, 53, 167, 8, 41, 51, 134, 245, 27, 85, 107, 128,
+			133, 55, 170, 214, 92, 207, 121, 66, 156, 153, 60, 118, 244, 19,
+			110, 159, 17, 59, 250, 137, 254, 33, 35, 118, 244, 19, 226, 204,
+			196, 216, 209, 79, 186, 19, 70, 236, 232, 39, 85, 49, 56, 106,
+			159, 236, 247, 140, 216, 209, 79, 142, 141, 147, 105, 44, 86, 244,
+			156, 69, 119, 120, 202, 167, 173, 184, 133, 98, 69, 193, 172, 193,
+			5, 112, 123, 45, 75, 164, 87, 225, 34, 230, 148, 238, 144, 225,
+			24, 94, 44, 201, 6, 224, 24, 94, 84, 88, 238, 246, 156, 75,
+			238, 168, 248, 212, 93, 132, 148, 28, 55, 28, 195, 151, 132, 251,
+			103, 52, 28, 112, 46, 9, 175, 209, 96, 53, 224, 44, 41, 44,
+			195, 49, 188, 164, 138, 193, 49, 188, 164, 176, 12, 199, 240, 210,
+			176, 71, 46, 242, 216, 215, 43, 93, 13, 203, 127, 140, 2, 25,
+			215, 225, 31, 34, 108, 8, 41, 17, 208, 44, 226, 150, 231, 116,
+			66, 40, 29, 192, 200, 72, 212, 43, 165, 62, 114, 86, 70, 162,
+			126, 218, 126, 155, 227, 159, 160, 235, 185, 34, 156, 180, 8, 163,
+			77, 56, 147, 196, 23, 225, 142, 153, 7, 172, 126, 154, 120, 120,
+			172, 137, 128, 213, 215, 221, 145, 47, 231, 12, 31, 212, 177, 174,
+			221, 235, 238, 211, 163, 185, 96, 215, 215, 93, 162, 211, 150, 231,
+			92, 239, 29, 200, 5, 187, 190, 62, 236, 145, 47, 90, 162, 47,
+			150, 231, 172, 185, 163, 254, 23, 44, 10, 143, 141, 156, 180, 149,
+			123, 155, 224, 78, 141, 85, 71, 212, 224, 232, 74, 102, 184, 216,
+			228, 102, 217, 73, 19, 5, 154, 220, 18, 251, 109, 41, 90, 181,
+			197, 181, 118, 131, 115, 210, 210, 246, 70, 154, 133, 25, 231, 155,
+			25, 190, 94, 233, 138, 20, 64, 203, 86, 136, 198, 46, 183, 210,
+			196, 26, 231, 120, 23, 243, 126, 114, 121, 91, 208, 213, 57, 225,
+			86, 46, 0, 134, 144, 217, 86, 106, 88, 49, 104, 55, 81, 18,
+			133, 112, 85, 173, 185, 215, 117, 124, 112, 184, 168, 214, 12, 20,
+			194, 222, 95, 235, 29, 212, 105, 199, 115, 214, 60, 174, 131, 99,
+			243, 59, 251, 150, 27, 20, 228, 119, 156, 237, 91, 125, 99, 120,
+			103, 219, 242, 206, 126, 246, 203, 191, 179, 109, 117, 103, 63, 91,
+			188, 53, 65, 134, 52, 168, 0, 245, 247, 153, 16, 203, 115, 158,
+			237, 31, 50, 33, 142, 231, 60, 43, 238, 108, 91, 222, 217, 207,
+			11, 75, 49, 91, 221, 217, 207, 23, 159, 29, 51, 74, 193, 33,
+			248, 124, 177, 215, 132, 64, 177, 190, 17, 19, 226, 120, 206, 243,
+			227, 19, 228, 23, 45, 85, 181, 237, 57, 47, 22, 39, 253, 159,
+			180, 168, 124, 25, 210, 48, 170, 161, 126, 122, 10, 220, 197, 105,
+			0, 79, 115, 105, 56, 159, 191, 123, 249, 11, 69, 45, 14, 238,
+			203, 131, 200, 167, 128, 244, 70, 93, 227, 49, 229, 219, 97, 186,
+			197, 29, 152, 76, 77, 113, 229, 134, 40, 206, 230, 211, 182, 152,
+			99, 16, 127, 136, 87, 43, 170, 119, 227, 161, 245, 14, 104, 251,
+			60, 125, 199, 43, 175, 8, 167, 35, 82, 74, 35, 220, 4, 104,
+			124, 128, 158, 209, 139, 197, 231, 39, 141, 177, 194, 49, 250, 98,
+			145, 152, 16, 203, 115, 94, 236, 53, 241, 1, 7, 251, 139, 227,
+			19, 6, 166, 29, 207, 185, 147, 195, 180, 227, 122, 238, 157, 226,
+			139, 102, 205, 112, 1, 220, 201, 205, 33, 92, 2, 119, 250, 205,
+			154, 225, 34, 184, 51, 62, 65, 62, 110, 139, 189, 107, 131, 47,
+			251, 19, 254, 247, 219, 28, 97, 28, 161, 70, 36, 127, 67, 79,
+			70, 97, 20, 61, 219, 108, 132, 153, 138, 19, 164, 118, 26, 108,
+			46, 238, 234, 26, 85, 40, 16, 47, 205, 32, 171, 110, 9, 233,
+			244, 38, 123, 153, 158, 122, 203, 241, 119, 188, 240, 226, 43, 111,
+			61, 249, 202, 41, 140, 60, 63, 117, 252, 29, 245, 56, 126, 101,
+			74, 57, 74, 167, 241, 93, 17, 191, 0, 3, 198, 207, 209, 169,
+			227, 252, 255, 113, 2, 153, 85, 206, 40, 70, 161, 25, 127, 218,
+			106, 1, 9, 106, 73, 200, 25, 17, 135, 53, 116, 244, 34, 108,
+			124, 195, 22, 182, 202, 148, 214, 138, 54, 168, 221, 235, 204, 48,
+			54, 58, 204, 103, 104, 108, 84, 188, 171, 67, 215, 215, 105, 136,
+			5, 112, 144, 234, 52, 196, 2, 120, 232, 56, 94, 64, 54, 143,
+			5, 112, 194, 136, 112, 127, 87, 6, 29, 176, 69, 104, 128, 9,
+			35, 194, 253, 221, 73, 106, 68, 184, 191, 251, 208, 113, 124, 22,
+			56, 158, 27, 193, 115, 155, 112, 119, 36, 78, 84, 226, 215, 58,
+			88, 102, 57, 177, 240, 135, 226, 96, 237, 177, 29, 241, 219, 14,
+			173, 171, 156, 88, 196, 72, 64, 211, 42, 39, 238, 25, 151, 41,
+			240, 230, 127, 192, 23, 181, 88, 158, 211, 178, 149, 231, 17, 215,
+			115, 91, 118, 124, 80, 228, 132, 189, 220, 18, 113, 23, 208, 252,
+			201, 105, 141, 169, 111, 142, 231, 180, 142, 28, 85, 124, 240, 15,
+			46, 147, 197, 7, 151, 165, 51, 116, 53, 120, 123, 207, 88, 252,
+			127, 61, 236, 244, 47, 71, 178, 255, 165, 70, 243, 255, 6, 139,
+			140, 201, 21, 181, 18, 165, 89, 16, 101, 33, 30, 210, 175, 41,
+			118, 246, 99, 164, 71, 218, 245, 39, 42, 180, 238, 62, 92, 159,
+			138, 206, 122, 213, 45, 57, 67, 238, 212, 143, 186, 196, 235, 112,
+			228, 8, 58, 9, 199, 117, 204, 74, 103, 143, 32, 217, 252, 163,
+			119, 210, 136, 162, 111, 239, 19, 249, 88, 102, 240, 190, 142, 12,
+			40, 254, 21, 175, 219, 209, 129, 248, 246, 68, 67, 69, 49, 188,
+			176, 105, 239, 73, 50, 164, 106, 16, 213, 138, 192, 206, 163, 29,
+			205, 150, 111, 180, 155, 105, 101, 80, 230, 22, 95, 188, 215, 147,
+			254, 122, 108, 196, 194, 157, 44, 236, 171, 114, 209, 87, 143, 117,
+			112, 92, 224, 224, 161, 94, 206, 100, 81, 7, 171, 223, 141, 181,
+			242, 53, 200, 83, 225, 89, 189, 55, 144, 110, 97, 247, 46, 24,
+			232, 71, 246, 41, 181, 194, 115, 85, 100, 118, 255, 245, 164, 128,
+			53, 121, 83, 164, 63, 103, 213, 36, 130, 130, 66, 244, 120, 40,
+			188, 22, 190, 157, 93, 117, 75, 214, 144, 125, 213, 45, 217, 67,
+			142, 255, 102, 210, 45, 42, 131, 88, 144, 210, 248, 72, 68, 132,
+			229, 177, 98, 251, 3, 211, 114, 201, 44, 205, 23, 196, 85, 183,
+			84, 24, 42, 94, 117, 75, 197, 161, 238, 171, 110, 169, 123, 168,
+			116, 213, 45, 149, 134, 122, 166, 254, 149, 181, 123, 161, 164, 45,
+			224, 213, 74, 107, 5, 17, 225, 86, 165, 189, 83, 68, 49, 39,
+			111, 135, 53, 201, 146, 236, 211, 107, 105, 101, 169, 210, 43, 115,
+			172, 212, 82, 232, 180, 42, 128, 162, 92, 92, 34, 61, 122, 29,
+			160, 210, 146, 119, 130, 20, 141, 49, 137, 56, 188, 186, 87, 226,
+			35, 196, 203, 228, 50, 205, 219, 224, 215, 20, 231, 186, 84, 33,
+			28, 116, 37, 104, 100, 87, 191, 248, 56, 15, 175, 248, 55, 172,
+			175, 2, 246, 228, 13, 21, 94, 177, 251, 146, 12, 175, 88, 234,
+			126, 66, 48, 45, 73, 137, 200, 240, 138, 125, 93, 135, 44, 131,
+			177, 120, 152, 92, 87, 204, 67, 123, 204, 255, 58, 42, 78, 26,
+			73, 167, 227, 81, 198, 67, 126, 168, 79, 194, 131, 166, 120, 149,
+			64, 46, 227, 65, 162, 5, 212, 3, 118, 223, 81, 147, 27, 153,
+			139, 221, 51, 208, 51, 212, 193, 141, 252, 11, 75, 178, 35, 71,
+			237, 97, 255, 243, 22, 232, 141, 201, 94, 108, 134, 25, 38, 37,
+			173, 33, 58, 194, 181, 65, 177, 31, 181, 93, 143, 135, 186, 97,
+			96, 166, 117, 73, 164, 98, 200, 30, 239, 49, 29, 87, 77, 212,
+			63, 191, 29, 214, 88, 62, 222, 9, 65, 238, 109, 20, 43, 31,
+			100, 250, 19, 109, 196, 220, 62, 50, 142, 164, 14, 38, 56, 229,
+			195, 112, 90, 116, 6, 116, 125, 8, 157, 78, 88, 61, 61, 181,
+			197, 130, 90, 122, 170, 25, 164, 25, 75, 166, 103, 115, 98, 229,
+			81, 176, 141, 215, 172, 216, 209, 92, 224, 162, 81, 193, 86, 224,
+			36, 241, 232, 224, 16, 57, 5, 243, 216, 3, 172, 197, 126, 255,
+			33, 185, 20, 233, 89, 36, 88, 204, 153, 19, 1, 97, 9, 231,
+			56, 246, 116, 65, 9, 81, 87, 79, 151, 149, 75, 217, 60, 245,
+			205, 150, 228, 242, 30, 180, 103, 252, 183, 43, 223, 49, 73, 71,
+			40, 129, 160, 154, 181, 131, 134, 70, 39, 136, 38, 80, 205, 97,
+			155, 129, 102, 97, 202, 99, 121, 24, 111, 37, 98, 198, 64, 225,
+			43, 9, 167, 81, 213, 208, 196, 141, 7, 202, 49, 218, 161, 27,
+			167, 173, 14, 218, 19, 253, 146, 169, 92, 132, 142, 29, 48, 24,
+			206, 7, 253, 135, 12, 134, 243, 193, 135, 167, 201, 39, 138, 156,
+			79, 90, 238, 10, 45, 255, 99, 69, 186, 251, 236, 149, 138, 94,
+			224, 59, 47, 139, 105, 144, 166, 44, 17, 186, 44, 66, 127, 83,
+			134, 13, 147, 193, 244, 226, 58, 93, 186, 14, 254, 61, 144, 8,
+			16, 154, 64, 235, 208, 255, 211, 84, 250, 204, 225, 90, 61, 220,
+			127, 116, 139, 63, 15, 81, 149, 153, 206, 160, 198, 48, 126, 78,
+			239, 112, 37, 47, 248, 187, 147, 191, 23, 133, 62, 241, 108, 121,
+			207, 202, 229, 125, 154, 171, 82, 2, 101, 81, 94, 175, 40, 155,
+			161, 138, 174, 145, 39, 72, 88, 206, 83, 14, 119, 221, 104, 44,
+			88, 117, 65, 162, 215, 23, 28, 52, 239, 52, 244, 66, 117, 64,
+			58, 27, 145, 164, 53, 120, 236, 155, 78, 49, 130, 31, 218, 45,
+			43, 141, 10, 32, 150, 227, 108, 139, 64, 102, 174, 149, 64, 23,
+			163, 157, 221, 104, 50, 30, 251, 57, 167, 52, 58, 152, 10, 188,
+			58, 84, 124, 61, 225, 221, 101, 38, 44, 179, 50, 206, 32, 140,
+			11, 118, 38, 6, 227, 200, 68, 84, 168, 160, 86, 163, 129, 8,
+			183, 1, 140, 37, 152, 78, 84, 212, 19, 46, 131, 130, 140, 24,
+			77, 137, 202, 97, 196, 97, 54, 139, 10, 110, 145, 82, 218, 157,
+			83, 142, 57, 51, 88, 219, 208, 228, 182, 84, 11, 92, 89, 74,
+			229, 123, 72, 190, 14, 121, 163, 233, 41, 81, 103, 74, 160, 131,
+			101, 169, 49, 103, 204, 7, 90, 15, 115, 173, 94, 161, 231, 3,
+			251, 198, 156, 33, 17, 209, 5, 242, 9, 141, 42, 34, 239, 3,
+			105, 81, 204, 215, 167, 120, 113, 74, 205, 50, 184, 64, 112, 176,
+			194, 249, 164, 244, 249, 131, 218, 49, 29, 54, 204, 181, 48, 97,
+			213, 12, 189, 205, 72, 153, 66, 185, 228, 115, 167, 161, 200, 240,
+			60, 99, 63, 228, 191, 75, 96, 82, 189, 3, 213, 218, 80, 19,
+			137, 247, 163, 68, 20, 39, 220, 149, 246, 36, 233, 152, 75, 142,
+			33, 233, 128, 143, 99, 102, 135, 214, 226, 104, 58, 155, 83, 102,
+			13, 27, 76, 162, 69, 69, 31, 3, 94, 251, 25, 155, 24, 252,
+			215, 51, 189, 147, 6, 255, 245, 204, 129, 35, 6, 255, 245, 204,
+			177, 41, 242, 175, 45, 201, 128, 125, 204, 62, 226, 255, 130, 165,
+			93, 202, 233, 161, 96, 47, 249, 25, 96, 248, 10, 208, 110, 219,
+			213, 202, 231, 52, 4, 145, 253, 94, 223, 146, 123, 62, 172, 105,
+			51, 101, 172, 18, 87, 180, 240, 35, 185, 193, 80, 149, 52, 226,
+			94, 206, 69, 4, 194, 123, 60, 62, 79, 152, 228, 207, 11, 49,
+			81, 234, 214, 23, 219, 143, 179, 193, 131, 141, 248, 30, 83, 110,
+			109, 225, 214, 120, 204, 62, 243, 144, 193, 85, 126, 204, 30, 50,
+			184, 202, 143, 13, 31, 48, 184, 202, 143, 29, 58, 76, 222, 109,
+			73, 182, 242, 121, 251, 81, 63, 85, 207, 90, 62, 183, 226, 76,
+			196, 195, 189, 86, 11, 101, 100, 64, 209, 5, 126, 98, 243, 35,
+			36, 9, 239, 49, 105, 155, 41, 235, 72, 231, 248, 226, 229, 119,
+			50, 191, 10, 89, 50, 47, 111, 236, 141, 32, 13, 83, 53, 143,
+			192, 107, 62, 175, 230, 17, 206, 244, 243, 189, 212, 224, 108, 159,
+			63, 118, 202, 224, 108, 159, 95, 56, 75, 126, 221, 146, 172, 237,
+			39, 237, 71, 253, 127, 110, 169, 118, 229, 206, 221, 167, 247, 106,
+			238, 238, 219, 127, 225, 220, 85, 178, 8, 216, 75, 112, 189, 133,
+			145, 244, 53, 155, 197, 52, 127, 92, 151, 9, 93, 134, 176, 17,
+			92, 128, 196, 217, 11, 65, 43, 165, 103, 206, 159, 49, 212, 14,
+			95, 106, 135, 247, 130, 6, 139, 50, 185, 82, 162, 93, 213, 136,
+			145, 58, 46, 140, 141, 24, 108, 251, 39, 123, 143, 24, 108, 251,
+			39, 143, 158, 50, 216, 246, 79, 46, 156, 37, 63, 238, 72, 190,
+			253, 85, 251, 97, 255, 251, 29, 122, 217, 120, 187, 224, 250, 142,
+			76, 15, 14, 156, 55, 26, 38, 116, 70, 43, 47, 174, 44, 205,
+			113, 13, 197, 89, 165, 212, 43, 124, 251, 74, 190, 9, 20, 167,
+			97, 198, 183, 53, 39, 51, 132, 99, 136, 56, 9, 223, 110, 242,
+			22, 197, 118, 229, 136, 39, 120, 255, 171, 59, 79, 174, 107, 161,
+			48, 13, 159, 244, 57, 40, 57, 120, 53, 165, 30, 156, 178, 12,
+			117, 131, 115, 183, 149, 108, 69, 235, 231, 27, 93, 86, 222, 99,
+			51, 169, 72, 169, 252, 102, 112, 130, 34, 229, 215, 59, 6, 125,
+			218, 136, 179, 14, 196, 204, 17, 213, 49, 222, 229, 83, 106, 225,
+			104, 77, 105, 165, 187, 92, 67, 170, 32, 170, 5, 13, 184, 59,
+			184, 205, 70, 20, 35, 229, 128, 185, 72, 206, 3, 6, 143, 88,
+			203, 55, 173, 235, 122, 238, 85, 251, 201, 71, 13, 41, 203, 85,
+			123, 196, 144, 178, 92, 29, 61, 102, 72, 89, 174, 30, 63, 161,
+			133, 187, 215, 236, 231, 77, 225, 238, 53, 50, 72, 190, 211, 226,
+			201, 30, 207, 89, 237, 247, 252, 247, 89, 130, 65, 5, 182, 19,
+			65, 134, 98, 76, 92, 138, 57, 131, 18, 124, 99, 206, 73, 189,
+			207, 109, 70, 97, 49, 38, 92, 9, 161, 38, 152, 228, 152, 71,
+			154, 136, 149, 233, 149, 120, 27, 156, 237, 206, 241, 183, 15, 234,
+			58, 111, 51, 186, 122, 227, 218, 243, 72, 87, 231, 30, 171, 56,
+			86, 20, 178, 2, 185, 185, 218, 63, 32, 37, 159, 72, 112, 230,
+			210, 54, 79, 203, 236, 240, 121, 104, 88, 125, 182, 58, 211, 54,
+			79, 127, 139, 163, 101, 184, 207, 185, 135, 253, 191, 178, 233, 117,
+			253, 48, 134, 181, 179, 159, 164, 113, 26, 50, 77, 131, 227, 101,
+			46, 116, 156, 53, 159, 91, 68, 217, 245, 205, 1, 39, 185, 195,
+			217, 221, 237, 156, 43, 16, 237, 113, 154, 155, 237, 132, 41, 221,
+			10, 249, 213, 21, 145, 93, 97, 37, 166, 133, 93, 214, 180, 96,
+			70, 155, 171, 156, 47, 234, 32, 83, 241, 250, 54, 4, 217, 185,
+			17, 164, 140, 168, 224, 114, 56, 85, 105, 38, 10, 118, 222, 18,
+			58, 244, 214, 174, 110, 157, 158, 163, 129, 98, 85, 115, 104, 92,
+			167, 103, 30, 187, 126, 49, 23, 136, 177, 99, 64, 68, 57, 156,
+			62, 123, 250, 250, 197, 57, 181, 255, 81, 179, 186, 214, 174, 114,
+			18, 11, 190, 41, 137, 44, 127, 250, 61, 231, 174, 230, 35, 162,
+			62, 215, 17, 17, 245, 185, 222, 188, 36, 252, 185, 131, 135, 180,
+			28, 241, 205, 182, 39, 246, 73, 193, 245, 220, 55, 171, 245, 142,
+			98, 197, 55, 219, 221, 134, 88, 241, 205, 165, 126, 67, 172, 248,
+			230, 161, 97, 45, 79, 127, 139, 189, 105, 202, 211, 223, 66, 134,
+			201, 247, 242, 125, 98, 245, 120, 206, 237, 254, 99, 254, 7, 30,
+			104, 159, 8, 150, 202, 125, 118, 138, 200, 245, 192, 123, 37, 191,
+			158, 212, 102, 177, 96, 179, 220, 86, 155, 195, 194, 205, 146, 75,
+			219, 60, 45, 179, 195, 103, 181, 57, 172, 30, 171, 51, 109, 243,
+			180, 204, 14, 201, 145, 81, 245, 217, 182, 58, 210, 226, 187, 204,
+			238, 120, 206, 237, 241, 9, 245, 217, 177, 58, 210, 54, 79, 203,
+			236, 174, 231, 220, 62, 224, 171, 207, 174, 213, 145, 182, 121, 90,
+			102, 47, 120, 206, 237, 67, 135, 213, 231, 130, 213, 145, 182, 121,
+			90, 102, 47, 122, 206, 237, 163, 84, 125, 46, 90, 29, 105, 155,
+			167, 127, 200, 214, 90, 19, 117, 247, 144, 255, 33, 59, 79, 234,
+			242, 37, 108, 144, 194, 242, 179, 216, 63, 50, 228, 7, 191, 105,
+			48, 160, 172, 162, 224, 185, 246, 122, 166, 172, 121, 77, 146, 124,
+			111, 138, 220, 48, 146, 81, 246, 30, 34, 182, 30, 84, 193, 31,
+			117, 104, 65, 135, 59, 174, 156, 59, 67, 165, 61, 172, 208, 103,
+			79, 88, 83, 24, 23, 4, 185, 30, 155, 126, 237, 57, 49, 41,
+			44, 241, 202, 220, 40, 23, 169, 198, 188, 175, 106, 83, 245, 163,
+			238, 222, 62, 150, 83, 253, 168, 187, 165, 156, 234, 71, 189, 103,
+			34, 167, 250, 81, 247, 15, 138, 205, 90, 244, 156, 45, 21, 236,
+			161, 232, 66, 156, 228, 77, 199, 16, 216, 111, 217, 61, 134, 192,
+			126, 139, 12, 27, 2, 251, 45, 84, 128, 2, 17, 122, 225, 46,
+			242, 224, 164, 44, 252, 110, 201, 39, 191, 95, 144, 34, 145, 29,
+			123, 212, 255, 76, 65, 251, 68, 145, 70, 228, 220, 115, 38, 152,
+			130, 176, 109, 211, 66, 27, 204, 37, 222, 22, 183, 193, 168, 149,
+			213, 140, 216, 215, 141, 29, 41, 234, 51, 92, 13, 73, 194, 100,
+			206, 160, 67, 230, 118, 61, 200, 77, 136, 200, 198, 95, 109, 4,
+			59, 0, 156, 142, 121, 110, 189, 195, 169, 135, 132, 201, 136, 47,
+			58, 178, 66, 13, 124, 56, 193, 83, 97, 135, 79, 214, 52, 70,
+			46, 106, 131, 45, 228, 180, 184, 117, 130, 26, 10, 50, 33, 82,
+			188, 97, 36, 54, 147, 183, 18, 155, 21, 134, 241, 130, 188, 217,
+			129, 214, 244, 91, 73, 61, 40, 240, 65, 154, 176, 122, 131, 135,
+			71, 150, 143, 18, 249, 148, 21, 171, 82, 15, 154, 27, 105, 38,
+			104, 233, 45, 40, 125, 206, 92, 139, 121, 100, 128, 144, 71, 171,
+			196, 81, 3, 68, 81, 67, 97, 68, 12, 44, 114, 94, 131, 124,
+			42, 136, 171, 2, 94, 66, 188, 15, 119, 248, 122, 189, 163, 9,
+			99, 240, 211, 94, 111, 4, 155, 194, 154, 23, 59, 213, 129, 125,
+			140, 37, 46, 44, 123, 102, 132, 161, 176, 34, 158, 231, 232, 70,
+			80, 19, 66, 190, 57, 30, 220, 94, 108, 22, 102, 24, 184, 110,
+			48, 197, 156, 131, 94, 228, 153, 204, 119, 240, 210, 51, 36, 153,
+			242, 115, 202, 117, 0, 106, 36, 183, 138, 230, 104, 150, 123, 239,
+			241, 155, 27, 134, 157, 0, 141, 154, 176, 218, 238, 17, 108, 171,
+			11, 155, 24, 31, 195, 90, 122, 71, 199, 193, 112, 61, 119, 199,
+			190, 123, 72, 202, 244, 10, 176, 242, 139, 134, 188, 111, 167, 123,
+			208, 144, 247, 237, 120, 35, 228, 223, 89, 34, 38, 134, 243, 78,
+			123, 198, 255, 215, 22, 53, 107, 86, 109, 118, 232, 213, 40, 19,
+			245, 178, 100, 89, 68, 59, 198, 136, 197, 195, 141, 208, 13, 13,
+			189, 173, 159, 44, 24, 199, 56, 20, 177, 48, 16, 12, 90, 26,
+			169, 124, 28, 1, 163, 59, 23, 83, 4, 165, 187, 124, 65, 234,
+			88, 29, 249, 122, 231, 132, 177, 53, 152, 119, 51, 228, 224, 72,
+			91, 116, 161, 220, 2, 47, 92, 231, 157, 182, 74, 21, 61, 231,
+			157, 189, 227, 50, 5, 163, 159, 120, 72, 166, 28, 207, 121, 231,
+			195, 211, 228, 59, 84, 64, 138, 247, 88, 246, 9, 255, 61, 6,
+			106, 176, 110, 232, 168, 120, 149, 243, 96, 31, 187, 186, 32, 243,
+			139, 190, 200, 72, 28, 100, 223, 97, 96, 252, 128, 121, 51, 70,
+			10, 119, 174, 166, 198, 194, 167, 25, 216, 152, 239, 177, 108, 149,
+			44, 64, 178, 119, 216, 8, 83, 241, 30, 203, 163, 70, 152, 138,
+			247, 88, 15, 29, 39, 63, 82, 36, 194, 211, 232, 71, 44, 219,
+			247, 63, 82, 148, 87, 149, 246, 117, 108, 156, 128, 45, 150, 40,
+			35, 180, 216, 188, 121, 230, 76, 251, 180, 32, 218, 145, 102, 248,
+			156, 181, 201, 217, 31, 226, 46, 195, 29, 207, 245, 147, 101, 100,
+			53, 185, 61, 192, 216, 148, 115, 240, 224, 228, 133, 22, 197, 189,
+			136, 158, 2, 107, 194, 127, 132, 238, 19, 175, 125, 70, 30, 224,
+			23, 46, 192, 241, 61, 43, 44, 207, 91, 45, 22, 36, 184, 50,
+			9, 189, 179, 116, 99, 153, 127, 133, 109, 33, 227, 215, 227, 153,
+			142, 121, 27, 224, 242, 34, 239, 120, 67, 92, 208, 111, 50, 121,
+			19, 156, 43, 35, 162, 180, 169, 70, 49, 162, 202, 156, 138, 146,
+			18, 70, 192, 198, 22, 26, 157, 89, 76, 107, 140, 219, 208, 195,
+			189, 178, 35, 216, 186, 162, 214, 25, 201, 54, 132, 174, 204, 210,
+			38, 188, 22, 227, 72, 27, 197, 117, 114, 95, 229, 193, 9, 79,
+			65, 206, 136, 229, 199, 167, 190, 163, 59, 6, 202, 221, 134, 3,
+			155, 174, 67, 9, 133, 199, 194, 222, 237, 218, 156, 6, 28, 169,
+			13, 150, 166, 68, 232, 132, 205, 43, 103, 42, 24, 195, 76, 12,
+			65, 113, 185, 180, 247, 135, 25, 140, 164, 34, 45, 20, 247, 224,
+			199, 241, 238, 166, 179, 226, 9, 11, 127, 98, 217, 8, 226, 66,
+			6, 129, 224, 244, 13, 160, 89, 16, 191, 229, 14, 58, 43, 224,
+			87, 181, 56, 144, 225, 47, 71, 175, 228, 14, 39, 195, 75, 189,
+			246, 223, 168, 113, 29, 230, 30, 243, 188, 178, 156, 98, 19, 247,
+			76, 6, 187, 6, 60, 18, 125, 196, 178, 223, 99, 73, 85, 10,
+			112, 0, 244, 17, 203, 30, 144, 73, 136, 97, 2, 145, 119, 69,
+			18, 119, 213, 228, 1, 242, 147, 14, 110, 50, 215, 115, 63, 106,
+			217, 19, 254, 15, 59, 24, 228, 232, 78, 78, 98, 124, 7, 7,
+			165, 252, 232, 207, 210, 53, 37, 78, 52, 20, 144, 12, 63, 163,
+			162, 187, 184, 53, 12, 175, 110, 192, 255, 147, 174, 2, 153, 118,
+			104, 47, 61, 226, 54, 50, 58, 163, 163, 99, 206, 243, 28, 121,
+			182, 45, 167, 0, 48, 16, 106, 53, 110, 50, 140, 222, 217, 136,
+			171, 119, 33, 124, 143, 201, 192, 81, 19, 16, 229, 27, 3, 25,
+			6, 240, 62, 132, 83, 66, 185, 2, 19, 118, 47, 190, 43, 232,
+			24, 97, 104, 75, 27, 113, 180, 9, 14, 103, 226, 228, 46, 238,
+			184, 122, 91, 82, 39, 120, 22, 164, 242, 222, 222, 3, 83, 6,
+			153, 144, 247, 248, 35, 237, 187, 65, 183, 151, 239, 76, 233, 3,
+			146, 104, 244, 105, 204, 160, 171, 145, 56, 202, 194, 168, 173, 192,
+			209, 166, 154, 114, 8, 123, 243, 81, 203, 254, 136, 229, 139, 73,
+			5, 63, 219, 31, 149, 177, 190, 184, 187, 166, 143, 90, 221, 158,
+			76, 130, 91, 68, 107, 108, 92, 105, 163, 124, 172, 72, 158, 120,
+			112, 29, 14, 238, 161, 93, 82, 130, 127, 77, 222, 33, 94, 36,
+			67, 57, 131, 255, 215, 226, 25, 98, 138, 184, 80, 147, 208, 193,
+			64, 101, 13, 29, 102, 169, 130, 223, 174, 126, 210, 229, 166, 56,
+			222, 87, 153, 83, 136, 75, 92, 170, 221, 219, 229, 89, 254, 235,
+			105, 39, 10, 53, 23, 136, 251, 221, 211, 177, 21, 116, 172, 96,
+			195, 206, 166, 183, 52, 73, 38, 164, 56, 188, 223, 62, 236, 19,
+			201, 54, 172, 153, 130, 237, 126, 187, 215, 55, 44, 175, 251, 115,
+			150, 215, 253, 57, 203, 235, 254, 131, 202, 126, 28, 172, 91, 236,
+			113, 255, 21, 51, 200, 3, 222, 110, 74, 41, 81, 199, 122, 128,
+			107, 5, 206, 65, 121, 165, 132, 169, 17, 229, 141, 213, 184, 30,
+			42, 209, 126, 128, 84, 46, 29, 92, 142, 31, 48, 194, 133, 143,
+			96, 139, 230, 68, 205, 195, 16, 136, 131, 247, 20, 104, 170, 97,
+			187, 207, 16, 53, 15, 247, 15, 27, 162, 230, 225, 209, 49, 181,
+			87, 62, 55, 79, 30, 127, 173, 235, 156, 199, 144, 208, 27, 229,
+			75, 84, 152, 250, 64, 145, 16, 124, 4, 129, 167, 24, 176, 171,
+			235, 147, 92, 20, 120, 98, 76, 90, 123, 171, 32, 245, 6, 58,
+			225, 125, 29, 145, 26, 48, 183, 147, 32, 218, 100, 66, 41, 229,
+			160, 82, 33, 193, 170, 213, 69, 6, 89, 42, 125, 129, 145, 66,
+			11, 68, 22, 36, 213, 45, 161, 191, 52, 214, 81, 116, 13, 63,
+			86, 68, 38, 255, 42, 233, 51, 43, 3, 3, 83, 169, 89, 133,
+			6, 166, 152, 0, 181, 174, 70, 188, 141, 27, 184, 191, 2, 63,
+			193, 78, 115, 43, 220, 220, 18, 42, 63, 248, 219, 255, 128, 75,
+			138, 188, 122, 48, 181, 173, 197, 192, 13, 192, 122, 6, 22, 14,
+			237, 217, 139, 242, 18, 230, 169, 136, 188, 222, 52, 41, 160, 67,
+			15, 97, 163, 60, 12, 133, 110, 38, 49, 16, 140, 59, 194, 120,
+			15, 191, 123, 15, 17, 135, 69, 181, 73, 119, 191, 108, 240, 213,
+			91, 37, 3, 200, 222, 124, 249, 118, 61, 108, 100, 44, 73, 39,
+			11, 136, 145, 153, 189, 251, 178, 136, 121, 47, 243, 172, 220, 240,
+			176, 63, 48, 97, 222, 53, 210, 143, 254, 25, 84, 125, 69, 97,
+			143, 186, 103, 125, 203, 144, 53, 87, 93, 31, 51, 64, 254, 115,
+			196, 219, 221, 228, 30, 70, 141, 115, 121, 163, 198, 113, 104, 237,
+			58, 184, 17, 235, 192, 140, 182, 249, 172, 144, 225, 93, 141, 239,
+			81, 241, 116, 190, 226, 189, 176, 173, 141, 61, 41, 41, 242, 201,
+			242, 122, 72, 225, 77, 183, 150, 215, 214, 135, 186, 188, 94, 210,
+			189, 184, 190, 190, 124, 253, 230, 250, 144, 197, 181, 180, 42, 221,
+			105, 156, 100, 183, 55, 118, 174, 254, 234, 195, 92, 123, 233, 125,
+			95, 13, 218, 75, 79, 24, 39, 250, 251, 45, 60, 210, 11, 125,
+			48, 52, 255, 235, 169, 158, 249, 188, 121, 133, 176, 133, 192, 179,
+			69, 123, 26, 203, 169, 105, 104, 170, 121, 135, 63, 70, 202, 132,
+			174, 160, 146, 130, 58, 215, 181, 54, 10, 158, 195, 57, 206, 175,
+			105, 128, 233, 145, 255, 93, 185, 1, 25, 178, 167, 32, 46, 168,
+			113, 200, 116, 40, 146, 112, 201, 120, 196, 148, 214, 134, 50, 9,
+			85, 226, 164, 32, 53, 88, 246, 202, 73, 27, 63, 40, 121, 124,
+			105, 33, 194, 231, 164, 119, 106, 250, 19, 151, 170, 15, 167, 231,
+			168, 120, 203, 194, 179, 147, 71, 76, 228, 204, 173, 105, 96, 103,
+			168, 182, 212, 5, 131, 85, 78, 231, 148, 180, 134, 32, 24, 145,
+			190, 203, 134, 236, 33, 227, 46, 27, 26, 62, 108, 220, 101, 67,
+			244, 24, 183, 66, 5, 41, 148, 103, 143, 11, 43, 84, 148, 66,
+			121, 100, 28, 249, 180, 194, 24, 97, 68, 248, 178, 149, 246, 4,
+			35, 174, 199, 249, 253, 210, 154, 96, 196, 37, 58, 141, 6, 156,
+			67, 58, 45, 76, 56, 101, 125, 160, 249, 36, 188, 158, 74, 43,
+			130, 81, 119, 100, 76, 229, 71, 69, 41, 163, 62, 84, 149, 234,
+			29, 208, 105, 80, 150, 26, 246, 84, 125, 182, 231, 140, 9, 31,
+			216, 82, 11, 127, 204, 29, 29, 81, 249, 65, 4, 49, 102, 212,
+			7, 29, 24, 235, 29, 212, 105, 199, 115, 198, 188, 17, 242, 94,
+			229, 81, 229, 160, 253, 136, 255, 231, 22, 205, 221, 44, 123, 45,
+			137, 128, 242, 79, 166, 64, 58, 140, 114, 107, 25, 5, 202, 224,
+			122, 81, 10, 160, 159, 64, 217, 77, 84, 163, 112, 5, 24, 208,
+			70, 188, 45, 188, 245, 97, 149, 97, 74, 95, 104, 196, 219, 115,
+			152, 109, 182, 76, 175, 96, 110, 48, 152, 219, 96, 8, 99, 137,
+			118, 212, 134, 233, 52, 147, 157, 224, 245, 99, 163, 156, 92, 104,
+			196, 219, 102, 118, 72, 166, 25, 145, 217, 233, 140, 12, 244, 112,
+			122, 182, 172, 76, 133, 93, 64, 3, 49, 8, 138, 131, 189, 19,
+			6, 65, 113, 112, 242, 132, 65, 80, 28, 156, 153, 37, 223, 221,
+			131, 107, 201, 242, 10, 203, 246, 55, 90, 142, 255, 205, 61, 116,
+			145, 242, 179, 189, 3, 119, 124, 139, 199, 34, 234, 45, 143, 53,
+			208, 226, 7, 41, 188, 134, 185, 9, 129, 102, 224, 17, 253, 236,
+			16, 23, 73, 153, 138, 163, 26, 173, 7, 149, 41, 17, 206, 3,
+			173, 197, 217, 124, 141, 137, 208, 237, 180, 21, 100, 91, 101, 144,
+			160, 131, 236, 43, 0, 215, 115, 115, 52, 13, 118, 4, 135, 131,
+			33, 51, 50, 175, 22, 34, 250, 117, 158, 16, 74, 249, 11, 122,
+			38, 172, 93, 168, 177, 160, 182, 193, 88, 125, 246, 188, 120, 66,
+			11, 205, 158, 11, 244, 141, 42, 50, 234, 19, 148, 62, 4, 199,
+			57, 207, 161, 21, 104, 202, 134, 247, 156, 219, 92, 131, 15, 77,
+			254, 233, 5, 110, 243, 176, 43, 63, 24, 66, 221, 22, 98, 11,
+			122, 129, 190, 131, 103, 160, 116, 234, 46, 219, 153, 58, 79, 167,
+			184, 59, 148, 57, 5, 70, 47, 153, 83, 231, 233, 11, 83, 32,
+			12, 159, 154, 163, 103, 78, 207, 137, 92, 233, 212, 28, 190, 25,
+			223, 170, 115, 167, 237, 13, 200, 251, 142, 169, 102, 186, 57, 117,
+			158, 158, 57, 243, 202, 28, 85, 137, 133, 87, 100, 206, 87, 16,
+			1, 226, 80, 52, 81, 240, 245, 103, 30, 28, 9, 185, 136, 9,
+			23, 148, 44, 133, 127, 20, 161, 177, 106, 101, 12, 246, 202, 85,
+			12, 114, 85, 117, 100, 67, 180, 8, 74, 219, 196, 74, 146, 54,
+			50, 64, 203, 14, 140, 86, 65, 227, 187, 83, 231, 249, 115, 89,
+			143, 103, 29, 222, 205, 200, 51, 194, 151, 57, 95, 136, 32, 55,
+			110, 165, 172, 93, 139, 231, 145, 46, 229, 163, 227, 36, 214, 121,
+			173, 159, 70, 105, 158, 58, 58, 47, 123, 48, 101, 14, 18, 103,
+			65, 14, 115, 74, 226, 114, 234, 56, 63, 10, 246, 153, 227, 178,
+			154, 64, 236, 47, 61, 115, 250, 65, 11, 166, 237, 141, 50, 159,
+			185, 23, 206, 156, 201, 21, 220, 11, 107, 229, 248, 174, 108, 132,
+			231, 3, 156, 172, 38, 231, 201, 62, 3, 206, 81, 111, 122, 188,
+			15, 50, 152, 23, 206, 188, 21, 59, 245, 90, 70, 242, 194, 233,
+			183, 234, 193, 232, 14, 94, 11, 51, 150, 4, 13, 58, 93, 230,
+			142, 228, 166, 95, 152, 166, 213, 173, 32, 9, 170, 120, 4, 136,
+			51, 142, 165, 213, 160, 37, 185, 226, 1, 221, 8, 170, 119, 211,
+			70, 128, 113, 76, 249, 181, 6, 167, 214, 50, 25, 66, 43, 188,
+			46, 199, 130, 87, 230, 101, 119, 165, 32, 174, 1, 11, 233, 130,
+			203, 61, 131, 104, 134, 133, 105, 184, 248, 158, 42, 114, 155, 56,
+			1, 176, 0, 210, 107, 66, 108, 207, 121, 106, 192, 44, 100, 121,
+			206, 149, 162, 103, 100, 177, 16, 210, 111, 66, 108, 207, 185, 50,
+			52, 76, 254, 153, 205, 187, 2, 13, 221, 116, 199, 253, 127, 96,
+			83, 78, 51, 26, 188, 39, 206, 3, 173, 54, 130, 20, 185, 251,
+			242, 208, 212, 161, 252, 132, 129, 98, 74, 179, 152, 223, 31, 60,
+			192, 45, 161, 193, 189, 32, 108, 200, 248, 29, 34, 107, 194, 148,
+			42, 151, 228, 118, 194, 123, 83, 61, 75, 163, 221, 76, 68, 98,
+			184, 3, 150, 194, 112, 66, 232, 162, 80, 198, 66, 231, 159, 119,
+			22, 165, 20, 141, 71, 172, 68, 242, 40, 104, 136, 90, 143, 11,
+			49, 41, 158, 243, 105, 123, 67, 71, 224, 37, 216, 175, 80, 216,
+			251, 117, 58, 116, 219, 150, 199, 116, 64, 249, 227, 139, 223, 173,
+			130, 143, 204, 17, 5, 76, 44, 25, 26, 178, 75, 200, 63, 111,
+			26, 179, 138, 68, 208, 77, 117, 249, 115, 249, 231, 205, 222, 97,
+			157, 118, 60, 231, 230, 232, 24, 249, 109, 139, 47, 18, 226, 57,
+			183, 250, 39, 252, 95, 177, 232, 2, 13, 53, 61, 45, 98, 187,
+			32, 169, 142, 215, 9, 42, 0, 128, 66, 192, 142, 214, 15, 194,
+			12, 128, 216, 41, 113, 48, 78, 9, 203, 182, 80, 249, 222, 53,
+			220, 16, 72, 125, 3, 197, 65, 59, 21, 39, 187, 68, 214, 57,
+			127, 210, 68, 57, 51, 67, 73, 33, 16, 228, 12, 4, 130, 52,
+			104, 160, 215, 223, 140, 97, 15, 230, 209, 202, 77, 41, 86, 53,
+			83, 214, 184, 199, 82, 161, 146, 0, 67, 236, 130, 49, 142, 203,
+			109, 209, 227, 57, 207, 244, 15, 202, 143, 160, 175, 240, 76, 191,
+			36, 182, 184, 190, 66, 46, 109, 243, 244, 167, 45, 177, 118, 45,
+			207, 121, 193, 61, 234, 255, 111, 22, 93, 131, 167, 38, 238, 208,
+			101, 33, 53, 141, 91, 124, 137, 40, 95, 1, 220, 103, 176, 24,
+			83, 152, 164, 25, 71, 153, 184, 252, 119, 196, 69, 29, 1, 221,
+			147, 169, 200, 138, 111, 107, 167, 217, 158, 53, 76, 11, 52, 79,
+			27, 193, 201, 97, 208, 92, 20, 68, 180, 108, 140, 234, 176, 55,
+			115, 226, 13, 129, 55, 61, 175, 134, 211, 40, 124, 231, 25, 75,
+			9, 232, 210, 23, 220, 103, 6, 213, 82, 1, 34, 232, 5, 119,
+			84, 167, 97, 232, 99, 190, 78, 59, 158, 243, 194, 225, 35, 18,
+			147, 64, 151, 190, 69, 196, 91, 192, 164, 235, 185, 111, 113, 95,
+			56, 170, 242, 131, 180, 253, 45, 70, 125, 128, 202, 183, 140, 77,
+			234, 180, 227, 57, 111, 57, 120, 136, 252, 158, 43, 42, 4, 155,
+			59, 247, 188, 255, 105, 151, 230, 30, 194, 29, 212, 21, 63, 174,
+			97, 112, 211, 252, 194, 10, 155, 65, 198, 166, 197, 185, 80, 166,
+			139, 26, 152, 139, 134, 13, 168, 128, 19, 25, 73, 38, 41, 242,
+			65, 26, 98, 78, 107, 90, 71, 112, 136, 36, 1, 55, 1, 192,
+			115, 60, 237, 164, 174, 194, 58, 63, 157, 36, 59, 159, 54, 98,
+			100, 65, 115, 215, 201, 132, 82, 117, 143, 32, 177, 2, 247, 37,
+			16, 211, 83, 64, 132, 196, 117, 76, 195, 153, 4, 150, 160, 65,
+			4, 91, 104, 42, 205, 218, 245, 250, 212, 91, 95, 209, 87, 194,
+			186, 228, 127, 27, 218, 188, 122, 88, 146, 76, 20, 154, 25, 72,
+			79, 158, 39, 170, 69, 122, 65, 54, 249, 86, 5, 44, 199, 117,
+			14, 199, 166, 247, 128, 67, 87, 246, 0, 139, 174, 237, 247, 65,
+			140, 225, 126, 223, 231, 168, 108, 87, 72, 143, 184, 59, 161, 122,
+			187, 193, 25, 244, 56, 28, 148, 26, 9, 156, 166, 202, 125, 58,
+			19, 134, 240, 113, 221, 176, 195, 32, 252, 164, 228, 207, 214, 4,
+			230, 10, 127, 134, 232, 124, 31, 149, 150, 241, 165, 41, 227, 122,
+			161, 97, 121, 98, 44, 125, 48, 55, 110, 185, 111, 57, 172, 150,
+			34, 168, 173, 182, 220, 135, 117, 26, 140, 65, 167, 95, 167, 211,
+			176, 52, 223, 112, 142, 188, 91, 46, 85, 20, 139, 186, 143, 250,
+			95, 112, 168, 201, 90, 217, 127, 169, 226, 185, 175, 23, 41, 22,
+			234, 88, 157, 100, 223, 229, 201, 149, 127, 112, 21, 236, 181, 56,
+			229, 218, 148, 75, 147, 252, 215, 94, 155, 76, 140, 230, 85, 86,
+			229, 11, 167, 223, 10, 11, 131, 55, 167, 161, 103, 222, 90, 142,
+			235, 242, 27, 54, 221, 249, 237, 12, 255, 6, 221, 233, 252, 180,
+			192, 63, 241, 46, 126, 101, 151, 22, 95, 66, 112, 201, 12, 137,
+			37, 225, 186, 94, 225, 61, 150, 219, 58, 175, 86, 21, 68, 221,
+			120, 143, 229, 30, 211, 0, 20, 143, 79, 157, 210, 0, 20, 144,
+			47, 156, 229, 108, 9, 144, 247, 191, 215, 178, 15, 27, 22, 67,
+			239, 149, 162, 118, 180, 24, 114, 223, 43, 69, 237, 60, 150, 203,
+			123, 45, 111, 82, 38, 29, 72, 30, 60, 164, 216, 220, 63, 127,
+			129, 188, 6, 113, 206, 118, 208, 184, 203, 205, 147, 13, 46, 247,
+			145, 78, 151, 156, 53, 97, 41, 198, 243, 248, 71, 59, 191, 171,
+			91, 233, 175, 193, 38, 249, 203, 225, 209, 127, 169, 252, 249, 239,
+			45, 145, 62, 147, 129, 246, 192, 2, 44, 110, 110, 156, 236, 152,
+			18, 44, 205, 246, 171, 240, 143, 222, 44, 113, 155, 113, 141, 9,
+			230, 53, 242, 221, 243, 30, 242, 227, 26, 171, 96, 22, 224, 233,
+			114, 131, 93, 87, 243, 116, 119, 43, 63, 75, 83, 221, 5, 109,
+			170, 91, 16, 6, 212, 157, 249, 59, 141, 116, 161, 12, 123, 153,
+			151, 41, 238, 83, 102, 249, 101, 81, 70, 100, 244, 63, 104, 17,
+			23, 58, 137, 62, 18, 235, 169, 48, 152, 133, 159, 222, 5, 210,
+			195, 13, 112, 192, 44, 217, 70, 150, 254, 209, 61, 7, 88, 94,
+			146, 217, 42, 186, 196, 212, 2, 233, 81, 112, 175, 143, 148, 46,
+			175, 86, 158, 93, 172, 44, 173, 13, 117, 121, 253, 164, 231, 226,
+			226, 165, 167, 121, 210, 242, 74, 196, 189, 184, 186, 126, 101, 200,
+			246, 223, 41, 205, 140, 15, 146, 30, 212, 58, 100, 45, 49, 85,
+			78, 165, 4, 38, 198, 144, 246, 30, 37, 240, 251, 54, 44, 77,
+			49, 59, 7, 118, 185, 95, 93, 18, 235, 186, 210, 221, 228, 65,
+			10, 30, 196, 114, 217, 255, 159, 28, 109, 174, 252, 152, 41, 21,
+			233, 93, 160, 251, 225, 191, 188, 202, 13, 100, 164, 220, 228, 60,
+			233, 150, 150, 224, 246, 3, 150, 148, 5, 188, 39, 72, 143, 182,
+			4, 119, 30, 176, 180, 46, 2, 214, 202, 81, 187, 169, 37, 222,
+			41, 46, 182, 254, 74, 127, 212, 110, 170, 85, 158, 122, 7, 72,
+			169, 190, 93, 3, 236, 166, 194, 6, 185, 187, 206, 35, 187, 2,
+			226, 225, 217, 201, 191, 21, 241, 91, 105, 67, 4, 87, 245, 95,
+			33, 221, 162, 81, 88, 46, 96, 63, 45, 150, 75, 88, 75, 65,
+			58, 164, 36, 190, 37, 46, 225, 245, 198, 149, 89, 52, 119, 154,
+			41, 82, 104, 162, 45, 162, 143, 9, 35, 112, 149, 6, 71, 3,
+			200, 75, 97, 53, 217, 55, 145, 244, 111, 144, 110, 177, 126, 161,
+			98, 196, 118, 138, 222, 0, 122, 42, 34, 245, 154, 204, 255, 175,
+			126, 126, 129, 139, 43, 62, 252, 213, 32, 174, 152, 214, 198, 214,
+			51, 210, 216, 186, 71, 216, 93, 59, 158, 67, 186, 151, 240, 167,
+			235, 57, 189, 74, 180, 209, 95, 34, 228, 87, 108, 46, 218, 24,
+			7, 52, 248, 191, 104, 231, 196, 13, 29, 180, 14, 92, 41, 220,
+			34, 217, 20, 36, 188, 73, 234, 102, 38, 113, 123, 115, 139, 43,
+			228, 40, 157, 144, 105, 124, 195, 109, 7, 73, 45, 167, 46, 84,
+			38, 34, 242, 207, 86, 16, 213, 26, 44, 225, 111, 79, 6, 180,
+			80, 144, 49, 83, 243, 81, 196, 109, 153, 211, 42, 22, 220, 130,
+			51, 228, 222, 148, 26, 13, 214, 40, 19, 78, 33, 129, 201, 83,
+			32, 173, 37, 19, 241, 40, 109, 37, 49, 183, 10, 216, 216, 81,
+			245, 237, 104, 179, 129, 151, 218, 172, 205, 104, 128, 67, 35, 218,
+			195, 187, 54, 126, 136, 98, 208, 179, 7, 94, 2, 171, 202, 214,
+			197, 163, 144, 251, 221, 155, 81, 7, 149, 80, 246, 52, 100, 52,
+			227, 165, 81, 178, 36, 69, 52, 147, 246, 97, 255, 245, 116, 85,
+			188, 39, 203, 116, 141, 177, 125, 180, 148, 234, 113, 146, 123, 44,
+			230, 164, 35, 147, 246, 248, 184, 33, 29, 153, 204, 73, 250, 39,
+			115, 146, 254, 201, 131, 135, 200, 15, 58, 82, 34, 64, 237, 9,
+			255, 195, 14, 229, 98, 43, 109, 49, 157, 51, 154, 76, 56, 23,
+			134, 199, 88, 48, 20, 230, 178, 36, 0, 123, 237, 160, 65, 133,
+			131, 124, 98, 128, 16, 145, 113, 181, 218, 78, 208, 254, 138, 182,
+			35, 97, 225, 166, 240, 45, 149, 172, 32, 146, 64, 173, 29, 213,
+			130, 40, 51, 98, 175, 232, 240, 11, 81, 12, 139, 30, 109, 17,
+			20, 111, 134, 110, 177, 224, 222, 14, 114, 73, 30, 231, 220, 10,
+			209, 39, 152, 25, 44, 70, 132, 201, 47, 232, 195, 178, 26, 167,
+			84, 209, 81, 122, 21, 103, 110, 51, 72, 106, 13, 198, 121, 79,
+			91, 40, 80, 0, 245, 82, 160, 107, 96, 21, 112, 117, 63, 201,
+			165, 32, 157, 253, 213, 38, 19, 162, 227, 213, 29, 195, 0, 18,
+			209, 133, 61, 87, 65, 147, 185, 33, 90, 130, 161, 193, 184, 171,
+			127, 140, 229, 80, 195, 200, 80, 176, 13, 112, 207, 132, 17, 119,
+			134, 165, 67, 200, 112, 33, 18, 181, 39, 77, 5, 8, 154, 83,
+			128, 160, 253, 158, 33, 175, 160, 99, 227, 90, 244, 53, 101, 159,
+			49, 69, 95, 83, 100, 128, 252, 29, 91, 203, 190, 166, 221, 97,
+			255, 7, 108, 186, 116, 121, 141, 107, 159, 108, 111, 177, 140, 75,
+			95, 66, 97, 109, 138, 55, 235, 60, 231, 103, 204, 132, 233, 214,
+			44, 218, 85, 211, 139, 160, 122, 217, 241, 1, 89, 157, 132, 174,
+			133, 81, 149, 9, 89, 76, 80, 51, 182, 14, 90, 42, 54, 178,
+			112, 62, 219, 130, 210, 50, 210, 134, 224, 127, 113, 122, 60, 4,
+			165, 201, 52, 35, 148, 213, 235, 113, 34, 108, 139, 16, 145, 220,
+			120, 191, 129, 156, 38, 52, 139, 219, 218, 217, 72, 66, 240, 178,
+			148, 109, 51, 22, 225, 24, 0, 157, 23, 47, 175, 9, 179, 200,
+			148, 101, 25, 50, 249, 64, 46, 153, 196, 13, 46, 151, 220, 8,
+			3, 105, 64, 108, 172, 70, 81, 25, 244, 24, 159, 90, 141, 205,
+			56, 9, 179, 173, 166, 122, 56, 242, 61, 54, 237, 78, 13, 229,
+			100, 131, 211, 110, 73, 167, 45, 207, 153, 238, 233, 203, 201, 6,
+			167, 7, 135, 48, 132, 122, 151, 211, 5, 76, 217, 147, 238, 169,
+			130, 191, 70, 21, 253, 211, 169, 136, 151, 235, 87, 78, 141, 178,
+			83, 79, 79, 25, 246, 194, 1, 85, 86, 2, 64, 60, 93, 78,
+			246, 120, 130, 105, 219, 197, 79, 152, 185, 226, 136, 96, 209, 118,
+			137, 243, 96, 174, 56, 96, 66, 108, 207, 153, 27, 54, 11, 89,
+			158, 51, 95, 28, 53, 178, 88, 8, 25, 52, 33, 182, 231, 204,
+			123, 35, 70, 33, 219, 115, 202, 185, 44, 80, 77, 185, 72, 76,
+			8, 228, 17, 38, 65, 66, 94, 122, 218, 61, 148, 147, 151, 158,
+			118, 79, 21, 180, 60, 180, 8, 25, 250, 115, 242, 210, 211, 3,
+			19, 57, 121, 233, 105, 97, 226, 129, 29, 88, 176, 135, 140, 55,
+			213, 130, 90, 254, 200, 145, 90, 16, 90, 234, 188, 103, 11, 221,
+			189, 134, 23, 134, 133, 129, 65, 177, 109, 44, 207, 125, 212, 190,
+			230, 24, 172, 245, 71, 201, 32, 249, 57, 75, 51, 180, 207, 185,
+			147, 254, 79, 88, 104, 194, 7, 219, 67, 107, 110, 9, 97, 183,
+			41, 51, 205, 98, 121, 34, 178, 199, 233, 105, 41, 243, 14, 155,
+			77, 86, 11, 129, 119, 69, 232, 76, 20, 231, 230, 122, 118, 142,
+			206, 159, 145, 25, 163, 152, 235, 151, 78, 115, 193, 231, 19, 167,
+			165, 17, 56, 24, 224, 16, 34, 116, 155, 55, 131, 44, 188, 39,
+			88, 6, 176, 129, 194, 26, 75, 161, 146, 80, 251, 56, 232, 96,
+			36, 159, 115, 31, 53, 24, 197, 5, 24, 83, 79, 142, 145, 124,
+			142, 140, 228, 24, 201, 231, 198, 39, 200, 191, 177, 53, 99, 116,
+			209, 125, 196, 255, 5, 52, 99, 4, 42, 250, 213, 76, 24, 81,
+			33, 91, 173, 95, 126, 142, 138, 83, 57, 78, 212, 58, 70, 95,
+			169, 192, 192, 107, 200, 147, 95, 82, 76, 32, 155, 107, 132, 17,
+			146, 75, 211, 112, 58, 136, 195, 97, 122, 78, 72, 16, 164, 145,
+			140, 168, 156, 71, 213, 201, 80, 33, 153, 110, 132, 153, 84, 10,
+			21, 50, 226, 48, 197, 30, 113, 150, 159, 180, 80, 52, 194, 120,
+			8, 99, 39, 17, 92, 69, 49, 22, 246, 136, 33, 182, 153, 180,
+			170, 170, 46, 112, 148, 194, 18, 217, 37, 97, 122, 156, 209, 128,
+			110, 5, 73, 141, 232, 65, 212, 243, 49, 242, 59, 152, 178, 139,
+			238, 185, 201, 28, 83, 118, 209, 61, 148, 99, 202, 46, 30, 62,
+			145, 99, 202, 46, 206, 204, 146, 159, 115, 52, 87, 246, 105, 247,
+			176, 255, 19, 206, 87, 210, 186, 20, 187, 125, 155, 155, 122, 117,
+			90, 156, 114, 40, 231, 247, 147, 253, 236, 81, 209, 230, 232, 213,
+			13, 82, 41, 94, 185, 255, 157, 25, 164, 74, 70, 250, 211, 238,
+			226, 35, 154, 81, 94, 128, 57, 37, 57, 70, 250, 211, 189, 121,
+			70, 250, 211, 194, 32, 21, 157, 43, 94, 23, 6, 169, 220, 177,
+			226, 117, 117, 144, 33, 159, 243, 186, 10, 253, 2, 47, 130, 235,
+			165, 126, 195, 129, 249, 117, 52, 189, 132, 3, 208, 246, 10, 171,
+			246, 55, 89, 162, 32, 58, 210, 91, 37, 195, 66, 184, 136, 94,
+			95, 111, 186, 177, 60, 163, 185, 67, 215, 155, 125, 35, 228, 11,
+			22, 222, 4, 194, 167, 235, 115, 197, 17, 255, 183, 44, 80, 102,
+			16, 23, 239, 116, 88, 155, 214, 177, 170, 86, 234, 123, 40, 85,
+			75, 252, 104, 21, 208, 92, 48, 63, 150, 176, 233, 148, 178, 8,
+			159, 19, 6, 165, 132, 23, 60, 123, 89, 208, 7, 89, 44, 220,
+			37, 8, 49, 75, 8, 2, 67, 33, 14, 145, 209, 155, 194, 26,
+			42, 154, 243, 138, 212, 123, 10, 222, 28, 88, 94, 57, 113, 21,
+			52, 155, 120, 43, 16, 254, 194, 128, 229, 182, 19, 183, 133, 123,
+			76, 49, 96, 48, 36, 46, 222, 28, 35, 67, 26, 4, 166, 196,
+			69, 98, 66, 208, 152, 120, 192, 132, 128, 57, 49, 186, 231, 149,
+			152, 3, 185, 75, 113, 212, 63, 67, 87, 34, 30, 13, 53, 205,
+			221, 250, 121, 199, 40, 84, 219, 76, 154, 157, 65, 233, 78, 241,
+			185, 17, 163, 33, 208, 59, 122, 33, 215, 25, 148, 240, 244, 14,
+			154, 16, 144, 241, 120, 35, 228, 99, 142, 234, 141, 237, 57, 91,
+			197, 113, 255, 187, 29, 163, 59, 82, 248, 174, 228, 181, 29, 125,
+			146, 111, 149, 220, 76, 171, 17, 196, 137, 188, 148, 116, 40, 38,
+			125, 45, 52, 226, 205, 176, 42, 222, 13, 16, 211, 200, 168, 87,
+			5, 174, 20, 241, 46, 13, 235, 0, 101, 103, 192, 111, 81, 148,
+			161, 193, 73, 130, 150, 137, 219, 97, 202, 140, 181, 69, 232, 70,
+			35, 136, 238, 238, 238, 216, 116, 74, 69, 180, 88, 88, 58, 166,
+			206, 179, 242, 230, 169, 236, 95, 201, 125, 13, 96, 13, 251, 215,
+			125, 236, 94, 137, 50, 124, 173, 232, 128, 156, 224, 110, 101, 113,
+			125, 241, 246, 218, 202, 155, 151, 111, 95, 91, 185, 190, 178, 14,
+			29, 189, 130, 113, 234, 224, 214, 131, 115, 12, 150, 31, 127, 90,
+			231, 86, 32, 156, 28, 91, 197, 23, 70, 141, 9, 133, 179, 99,
+			43, 55, 233, 176, 188, 182, 122, 135, 77, 136, 176, 110, 125, 94,
+			205, 57, 120, 232, 44, 78, 250, 87, 0, 63, 134, 33, 238, 156,
+			86, 106, 226, 129, 233, 116, 156, 120, 17, 210, 51, 23, 45, 79,
+			30, 175, 185, 62, 194, 137, 116, 183, 184, 53, 110, 180, 239, 20,
+			160, 57, 179, 143, 112, 50, 221, 237, 53, 23, 47, 156, 78, 119,
+			199, 39, 200, 146, 234, 163, 235, 57, 81, 113, 194, 63, 187, 171,
+			143, 130, 37, 100, 244, 245, 85, 186, 227, 186, 158, 27, 21, 239,
+			78, 26, 77, 65, 184, 134, 40, 215, 29, 48, 12, 143, 122, 61,
+			19, 226, 120, 78, 52, 38, 117, 8, 209, 240, 183, 37, 194, 146,
+			119, 9, 47, 239, 45, 227, 124, 196, 215, 120, 203, 237, 213, 105,
+			16, 48, 245, 13, 235, 52, 8, 152, 70, 199, 84, 125, 150, 231,
+			188, 228, 78, 170, 250, 96, 55, 191, 228, 182, 198, 85, 126, 32,
+			11, 94, 50, 234, 131, 157, 252, 82, 223, 136, 78, 59, 158, 243,
+			146, 176, 116, 239, 194, 216, 236, 78, 226, 250, 170, 62, 88, 40,
+			137, 251, 210, 164, 202, 15, 148, 113, 98, 212, 7, 29, 72, 250,
+			198, 116, 218, 241, 156, 100, 242, 0, 249, 46, 91, 84, 8, 54,
+			157, 238, 17, 255, 155, 140, 184, 108, 42, 50, 177, 166, 128, 141,
+			111, 198, 9, 209, 98, 137, 182, 29, 16, 151, 0, 17, 151, 236,
+			110, 203, 31, 195, 164, 78, 95, 11, 70, 100, 109, 216, 29, 102,
+			31, 228, 157, 14, 36, 6, 242, 163, 80, 17, 17, 76, 76, 179,
+			24, 149, 6, 67, 16, 192, 173, 197, 242, 109, 168, 108, 234, 238,
+			156, 185, 67, 242, 198, 238, 200, 83, 91, 92, 91, 207, 89, 246,
+			212, 216, 110, 11, 8, 162, 48, 11, 203, 123, 199, 77, 124, 133,
+			57, 88, 220, 59, 234, 242, 70, 115, 46, 103, 167, 247, 128, 78,
+			3, 38, 15, 29, 38, 31, 148, 152, 117, 61, 231, 93, 238, 184,
+			255, 151, 22, 189, 204, 249, 174, 52, 84, 231, 174, 142, 207, 109,
+			160, 115, 23, 15, 109, 199, 188, 24, 137, 118, 19, 40, 200, 27,
+			78, 206, 226, 217, 90, 221, 2, 101, 204, 84, 56, 17, 100, 41,
+			131, 103, 123, 92, 55, 203, 43, 230, 7, 47, 253, 184, 60, 123,
+			133, 30, 115, 138, 143, 79, 64, 163, 236, 70, 144, 59, 136, 165,
+			67, 48, 174, 143, 136, 12, 153, 52, 211, 60, 33, 176, 42, 167,
+			97, 7, 29, 96, 160, 19, 182, 231, 187, 220, 157, 35, 10, 93,
+			176, 57, 223, 165, 30, 220, 104, 42, 229, 188, 171, 71, 111, 36,
+			216, 152, 239, 26, 29, 35, 239, 149, 232, 44, 120, 238, 251, 44,
+			119, 194, 255, 83, 139, 94, 20, 188, 234, 251, 33, 148, 147, 141,
+			213, 187, 48, 28, 83, 39, 155, 228, 88, 51, 95, 18, 26, 37,
+			22, 201, 190, 104, 148, 13, 223, 15, 143, 28, 141, 228, 62, 120,
+			28, 18, 104, 41, 184, 94, 225, 125, 150, 251, 46, 125, 68, 21,
+			56, 98, 74, 26, 96, 1, 160, 199, 211, 0, 7, 0, 99, 227,
+			228, 125, 150, 136, 105, 227, 190, 223, 178, 199, 253, 109, 229, 60,
+			164, 195, 29, 97, 173, 102, 178, 230, 204, 193, 43, 187, 94, 254,
+			150, 48, 117, 174, 248, 91, 9, 118, 224, 78, 75, 196, 90, 134,
+			103, 113, 187, 57, 159, 197, 243, 141, 32, 217, 228, 22, 150, 53,
+			97, 60, 135, 39, 118, 241, 253, 22, 210, 166, 156, 198, 5, 185,
+			235, 251, 45, 187, 71, 38, 45, 72, 18, 41, 56, 5, 153, 235,
+			251, 173, 81, 56, 202, 128, 174, 117, 188, 226, 7, 45, 251, 59,
+			100, 97, 7, 94, 250, 31, 132, 220, 55, 113, 189, 56, 118, 151,
+			231, 126, 139, 229, 30, 245, 191, 142, 46, 241, 184, 206, 114, 85,
+			212, 84, 192, 248, 188, 240, 91, 120, 15, 208, 243, 43, 61, 22,
+			112, 60, 114, 231, 213, 223, 98, 185, 125, 26, 80, 0, 64, 255,
+			136, 6, 88, 0, 24, 245, 53, 192, 1, 192, 225, 35, 164, 34,
+			122, 101, 121, 238, 183, 91, 46, 245, 47, 62, 104, 175, 76, 125,
+			237, 142, 126, 13, 139, 70, 44, 215, 43, 126, 187, 229, 126, 139,
+			117, 84, 181, 107, 21, 177, 29, 79, 3, 176, 225, 145, 131, 26,
+			224, 0, 224, 200, 81, 33, 194, 46, 120, 238, 135, 44, 123, 92,
+			76, 77, 193, 245, 138, 31, 50, 176, 11, 14, 112, 220, 15, 233,
+			169, 129, 37, 246, 33, 61, 53, 176, 192, 62, 100, 153, 182, 90,
+			189, 228, 245, 15, 46, 202, 21, 63, 13, 9, 246, 171, 5, 149,
+			252, 178, 164, 211, 95, 190, 239, 111, 255, 175, 33, 164, 183, 255,
+			101, 26, 125, 250, 95, 134, 134, 192, 194, 95, 89, 196, 69, 41,
+			224, 34, 25, 236, 240, 76, 234, 141, 239, 237, 42, 218, 223, 19,
+			158, 182, 188, 101, 50, 188, 43, 154, 173, 135, 2, 233, 189, 130,
+			220, 250, 251, 4, 208, 244, 46, 144, 254, 156, 1, 165, 135, 126,
+			181, 59, 109, 42, 247, 45, 62, 71, 122, 212, 241, 239, 13, 117,
+			138, 82, 253, 188, 155, 230, 171, 63, 87, 228, 6, 169, 71, 190,
+			42, 98, 195, 9, 121, 96, 73, 59, 95, 38, 221, 87, 165, 60,
+			176, 183, 251, 105, 41, 15, 236, 235, 126, 10, 127, 22, 60, 167,
+			95, 217, 177, 14, 150, 8, 153, 37, 118, 17, 204, 106, 186, 142,
+			88, 254, 97, 110, 188, 180, 164, 233, 143, 53, 254, 102, 94, 188,
+			185, 194, 5, 94, 69, 180, 185, 41, 245, 145, 55, 17, 183, 136,
+			172, 129, 81, 251, 186, 191, 244, 234, 23, 8, 30, 109, 226, 230,
+			144, 54, 252, 187, 13, 164, 128, 65, 81, 228, 239, 234, 209, 226,
+			152, 76, 217, 158, 51, 58, 126, 82, 166, 192, 160, 230, 177, 167,
+			193, 115, 116, 17, 95, 215, 19, 246, 155, 252, 175, 3, 76, 139,
+			64, 228, 27, 59, 154, 130, 20, 252, 129, 52, 23, 116, 93, 171,
+			153, 242, 151, 207, 210, 117, 213, 48, 80, 222, 19, 197, 9, 153,
+			2, 119, 196, 147, 167, 100, 10, 226, 166, 157, 95, 37, 207, 96,
+			195, 182, 231, 248, 246, 138, 191, 242, 0, 13, 75, 145, 0, 159,
+			90, 109, 251, 139, 87, 187, 84, 124, 151, 61, 128, 17, 249, 197,
+			17, 153, 130, 102, 70, 167, 101, 202, 241, 28, 127, 225, 41, 242,
+			60, 246, 192, 241, 156, 195, 246, 27, 252, 107, 52, 105, 71, 169,
+			150, 158, 162, 241, 124, 208, 184, 155, 210, 0, 216, 164, 220, 76,
+			183, 3, 209, 250, 142, 201, 11, 193, 100, 39, 96, 53, 29, 46,
+			14, 202, 148, 237, 57, 135, 135, 142, 202, 20, 180, 251, 200, 99,
+			242, 152, 255, 127, 7, 0, 40, 234, 208, 4, 127, 25, 1, 0},
 	)
 }
 
@@ -8,6 +8,8 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/luci/luci-go/common/data/text/templateproto"
@@ -16,7 +18,43 @@ import (
 // IsEmpty returns true if this metadata retry message only contains
 // zero-values.
 func (q *Quest_Desc_Meta_Retry) IsEmpty() bool {
-	return q.Crashed == 0 && q.Expired == 0 && q.Failed == 0 && q.TimedOut == 0
+	return (q.Crashed == 0 && q.Expired == 0 && q.Failed == 0 && q.TimedOut == 0 &&
+		q.Rejected == 0 && q.Backoff.Duration() == 0 && q.MaxBackoff.Duration() == 0)
+}
+
+// Normalize ensures that all backoff durations are >= 0
+func (q *Quest_Desc_Meta_Retry) Normalize() error {
+	if d := q.Backoff.Duration(); d < 0 {
+		return fmt.Errorf("desc.meta.retry.backoff < 0: %s", d)
+	}
+	if d := q.MaxBackoff.Duration(); d < 0 {
+		return fmt.Errorf("desc.meta.retry.max_backoff < 0: %s", d)
+	}
+	return nil
+}
+
+// Delay returns the amount of time to wait before the n'th retry in a row
+// (starting at 1), according to the Backoff and MaxBackoff settings.
+func (q *Quest_Desc_Meta_Retry) Delay(n uint32) time.Duration {
+	ret := q.GetBackoff().Duration()
+	if ret <= 0 || n == 0 {
+		return 0
+	}
+	max := q.GetMaxBackoff().Duration()
+	for i := uint32(1); i < n; i++ {
+		if max > 0 && ret >= max {
+			break
+		}
+		if ret > math.MaxInt64/2 {
+			// don't overflow; this is already ~146 years.
+			return time.Duration(math.MaxInt64)
+		}
+		ret *= 2
+	}
+	if max > 0 && ret > max {
+		ret = max
+	}
+	return ret
 }
 
 // Normalize ensures that all timeouts are >= 0
@@ -63,6 +101,9 @@ func (q *Quest_Desc) Normalize() error {
 			q.Meta.Timeouts = &Quest_Desc_Meta_Timeouts{}
 		}
 	}
+	if err := q.Meta.Retry.Normalize(); err != nil {
+		return err
+	}
 	if err := q.Meta.Timeouts.Normalize(); err != nil {
 		return err
 	}
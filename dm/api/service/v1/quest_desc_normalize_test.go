@@ -0,0 +1,63 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dm
+
+import (
+	"testing"
+	"time"
+
+	google_pb "github.com/luci/luci-go/common/proto/google"
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestQuestDescRetry(t *testing.T) {
+	t.Parallel()
+
+	Convey("Quest_Desc_Meta_Retry", t, func() {
+		r := &Quest_Desc_Meta_Retry{}
+
+		Convey("Delay", func() {
+			Convey("no backoff", func() {
+				So(r.Delay(1), ShouldEqual, 0)
+				So(r.Delay(10), ShouldEqual, 0)
+			})
+
+			Convey("unbounded", func() {
+				r.Backoff = google_pb.NewDuration(time.Second)
+				So(r.Delay(0), ShouldEqual, 0)
+				So(r.Delay(1), ShouldEqual, time.Second)
+				So(r.Delay(2), ShouldEqual, 2*time.Second)
+				So(r.Delay(4), ShouldEqual, 8*time.Second)
+				So(r.Delay(1000), ShouldBeGreaterThan, 0)
+			})
+
+			Convey("bounded", func() {
+				r.Backoff = google_pb.NewDuration(time.Second)
+				r.MaxBackoff = google_pb.NewDuration(5 * time.Second)
+				So(r.Delay(3), ShouldEqual, 4*time.Second)
+				So(r.Delay(4), ShouldEqual, 5*time.Second)
+				So(r.Delay(1000), ShouldEqual, 5*time.Second)
+			})
+		})
+
+		Convey("Normalize", func() {
+			So(r.Normalize(), ShouldBeNil)
+
+			r.Backoff = google_pb.NewDuration(-time.Second)
+			So(r.Normalize(), ShouldErrLike, "backoff < 0")
+
+			r.Backoff = nil
+			r.MaxBackoff = google_pb.NewDuration(-time.Second)
+			So(r.Normalize(), ShouldErrLike, "max_backoff < 0")
+		})
+
+		Convey("IsEmpty", func() {
+			So(r.IsEmpty(), ShouldBeTrue)
+			r.Rejected = 1
+			So(r.IsEmpty(), ShouldBeFalse)
+		})
+	})
+}
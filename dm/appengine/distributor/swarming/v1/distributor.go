@@ -0,0 +1,432 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package swarming implements a DM distributor which runs Executions as
+// Swarming tasks.
+//
+// Tasks are described by the quest's parameters (see
+// swarmingV1.Parameters), and are combined with the isolate, CIPD and
+// dimension settings from the distributor's configuration (see
+// swarmingV1.Config). Swarming notifies DM about finished tasks via PubSub.
+//
+// The task learns how to talk to DM from its environment:
+//   * DM_HOST is the host of the DM service.
+//   * DM_EXECUTION_AUTH is the JSONPB-encoded dm.Execution_Auth which the
+//     task must use to call ActivateExecution.
+//   * DM_PREVIOUS_RESULT is the JSON result of the Attempt's previous
+//     successful Execution, if there was one.
+package swarming
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"golang.org/x/net/context"
+	"google.golang.org/api/googleapi"
+
+	swarm "github.com/luci/luci-go/common/api/swarming/swarming/v1"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	sv1 "github.com/luci/luci-go/dm/api/distributor/swarming/v1"
+	dm "github.com/luci/luci-go/dm/api/service/v1"
+	"github.com/luci/luci-go/dm/appengine/distributor"
+	"github.com/luci/luci-go/server/auth"
+)
+
+const (
+	defaultPriority         = 100
+	defaultExpiration       = time.Hour
+	defaultExecutionTimeout = time.Hour
+	defaultIsolateNamespace = "default-gzip"
+
+	// pollSlack is added to the longest time that a task could take when
+	// computing the pollTimeout returned from Run.
+	pollSlack = 15 * time.Minute
+)
+
+type swarmingDist struct {
+	c   context.Context
+	cfg *distributor.Config
+
+	// client, if not nil, is used for swarming API calls instead of one which
+	// authenticates as the DM service. It's used for testing.
+	client *http.Client
+}
+
+var _ distributor.D = (*swarmingDist)(nil)
+
+func (d *swarmingDist) sCfg() *sv1.Config {
+	return d.cfg.Content.(*sv1.Config)
+}
+
+func (d *swarmingDist) swarmingURL() string {
+	return strings.TrimSuffix(d.sCfg().Swarming.Url, "/")
+}
+
+func (d *swarmingDist) newSwarmClient() (*swarm.Service, error) {
+	client := d.client
+	if client == nil {
+		tr, err := auth.GetRPCTransport(d.c, auth.AsSelf)
+		if err != nil {
+			return nil, err
+		}
+		client = &http.Client{Transport: tr}
+	}
+	svc, err := swarm.New(client)
+	if err != nil {
+		return nil, err
+	}
+	svc.BasePath = d.swarmingURL() + "/_ah/api/swarming/v1/"
+	return svc, nil
+}
+
+// parseParams parses and checks the quest parameters against this
+// distributor's configuration.
+func (d *swarmingDist) parseParams(parameters string) (*sv1.Parameters, error) {
+	params := &sv1.Parameters{}
+	if err := jsonpb.UnmarshalString(parameters, params); err != nil {
+		return nil, err
+	}
+	if err := params.Normalize(); err != nil {
+		return nil, err
+	}
+
+	cfg := d.sCfg()
+	if params.Job.Isolated != "" && cfg.Isolate == nil {
+		return nil, errors.New("isolated inputs require the distributor to have an isolate configuration")
+	}
+	for k, v := range params.GetScheduling().GetDimensions() {
+		if cv, ok := cfg.Dimensions[k]; ok && cv != v {
+			return nil, fmt.Errorf("dimension %q may not override the distributor's value %q", k, cv)
+		}
+	}
+	return params, nil
+}
+
+func (d *swarmingDist) Run(tsk *distributor.TaskDescription) (tok distributor.Token, pollTimeout time.Duration, err error) {
+	exAuth := tsk.ExecutionAuth()
+
+	params, err := d.parseParams(tsk.Payload().Parameters)
+	if err != nil {
+		return
+	}
+	req, err := d.newTaskRequest(exAuth, tsk.PreviousResult(), params)
+	if err != nil {
+		return
+	}
+
+	topic, token, err := tsk.PrepareTopic()
+	if err != nil {
+		return
+	}
+	req.PubsubTopic = string(topic)
+	req.PubsubAuthToken = token
+
+	svc, err := d.newSwarmClient()
+	if err != nil {
+		return
+	}
+	rsp, err := svc.Tasks.New(req).Do()
+	if err != nil {
+		logging.Fields{
+			logging.ErrorKey: err,
+			"eid":            exAuth.Id,
+		}.Errorf(d.c, "swarming: failed to trigger task")
+		err = wrapAPIError(err)
+		return
+	}
+	logging.Fields{
+		"eid":    exAuth.Id,
+		"taskID": rsp.TaskId,
+	}.Infof(d.c, "swarming: triggered task")
+
+	tok = distributor.Token(rsp.TaskId)
+	props := req.Properties
+	pollTimeout = time.Duration(req.ExpirationSecs+props.ExecutionTimeoutSecs+props.GracePeriodSecs)*time.Second + pollSlack
+	return
+}
+
+// newTaskRequest builds the swarming request for an Execution. The PubSub
+// fields are left for the caller to fill in.
+func (d *swarmingDist) newTaskRequest(exAuth *dm.Execution_Auth, prev *dm.JsonResult, params *sv1.Parameters) (*swarm.SwarmingRpcsNewTaskRequest, error) {
+	cfg := d.sCfg()
+	sched := params.Scheduling
+	if sched == nil {
+		sched = &sv1.Parameters_Scheduling{}
+	}
+	job := params.Job
+
+	eid := exAuth.Id
+	exAuthJSON, err := (&jsonpb.Marshaler{}).MarshalToString(exAuth)
+	if err != nil {
+		return nil, err
+	}
+	// The job's environment can't override the DM variables.
+	env := make(map[string]string, len(job.Env)+3)
+	for k, v := range job.Env {
+		env[k] = v
+	}
+	env["DM_HOST"] = d.cfg.DMHost
+	env["DM_EXECUTION_AUTH"] = exAuthJSON
+	if prev != nil && prev.Object != "" {
+		env["DM_PREVIOUS_RESULT"] = prev.Object
+	} else {
+		delete(env, "DM_PREVIOUS_RESULT")
+	}
+
+	dims := make(map[string]string, len(cfg.Dimensions)+len(sched.Dimensions))
+	for k, v := range sched.Dimensions {
+		dims[k] = v
+	}
+	for k, v := range cfg.Dimensions {
+		dims[k] = v
+	}
+
+	priority := int64(sched.Priority)
+	if priority == 0 {
+		priority = int64(cfg.DefaultPriority)
+	}
+	if priority == 0 {
+		priority = defaultPriority
+	}
+
+	expiration := sched.Expiration.Duration()
+	if expiration == 0 {
+		expiration = defaultExpiration
+	}
+	executionTimeout := job.ExecutionTimeout.Duration()
+	if executionTimeout == 0 {
+		executionTimeout = defaultExecutionTimeout
+	}
+
+	namePrefix := ""
+	if m := params.Meta; m != nil && m.NamePrefix != "" {
+		namePrefix = m.NamePrefix + " "
+	}
+
+	req := &swarm.SwarmingRpcsNewTaskRequest{
+		Name:           fmt.Sprintf("%sdm:%s|%d|%d", namePrefix, eid.Quest, eid.Attempt, eid.Id),
+		ExpirationSecs: int64(expiration / time.Second),
+		Priority:       priority,
+		Tags: []string{
+			"dm_distributor:" + d.cfg.Name,
+			"dm_quest:" + eid.Quest,
+			fmt.Sprintf("dm_attempt:%d", eid.Attempt),
+			fmt.Sprintf("dm_execution:%d", eid.Id),
+		},
+		Properties: &swarm.SwarmingRpcsTaskProperties{
+			CipdInput:            cipdInput(cfg.Cipd, job.CipdPackages),
+			Command:              job.Command,
+			Dimensions:           stringPairs(dims),
+			Env:                  stringPairs(env),
+			ExecutionTimeoutSecs: int64(executionTimeout / time.Second),
+			ExtraArgs:            job.ExtraArgs,
+			GracePeriodSecs:      int64(job.GracePeriod.Duration() / time.Second),
+			IoTimeoutSecs:        int64(sched.IoTimeout.Duration() / time.Second),
+		},
+	}
+
+	if job.Isolated != "" {
+		namespace := cfg.Isolate.Namespace
+		if namespace == "" {
+			namespace = defaultIsolateNamespace
+		}
+		req.Properties.InputsRef = &swarm.SwarmingRpcsFilesRef{
+			Isolated:       job.Isolated,
+			Isolatedserver: strings.TrimSuffix(cfg.Isolate.Url, "/"),
+			Namespace:      namespace,
+		}
+	}
+	return req, nil
+}
+
+func (d *swarmingDist) Cancel(tok distributor.Token) error {
+	svc, err := d.newSwarmClient()
+	if err != nil {
+		return err
+	}
+	// Swarming responds with Ok == false if the task has already finished, which
+	// is fine.
+	if _, err := svc.Task.Cancel(string(tok)).Do(); err != nil {
+		return wrapAPIError(err)
+	}
+	return nil
+}
+
+func (d *swarmingDist) GetStatus(tok distributor.Token) (*dm.Result, error) {
+	svc, err := d.newSwarmClient()
+	if err != nil {
+		return nil, err
+	}
+	rslt, err := svc.Task.Result(string(tok)).Do()
+	if err != nil {
+		return nil, wrapAPIError(err)
+	}
+	return toDMResult(rslt)
+}
+
+func (d *swarmingDist) InfoURL(tok distributor.Token) string {
+	return fmt.Sprintf("%s/user/task/%s", d.swarmingURL(), tok)
+}
+
+// notification is the body of the PubSub messages that swarming sends when
+// a task finishes.
+type notification struct {
+	TaskID   string `json:"task_id"`
+	Userdata string `json:"userdata"`
+}
+
+func (d *swarmingDist) HandleNotification(note *distributor.Notification) (*dm.Result, error) {
+	n := &notification{}
+	if err := json.Unmarshal(note.Data, n); err != nil {
+		return nil, err
+	}
+	if n.TaskID == "" {
+		return nil, errors.New("swarming notification is missing task_id")
+	}
+	return d.GetStatus(distributor.Token(n.TaskID))
+}
+
+func (d *swarmingDist) HandleTaskQueueTask(r *http.Request) ([]*distributor.Notification, error) {
+	// The swarming distributor never uses Config.EnqueueTask.
+	return nil, errors.New("swarming: unexpected task queue task")
+}
+
+func (d *swarmingDist) Validate(parameters string) error {
+	_, err := d.parseParams(parameters)
+	return err
+}
+
+// taskResult is the JSON result of a successful swarming Execution.
+type taskResult struct {
+	TaskID   string      `json:"task_id"`
+	ExitCode int64       `json:"exit_code"`
+	Outputs  *outputsRef `json:"outputs,omitempty"`
+}
+
+// outputsRef is the isolated output of a swarming task.
+type outputsRef struct {
+	Isolated       string `json:"isolated"`
+	IsolatedServer string `json:"isolated_server"`
+	Namespace      string `json:"namespace"`
+}
+
+// toDMResult converts a swarming task result to a dm.Result. It returns nil if
+// the task hasn't finished yet.
+func toDMResult(r *swarm.SwarmingRpcsTaskResult) (*dm.Result, error) {
+	abnormal := func(status dm.AbnormalFinish_Status, reason string) *dm.Result {
+		return &dm.Result{AbnormalFinish: &dm.AbnormalFinish{Status: status, Reason: reason}}
+	}
+
+	switch r.State {
+	case "PENDING", "RUNNING":
+		return nil, nil
+
+	case "COMPLETED":
+		switch {
+		case r.InternalFailure:
+			return abnormal(dm.AbnormalFinish_CRASHED, "swarming internal failure"), nil
+		case r.Failure:
+			return abnormal(dm.AbnormalFinish_FAILED,
+				fmt.Sprintf("task failed with exit code %d", r.ExitCode)), nil
+		}
+
+		tr := &taskResult{TaskID: r.TaskId, ExitCode: r.ExitCode}
+		if o := r.OutputsRef; o != nil && o.Isolated != "" {
+			tr.Outputs = &outputsRef{o.Isolated, o.Isolatedserver, o.Namespace}
+		}
+		data, err := json.Marshal(tr)
+		if err != nil {
+			return nil, err
+		}
+		return &dm.Result{Data: dm.NewJSONObject(string(data))}, nil
+
+	case "BOT_DIED":
+		return abnormal(dm.AbnormalFinish_CRASHED, "swarming bot died while running the task"), nil
+	case "EXPIRED":
+		return abnormal(dm.AbnormalFinish_EXPIRED, "swarming task expired before a bot could run it"), nil
+	case "TIMED_OUT":
+		return abnormal(dm.AbnormalFinish_TIMED_OUT, "swarming task timed out"), nil
+	case "CANCELED":
+		return abnormal(dm.AbnormalFinish_CANCELLED, "swarming task was cancelled"), nil
+	}
+	return nil, fmt.Errorf("unknown swarming task state %q", r.State)
+}
+
+func cipdInput(spec *sv1.CipdSpec, extra []*sv1.CipdPackage) *swarm.SwarmingRpcsCipdInput {
+	pkgs := make([]*sv1.CipdPackage, 0, len(spec.GetPackages())+len(extra))
+	pkgs = append(pkgs, spec.GetPackages()...)
+	pkgs = append(pkgs, extra...)
+	if len(pkgs) == 0 {
+		return nil
+	}
+
+	ret := &swarm.SwarmingRpcsCipdInput{}
+	if spec != nil {
+		ret.Server = spec.Server
+		if spec.Client != nil {
+			ret.ClientPackage = cipdPackage(spec.Client)
+		}
+	}
+	ret.Packages = make([]*swarm.SwarmingRpcsCipdPackage, len(pkgs))
+	for i, pkg := range pkgs {
+		ret.Packages[i] = cipdPackage(pkg)
+	}
+	return ret
+}
+
+func cipdPackage(pkg *sv1.CipdPackage) *swarm.SwarmingRpcsCipdPackage {
+	path := pkg.Path
+	if path == "" {
+		path = "."
+	}
+	return &swarm.SwarmingRpcsCipdPackage{
+		PackageName: pkg.Name,
+		Version:     pkg.Version,
+		Path:        path,
+	}
+}
+
+// stringPairs converts a map into swarming's StringPairs, sorted by key.
+func stringPairs(m map[string]string) []*swarm.SwarmingRpcsStringPair {
+	if len(m) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	ret := make([]*swarm.SwarmingRpcsStringPair, len(keys))
+	for i, k := range keys {
+		ret[i] = &swarm.SwarmingRpcsStringPair{Key: k, Value: m[k]}
+	}
+	return ret
+}
+
+// wrapAPIError marks errors from the swarming API as transient if they could
+// go away on retry.
+func wrapAPIError(err error) error {
+	if apiErr, ok := err.(*googleapi.Error); ok && apiErr.Code != 0 && apiErr.Code < 500 && apiErr.Code != 429 {
+		return err
+	}
+	return errors.WrapTransient(err)
+}
+
+// AddFactory adds this distributor implementation into the distributor
+// Registry.
+func AddFactory(m distributor.FactoryMap) {
+	m[(*sv1.Config)(nil)] = func(c context.Context, cfg *distributor.Config) (distributor.D, error) {
+		if err := cfg.Content.(*sv1.Config).Normalize(); err != nil {
+			return nil, err
+		}
+		return &swarmingDist{c: c, cfg: cfg}, nil
+	}
+}
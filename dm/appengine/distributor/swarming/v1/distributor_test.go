@@ -0,0 +1,346 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package swarming
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/luci/gae/impl/memory"
+	swarm "github.com/luci/luci-go/common/api/swarming/swarming/v1"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/server/secrets/testsecrets"
+	"golang.org/x/net/context"
+
+	sv1 "github.com/luci/luci-go/dm/api/distributor/swarming/v1"
+	dm "github.com/luci/luci-go/dm/api/service/v1"
+	"github.com/luci/luci-go/dm/appengine/distributor"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeSwarming is a stand-in for the parts of the swarming API that the
+// distributor uses.
+type fakeSwarming struct {
+	sync.Mutex
+
+	requests  []*swarm.SwarmingRpcsNewTaskRequest
+	results   map[string]*swarm.SwarmingRpcsTaskResult
+	cancelled []string
+	newStatus int
+}
+
+func (f *fakeSwarming) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	const prefix = "/_ah/api/swarming/v1/"
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		http.NotFound(w, r)
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, prefix), "/")
+
+	reply := func(v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(v)
+	}
+
+	switch {
+	case len(parts) == 2 && parts[0] == "tasks" && parts[1] == "new" && r.Method == "POST":
+		if f.newStatus != 0 {
+			http.Error(w, "nope", f.newStatus)
+			return
+		}
+		req := &swarm.SwarmingRpcsNewTaskRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.requests = append(f.requests, req)
+		id := "deadbeef0"
+		f.results[id] = &swarm.SwarmingRpcsTaskResult{TaskId: id, State: "PENDING"}
+		reply(map[string]string{"task_id": id})
+
+	case len(parts) == 3 && parts[0] == "task" && parts[2] == "result" && r.Method == "GET":
+		rslt, ok := f.results[parts[1]]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		reply(rslt)
+
+	case len(parts) == 3 && parts[0] == "task" && parts[2] == "cancel" && r.Method == "POST":
+		rslt, ok := f.results[parts[1]]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		wasPending := rslt.State == "PENDING"
+		if wasPending {
+			rslt.State = "CANCELED"
+			f.cancelled = append(f.cancelled, parts[1])
+		}
+		reply(map[string]bool{"ok": wasPending})
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (f *fakeSwarming) setResult(id string, rslt *swarm.SwarmingRpcsTaskResult) {
+	f.Lock()
+	defer f.Unlock()
+	rslt.TaskId = id
+	f.results[id] = rslt
+}
+
+func TestSwarmingDistributor(t *testing.T) {
+	t.Parallel()
+
+	Convey("Swarming distributor", t, func() {
+		c := testsecrets.Use(memory.Use(context.Background()))
+
+		fake := &fakeSwarming{results: map[string]*swarm.SwarmingRpcsTaskResult{}}
+		srv := httptest.NewServer(fake)
+		defer srv.Close()
+
+		sCfg := &sv1.Config{}
+		So(proto.UnmarshalText(`
+			isolate: < url: "https://isolate.example.com" >
+			cipd: <
+				client: < name: "infra/tools/cipd" version: "latest" >
+				packages: < name: "infra/dm/runner" version: "stable" path: "bin" >
+			>
+			dimensions: < key: "pool" value: "dm" >
+			default_priority: 50
+		`, sCfg), ShouldBeNil)
+		sCfg.Swarming = &sv1.Config_Swarming{Url: srv.URL}
+		So(sCfg.Normalize(), ShouldBeNil)
+
+		d := &swarmingDist{
+			c: c,
+			cfg: &distributor.Config{
+				DMHost:  "dm.example.com",
+				Name:    "swarming",
+				Version: "1",
+				Content: sCfg,
+			},
+			client: &http.Client{},
+		}
+
+		eid := dm.NewExecutionID("quest", 1, 2)
+		exAuth := &dm.Execution_Auth{Id: eid, Token: []byte("token")}
+		newTask := func(params string, prev *dm.JsonResult) *distributor.TaskDescription {
+			return distributor.NewTaskDescription(c, &dm.Quest_Desc{
+				DistributorConfigName: "swarming",
+				Parameters:            params,
+			}, exAuth, prev)
+		}
+
+		params := `{
+			"scheduling": {
+				"dimensions": {"os": "Linux"},
+				"expiration": "600s"
+			},
+			"job": {
+				"isolated": "deadbeefdeadbeef",
+				"extraArgs": ["--verbose"],
+				"env": {"FOO": "bar", "DM_HOST": "evil.example.com"},
+				"cipdPackages": [{"name": "some/package", "version": "1.0"}],
+				"executionTimeout": "1200s"
+			},
+			"meta": {"namePrefix": "test"}
+		}`
+
+		Convey("Validate", func() {
+			So(d.Validate(params), ShouldBeNil)
+
+			Convey("rejects bad JSON", func() {
+				So(d.Validate(`{"job": `), ShouldNotBeNil)
+			})
+
+			Convey("rejects invalid parameters", func() {
+				So(d.Validate(`{}`), ShouldErrLike, "job is required")
+			})
+
+			Convey("rejects overridden dimensions", func() {
+				So(d.Validate(`{"scheduling": {"dimensions": {"pool": "other"}}, "job": {"command": ["true"]}}`),
+					ShouldErrLike, `dimension "pool" may not override`)
+			})
+
+			Convey("rejects isolated without an isolate server", func() {
+				sCfg.Isolate = nil
+				So(d.Validate(params), ShouldErrLike, "isolate configuration")
+			})
+		})
+
+		Convey("Run", func() {
+			tok, pollTimeout, err := d.Run(newTask(params, dm.NewJSONObject(`{"previous": true}`)))
+			So(err, ShouldBeNil)
+			So(tok, ShouldEqual, distributor.Token("deadbeef0"))
+			So(pollTimeout, ShouldEqual, 600*time.Second+1200*time.Second+pollSlack)
+
+			So(fake.requests, ShouldHaveLength, 1)
+			req := fake.requests[0]
+			So(req.Name, ShouldEqual, "test dm:quest|1|2")
+			So(req.Priority, ShouldEqual, 50)
+			So(req.ExpirationSecs, ShouldEqual, 600)
+			So(req.PubsubTopic, ShouldNotEqual, "")
+			So(req.PubsubAuthToken, ShouldNotEqual, "")
+			So(req.Tags, ShouldContain, "dm_quest:quest")
+
+			props := req.Properties
+			So(props.ExecutionTimeoutSecs, ShouldEqual, 1200)
+			So(props.ExtraArgs, ShouldResemble, []string{"--verbose"})
+			So(props.InputsRef, ShouldResemble, &swarm.SwarmingRpcsFilesRef{
+				Isolated:       "deadbeefdeadbeef",
+				Isolatedserver: "https://isolate.example.com",
+				Namespace:      "default-gzip",
+			})
+			So(props.Dimensions, ShouldResemble, []*swarm.SwarmingRpcsStringPair{
+				{Key: "os", Value: "Linux"},
+				{Key: "pool", Value: "dm"},
+			})
+			So(props.CipdInput, ShouldResemble, &swarm.SwarmingRpcsCipdInput{
+				ClientPackage: &swarm.SwarmingRpcsCipdPackage{
+					PackageName: "infra/tools/cipd", Version: "latest", Path: "."},
+				Packages: []*swarm.SwarmingRpcsCipdPackage{
+					{PackageName: "infra/dm/runner", Version: "stable", Path: "bin"},
+					{PackageName: "some/package", Version: "1.0", Path: "."},
+				},
+			})
+
+			env := map[string]string{}
+			for _, kv := range props.Env {
+				env[kv.Key] = kv.Value
+			}
+			So(env["FOO"], ShouldEqual, "bar")
+			So(env["DM_HOST"], ShouldEqual, "dm.example.com")
+			So(env["DM_PREVIOUS_RESULT"], ShouldEqual, `{"previous": true}`)
+			So(env["DM_EXECUTION_AUTH"], ShouldContainSubstring, `"quest":"quest"`)
+
+			Convey("GetStatus", func() {
+				Convey("pending", func() {
+					rslt, err := d.GetStatus(tok)
+					So(err, ShouldBeNil)
+					So(rslt, ShouldBeNil)
+				})
+
+				Convey("success", func() {
+					fake.setResult("deadbeef0", &swarm.SwarmingRpcsTaskResult{
+						State: "COMPLETED",
+						OutputsRef: &swarm.SwarmingRpcsFilesRef{
+							Isolated:       "cafebabe",
+							Isolatedserver: "https://isolate.example.com",
+							Namespace:      "default-gzip",
+						},
+					})
+					rslt, err := d.GetStatus(tok)
+					So(err, ShouldBeNil)
+					So(rslt.AbnormalFinish, ShouldBeNil)
+					So(rslt.Data.Object, ShouldEqual,
+						`{"task_id":"deadbeef0","exit_code":0,"outputs":{"isolated":"cafebabe",`+
+							`"isolated_server":"https://isolate.example.com","namespace":"default-gzip"}}`)
+				})
+
+				Convey("failure", func() {
+					fake.setResult("deadbeef0", &swarm.SwarmingRpcsTaskResult{
+						State: "COMPLETED", Failure: true, ExitCode: 3})
+					rslt, err := d.GetStatus(tok)
+					So(err, ShouldBeNil)
+					So(rslt.AbnormalFinish.Status, ShouldEqual, dm.AbnormalFinish_FAILED)
+					So(rslt.AbnormalFinish.Reason, ShouldContainSubstring, "exit code 3")
+				})
+
+				Convey("abnormal states", func() {
+					for state, status := range map[string]dm.AbnormalFinish_Status{
+						"BOT_DIED":  dm.AbnormalFinish_CRASHED,
+						"EXPIRED":   dm.AbnormalFinish_EXPIRED,
+						"TIMED_OUT": dm.AbnormalFinish_TIMED_OUT,
+						"CANCELED":  dm.AbnormalFinish_CANCELLED,
+					} {
+						fake.setResult("deadbeef0", &swarm.SwarmingRpcsTaskResult{State: state})
+						rslt, err := d.GetStatus(tok)
+						So(err, ShouldBeNil)
+						So(rslt.AbnormalFinish.Status, ShouldEqual, status)
+					}
+				})
+
+				Convey("missing task", func() {
+					_, err := d.GetStatus("unknown")
+					So(err, ShouldNotBeNil)
+					So(errors.IsTransient(err), ShouldBeFalse)
+				})
+			})
+
+			Convey("HandleNotification", func() {
+				fake.setResult("deadbeef0", &swarm.SwarmingRpcsTaskResult{State: "TIMED_OUT"})
+				rslt, err := d.HandleNotification(&distributor.Notification{
+					ID:   eid,
+					Data: []byte(`{"task_id": "deadbeef0", "userdata": ""}`),
+				})
+				So(err, ShouldBeNil)
+				So(rslt.AbnormalFinish.Status, ShouldEqual, dm.AbnormalFinish_TIMED_OUT)
+
+				Convey("rejects notifications without a task ID", func() {
+					_, err := d.HandleNotification(&distributor.Notification{ID: eid, Data: []byte(`{}`)})
+					So(err, ShouldErrLike, "missing task_id")
+				})
+			})
+
+			Convey("Cancel", func() {
+				So(d.Cancel(tok), ShouldBeNil)
+				So(fake.cancelled, ShouldResemble, []string{"deadbeef0"})
+
+				rslt, err := d.GetStatus(tok)
+				So(err, ShouldBeNil)
+				So(rslt.AbnormalFinish.Status, ShouldEqual, dm.AbnormalFinish_CANCELLED)
+
+				// Cancelling again is fine.
+				So(d.Cancel(tok), ShouldBeNil)
+			})
+		})
+
+		Convey("Run uses defaults", func() {
+			_, pollTimeout, err := d.Run(newTask(`{"job": {"command": ["echo", "hi"], "env": {"DM_PREVIOUS_RESULT": "{}"}}}`, nil))
+			So(err, ShouldBeNil)
+			So(pollTimeout, ShouldEqual, defaultExpiration+defaultExecutionTimeout+pollSlack)
+
+			req := fake.requests[0]
+			So(req.Name, ShouldEqual, "dm:quest|1|2")
+			So(req.Properties.Command, ShouldResemble, []string{"echo", "hi"})
+			So(req.Properties.InputsRef, ShouldBeNil)
+			for _, kv := range req.Properties.Env {
+				So(kv.Key, ShouldNotEqual, "DM_PREVIOUS_RESULT")
+			}
+		})
+
+		Convey("Run reports server errors as transient", func() {
+			fake.newStatus = http.StatusInternalServerError
+			_, _, err := d.Run(newTask(params, nil))
+			So(err, ShouldNotBeNil)
+			So(errors.IsTransient(err), ShouldBeTrue)
+		})
+
+		Convey("Run reports rejected requests as permanent", func() {
+			fake.newStatus = http.StatusBadRequest
+			_, _, err := d.Run(newTask(params, nil))
+			So(err, ShouldNotBeNil)
+			So(errors.IsTransient(err), ShouldBeFalse)
+		})
+
+		Convey("InfoURL", func() {
+			So(d.InfoURL("deadbeef0"), ShouldEqual, srv.URL+"/user/task/deadbeef0")
+		})
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/luci/luci-go/dm/appengine/deps"
 	"github.com/luci/luci-go/dm/appengine/distributor"
 	"github.com/luci/luci-go/dm/appengine/distributor/jobsim"
+	"github.com/luci/luci-go/dm/appengine/distributor/swarming/v1"
 	"github.com/luci/luci-go/dm/appengine/mutate"
 	"github.com/luci/luci-go/grpc/discovery"
 	"github.com/luci/luci-go/grpc/prpc"
@@ -23,6 +24,7 @@ func init() {
 
 	distributors := distributor.FactoryMap{}
 	jobsim.AddFactory(distributors)
+	swarming.AddFactory(distributors)
 
 	reg := distributor.NewRegistry(distributors, mutate.FinishExecutionFn)
 
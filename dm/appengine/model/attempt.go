@@ -23,6 +23,7 @@ type AttemptRetryState struct {
 	Expired  uint32
 	TimedOut uint32
 	Crashed  uint32
+	Rejected uint32
 }
 
 // Reset resets all of the AttemptRetryState counters.
@@ -30,6 +31,26 @@ func (a *AttemptRetryState) Reset() {
 	*a = AttemptRetryState{}
 }
 
+// Total returns the sum of all of the AttemptRetryState counters.
+func (a *AttemptRetryState) Total() uint32 {
+	return a.Failed + a.Expired + a.TimedOut + a.Crashed + a.Rejected
+}
+
+// ToProto returns a dm proto version of this AttemptRetryState, or nil if all
+// of the counters are zero.
+func (a *AttemptRetryState) ToProto() *dm.Attempt_Data_Retries {
+	if a.Total() == 0 {
+		return nil
+	}
+	return &dm.Attempt_Data_Retries{
+		Failed:   a.Failed,
+		Crashed:  a.Crashed,
+		Expired:  a.Expired,
+		TimedOut: a.TimedOut,
+		Rejected: a.Rejected,
+	}
+}
+
 // Attempt is the datastore model for a DM Attempt. It has no parent key, but
 // it may have the following children entities:
 //   * FwdDep
@@ -150,5 +171,6 @@ func (a *Attempt) DataProto() (ret *dm.Attempt_Data) {
 	ret.Created = google_pb.NewTimestamp(a.Created)
 	ret.Modified = google_pb.NewTimestamp(a.Modified)
 	ret.NumExecutions = a.CurExecution
+	ret.Retries = a.RetryState.ToProto()
 	return ret
 }
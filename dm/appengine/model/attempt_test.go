@@ -79,6 +79,19 @@ func TestAttempt(t *testing.T) {
 				})
 			})
 
+			Convey("Retrying", func() {
+				a := MakeAttempt(c, dm.NewAttemptID("quest", 10))
+				a.CurExecution = 2
+				a.RetryState.Crashed = 1
+				a.RetryState.Rejected = 2
+
+				So(a.ToProto(true).Data.Retries, ShouldResemble, &dm.Attempt_Data_Retries{
+					Crashed: 1, Rejected: 2})
+
+				a.RetryState.Reset()
+				So(a.ToProto(true).Data.Retries, ShouldBeNil)
+			})
+
 			Convey("Waiting", func() {
 				a := MakeAttempt(c, dm.NewAttemptID("quest", 10))
 				clk.Add(10 * time.Second)
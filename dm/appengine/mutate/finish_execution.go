@@ -6,12 +6,14 @@ package mutate
 
 import (
 	"fmt"
+	"time"
 
 	"golang.org/x/net/context"
 
 	"github.com/luci/gae/filter/txnBuf"
 	"github.com/luci/gae/service/datastore"
 
+	"github.com/luci/luci-go/common/clock"
 	"github.com/luci/luci-go/common/logging"
 	dm "github.com/luci/luci-go/dm/api/service/v1"
 	"github.com/luci/luci-go/dm/appengine/model"
@@ -31,11 +33,11 @@ func (f *FinishExecution) Root(c context.Context) *datastore.Key {
 }
 
 // shouldRetry loads the quest for this attempt, to determine if the attempt can
-// be retried. As a side-effect, it increments the RetryState counter for the
-// indicated failure type.
+// be retried, and how long to wait before doing so. As a side-effect, it
+// increments the RetryState counter for the indicated failure type.
 //
 // If stat is not a retryable AbnormalFinish_Status, this will panic.
-func shouldRetry(c context.Context, a *model.Attempt, stat dm.AbnormalFinish_Status) (retry bool, err error) {
+func shouldRetry(c context.Context, a *model.Attempt, stat dm.AbnormalFinish_Status) (retry bool, delay time.Duration, err error) {
 	if !stat.CouldRetry() {
 		return
 	}
@@ -58,10 +60,16 @@ func shouldRetry(c context.Context, a *model.Attempt, stat dm.AbnormalFinish_Sta
 	case dm.AbnormalFinish_TIMED_OUT:
 		cur, max = a.RetryState.TimedOut, q.Desc.Meta.Retry.TimedOut
 		a.RetryState.TimedOut++
+	case dm.AbnormalFinish_REJECTED:
+		cur, max = a.RetryState.Rejected, q.Desc.Meta.Retry.Rejected
+		a.RetryState.Rejected++
 	default:
 		panic(fmt.Errorf("do not know how to retry %q", stat))
 	}
 	retry = cur < max
+	if retry {
+		delay = q.Desc.Meta.Retry.Delay(a.RetryState.Total())
+	}
 	return
 }
 
@@ -96,14 +104,19 @@ func (f *FinishExecution) RollForward(c context.Context) (muts []tumble.Mutation
 		}
 
 		var retry bool
-		if retry, err = shouldRetry(c, a, ab.Status); err != nil {
+		var delay time.Duration
+		if retry, delay, err = shouldRetry(c, a, ab.Status); err != nil {
 			return
 		} else if retry {
 			if err = a.ModifyState(c, dm.Attempt_SCHEDULING); err != nil {
 				return
 			}
 			a.DepMap.Reset()
-			muts = append(muts, &ScheduleExecution{&a.ID})
+			if delay > 0 {
+				muts = append(muts, &RetryExecution{&a.ID, a.CurExecution, clock.Now(c).UTC().Add(delay)})
+			} else {
+				muts = append(muts, &ScheduleExecution{&a.ID})
+			}
 		} else {
 			// ran out of retries, or non-retriable error type
 			if err = a.ModifyState(c, dm.Attempt_ABNORMAL_FINISHED); err != nil {
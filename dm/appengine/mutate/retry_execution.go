@@ -0,0 +1,58 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/gae/service/datastore"
+	dm "github.com/luci/luci-go/dm/api/service/v1"
+	"github.com/luci/luci-go/dm/appengine/model"
+	"github.com/luci/luci-go/tumble"
+)
+
+// RetryExecution is a delayed mutation which re-schedules an Attempt after an
+// abnormal Execution, once the quest's retry backoff has elapsed.
+type RetryExecution struct {
+	For *dm.Attempt_ID
+
+	// After is the Execution which finished abnormally. If the Attempt has
+	// moved on to a different Execution by the time this mutation runs, it does
+	// nothing.
+	After uint32
+
+	Deadline time.Time
+}
+
+var _ tumble.DelayedMutation = (*RetryExecution)(nil)
+
+// Root implements tumble.Mutation
+func (r *RetryExecution) Root(c context.Context) *datastore.Key {
+	return model.AttemptKeyFromID(c, r.For)
+}
+
+// RollForward implements tumble.Mutation
+func (r *RetryExecution) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
+	a := model.AttemptFromID(r.For)
+	if err = datastore.Get(c).Get(a); err != nil {
+		return
+	}
+	if a.State != dm.Attempt_SCHEDULING || a.CurExecution != r.After {
+		return
+	}
+	return (&ScheduleExecution{r.For}).RollForward(c)
+}
+
+// ProcessAfter implements tumble.DelayedMutation
+func (r *RetryExecution) ProcessAfter() time.Time { return r.Deadline }
+
+// HighPriority implements tumble.DelayedMutation
+func (r *RetryExecution) HighPriority() bool { return false }
+
+func init() {
+	tumble.Register((*RetryExecution)(nil))
+}
@@ -0,0 +1,99 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/dm/api/service/v1"
+	"github.com/luci/luci-go/dm/appengine/distributor/fake"
+	"github.com/luci/luci-go/dm/appengine/model"
+	"github.com/luci/luci-go/tumble"
+)
+
+func TestRetryExecution(t *testing.T) {
+	t.Parallel()
+
+	Convey("RetryExecution", t, func() {
+		_, c, _ := fake.Setup(FinishExecutionFn)
+		ds := datastore.Get(c)
+
+		qdesc := fake.QuestDesc("quest")
+		qdesc.Meta.Retry.Crashed = 2
+		qdesc.Meta.Retry.Backoff = google.NewDuration(time.Minute)
+		qid := qdesc.QuestID()
+		aid := dm.NewAttemptID(qid, 1)
+		eid := dm.NewExecutionID(qid, 1, 1)
+
+		q := &model.Quest{ID: qid, Desc: *qdesc}
+		a := &model.Attempt{ID: *aid, State: dm.Attempt_EXECUTING, CurExecution: 1}
+		e := model.ExecutionFromID(c, eid)
+		e.State = dm.Execution_RUNNING
+		So(ds.Put(q, a, e), ShouldBeNil)
+
+		re := &RetryExecution{aid, 1, clock.Now(c).UTC().Add(time.Minute)}
+
+		Convey("Root", func() {
+			So(re.Root(c).String(), ShouldEqual, fmt.Sprintf(`dev~app::/Attempt,"%s|fffffffe"`, qid))
+		})
+
+		Convey("FinishExecution schedules a delayed retry", func() {
+			fe := NewFinishExecutionAbnormal(eid, dm.AbnormalFinish_CRASHED, "boom")
+			muts, err := fe.RollForward(c)
+			So(err, ShouldBeNil)
+			So(muts, ShouldResemble, []tumble.Mutation{re})
+
+			So(ds.Get(a), ShouldBeNil)
+			So(a.State, ShouldEqual, dm.Attempt_SCHEDULING)
+			So(a.RetryState.Crashed, ShouldEqual, 1)
+
+			Convey("which backs off exponentially", func() {
+				a.RetryState.Crashed = 1
+				a.CurExecution = 2
+				a.State = dm.Attempt_EXECUTING
+				e2 := model.ExecutionFromID(c, dm.NewExecutionID(qid, 1, 2))
+				e2.State = dm.Execution_RUNNING
+				So(ds.Put(a, e2), ShouldBeNil)
+
+				fe := NewFinishExecutionAbnormal(e2.GetEID(), dm.AbnormalFinish_CRASHED, "boom")
+				muts, err := fe.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldResemble, []tumble.Mutation{
+					&RetryExecution{aid, 2, clock.Now(c).UTC().Add(2 * time.Minute)}})
+			})
+
+			Convey("and then schedules the Attempt", func() {
+				muts, err := re.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeNil)
+
+				So(ds.Get(a), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_EXECUTING)
+				So(a.CurExecution, ShouldEqual, 2)
+			})
+		})
+
+		Convey("does nothing if the Attempt moved on", func() {
+			a.State = dm.Attempt_SCHEDULING
+			a.CurExecution = 2
+			So(ds.Put(a), ShouldBeNil)
+
+			muts, err := re.RollForward(c)
+			So(err, ShouldBeNil)
+			So(muts, ShouldBeNil)
+
+			So(ds.Get(a), ShouldBeNil)
+			So(a.State, ShouldEqual, dm.Attempt_SCHEDULING)
+			So(a.CurExecution, ShouldEqual, 2)
+		})
+	})
+}
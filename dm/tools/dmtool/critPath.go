@@ -0,0 +1,93 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/maruel/subcommands"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/cli"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/logging"
+)
+
+var cmdCritPath = &subcommands.Command{
+	UsageLine: `critpath [options] QUEST[:N]`,
+	ShortDesc: "Computes the critical path of a DM attempt's dependencies.",
+	LongDesc: `This command loads the full dependency graph of the given attempt,
+	and prints the chain of dependencies which finished last, along with how
+	long each spent overall and in executions. Unfinished attempts are timed up
+	until now.`,
+	CommandRun: func() subcommands.CommandRun {
+		r := &critPathRun{}
+		r.registerOptions()
+		return r
+	},
+}
+
+type critPathRun struct {
+	rpcRun
+}
+
+func (r *critPathRun) registerOptions() {
+	r.registerRPCOptions()
+}
+
+func (r *critPathRun) Run(a subcommands.Application, args []string) int {
+	r.cmd = cmdCritPath
+
+	c, cancel := context.WithCancel(cli.GetContext(a, r))
+	defer cancel()
+	cancelOnSignal(cancel)
+
+	if len(args) != 1 {
+		return r.argErr("expected exactly one attempt, got %d", len(args))
+	}
+	al, err := parseAttemptList(args)
+	if err != nil {
+		return r.argErr("%s", err)
+	}
+	aids := attemptIDs(al)
+	if len(aids) != 1 {
+		return r.argErr("expected exactly one attempt, got %d", len(aids))
+	}
+
+	dc, err := r.depsClient(c)
+	if err != nil {
+		logging.WithError(err).Errorf(c, "could not create client")
+		return 1
+	}
+	gdata, err := walkDeps(c, dc, al, -1)
+	if err != nil {
+		logging.WithError(err).Errorf(c, "error running query")
+		return 1
+	}
+
+	path := gdata.CriticalPath(aids[0], clock.Now(c))
+	if len(path) == 0 {
+		logging.Errorf(c, "%s:%d does not exist", aids[0].Quest, aids[0].Id)
+		return 1
+	}
+
+	base := path[0].Start
+	tw := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "ATTEMPT\tSTART\tELAPSED\tEXECUTING")
+	for _, s := range path {
+		fmt.Fprintf(tw, "%s:%d\t+%s\t%s\t%s\n", s.ID.Quest, s.ID.Id,
+			roundDur(s.Start.Sub(base)), roundDur(s.Elapsed()), roundDur(s.Executing))
+	}
+	fmt.Fprintf(tw, "total\t\t%s\t\n", roundDur(path[0].Elapsed()))
+	tw.Flush()
+	return 0
+}
+
+func roundDur(d time.Duration) time.Duration {
+	return d - d%time.Second
+}
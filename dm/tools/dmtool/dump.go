@@ -0,0 +1,160 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/maruel/subcommands"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/cli"
+	"github.com/luci/luci-go/common/flag/stringlistflag"
+	"github.com/luci/luci-go/common/logging"
+	dm "github.com/luci/luci-go/dm/api/service/v1"
+)
+
+var cmdDump = &subcommands.Command{
+	UsageLine: `dump [options] QUEST[:N[,N...]]...`,
+	ShortDesc: "Dumps the dependency graph of DM attempts as DOT or JSON.",
+	LongDesc: `This command walks the dependencies of the given attempts and writes
+	the resulting graph in DOT or JSON form. A bare QUEST means attempt 1.
+
+	The graph may be narrowed with -state (e.g. -state EXECUTING), -quest
+	(a path.Match pattern on quest IDs) and -depth (number of dependency hops
+	from the given attempts). -state and -quest may be repeated.`,
+	CommandRun: func() subcommands.CommandRun {
+		r := &dumpRun{}
+		r.registerOptions()
+		return r
+	},
+}
+
+type dumpRun struct {
+	rpcRun
+	format string
+	path   string
+	states stringlistflag.Flag
+	quests stringlistflag.Flag
+	depth  int64
+}
+
+func (r *dumpRun) registerOptions() {
+	r.registerRPCOptions()
+	r.Flags.StringVar(&r.format, "format", "dot",
+		"The output format, either 'dot' or 'json'.")
+	r.Flags.StringVar(&r.path, "path", "",
+		"The output path. Leave empty to print to stdout.")
+	r.Flags.Var(&r.states, "state",
+		"Only include attempts in this state. May be repeated.")
+	r.Flags.Var(&r.quests, "quest",
+		"Only include quests whose ID matches this pattern. May be repeated.")
+	r.Flags.Int64Var(&r.depth, "depth", -1,
+		"The number of dependency hops to include; 0 means just the given attempts and -1 means no limit.")
+}
+
+// parseStates converts attempt state names (case-insensitively) to
+// Attempt_States.
+func parseStates(names []string) ([]dm.Attempt_State, error) {
+	ret := make([]dm.Attempt_State, 0, len(names))
+	for _, n := range names {
+		v, ok := dm.Attempt_State_value[strings.ToUpper(n)]
+		if !ok {
+			return nil, fmt.Errorf("unknown attempt state %q", n)
+		}
+		ret = append(ret, dm.Attempt_State(v))
+	}
+	return ret, nil
+}
+
+// walkDeps loads the graph of FwdDeps reachable within maxDepth hops of al.
+func walkDeps(c context.Context, dc dm.DepsClient, al *dm.AttemptList, maxDepth int64) (*dm.GraphData, error) {
+	gdata, err := runQuery(c, dc, &dm.WalkGraphReq{
+		Query:   dm.AttemptListQuery(al),
+		Limit:   &dm.WalkGraphReq_Limit{MaxDepth: maxDepth},
+		Include: dm.MakeWalkGraphIncludeAll(),
+	})
+	if err == nil && gdata.HadErrors {
+		err = fmt.Errorf("WalkGraph returned errors")
+	}
+	return gdata, err
+}
+
+func (r *dumpRun) Run(a subcommands.Application, args []string) int {
+	r.cmd = cmdDump
+
+	c, cancel := context.WithCancel(cli.GetContext(a, r))
+	defer cancel()
+	cancelOnSignal(cancel)
+
+	if r.format != "dot" && r.format != "json" {
+		return r.argErr("unknown format %q", r.format)
+	}
+	if len(args) == 0 {
+		return r.argErr("expected at least one attempt")
+	}
+	al, err := parseAttemptList(args)
+	if err != nil {
+		return r.argErr("%s", err)
+	}
+	states, err := parseStates(r.states)
+	if err != nil {
+		return r.argErr("%s", err)
+	}
+
+	dc, err := r.depsClient(c)
+	if err != nil {
+		logging.WithError(err).Errorf(c, "could not create client")
+		return 1
+	}
+	gdata, err := walkDeps(c, dc, al, r.depth)
+	if err != nil {
+		logging.WithError(err).Errorf(c, "error running query")
+		return 1
+	}
+
+	gdata, err = gdata.Filter(&dm.GraphFilter{
+		States:        states,
+		QuestPatterns: r.quests,
+		MaxDepth:      r.depth,
+		Roots:         al,
+	})
+	if err != nil {
+		return r.argErr("%s", err)
+	}
+
+	out := io.Writer(os.Stdout)
+	if r.path != "" {
+		f, err := os.Create(r.path)
+		if err != nil {
+			logging.Fields{
+				logging.ErrorKey: err,
+				"outfile":        r.path,
+			}.Errorf(c, "error opening output file")
+			return 1
+		}
+		defer f.Close()
+		out = f
+	}
+
+	switch r.format {
+	case "dot":
+		_, err = io.WriteString(out, renderDotFile(gdata))
+	case "json":
+		err = (&jsonpb.Marshaler{Indent: "  "}).Marshal(out, gdata)
+		if err == nil {
+			_, err = io.WriteString(out, "\n")
+		}
+	}
+	if err != nil {
+		logging.WithError(err).Errorf(c, "error writing output")
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,286 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/maruel/subcommands"
+	"gopkg.in/yaml.v2"
+
+	"github.com/luci/luci-go/common/cli"
+	"github.com/luci/luci-go/common/flag/stringmapflag"
+	"github.com/luci/luci-go/common/logging"
+	google_pb "github.com/luci/luci-go/common/proto/google"
+	dm "github.com/luci/luci-go/dm/api/service/v1"
+)
+
+var cmdEnsure = &subcommands.Command{
+	UsageLine: `ensure [options] template.yaml`,
+	ShortDesc: "Ensures that the quests and attempts in a YAML template exist.",
+	LongDesc: `This command reads a YAML file describing quests and their attempts,
+	and makes a single EnsureGraphData call for all of them. Occurrences of
+	${name} in the file are replaced by the value of -var name=value before
+	parsing.
+
+	The file looks like:
+
+	  quests:
+	  - distributor: swarming
+	    parameters: {target: ${target}}
+	    distributor_parameters: {...}
+	    meta:
+	      retry: {failed: 2, backoff: 30s, max_backoff: 10m}
+	      timeouts: {start: 10m, run: 1h, stop: 5m}
+	    attempts: [1]`,
+	CommandRun: func() subcommands.CommandRun {
+		r := &ensureRun{}
+		r.registerOptions()
+		return r
+	},
+}
+
+type ensureRun struct {
+	rpcRun
+	vars   stringmapflag.Value
+	dryRun bool
+}
+
+func (r *ensureRun) registerOptions() {
+	r.registerRPCOptions()
+	r.Flags.Var(&r.vars, "var",
+		"A `name=value` substitution for ${name} in the template. May be repeated.")
+	r.Flags.BoolVar(&r.dryRun, "dry-run", false,
+		"Print the EnsureGraphDataReq as JSON instead of sending it.")
+}
+
+// ensureTemplate is the YAML schema for the ensure subcommand.
+type ensureTemplate struct {
+	Quests []struct {
+		Distributor           string      `yaml:"distributor"`
+		Parameters            interface{} `yaml:"parameters"`
+		DistributorParameters interface{} `yaml:"distributor_parameters"`
+		Meta                  struct {
+			AsAccount string `yaml:"as_account"`
+			Retry     struct {
+				Failed     uint32 `yaml:"failed"`
+				Crashed    uint32 `yaml:"crashed"`
+				Expired    uint32 `yaml:"expired"`
+				TimedOut   uint32 `yaml:"timed_out"`
+				Rejected   uint32 `yaml:"rejected"`
+				Backoff    string `yaml:"backoff"`
+				MaxBackoff string `yaml:"max_backoff"`
+			} `yaml:"retry"`
+			Timeouts struct {
+				Start string `yaml:"start"`
+				Run   string `yaml:"run"`
+				Stop  string `yaml:"stop"`
+			} `yaml:"timeouts"`
+		} `yaml:"meta"`
+		Attempts []uint32 `yaml:"attempts"`
+	} `yaml:"quests"`
+}
+
+var templateVarRe = regexp.MustCompile(`\$\{([a-zA-Z_][a-zA-Z0-9_]*)\}`)
+
+// expandVars replaces all ${name} occurrences in data with vars[name]. It's an
+// error for data to refer to a variable which isn't in vars.
+func expandVars(data []byte, vars map[string]string) ([]byte, error) {
+	missing := ""
+	ret := templateVarRe.ReplaceAllFunc(data, func(m []byte) []byte {
+		name := string(templateVarRe.FindSubmatch(m)[1])
+		val, ok := vars[name]
+		if !ok {
+			if missing == "" {
+				missing = name
+			}
+			return m
+		}
+		return []byte(val)
+	})
+	if missing != "" {
+		return nil, fmt.Errorf("template refers to undefined variable %q", missing)
+	}
+	return ret, nil
+}
+
+// toJSON renders a YAML-decoded value as a JSON string, defaulting to an empty
+// object. YAML maps decode with interface{} keys, which encoding/json can't
+// handle, so they're converted first.
+func toJSON(v interface{}) (string, error) {
+	if v == nil {
+		return "{}", nil
+	}
+	var fix func(interface{}) (interface{}, error)
+	fix = func(v interface{}) (interface{}, error) {
+		switch x := v.(type) {
+		case map[interface{}]interface{}:
+			ret := make(map[string]interface{}, len(x))
+			for k, v := range x {
+				ks, ok := k.(string)
+				if !ok {
+					return nil, fmt.Errorf("non-string key %v (try quoting it)", k)
+				}
+				var err error
+				if ret[ks], err = fix(v); err != nil {
+					return nil, err
+				}
+			}
+			return ret, nil
+		case []interface{}:
+			ret := make([]interface{}, len(x))
+			for i, v := range x {
+				var err error
+				if ret[i], err = fix(v); err != nil {
+					return nil, err
+				}
+			}
+			return ret, nil
+		}
+		return v, nil
+	}
+	v, err := fix(v)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(v)
+	return string(data), err
+}
+
+func parseDuration(s string) (*google_pb.Duration, error) {
+	if s == "" {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return nil, err
+	}
+	return google_pb.NewDuration(d), nil
+}
+
+// buildEnsureReq converts the YAML template into an EnsureGraphDataReq.
+func buildEnsureReq(data []byte, vars map[string]string) (*dm.EnsureGraphDataReq, error) {
+	data, err := expandVars(data, vars)
+	if err != nil {
+		return nil, err
+	}
+	tmpl := &ensureTemplate{}
+	if err := yaml.Unmarshal(data, tmpl); err != nil {
+		return nil, err
+	}
+	if len(tmpl.Quests) == 0 {
+		return nil, fmt.Errorf("template contains no quests")
+	}
+
+	ret := &dm.EnsureGraphDataReq{}
+	attempts := map[string][]uint32{}
+	for i, q := range tmpl.Quests {
+		desc := &dm.Quest_Desc{DistributorConfigName: q.Distributor}
+		if desc.Parameters, err = toJSON(q.Parameters); err != nil {
+			return nil, fmt.Errorf("quest %d: parameters: %s", i, err)
+		}
+		if desc.DistributorParameters, err = toJSON(q.DistributorParameters); err != nil {
+			return nil, fmt.Errorf("quest %d: distributor_parameters: %s", i, err)
+		}
+
+		m := q.Meta
+		desc.Meta = &dm.Quest_Desc_Meta{
+			AsAccount: m.AsAccount,
+			Retry: &dm.Quest_Desc_Meta_Retry{
+				Failed:   m.Retry.Failed,
+				Crashed:  m.Retry.Crashed,
+				Expired:  m.Retry.Expired,
+				TimedOut: m.Retry.TimedOut,
+				Rejected: m.Retry.Rejected,
+			},
+			Timeouts: &dm.Quest_Desc_Meta_Timeouts{},
+		}
+		re, to := desc.Meta.Retry, desc.Meta.Timeouts
+		for _, t := range []struct {
+			name string
+			val  string
+			dst  **google_pb.Duration
+		}{
+			{"retry.backoff", m.Retry.Backoff, &re.Backoff},
+			{"retry.max_backoff", m.Retry.MaxBackoff, &re.MaxBackoff},
+			{"timeouts.start", m.Timeouts.Start, &to.Start},
+			{"timeouts.run", m.Timeouts.Run, &to.Run},
+			{"timeouts.stop", m.Timeouts.Stop, &to.Stop},
+		} {
+			if *t.dst, err = parseDuration(t.val); err != nil {
+				return nil, fmt.Errorf("quest %d: %s: %s", i, t.name, err)
+			}
+		}
+
+		if err := desc.Normalize(); err != nil {
+			return nil, fmt.Errorf("quest %d: %s", i, err)
+		}
+		ret.Quest = append(ret.Quest, desc)
+
+		aids := q.Attempts
+		if len(aids) == 0 {
+			aids = []uint32{1}
+		}
+		qid := desc.QuestID()
+		attempts[qid] = append(attempts[qid], aids...)
+	}
+	ret.Attempts = dm.NewAttemptList(attempts)
+	return ret, nil
+}
+
+func (r *ensureRun) Run(a subcommands.Application, args []string) int {
+	r.cmd = cmdEnsure
+	c := cli.GetContext(a, r)
+
+	if len(args) != 1 {
+		return r.argErr("expected exactly one template file, got %d", len(args))
+	}
+
+	data, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		logging.WithError(err).Errorf(c, "could not read template")
+		return 1
+	}
+	req, err := buildEnsureReq(data, r.vars)
+	if err != nil {
+		logging.WithError(err).Errorf(c, "could not build request")
+		return 1
+	}
+
+	if r.dryRun {
+		if err := (&jsonpb.Marshaler{Indent: "  "}).Marshal(os.Stdout, req); err != nil {
+			logging.WithError(err).Errorf(c, "could not render request")
+			return 1
+		}
+		fmt.Println()
+		return 0
+	}
+
+	dc, err := r.depsClient(c)
+	if err != nil {
+		logging.WithError(err).Errorf(c, "could not create client")
+		return 1
+	}
+	rsp, err := dc.EnsureGraphData(c, req)
+	if err != nil {
+		logging.WithError(err).Errorf(c, "error running EnsureGraphData")
+		return 1
+	}
+	if !rsp.Accepted {
+		logging.Errorf(c, "EnsureGraphData was not accepted")
+		return 1
+	}
+
+	for _, aid := range attemptIDs(req.Attempts) {
+		fmt.Printf("%s:%d\n", aid.Quest, aid.Id)
+	}
+	return 0
+}
@@ -7,10 +7,13 @@ package main
 import (
 	"fmt"
 	"os"
+	"os/signal"
 
 	"github.com/maruel/subcommands"
 	"golang.org/x/net/context"
 
+	"github.com/luci/luci-go/client/authcli"
+	"github.com/luci/luci-go/common/auth"
 	"github.com/luci/luci-go/common/cli"
 	"github.com/luci/luci-go/common/logging/gologger"
 )
@@ -38,6 +41,17 @@ func (r *cmdRun) argErr(format string, a ...interface{}) int {
 	return -1
 }
 
+// cancelOnSignal calls cancel when the process receives an interrupt.
+func cancelOnSignal(cancel context.CancelFunc) {
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt)
+	go func() {
+		<-sigChan
+		signal.Stop(sigChan)
+		cancel()
+	}()
+}
+
 var application = &cli.Application{
 	Name:  "dmtool",
 	Title: "Dungeon Master CLI tool",
@@ -47,6 +61,13 @@ var application = &cli.Application{
 	Commands: []*subcommands.Command{
 		cmdHashQuest,
 		cmdVisQuery,
+		cmdEnsure,
+		cmdWatch,
+		cmdDump,
+		cmdCritPath,
+		authcli.SubcommandLogin(auth.Options{}, "login"),
+		authcli.SubcommandLogout(auth.Options{}, "logout"),
+		authcli.SubcommandInfo(auth.Options{}, "whoami"),
 		subcommands.CmdHelp,
 	},
 }
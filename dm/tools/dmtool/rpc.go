@@ -0,0 +1,92 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/client/authcli"
+	"github.com/luci/luci-go/common/auth"
+	dm "github.com/luci/luci-go/dm/api/service/v1"
+	"github.com/luci/luci-go/grpc/prpc"
+)
+
+// rpcRun is a base of subcommands which talk to a DM service.
+type rpcRun struct {
+	cmdRun
+	host      string
+	authFlags authcli.Flags
+}
+
+func (r *rpcRun) registerRPCOptions() {
+	r.Flags.StringVar(&r.host, "host", ":8080",
+		"The host to connect to")
+	r.authFlags.Register(&r.Flags, auth.Options{})
+}
+
+// depsClient returns a DepsClient for r.host. Connections to localhost are
+// made insecurely and without authentication.
+func (r *rpcRun) depsClient(c context.Context) (dm.DepsClient, error) {
+	client := &prpc.Client{
+		Host:    r.host,
+		Options: prpc.DefaultOptions(),
+	}
+	if isLocalHost(r.host) {
+		client.Options.Insecure = true
+	} else {
+		opts, err := r.authFlags.Options()
+		if err != nil {
+			return nil, err
+		}
+		// OptionalLogin allows anonymous access to servers which permit it.
+		if client.C, err = auth.NewAuthenticator(c, auth.OptionalLogin, opts).Client(); err != nil {
+			return nil, err
+		}
+	}
+	return dm.NewDepsPRPCClient(client), nil
+}
+
+// parseAttemptList parses a list of attempt specs into an AttemptList.
+//
+// Each spec is either `QUEST_ID:N[,N...]`, naming specific attempts of a quest,
+// or a bare `QUEST_ID`, which is the same as `QUEST_ID:1`.
+func parseAttemptList(specs []string) (*dm.AttemptList, error) {
+	ret := map[string][]uint32{}
+	for _, spec := range specs {
+		qid, nums := spec, "1"
+		if idx := strings.IndexRune(spec, ':'); idx != -1 {
+			qid, nums = spec[:idx], spec[idx+1:]
+		}
+		if qid == "" {
+			return nil, fmt.Errorf("bad attempt spec %q: empty quest id", spec)
+		}
+		for _, tok := range strings.Split(nums, ",") {
+			aid, err := strconv.ParseUint(tok, 10, 32)
+			if err != nil || aid == 0 {
+				return nil, fmt.Errorf("bad attempt spec %q: bad attempt number %q", spec, tok)
+			}
+			ret[qid] = append(ret[qid], uint32(aid))
+		}
+	}
+	return dm.NewAttemptList(ret), nil
+}
+
+// attemptIDs returns all of the Attempt_IDs contained in al.
+func attemptIDs(al *dm.AttemptList) []*dm.Attempt_ID {
+	ret := []*dm.Attempt_ID(nil)
+	for qid, nums := range al.GetTo() {
+		if nums == nil {
+			continue
+		}
+		for _, aid := range nums.Nums {
+			ret = append(ret, dm.NewAttemptID(qid, aid))
+		}
+	}
+	return ret
+}
@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
-	"os/signal"
 	"sort"
 	"strings"
 	"sync"
@@ -25,7 +24,6 @@ import (
 	"github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/common/system/ctxcmd"
 	dm "github.com/luci/luci-go/dm/api/service/v1"
-	"github.com/luci/luci-go/grpc/prpc"
 	"github.com/maruel/subcommands"
 )
 
@@ -42,16 +40,14 @@ var cmdVisQuery = &subcommands.Command{
 }
 
 type visQueryRun struct {
-	cmdRun
-	host       string
+	rpcRun
 	path       string
 	sequence   bool
 	includeAll bool
 }
 
 func (r *visQueryRun) registerOptions() {
-	r.Flags.StringVar(&r.host, "host", ":8080",
-		"The host to connect to")
+	r.registerRPCOptions()
 	r.Flags.StringVar(&r.path, "path", "",
 		"The output path for the .dot file. Leave empty to query once and print the result stdout.")
 	r.Flags.BoolVar(&r.sequence, "sequence", false,
@@ -114,7 +110,7 @@ func getColor(a *dm.Attempt) string {
 
 func nameFor(qid string) string {
 	alphabetLock.Lock()
-	defer alphabetLock.Lock()
+	defer alphabetLock.Unlock()
 	if curName, ok := alphabetMap[qid]; ok {
 		return curName.String()
 	}
@@ -179,7 +175,7 @@ func renderDotFile(gdata *dm.GraphData) string {
 
 func runQuery(c context.Context, dc dm.DepsClient, query *dm.WalkGraphReq) (ret *dm.GraphData, err error) {
 	query = proto.Clone(query).(*dm.WalkGraphReq)
-	ret = &dm.GraphData{}
+	ret = &dm.GraphData{Quests: map[string]*dm.Quest{}}
 	for c.Err() == nil {
 		newRet := (*dm.GraphData)(nil)
 		newRet, err = dc.WalkGraph(c, query)
@@ -217,13 +213,7 @@ func (r *visQueryRun) Run(a subcommands.Application, args []string) int {
 		return r.argErr("path is required for sequence")
 	}
 
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, os.Interrupt)
-	go func() {
-		<-sigChan
-		signal.Stop(sigChan)
-		cancel()
-	}()
+	cancelOnSignal(cancel)
 
 	query := &dm.WalkGraphReq{}
 	if err := flagpb.UnmarshalMessage(args, flagpb.NewResolver(dm.FileDescriptorSet()), query); err != nil {
@@ -234,12 +224,11 @@ func (r *visQueryRun) Run(a subcommands.Application, args []string) int {
 		query.Include = dm.MakeWalkGraphIncludeAll()
 	}
 
-	client := &prpc.Client{
-		Host:    r.host,
-		Options: prpc.DefaultOptions(),
+	dc, err := r.depsClient(c)
+	if err != nil {
+		logging.WithError(err).Errorf(c, "could not create client")
+		return 1
 	}
-	client.Options.Insecure = isLocalHost(r.host)
-	dc := dm.NewDepsPRPCClient(client)
 
 	prev := ""
 	seq := 0
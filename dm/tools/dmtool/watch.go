@@ -0,0 +1,126 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"time"
+
+	"github.com/maruel/subcommands"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/cli"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	dm "github.com/luci/luci-go/dm/api/service/v1"
+)
+
+var cmdWatch = &subcommands.Command{
+	UsageLine: `watch [options] QUEST[:N[,N...]]...`,
+	ShortDesc: "Waits for DM attempts to finish.",
+	LongDesc: `This command polls the given attempts, logging each state change,
+	until all of them are in a terminal state. A bare QUEST means attempt 1.
+
+	Exits 0 if every attempt FINISHED, 2 if any ended ABNORMAL_FINISHED and 1
+	on errors.`,
+	CommandRun: func() subcommands.CommandRun {
+		r := &watchRun{}
+		r.registerOptions()
+		return r
+	},
+}
+
+type watchRun struct {
+	rpcRun
+	interval time.Duration
+	timeout  time.Duration
+}
+
+func (r *watchRun) registerOptions() {
+	r.registerRPCOptions()
+	r.Flags.DurationVar(&r.interval, "interval", 5*time.Second,
+		"How often to poll DM.")
+	r.Flags.DurationVar(&r.timeout, "timeout", 0,
+		"Give up after this long. 0 means wait forever.")
+}
+
+func (r *watchRun) Run(a subcommands.Application, args []string) int {
+	r.cmd = cmdWatch
+
+	c, cancel := context.WithCancel(cli.GetContext(a, r))
+	defer cancel()
+	if r.timeout > 0 {
+		c, cancel = clock.WithTimeout(c, r.timeout)
+		defer cancel()
+	}
+	cancelOnSignal(cancel)
+
+	if len(args) == 0 {
+		return r.argErr("expected at least one attempt")
+	}
+	al, err := parseAttemptList(args)
+	if err != nil {
+		return r.argErr("%s", err)
+	}
+
+	dc, err := r.depsClient(c)
+	if err != nil {
+		logging.WithError(err).Errorf(c, "could not create client")
+		return 1
+	}
+
+	query := &dm.WalkGraphReq{
+		Query: dm.AttemptListQuery(al),
+		Limit: &dm.WalkGraphReq_Limit{MaxDepth: 0},
+		Include: &dm.WalkGraphReq_Include{
+			Attempt: &dm.WalkGraphReq_Include_Options{Data: true, Abnormal: true},
+		},
+	}
+
+	states := map[string]dm.Attempt_State{}
+	for {
+		gdata, err := dc.WalkGraph(c, query)
+		if err != nil {
+			if errors.Contains(err, context.Canceled) || errors.Contains(err, context.DeadlineExceeded) {
+				logging.Errorf(c, "gave up waiting")
+			} else {
+				logging.WithError(err).Errorf(c, "error running WalkGraph")
+			}
+			return 1
+		}
+
+		done, abnormal := true, false
+		for _, aid := range attemptIDs(al) {
+			a := gdata.Quests[aid.Quest].GetAttempts()[aid.Id]
+			if a == nil || a.DNE {
+				logging.Errorf(c, "%s:%d does not exist", aid.Quest, aid.Id)
+				return 1
+			}
+
+			key := aid.DMEncoded()
+			st := a.Data.State()
+			if prev, ok := states[key]; !ok || prev != st {
+				states[key] = st
+				logging.Infof(c, "%s:%d is %s", aid.Quest, aid.Id, st)
+			}
+			if !st.Terminal() {
+				done = false
+			} else if st == dm.Attempt_ABNORMAL_FINISHED {
+				abnormal = true
+			}
+		}
+		if done {
+			if abnormal {
+				return 2
+			}
+			return 0
+		}
+
+		if tr := clock.Sleep(c, r.interval); tr.Incomplete() {
+			logging.Errorf(c, "gave up waiting")
+			return 1
+		}
+	}
+}
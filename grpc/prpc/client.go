@@ -26,6 +26,7 @@ import (
 	"github.com/luci/luci-go/common/errors"
 	"github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/common/retry"
+	"github.com/luci/luci-go/common/trace"
 	"github.com/luci/luci-go/grpc/grpcutil"
 )
 
@@ -130,6 +131,9 @@ func (c *Client) Call(ctx context.Context, serviceName, methodName string, in, o
 //
 // If there is a Deadline applied to the Context, it will be forwarded to the
 // server using the HeaderTimeout header.
+//
+// The call is recorded as a client trace span, which is propagated to the
+// server using the traceparent header.
 func (c *Client) CallRaw(ctx context.Context, serviceName, methodName string, in []byte, inf, outf Format,
 	opts ...grpc.CallOption) ([]byte, error) {
 	options, err := c.renderOptions(opts)
@@ -144,6 +148,14 @@ func (c *Client) CallRaw(ctx context.Context, serviceName, methodName string, in
 		"method":  methodName,
 	})
 
+	ctx, span := trace.StartSpan(ctx, fmt.Sprintf("prpc.Client/%s.%s", serviceName, methodName), trace.SpanKindClient)
+	defer span.End()
+	span.SetAttribute("rpc.system", "prpc")
+	span.SetAttribute("rpc.service", serviceName)
+	span.SetAttribute("rpc.method", methodName)
+	span.SetAttribute("net.peer.name", c.Host)
+	trace.Inject(ctx, req.Header)
+
 	// Send the request in a retry loop.
 	var buf bytes.Buffer
 	var contentType string
@@ -262,6 +274,7 @@ func (c *Client) CallRaw(ctx context.Context, serviceName, methodName string, in
 	// https://github.com/grpc/grpc-go/issues/494
 	if err != nil {
 		logging.WithError(err).Warningf(ctx, "RPC failed permanently: %s", err)
+		span.SetError(err)
 		return nil, errors.Unwrap(err)
 	}
 
@@ -25,6 +25,7 @@ import (
 	"github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/common/logging/memlogger"
 	"github.com/luci/luci-go/common/retry"
+	"github.com/luci/luci-go/common/trace"
 
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -143,6 +144,24 @@ func TestClient(t *testing.T) {
 				So(log, shouldHaveMessagesLike, expectedCallLogEntry(client))
 			})
 
+			Convey("Propagates the trace span", func(c C) {
+				var traceparent string
+				client, server := setUp(func(w http.ResponseWriter, r *http.Request) {
+					traceparent = r.Header.Get(trace.TraceparentHeader)
+					sayHello(c)(w, r)
+				})
+				defer server.Close()
+
+				ctx, parent := trace.StartSpan(ctx, "parent", trace.SpanKindInternal)
+				err := client.Call(ctx, "prpc.Greeter", "SayHello", req, res)
+				So(err, ShouldBeNil)
+
+				sc, err := trace.ParseTraceparent(traceparent)
+				So(err, ShouldBeNil)
+				So(sc.TraceID, ShouldEqual, parent.SpanContext().TraceID)
+				So(sc.SpanID, ShouldNotEqual, parent.SpanContext().SpanID)
+			})
+
 			Convey("With a deadline <= now, does not execute.", func(c C) {
 				client, server := setUp(doPanicHandler)
 				defer server.Close()
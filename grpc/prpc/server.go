@@ -17,6 +17,7 @@ import (
 
 	"github.com/luci/luci-go/common/errors"
 	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/trace"
 	"github.com/luci/luci-go/server/auth"
 	"github.com/luci/luci-go/server/router"
 )
@@ -163,7 +164,24 @@ func (s *Server) InstallHandlers(r *router.Router, base router.MiddlewareChain)
 func (s *Server) handlePOST(c *router.Context) {
 	serviceName := c.Params.ByName("service")
 	methodName := c.Params.ByName("method")
+
+	// The span is a child of the request span if the router has the tracing
+	// middleware, otherwise of the caller's span.
+	if trace.SpanFromContext(c.Context) == nil {
+		c.Context = trace.Extract(c.Context, c.Request.Header)
+	}
+	var span *trace.Span
+	c.Context, span = trace.StartSpan(c.Context, fmt.Sprintf("prpc.Server/%s.%s", serviceName, methodName), trace.SpanKindServer)
+	defer span.End()
+	span.SetAttribute("rpc.system", "prpc")
+	span.SetAttribute("rpc.service", serviceName)
+	span.SetAttribute("rpc.method", methodName)
+
 	res := s.respond(c.Context, c.Writer, c.Request, serviceName, methodName)
+	span.SetAttribute("rpc.grpc.status_code", int(res.code))
+	if res.code != codes.OK {
+		span.SetStatus(trace.StatusError, res.code.String())
+	}
 
 	c.Context = logging.SetFields(c.Context, logging.Fields{
 		"service": serviceName,
@@ -15,6 +15,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
+	"github.com/luci/luci-go/common/trace"
 	"github.com/luci/luci-go/server/auth"
 	"github.com/luci/luci-go/server/router"
 
@@ -41,6 +42,16 @@ func (s *calcService) Multiply(c context.Context, req *MultiplyRequest) (*Multip
 	}, nil
 }
 
+type spanCollector struct {
+	spans []*trace.SpanData
+}
+
+func (e *spanCollector) ExportSpan(c context.Context, s *trace.SpanData) {
+	e.spans = append(e.spans, s)
+}
+
+func (e *spanCollector) Flush(c context.Context) error { return nil }
+
 func TestServer(t *testing.T) {
 	t.Parallel()
 
@@ -86,6 +97,24 @@ func TestServer(t *testing.T) {
 				So(res.Body.String(), ShouldEqual, "message: \"Hello Lucy\"\n")
 			})
 
+			Convey("Records a trace span", func() {
+				spans := &spanCollector{}
+				c = trace.SetExporter(c, spans)
+				req.Header.Set("Accept", mtPRPCText)
+				req.Header.Set(trace.TraceparentHeader, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+				r.ServeHTTP(res, req)
+				So(res.Code, ShouldEqual, http.StatusOK)
+
+				So(len(spans.spans), ShouldEqual, 1)
+				span := spans.spans[0]
+				So(span.Name, ShouldEqual, "prpc.Server/prpc.Greeter.SayHello")
+				So(span.Kind, ShouldEqual, trace.SpanKindServer)
+				So(span.TraceID.String(), ShouldEqual, "4bf92f3577b34da6a3ce929d0e0e4736")
+				So(span.ParentSpanID.String(), ShouldEqual, "00f067aa0ba902b7")
+				So(span.Attributes["rpc.grpc.status_code"], ShouldEqual, 0)
+				So(span.StatusCode, ShouldEqual, trace.StatusUnset)
+			})
+
 			Convey("Invalid Accept header", func() {
 				req.Header.Set("Accept", "blah")
 				r.ServeHTTP(res, req)
@@ -14,6 +14,7 @@ It is generated from these files:
 
 It has these top-level messages:
 	ArchiveIndexConfig
+	ArchiveConfig
 	Config
 	Coordinator
 	Collector
@@ -39,6 +40,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// Type is the type of blob store.
+type ArchiveConfig_Type int32
+
+const (
+	// Google Cloud Storage. This is the default.
+	ArchiveConfig_GCS ArchiveConfig_Type = 0
+	// A local filesystem directory. Each bucket is a subdirectory of
+	// "local_root".
+	ArchiveConfig_LOCAL ArchiveConfig_Type = 1
+	// An S3-compatible object store accessed over HTTP.
+	ArchiveConfig_S3 ArchiveConfig_Type = 2
+)
+
+var ArchiveConfig_Type_name = map[int32]string{
+	0: "GCS",
+	1: "LOCAL",
+	2: "S3",
+}
+var ArchiveConfig_Type_value = map[string]int32{
+	"GCS":   0,
+	"LOCAL": 1,
+	"S3":    2,
+}
+
+func (x ArchiveConfig_Type) String() string {
+	return proto.EnumName(ArchiveConfig_Type_name, int32(x))
+}
+func (ArchiveConfig_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 0} }
+
 // ArchiveIndexConfig specifies how archive indexes should be generated.
 //
 // By default, each log entry will be present in the index. This is generally
@@ -58,8 +88,53 @@ func (m *ArchiveIndexConfig) String() string            { return proto.CompactTe
 func (*ArchiveIndexConfig) ProtoMessage()               {}
 func (*ArchiveIndexConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
 
+// ArchiveConfig specifies the blob store that archived logs are written to.
+//
+// The archive and staging bucket names are interpreted by the selected store.
+type ArchiveConfig struct {
+	Type ArchiveConfig_Type `protobuf:"varint,1,opt,name=type,enum=svcconfig.ArchiveConfig_Type" json:"type,omitempty"`
+	// The root directory for the LOCAL blob store.
+	LocalRoot string `protobuf:"bytes,2,opt,name=local_root,json=localRoot" json:"local_root,omitempty"`
+	// The configuration for the S3 blob store.
+	S3 *ArchiveConfig_S3Config `protobuf:"bytes,3,opt,name=s3" json:"s3,omitempty"`
+}
+
+func (m *ArchiveConfig) Reset()                    { *m = ArchiveConfig{} }
+func (m *ArchiveConfig) String() string            { return proto.CompactTextString(m) }
+func (*ArchiveConfig) ProtoMessage()               {}
+func (*ArchiveConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *ArchiveConfig) GetS3() *ArchiveConfig_S3Config {
+	if m != nil {
+		return m.S3
+	}
+	return nil
+}
+
+// S3Config is the configuration for an S3-compatible object store.
+type ArchiveConfig_S3Config struct {
+	// The base URL of the object store (e.g., "https://s3.example.com").
+	// Buckets are addressed path-style.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint" json:"endpoint,omitempty"`
+	// The region to use when signing requests. If empty, "us-east-1" will be
+	// used.
+	Region string `protobuf:"bytes,2,opt,name=region" json:"region,omitempty"`
+	// Path to a JSON file containing the "access_key_id" and
+	// "secret_access_key" used to sign requests. If empty, requests will not be
+	// signed.
+	CredentialsPath string `protobuf:"bytes,3,opt,name=credentials_path,json=credentialsPath" json:"credentials_path,omitempty"`
+}
+
+func (m *ArchiveConfig_S3Config) Reset()                    { *m = ArchiveConfig_S3Config{} }
+func (m *ArchiveConfig_S3Config) String() string            { return proto.CompactTextString(m) }
+func (*ArchiveConfig_S3Config) ProtoMessage()               {}
+func (*ArchiveConfig_S3Config) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 0} }
+
 func init() {
 	proto.RegisterType((*ArchiveIndexConfig)(nil), "svcconfig.ArchiveIndexConfig")
+	proto.RegisterType((*ArchiveConfig)(nil), "svcconfig.ArchiveConfig")
+	proto.RegisterType((*ArchiveConfig_S3Config)(nil), "svcconfig.ArchiveConfig.S3Config")
+	proto.RegisterEnum("svcconfig.ArchiveConfig_Type", ArchiveConfig_Type_name, ArchiveConfig_Type_value)
 }
 
 func init() {
@@ -67,16 +142,26 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 171 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0x72, 0x4a, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0xcf, 0x29, 0x4d, 0xce, 0x04, 0x13, 0xba, 0xe9, 0xf9,
-	0xfa, 0x39, 0xf9, 0xe9, 0x29, 0xf9, 0xe9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0xc9, 0xf9, 0x79, 0x69,
-	0x99, 0xe9, 0xfa, 0xc5, 0x65, 0xc9, 0x50, 0x56, 0x62, 0x51, 0x72, 0x46, 0x66, 0x59, 0x62, 0x8e,
-	0x5e, 0x41, 0x51, 0x7e, 0x49, 0xbe, 0x10, 0x27, 0x5c, 0x46, 0xa9, 0x92, 0x4b, 0xc8, 0x11, 0x2c,
-	0x99, 0xea, 0x99, 0x97, 0x92, 0x5a, 0xe1, 0x0c, 0x16, 0x15, 0x52, 0xe4, 0xe2, 0x29, 0x2e, 0x29,
-	0x4a, 0x4d, 0xcc, 0x8d, 0x2f, 0x4a, 0xcc, 0x4b, 0x4f, 0x95, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x0d,
-	0xe2, 0x86, 0x88, 0x05, 0x81, 0x84, 0x40, 0x4a, 0x0a, 0x8a, 0x52, 0xd3, 0x32, 0x2b, 0xa0, 0x4a,
-	0x98, 0x20, 0x4a, 0x20, 0x62, 0x10, 0x25, 0xb2, 0x5c, 0x5c, 0x49, 0x95, 0x25, 0xa9, 0x50, 0x05,
-	0xcc, 0x60, 0x05, 0x9c, 0x20, 0x11, 0xb0, 0x74, 0x12, 0x1b, 0xd8, 0x31, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x06, 0x73, 0xbd, 0x23, 0xd2, 0x00, 0x00, 0x00,
+	// 324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x91, 0x4d, 0x4b, 0xc3, 0x40,
+	0x10, 0x86, 0x6d, 0xfa, 0x61, 0x33, 0xf5, 0x23, 0xec, 0x41, 0xa4, 0x50, 0xb0, 0x39, 0xe9, 0xc1,
+	0x84, 0x36, 0xbf, 0xa0, 0xf6, 0x20, 0x42, 0x41, 0xd9, 0x7a, 0x2f, 0xdb, 0x64, 0x9b, 0x2c, 0xa4,
+	0x3b, 0xcb, 0x66, 0x5b, 0x9a, 0xdf, 0xe3, 0x1f, 0x95, 0x6c, 0xd6, 0xa2, 0x07, 0x2f, 0x61, 0xe6,
+	0x99, 0x87, 0xbc, 0x6f, 0x08, 0xbc, 0xe4, 0xc2, 0x14, 0x87, 0x6d, 0x94, 0xe2, 0x3e, 0x2e, 0x0f,
+	0xa9, 0xb0, 0x8f, 0xe7, 0x1c, 0xe3, 0x12, 0xf3, 0x0c, 0xf3, 0x98, 0x29, 0x11, 0xa7, 0x28, 0x77,
+	0x22, 0x8f, 0xab, 0x63, 0xea, 0x26, 0xa6, 0xd3, 0x42, 0x1c, 0x59, 0x19, 0x29, 0x8d, 0x06, 0x89,
+	0x7f, 0xbe, 0x84, 0x35, 0x90, 0x85, 0x3d, 0xf2, 0x37, 0x99, 0xf1, 0xd3, 0xd2, 0x52, 0x32, 0x85,
+	0xab, 0xca, 0x68, 0xce, 0xf6, 0x1b, 0xcd, 0x64, 0xce, 0xef, 0x3b, 0x0f, 0x9d, 0xc7, 0x3e, 0x1d,
+	0xb5, 0x8c, 0x36, 0xa8, 0x51, 0x94, 0xe6, 0x3b, 0x71, 0x72, 0x8a, 0xd7, 0x2a, 0x2d, 0x6b, 0x95,
+	0x09, 0xc0, 0xb6, 0x36, 0xdc, 0x09, 0x5d, 0x2b, 0xf8, 0x0d, 0xb1, 0xe7, 0xf0, 0xcb, 0x83, 0x6b,
+	0x97, 0xed, 0x62, 0x67, 0xd0, 0x33, 0xb5, 0x6a, 0xe3, 0x6e, 0xe6, 0x93, 0xe8, 0x5c, 0x33, 0xfa,
+	0xe3, 0x45, 0x9f, 0xb5, 0xe2, 0xd4, 0xaa, 0x4d, 0x46, 0x89, 0x29, 0x2b, 0x37, 0x1a, 0xd1, 0xd8,
+	0x12, 0x3e, 0xf5, 0x2d, 0xa1, 0x88, 0x86, 0xcc, 0xc0, 0xab, 0x12, 0x1b, 0x3d, 0x9a, 0x4f, 0xff,
+	0x7d, 0xdf, 0x3a, 0x69, 0x07, 0xea, 0x55, 0xc9, 0x58, 0xc0, 0xf0, 0x67, 0x27, 0x63, 0x18, 0x72,
+	0x99, 0x29, 0x14, 0xd2, 0xd8, 0x52, 0x3e, 0x3d, 0xef, 0xe4, 0x0e, 0x06, 0x9a, 0xe7, 0x02, 0xa5,
+	0x4b, 0x75, 0x1b, 0x79, 0x82, 0x20, 0xd5, 0x3c, 0xe3, 0xd2, 0x08, 0x56, 0x56, 0x1b, 0xc5, 0x4c,
+	0x61, 0x0b, 0xf8, 0xf4, 0xf6, 0x17, 0xff, 0x60, 0xa6, 0x08, 0x43, 0xe8, 0x35, 0x9f, 0x42, 0x2e,
+	0xa1, 0xfb, 0xba, 0x5c, 0x07, 0x17, 0xc4, 0x87, 0xfe, 0xea, 0x7d, 0xb9, 0x58, 0x05, 0x1d, 0x32,
+	0x00, 0x6f, 0x9d, 0x04, 0xde, 0x76, 0x60, 0x7f, 0x59, 0xf2, 0x3d, 0x00, 0xf8, 0x51, 0xa4, 0x4e,
+	0xf8, 0x01, 0x00, 0x00,
 }
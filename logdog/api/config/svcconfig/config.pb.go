@@ -209,6 +209,9 @@ type Archivist struct {
 	// Streams without an explicit binary file extension will default to ".bin" if
 	// this is enabled.
 	RenderAllStreams bool `protobuf:"varint,13,opt,name=render_all_streams,json=renderAllStreams" json:"render_all_streams,omitempty"`
+	// The blob store that archived logs are written to. If not specified, logs
+	// will be archived to Google Cloud Storage.
+	ArchiveConfig *ArchiveConfig `protobuf:"bytes,14,opt,name=archive_config,json=archiveConfig" json:"archive_config,omitempty"`
 }
 
 func (m *Archivist) Reset()                    { *m = Archivist{} }
@@ -223,6 +226,13 @@ func (m *Archivist) GetArchiveIndexConfig() *ArchiveIndexConfig {
 	return nil
 }
 
+func (m *Archivist) GetArchiveConfig() *ArchiveConfig {
+	if m != nil {
+		return m.ArchiveConfig
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Config)(nil), "svcconfig.Config")
 	proto.RegisterType((*Coordinator)(nil), "svcconfig.Coordinator")
@@ -235,46 +245,48 @@ func init() {
 }
 
 var fileDescriptor1 = []byte{
-	// 655 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x54, 0xdb, 0x4e, 0x13, 0x41,
-	0x18, 0x4e, 0x41, 0xd0, 0x4e, 0x39, 0xb4, 0x63, 0xc1, 0x95, 0x44, 0x24, 0xf5, 0x86, 0x18, 0xdd,
-	0x26, 0x98, 0x18, 0x2f, 0x2d, 0x05, 0x8d, 0x31, 0x84, 0x64, 0x4b, 0xe2, 0xe5, 0x64, 0x3a, 0x3b,
-	0x4c, 0x27, 0x6c, 0x77, 0x36, 0x33, 0xb3, 0x50, 0x7d, 0x06, 0xaf, 0x7c, 0x50, 0xe3, 0x23, 0x38,
-	0xa7, 0x3d, 0x24, 0x5c, 0x90, 0x70, 0x03, 0xed, 0x77, 0xea, 0xcc, 0xff, 0xfd, 0xbb, 0xe0, 0x33,
-	0xe3, 0x7a, 0x51, 0xce, 0x63, 0x22, 0x96, 0xe3, 0xac, 0x24, 0xdc, 0xfd, 0x79, 0xcf, 0xc4, 0x38,
-	0x13, 0x2c, 0x15, 0x6c, 0x8c, 0x0b, 0x3e, 0x26, 0x22, 0xbf, 0xe6, 0x6c, 0xac, 0x6e, 0x49, 0xf8,
-	0xe4, 0xff, 0xc5, 0x85, 0x14, 0x5a, 0xc0, 0x6e, 0x8d, 0x1f, 0x9c, 0x3e, 0x26, 0x0c, 0x4b, 0xb2,
-	0xe0, 0xb7, 0x38, 0xf3, 0x71, 0x07, 0x93, 0xc7, 0x64, 0x28, 0x2d, 0x24, 0x66, 0x34, 0x44, 0x4c,
-	0x1f, 0x13, 0xa1, 0x25, 0xce, 0x55, 0x21, 0xa4, 0x0e, 0x21, 0x87, 0x4c, 0x08, 0x96, 0xd1, 0xb1,
-	0xfb, 0x36, 0x2f, 0xaf, 0xc7, 0x69, 0x29, 0xb1, 0xe6, 0x22, 0xf7, 0xfc, 0xe8, 0xf7, 0x1a, 0xd8,
-	0x9c, 0x3a, 0x2b, 0x3c, 0x01, 0xdd, 0xda, 0x1d, 0x81, 0xa3, 0xce, 0x71, 0xef, 0x64, 0x18, 0xd7,
-	0xc9, 0xf1, 0x55, 0xc5, 0x25, 0x8d, 0x0c, 0xbe, 0x03, 0x4f, 0xc3, 0xa1, 0xa3, 0x9e, 0x73, 0xc0,
-	0x96, 0x63, 0xe6, 0x99, 0xa4, 0x92, 0xc0, 0x4f, 0xa0, 0x47, 0x84, 0x90, 0x29, 0xcf, 0xb1, 0x41,
-	0xa2, 0xa1, 0x73, 0xec, 0xb7, 0x1c, 0xd3, 0x86, 0x4d, 0xda, 0x52, 0x7b, 0x36, 0x22, 0xb2, 0x8c,
-	0x12, 0xeb, 0xdb, 0xbb, 0x77, 0xb6, 0x69, 0xc5, 0x25, 0x8d, 0xcc, 0x7a, 0x7c, 0x29, 0x5c, 0xe9,
-	0x68, 0xff, 0x9e, 0x67, 0x52, 0x71, 0x49, 0x23, 0x1b, 0xfd, 0x59, 0x07, 0xbd, 0xd6, 0x21, 0xe0,
-	0x31, 0xe8, 0xe3, 0x74, 0xc9, 0x73, 0x84, 0x4b, 0xbd, 0x40, 0x4c, 0x8a, 0xb2, 0x70, 0xa3, 0xe9,
-	0x26, 0x3b, 0x0e, 0x9f, 0x18, 0xf8, 0xab, 0x45, 0xcd, 0x24, 0xa0, 0xa2, 0xf2, 0x96, 0x13, 0xda,
-	0xd6, 0xf6, 0x9c, 0xb6, 0x1f, 0x98, 0x46, 0xfd, 0x16, 0x0c, 0x64, 0x41, 0x10, 0xce, 0x32, 0x71,
-	0x87, 0x84, 0xe4, 0x8c, 0xe7, 0xca, 0xcc, 0x63, 0xdd, 0x88, 0x77, 0x0d, 0x31, 0xb1, 0xf8, 0xa5,
-	0x87, 0xe1, 0x17, 0x30, 0x28, 0x24, 0xbd, 0xe6, 0x2b, 0x44, 0x57, 0x05, 0xf7, 0xed, 0x85, 0x19,
-	0xbc, 0x8c, 0x7d, 0xbd, 0x71, 0x55, 0x6f, 0x7c, 0x16, 0xea, 0x4d, 0xfa, 0xde, 0x73, 0x5e, 0x5b,
-	0xe0, 0x1b, 0xb0, 0xed, 0x2f, 0x4a, 0x91, 0x16, 0x05, 0x27, 0xd1, 0xa1, 0x3b, 0xdc, 0x56, 0x00,
-	0xaf, 0x2c, 0x06, 0xbf, 0x83, 0x61, 0x25, 0x52, 0x54, 0xeb, 0x8c, 0xa2, 0x94, 0x66, 0xf8, 0x67,
-	0xf4, 0xfa, 0xa1, 0xdf, 0x83, 0xc1, 0x36, 0x73, 0xae, 0x33, 0x6b, 0x82, 0xe7, 0x60, 0x50, 0x85,
-	0xb9, 0x14, 0xb4, 0xc4, 0xab, 0xe8, 0xe8, 0xa1, 0xa4, 0xdd, 0xe0, 0x71, 0x19, 0x17, 0x78, 0x35,
-	0xfa, 0xdb, 0x01, 0xdd, 0xba, 0x61, 0xf8, 0x11, 0xbc, 0x30, 0x31, 0xc8, 0xb4, 0x48, 0x4a, 0x29,
-	0x69, 0xae, 0xd1, 0x92, 0x2a, 0x65, 0xd6, 0x4b, 0x45, 0x1d, 0x13, 0xbd, 0x91, 0xec, 0x19, 0x7a,
-	0x5a, 0xb3, 0x17, 0x81, 0x84, 0x31, 0x78, 0x6e, 0x7d, 0x41, 0x8c, 0xee, 0x84, 0xbc, 0xa1, 0x52,
-	0x45, 0x6b, 0xce, 0x33, 0x30, 0x54, 0x50, 0xfe, 0xf0, 0x84, 0xad, 0x5e, 0x69, 0xac, 0x29, 0x22,
-	0x98, 0x2c, 0xcc, 0x34, 0xf8, 0x2f, 0x1a, 0xad, 0x3b, 0xf1, 0x8e, 0xc3, 0xa7, 0x16, 0x9e, 0x19,
-	0x14, 0x5e, 0x82, 0xfd, 0xb6, 0xb2, 0xd5, 0xd2, 0x93, 0x87, 0xee, 0x3a, 0x6c, 0xa2, 0x9a, 0xa6,
-	0x46, 0xff, 0xcc, 0x85, 0xeb, 0xf5, 0x84, 0x23, 0xb0, 0xa5, 0xca, 0xb9, 0x22, 0x92, 0x17, 0x2e,
-	0xb4, 0xe3, 0x6b, 0x6b, 0x63, 0x70, 0x08, 0x36, 0x34, 0x56, 0x37, 0xd5, 0x75, 0xfc, 0x17, 0xbb,
-	0x65, 0x4c, 0x21, 0xf3, 0x13, 0x66, 0x8f, 0x18, 0x9a, 0x97, 0xe4, 0x86, 0x6a, 0x77, 0x07, 0xb3,
-	0x65, 0x4c, 0xcd, 0x3c, 0x7e, 0xea, 0x60, 0x73, 0x89, 0xba, 0x78, 0x9e, 0xa7, 0xd4, 0x0d, 0xd8,
-	0x3c, 0x26, 0xe1, 0x45, 0xf0, 0xea, 0xde, 0x83, 0x43, 0xbf, 0x59, 0x95, 0x7f, 0x75, 0xd4, 0xe5,
-	0xb7, 0x30, 0xfb, 0x40, 0x98, 0xf9, 0xa7, 0x54, 0xda, 0x2d, 0x37, 0x87, 0x90, 0x14, 0x2f, 0x55,
-	0xb4, 0x6d, 0xe2, 0x9e, 0x25, 0x7d, 0xcf, 0x98, 0x35, 0x9f, 0x79, 0x7c, 0xbe, 0xe9, 0x66, 0xf3,
-	0xe1, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x11, 0xee, 0x77, 0xc9, 0x05, 0x00, 0x00,
+	// 679 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x54, 0xdb, 0x4e, 0xdb, 0x3c,
+	0x1c, 0x57, 0xe1, 0x83, 0x6f, 0x75, 0xa1, 0xb4, 0x5e, 0x61, 0x19, 0xd2, 0x58, 0xd5, 0xdd, 0x54,
+	0x13, 0x4b, 0x25, 0x26, 0x4d, 0xbb, 0xdb, 0x4a, 0x61, 0xd3, 0x34, 0x21, 0xa4, 0x14, 0x69, 0x97,
+	0x96, 0xeb, 0xba, 0xae, 0x45, 0x12, 0x47, 0xb6, 0x03, 0x19, 0xcf, 0xb0, 0xab, 0xbd, 0xc9, 0x5e,
+	0x6c, 0xcf, 0x30, 0xf9, 0x90, 0x83, 0xd4, 0x0b, 0x24, 0x6e, 0xa0, 0xf9, 0x9d, 0xfe, 0xb6, 0x7f,
+	0x4e, 0xc0, 0x67, 0xc6, 0xf5, 0x3a, 0x5f, 0x84, 0x44, 0x24, 0x93, 0x38, 0x27, 0xdc, 0xfe, 0x79,
+	0xc7, 0xc4, 0x24, 0x16, 0x6c, 0x29, 0xd8, 0x04, 0x67, 0x7c, 0x42, 0x44, 0xba, 0xe2, 0x6c, 0xa2,
+	0xee, 0x88, 0xff, 0xe5, 0xfe, 0x85, 0x99, 0x14, 0x5a, 0xc0, 0x76, 0x85, 0x1f, 0x9f, 0x3f, 0x25,
+	0x0c, 0x4b, 0xb2, 0xe6, 0x77, 0x38, 0x76, 0x71, 0xc7, 0xd3, 0xa7, 0x64, 0x28, 0x2d, 0x24, 0x66,
+	0xd4, 0x47, 0xcc, 0x9e, 0x12, 0xa1, 0x25, 0x4e, 0x55, 0x26, 0xa4, 0xf6, 0x21, 0x27, 0x4c, 0x08,
+	0x16, 0xd3, 0x89, 0x7d, 0x5a, 0xe4, 0xab, 0xc9, 0x32, 0x97, 0x58, 0x73, 0x91, 0x3a, 0x7e, 0xf4,
+	0x6b, 0x0b, 0xec, 0xce, 0xac, 0x15, 0x9e, 0x81, 0x76, 0xe5, 0x0e, 0xc0, 0xb0, 0x35, 0xee, 0x9c,
+	0x0d, 0xc2, 0x2a, 0x39, 0xbc, 0x29, 0xb9, 0xa8, 0x96, 0xc1, 0x53, 0xf0, 0xbf, 0x5f, 0x74, 0xd0,
+	0xb1, 0x0e, 0xd8, 0x70, 0xcc, 0x1d, 0x13, 0x95, 0x12, 0xf8, 0x11, 0x74, 0x88, 0x10, 0x72, 0xc9,
+	0x53, 0xac, 0x85, 0x0c, 0x06, 0xd6, 0x71, 0xd4, 0x70, 0xcc, 0x6a, 0x36, 0x6a, 0x4a, 0xcd, 0xda,
+	0x88, 0x88, 0x63, 0x4a, 0x8c, 0xef, 0x70, 0x63, 0x6d, 0xb3, 0x92, 0x8b, 0x6a, 0x99, 0xf1, 0xb8,
+	0x52, 0xb8, 0xd2, 0xc1, 0xd1, 0x86, 0x67, 0x5a, 0x72, 0x51, 0x2d, 0x1b, 0xfd, 0xde, 0x06, 0x9d,
+	0xc6, 0x22, 0xe0, 0x18, 0xf4, 0xf0, 0x32, 0xe1, 0x29, 0xc2, 0xb9, 0x5e, 0x23, 0x26, 0x45, 0x9e,
+	0xd9, 0xa3, 0x69, 0x47, 0x5d, 0x8b, 0x4f, 0x73, 0xbd, 0xfe, 0x6a, 0x50, 0x78, 0x0a, 0xa0, 0xa2,
+	0xf2, 0x8e, 0x13, 0xda, 0xd4, 0x76, 0xac, 0xb6, 0xe7, 0x99, 0x5a, 0xfd, 0x16, 0xf4, 0x65, 0x46,
+	0x10, 0x8e, 0x63, 0x71, 0x8f, 0x84, 0xe4, 0x8c, 0xa7, 0x2a, 0x18, 0x0c, 0xb7, 0xc7, 0xed, 0xe8,
+	0x40, 0x66, 0x64, 0x6a, 0xf0, 0x6b, 0x07, 0xc3, 0x2f, 0xa0, 0x9f, 0x49, 0xba, 0xe2, 0x05, 0xa2,
+	0x45, 0xc6, 0x5d, 0x7b, 0xfe, 0x0c, 0x5e, 0x86, 0xae, 0xde, 0xb0, 0xac, 0x37, 0xbc, 0xf0, 0xf5,
+	0x46, 0x3d, 0xe7, 0xb9, 0xac, 0x2c, 0xf0, 0x0d, 0xd8, 0x77, 0x1b, 0xa5, 0x48, 0x8b, 0x8c, 0x93,
+	0xe0, 0xc4, 0x2e, 0x6e, 0xcf, 0x83, 0x37, 0x06, 0x83, 0xdf, 0xc1, 0xa0, 0x14, 0x29, 0xaa, 0x75,
+	0x4c, 0xd1, 0x92, 0xc6, 0xf8, 0x67, 0xf0, 0xfa, 0xb1, 0x79, 0xd0, 0xdb, 0xe6, 0xd6, 0x75, 0x61,
+	0x4c, 0xf0, 0x12, 0xf4, 0xcb, 0x30, 0x9b, 0x82, 0x12, 0x5c, 0x04, 0xc3, 0xc7, 0x92, 0x0e, 0xbc,
+	0xc7, 0x66, 0x5c, 0xe1, 0x62, 0xf4, 0xb7, 0x05, 0xda, 0x55, 0xc3, 0xf0, 0x03, 0x78, 0x91, 0xe0,
+	0x02, 0x11, 0x91, 0x92, 0x5c, 0x4a, 0x9a, 0x6a, 0x94, 0x50, 0xa5, 0x30, 0xa3, 0x2a, 0x68, 0x0d,
+	0x5b, 0xe3, 0x9d, 0xe8, 0x30, 0xc1, 0xc5, 0xac, 0x62, 0xaf, 0x3c, 0x09, 0x43, 0xf0, 0xdc, 0xf8,
+	0xbc, 0x18, 0xdd, 0x0b, 0x79, 0x4b, 0xa5, 0x0a, 0xb6, 0xac, 0xa7, 0x9f, 0xe0, 0xc2, 0x2b, 0x7f,
+	0x38, 0xc2, 0x54, 0xaf, 0x34, 0xd6, 0x14, 0x11, 0x4c, 0xd6, 0x14, 0x29, 0xfe, 0x40, 0x83, 0x6d,
+	0x2b, 0xee, 0x5a, 0x7c, 0x66, 0xe0, 0x39, 0x7f, 0xa0, 0xf0, 0x1a, 0x1c, 0x35, 0x95, 0x8d, 0x96,
+	0xfe, 0x7b, 0x6c, 0xaf, 0x83, 0x3a, 0xaa, 0x6e, 0x6a, 0xf4, 0x67, 0x0b, 0xb4, 0xab, 0xeb, 0x09,
+	0x47, 0x60, 0x4f, 0xe5, 0x0b, 0x45, 0x24, 0xcf, 0x6c, 0x68, 0xcb, 0xd5, 0xd6, 0xc4, 0xe0, 0x00,
+	0xec, 0x68, 0xac, 0x6e, 0xcb, 0xed, 0xb8, 0x07, 0x73, 0xcb, 0x98, 0x42, 0x4a, 0x63, 0xc6, 0x53,
+	0x86, 0x16, 0x39, 0xb9, 0xa5, 0xda, 0xee, 0xa1, 0x1d, 0x1d, 0x30, 0x35, 0x77, 0xf8, 0xb9, 0x85,
+	0xe1, 0x75, 0x5d, 0x3c, 0x4f, 0x97, 0xd4, 0x1e, 0xf0, 0x8a, 0x33, 0xff, 0x21, 0x78, 0xb5, 0xf1,
+	0xe2, 0xd0, 0x6f, 0x46, 0xe5, 0x3e, 0x1d, 0x55, 0xf9, 0x0d, 0xcc, 0xbc, 0x10, 0x92, 0xa6, 0x4b,
+	0x2a, 0xcd, 0x2d, 0x47, 0x4a, 0x4b, 0x8a, 0x13, 0x15, 0xec, 0x0f, 0x5b, 0xe3, 0x67, 0x51, 0xcf,
+	0x31, 0xd3, 0x38, 0x9e, 0x3b, 0x1c, 0x7e, 0x02, 0xdd, 0x72, 0xbc, 0x1f, 0xdc, 0xb5, 0x83, 0x83,
+	0xcd, 0xc1, 0x7e, 0xe6, 0x3e, 0x6e, 0x3e, 0x2e, 0x76, 0xed, 0xe1, 0xbe, 0xff, 0x37, 0x00, 0xac,
+	0x29, 0xf7, 0xc9, 0x0a, 0x06, 0x00, 0x00,
 }
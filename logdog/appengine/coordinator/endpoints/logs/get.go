@@ -16,6 +16,7 @@ import (
 	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/logs/v1"
 	"github.com/luci/luci-go/logdog/api/logpb"
 	"github.com/luci/luci-go/logdog/appengine/coordinator"
+	"github.com/luci/luci-go/logdog/common/blobstore"
 	"github.com/luci/luci-go/logdog/common/storage"
 	"github.com/luci/luci-go/logdog/common/storage/archive"
 	"github.com/luci/luci-go/logdog/common/types"
@@ -153,26 +154,31 @@ func (s *server) getLogs(c context.Context, req *logdog.GetRequest, tail bool, l
 			"archiveTime": lst.ArchivedTime,
 		}.Debugf(c, "Log is archived. Fetching from archive storage.")
 
-		var err error
-		gs, err := svc.GSClient(c)
+		cfg, err := svc.Config(c)
+		if err != nil {
+			log.WithError(err).Errorf(c, "Failed to load service configuration.")
+			return nil, err
+		}
+
+		client, err := blobstore.NewArchiveReadClient(c, cfg.GetArchivist().GetArchiveConfig(), svc.GSClient)
 		if err != nil {
-			log.WithError(err).Errorf(c, "Failed to create Google Storage client.")
+			log.WithError(err).Errorf(c, "Failed to create blob store client.")
 			return nil, err
 		}
 		defer func() {
-			if err := gs.Close(); err != nil {
-				log.WithError(err).Warningf(c, "Failed to close Google Storage client.")
+			if err := client.Close(); err != nil {
+				log.WithError(err).Warningf(c, "Failed to close blob store client.")
 			}
 		}()
 
 		st, err = archive.New(c, archive.Options{
 			IndexURL:  lst.ArchiveIndexURL,
 			StreamURL: lst.ArchiveStreamURL,
-			Client:    gs,
+			Client:    client,
 			MaxBytes:  byteLimit,
 		})
 		if err != nil {
-			log.WithError(err).Errorf(c, "Failed to create Google Storage storage instance.")
+			log.WithError(err).Errorf(c, "Failed to create archive storage instance.")
 			return nil, err
 		}
 	}
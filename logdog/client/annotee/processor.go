@@ -105,6 +105,10 @@ type Options struct {
 
 	// CloseSteps specified whether outstanding open steps must be closed.
 	CloseSteps bool
+
+	// Summary, if not nil, receives a summary of the annotation state whenever
+	// a step changes structurally and once processing has finished.
+	Summary SummaryWriter
 }
 
 // Processor consumes data from a list of Stream entries and interacts with the
@@ -311,6 +315,8 @@ func (p *Processor) Finish() *annotation.State {
 		p.annotationStream = nil
 	}
 
+	// Write our final summary.
+	p.writeSummary(p.astate.RootStep().Proto())
 	return p.astate
 }
 
@@ -358,16 +364,32 @@ func (p *Processor) createStream(name types.StreamName, archetype *streamproto.F
 func (p *Processor) annotationStateUpdated(ut annotation.UpdateType) {
 	// Serialize our annotation state immediately, as the Step's internal state
 	// may change in future annotation processing iterations.
-	data, err := proto.Marshal(p.astate.RootStep().Proto())
+	root := p.astate.RootStep().Proto()
+	data, err := proto.Marshal(root)
 	if err != nil {
 		log.WithError(err).Errorf(p.ctx, "Failed to marshal state.")
 		return
 	}
+	if ut == annotation.UpdateStructural {
+		p.writeSummary(root)
+	}
 
 	// Send the data to our meter for transmission.
 	p.annotationC <- annotationSignal{data, ut}
 }
 
+// writeSummary writes a summary of the supplied root step to the configured
+// SummaryWriter, if one is configured. Failures are logged, but are otherwise
+// ignored, since the summary is not critical to processing.
+func (p *Processor) writeSummary(root *milo.Step) {
+	if p.o.Summary == nil {
+		return
+	}
+	if err := p.o.Summary.WriteSummary(root); err != nil {
+		log.WithError(err).Warningf(p.ctx, "Failed to write annotation summary.")
+	}
+}
+
 func (p *Processor) runAnnotationMeter(s streamclient.Stream, interval time.Duration) {
 	defer close(p.annotationFinishedC)
 
@@ -0,0 +1,120 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package annotee
+
+import (
+	"io"
+
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/logdog/client/annotee/annotation"
+	"github.com/luci/luci-go/logdog/common/types"
+	"golang.org/x/net/context"
+)
+
+// ReplayOptions are the configuration options for Replay.
+type ReplayOptions struct {
+	// Base is the base log stream name. This is used to derive the names of the
+	// steps' log streams.
+	Base types.StreamName
+
+	// Execution, if not nil, describes the execution that generated the log.
+	Execution *annotation.Execution
+
+	// Summary, if not nil, receives a summary of the annotation state whenever
+	// a step changes structurally and once the replay has finished.
+	Summary SummaryWriter
+
+	// CloseSteps specified whether outstanding open steps must be closed.
+	CloseSteps bool
+
+	// BufferSize is the size of the read buffer. If <= 0, DefaultBufferSize will
+	// be used.
+	BufferSize int
+}
+
+// Replay reconstructs annotation step state from an existing annotated log.
+//
+// The log is read from r until EOF. Non-annotation lines are ignored. Since
+// the log was generated at some point in the past, replay is performed
+// offline: step times are derived solely from CURRENT_TIMESTAMP annotations.
+//
+// Replay returns the finished annotation state.
+func Replay(c context.Context, r io.Reader, o ReplayOptions) (*annotation.State, error) {
+	rc := replayCallbacks{
+		Context: c,
+		o:       &o,
+	}
+	st := annotation.State{
+		LogNameBase: o.Base,
+		Callbacks:   &rc,
+		Execution:   o.Execution,
+		Offline:     true,
+	}
+	rc.st = &st
+
+	bufferSize := o.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+
+	lr := newLineReader(r, bufferSize)
+	for lineNo := 1; ; lineNo++ {
+		line, err := lr.readLine()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		a := extractAnnotation(line)
+		if a == "" {
+			continue
+		}
+		if err := st.Append(a); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"line":       lineNo,
+				"annotation": a,
+			}.Warningf(c, "Failed to process annotation.")
+		}
+	}
+
+	if o.CloseSteps {
+		st.Finish()
+	}
+	rc.writeSummary()
+	return &st, nil
+}
+
+// replayCallbacks is an annotation.Callbacks implementation that writes
+// summaries as the replayed annotation state changes.
+type replayCallbacks struct {
+	context.Context
+
+	o  *ReplayOptions
+	st *annotation.State
+}
+
+func (rc *replayCallbacks) StepClosed(*annotation.Step) {}
+
+func (rc *replayCallbacks) Updated(step *annotation.Step, ut annotation.UpdateType) {
+	if ut == annotation.UpdateStructural {
+		rc.writeSummary()
+	}
+}
+
+func (rc *replayCallbacks) StepLogLine(*annotation.Step, types.StreamName, string, string) {}
+
+func (rc *replayCallbacks) StepLogEnd(*annotation.Step, types.StreamName) {}
+
+func (rc *replayCallbacks) writeSummary() {
+	if rc.o.Summary == nil {
+		return
+	}
+	if err := rc.o.Summary.WriteSummary(rc.st.RootStep().Proto()); err != nil {
+		log.WithError(err).Warningf(rc, "Failed to write annotation summary.")
+	}
+}
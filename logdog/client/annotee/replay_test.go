@@ -0,0 +1,158 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package annotee
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/luci/luci-go/common/proto/milo"
+	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// testSummaryWriter is a SummaryWriter that records the summaries that it
+// receives.
+type testSummaryWriter struct {
+	summaries []*milo.Step
+}
+
+func (w *testSummaryWriter) WriteSummary(st *milo.Step) error {
+	w.summaries = append(w.summaries, proto.Clone(st).(*milo.Step))
+	return nil
+}
+
+const testReplayLog = `
+Starting build.
+@@@CURRENT_TIMESTAMP@1420070000@@@
+@@@SEED_STEP compile@@@
+@@@STEP_CURSOR compile@@@
+@@@CURRENT_TIMESTAMP@1420070001@@@
+@@@STEP_STARTED@@@
+Compiling...
+@@@STEP_TEXT@built 3 targets@@@
+@@@STEP_LINK@results@https://example.com/results@@@
+@@@SET_BUILD_PROPERTY@got_revision@"deadbeef"@@@
+@@@CURRENT_TIMESTAMP@1420070011@@@
+@@@STEP_CLOSED@@@
+@@@SEED_STEP test@@@
+@@@STEP_CURSOR test@@@
+@@@CURRENT_TIMESTAMP@1420070012@@@
+@@@STEP_STARTED@@@
+@@@STEP_FAILURE@@@
+@@@CURRENT_TIMESTAMP@1420070020@@@
+@@@STEP_CLOSED@@@
+Finished build.
+@@@CURRENT_TIMESTAMP@1420070021@@@
+`
+
+func TestReplay(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Replaying an annotated log`, t, func() {
+		c := context.Background()
+		tsw := testSummaryWriter{}
+
+		st, err := Replay(c, strings.NewReader(testReplayLog), ReplayOptions{
+			Base:       "base",
+			Summary:    &tsw,
+			CloseSteps: true,
+		})
+		So(err, ShouldBeNil)
+
+		Convey(`Reconstructs the step state.`, func() {
+			root := st.RootStep().Proto()
+			So(root.Status, ShouldEqual, milo.Status_FAILURE)
+			So(root.Substep, ShouldHaveLength, 2)
+
+			compile := root.Substep[0].GetStep()
+			So(compile.Name, ShouldEqual, "compile")
+			So(compile.Status, ShouldEqual, milo.Status_SUCCESS)
+			So(compile.Text, ShouldResemble, []string{"built 3 targets"})
+
+			test := root.Substep[1].GetStep()
+			So(test.Name, ShouldEqual, "test")
+			So(test.Status, ShouldEqual, milo.Status_FAILURE)
+		})
+
+		Convey(`Writes a summary on each structural change and on completion.`, func() {
+			So(len(tsw.summaries), ShouldBeGreaterThan, 1)
+
+			last := tsw.summaries[len(tsw.summaries)-1]
+			So(proto.Equal(last, st.RootStep().Proto()), ShouldBeTrue)
+		})
+
+		Convey(`Can be summarized.`, func() {
+			ss := Summarize(st.RootStep().Proto())
+			So(ss.Status, ShouldEqual, "FAILURE")
+			So(ss.DurationSecs, ShouldEqual, 21)
+			So(ss.Substeps, ShouldHaveLength, 2)
+
+			compile := ss.Substeps[0]
+			So(compile.Status, ShouldEqual, "SUCCESS")
+			So(compile.DurationSecs, ShouldEqual, 10)
+			So(compile.Properties, ShouldResemble, map[string]string{"got_revision": `"deadbeef"`})
+			So(compile.Links, ShouldResemble, []*LinkSummary{
+				{Label: "results", URL: "https://example.com/results"},
+			})
+
+			test := ss.Substeps[1]
+			So(test.Status, ShouldEqual, "FAILURE")
+			So(test.DurationSecs, ShouldEqual, 8)
+		})
+	})
+}
+
+func TestFileSummaryWriter(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A FileSummaryWriter writing to a temporary directory`, t, func() {
+		tdir, err := ioutil.TempDir("", "annotee_summary_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tdir)
+
+		st := milo.Step{
+			Name:   "steps",
+			Status: milo.Status_SUCCESS,
+			Text:   []string{"hello"},
+		}
+
+		Convey(`Can write a JSON summary.`, func() {
+			w := FileSummaryWriter{Path: filepath.Join(tdir, "summary.json"), Format: SummaryJSON}
+			So(w.WriteSummary(&st), ShouldBeNil)
+
+			d, err := ioutil.ReadFile(w.Path)
+			So(err, ShouldBeNil)
+
+			var ss StepSummary
+			So(json.Unmarshal(d, &ss), ShouldBeNil)
+			So(ss, ShouldResemble, StepSummary{Name: "steps", Status: "SUCCESS", Text: []string{"hello"}})
+		})
+
+		Convey(`Can write a binary protobuf summary, replacing the previous one.`, func() {
+			w := FileSummaryWriter{Path: filepath.Join(tdir, "summary.pb"), Format: SummaryProto}
+			So(w.WriteSummary(&milo.Step{Name: "old"}), ShouldBeNil)
+			So(w.WriteSummary(&st), ShouldBeNil)
+
+			d, err := ioutil.ReadFile(w.Path)
+			So(err, ShouldBeNil)
+
+			var rst milo.Step
+			So(proto.Unmarshal(d, &rst), ShouldBeNil)
+			So(proto.Equal(&rst, &st), ShouldBeTrue)
+
+			// Only the summary file should remain.
+			fis, err := ioutil.ReadDir(tdir)
+			So(err, ShouldBeNil)
+			So(fis, ShouldHaveLength, 1)
+		})
+	})
+}
@@ -0,0 +1,203 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package annotee
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/luci/luci-go/common/proto/milo"
+)
+
+// SummaryFormat is the format in which a step summary is written.
+type SummaryFormat int
+
+const (
+	// SummaryJSON writes the summary as a JSON StepSummary.
+	SummaryJSON SummaryFormat = iota
+	// SummaryProto writes the summary as a binary milo.Step protobuf.
+	SummaryProto
+	// SummaryTextProto writes the summary as a text milo.Step protobuf.
+	SummaryTextProto
+)
+
+// StepSummary is a machine-readable summary of a step and its substeps.
+//
+// It is derived from the step's milo.Step protobuf, flattening the parts that
+// are useful to consumers who are not interested in the full annotation
+// protocol.
+type StepSummary struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	// FailureType is the type of failure, if the step failed.
+	FailureType string `json:"failureType,omitempty"`
+	// FailureText is the failure description, if the step failed.
+	FailureText string `json:"failureText,omitempty"`
+
+	// Started is the time when the step started. It is nil if the step has not
+	// started, or if its start time is not known.
+	Started *time.Time `json:"started,omitempty"`
+	// Ended is the time when the step ended. It is nil if the step has not
+	// ended, or if its end time is not known.
+	Ended *time.Time `json:"ended,omitempty"`
+	// DurationSecs is the step's duration in seconds. It is only populated if
+	// both Started and Ended are known.
+	DurationSecs float64 `json:"durationSecs,omitempty"`
+
+	Text []string `json:"text,omitempty"`
+
+	// Stdout and Stderr are the names of the step's STDOUT and STDERR log
+	// streams.
+	Stdout string `json:"stdout,omitempty"`
+	Stderr string `json:"stderr,omitempty"`
+
+	Links      []*LinkSummary    `json:"links,omitempty"`
+	Properties map[string]string `json:"properties,omitempty"`
+
+	Substeps []*StepSummary `json:"substeps,omitempty"`
+}
+
+// LinkSummary is a summary of a single step link.
+type LinkSummary struct {
+	Label string `json:"label,omitempty"`
+	// URL is the link's URL, if it is a URL link.
+	URL string `json:"url,omitempty"`
+	// Stream is the name of the linked log stream, if it is a LogDog stream link.
+	Stream string `json:"stream,omitempty"`
+}
+
+// Summarize builds a StepSummary from the supplied step protobuf.
+func Summarize(st *milo.Step) *StepSummary {
+	ss := StepSummary{
+		Name:   st.Name,
+		Status: st.Status.String(),
+		Text:   st.Text,
+		Stdout: logdogStreamName(st.StdoutStream),
+		Stderr: logdogStreamName(st.StderrStream),
+	}
+	if fd := st.FailureDetails; fd != nil {
+		ss.FailureType = fd.Type.String()
+		ss.FailureText = fd.Text
+	}
+
+	if st.Started != nil {
+		t := st.Started.Time()
+		ss.Started = &t
+	}
+	if st.Ended != nil {
+		t := st.Ended.Time()
+		ss.Ended = &t
+	}
+	if ss.Started != nil && ss.Ended != nil {
+		ss.DurationSecs = ss.Ended.Sub(*ss.Started).Seconds()
+	}
+
+	if l := st.Link; l != nil {
+		ss.Links = append(ss.Links, summarizeLink(l))
+	}
+	for _, l := range st.OtherLinks {
+		ss.Links = append(ss.Links, summarizeLink(l))
+	}
+
+	if len(st.Property) > 0 {
+		ss.Properties = make(map[string]string, len(st.Property))
+		for _, p := range st.Property {
+			ss.Properties[p.Name] = p.Value
+		}
+	}
+
+	for _, sub := range st.Substep {
+		if s := sub.GetStep(); s != nil {
+			ss.Substeps = append(ss.Substeps, Summarize(s))
+		}
+	}
+	return &ss
+}
+
+func summarizeLink(l *milo.Link) *LinkSummary {
+	return &LinkSummary{
+		Label:  l.Label,
+		URL:    l.GetUrl(),
+		Stream: logdogStreamName(l.GetLogdogStream()),
+	}
+}
+
+func logdogStreamName(ls *milo.LogdogStream) string {
+	if ls == nil {
+		return ""
+	}
+	return ls.Name
+}
+
+// WriteSummary writes a summary of the supplied step to w in the specified
+// format.
+func WriteSummary(w io.Writer, st *milo.Step, f SummaryFormat) error {
+	switch f {
+	case SummaryJSON:
+		d, err := json.MarshalIndent(Summarize(st), "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(append(d, '\n'))
+		return err
+
+	case SummaryProto:
+		d, err := proto.Marshal(st)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(d)
+		return err
+
+	case SummaryTextProto:
+		return proto.MarshalText(w, st)
+
+	default:
+		return fmt.Errorf("unknown summary format: %v", f)
+	}
+}
+
+// SummaryWriter receives snapshots of the annotation step tree.
+type SummaryWriter interface {
+	// WriteSummary writes a summary of the supplied root step.
+	WriteSummary(*milo.Step) error
+}
+
+// FileSummaryWriter is a SummaryWriter that writes summaries to a file.
+//
+// Each summary atomically replaces the file's previous contents, so readers
+// will always observe a complete summary.
+type FileSummaryWriter struct {
+	// Path is the path of the summary file.
+	Path string
+	// Format is the format to write the summary in.
+	Format SummaryFormat
+}
+
+var _ SummaryWriter = (*FileSummaryWriter)(nil)
+
+// WriteSummary implements SummaryWriter.
+func (w *FileSummaryWriter) WriteSummary(st *milo.Step) error {
+	fd, err := ioutil.TempFile(filepath.Dir(w.Path), "."+filepath.Base(w.Path)+".")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(fd.Name()) // Will fail once renamed; that's fine.
+
+	if err := WriteSummary(fd, st, w.Format); err != nil {
+		fd.Close()
+		return err
+	}
+	if err := fd.Close(); err != nil {
+		return err
+	}
+	return os.Rename(fd.Name(), w.Path)
+}
@@ -23,6 +23,11 @@ type Environment struct {
 	// StreamServerURI is the streamserver URI. If not empty, this will be
 	// exported to subprocesses.
 	StreamServerURI string
+	// StreamServerToken is the streamserver authentication token. If not empty,
+	// this will be exported to subprocesses. If empty and StreamServerURI is
+	// set, any inherited token will be removed, since it doesn't apply to the
+	// exported stream server.
+	StreamServerToken string
 }
 
 // Augment augments the supplied base environment with LogDog Butler bootstrap
@@ -37,4 +42,8 @@ func (e *Environment) Augment(base environ.Env) {
 	exportIf(bootstrap.EnvStreamPrefix, string(e.Prefix))
 	exportIf(bootstrap.EnvStreamProject, string(e.Project))
 	exportIf(bootstrap.EnvStreamServerPath, e.StreamServerURI)
+	if e.StreamServerURI != "" && e.StreamServerToken == "" {
+		delete(base, bootstrap.EnvStreamServerToken)
+	}
+	exportIf(bootstrap.EnvStreamServerToken, e.StreamServerToken)
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/luci/luci-go/common/proto/google"
 	"github.com/luci/luci-go/common/sync/cancelcond"
 	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/client/butler/redact"
 	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
 	"github.com/luci/luci-go/logdog/common/types"
 	"golang.org/x/net/context"
@@ -45,6 +46,10 @@ type Config struct {
 	// bundled data. Other factors can cause the bundle to be sent before this,
 	// but it is an upper bound.
 	MaxBufferDelay time.Duration
+
+	// RedactionRules, if not empty, is the set of redaction rules to apply to
+	// the lines of TEXT streams before they are bundled.
+	RedactionRules []redact.Rule
 }
 
 type bundlerStream interface {
@@ -75,6 +80,12 @@ type Bundler struct {
 
 	// prefixCounter is a global counter for Prefix-wide streams.
 	prefixCounter counter
+
+	// filtersLock protects filters.
+	filtersLock sync.Mutex
+	// filters is the map of stream name to its redaction Filter. Unlike streams,
+	// entries are retained after a stream has been drained.
+	filters map[string]*redact.Filter
 }
 
 // New instantiates a new Bundler instance.
@@ -84,6 +95,7 @@ func New(c Config) *Bundler {
 		finishedC: make(chan struct{}),
 		bundleC:   make(chan *logpb.ButlerLogBundle),
 		streams:   map[string]bundlerStream{},
+		filters:   map[string]*redact.Filter{},
 	}
 	b.streamsCond = cancelcond.New(&b.streamsLock)
 
@@ -117,8 +129,13 @@ func (b *Bundler) Register(p streamproto.Properties) (Stream, error) {
 		},
 	}
 
+	var filter *redact.Filter
+	if p.StreamType == logpb.StreamType_TEXT {
+		filter = redact.NewFilter(b.c.RedactionRules)
+	}
+
 	err := error(nil)
-	c.parser, err = newParser(&p, &b.prefixCounter)
+	c.parser, err = newParser(&p, &b.prefixCounter, filter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create stream parser: %s", err)
 	}
@@ -135,9 +152,29 @@ func (b *Bundler) Register(p streamproto.Properties) (Stream, error) {
 	// will not stop until it is closed.
 	s := newStream(c)
 	b.registerStreamLocked(s)
+
+	if filter != nil {
+		b.filtersLock.Lock()
+		defer b.filtersLock.Unlock()
+		b.filters[p.Name] = filter
+	}
 	return s, nil
 }
 
+// Redactions returns a map of stream name to the number of redactions that
+// have been made in that stream. Only streams that are subject to redaction
+// are included.
+func (b *Bundler) Redactions() map[string]int64 {
+	b.filtersLock.Lock()
+	defer b.filtersLock.Unlock()
+
+	r := make(map[string]int64, len(b.filters))
+	for name, f := range b.filters {
+		r[name] = f.Redactions()
+	}
+	return r
+}
+
 // CloseAndFlush closes the Bundler, alerting it that no more streams will be
 // added and that existing data may be aggressively output.
 //
@@ -11,6 +11,7 @@ import (
 	"github.com/luci/luci-go/common/data/chunkstream"
 	"github.com/luci/luci-go/common/proto/google"
 	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/client/butler/redact"
 	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
 	"github.com/luci/luci-go/logdog/common/types"
 )
@@ -54,7 +55,9 @@ type parser interface {
 	firstChunkTime() (time.Time, bool)
 }
 
-func newParser(p *streamproto.Properties, c *counter) (parser, error) {
+// newParser instantiates a parser for the stream described by p. If f is not
+// nil, it will be used to redact the lines of TEXT streams.
+func newParser(p *streamproto.Properties, c *counter, f *redact.Filter) (parser, error) {
 	base := baseParser{
 		counter:  c,
 		timeBase: p.Timestamp.Time(),
@@ -64,6 +67,7 @@ func newParser(p *streamproto.Properties, c *counter) (parser, error) {
 	case logpb.StreamType_TEXT:
 		return &textParser{
 			baseParser: base,
+			redact:     f,
 		}, nil
 
 	case logpb.StreamType_BINARY:
@@ -10,6 +10,7 @@ import (
 	"unicode/utf8"
 
 	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/client/butler/redact"
 )
 
 const (
@@ -27,6 +28,18 @@ type textParser struct {
 
 	sequence int64
 	buf      bytes.Buffer
+
+	// redact, if not nil, is the Filter used to redact emitted lines.
+	redact *redact.Filter
+	// deferred is the redacted form of a line that was left for the next
+	// LogEntry. Redactors may be stateful, so a line must be redacted only once.
+	deferred *deferredLine
+}
+
+// deferredLine is a redacted line that didn't fit into a LogEntry.
+type deferredLine struct {
+	raw      string
+	redacted string
 }
 
 var _ parser = (*textParser)(nil)
@@ -92,37 +105,42 @@ func (p *textParser) nextEntry(c *constraints) (*logpb.LogEntry, error) {
 			}
 		}
 
-		partial := (idx < 0)
-		if !partial {
-			lineCount++
-		}
-
 		// If we didn't have a delimiter, make sure we don't terminate in the middle
 		// of a UTF8 character.
+		partial := (idx < 0)
 		if partial {
-			count := 0
-			lidx := -1
-			b := p.buf.Bytes()
-			for len(b) > 0 {
-				r, sz := utf8.DecodeRune(b)
-				count += sz
-				if r != utf8.RuneError {
-					lidx = count
-				}
-				b = b[sz:]
-			}
+			lidx := lastRuneBoundary(p.buf.Bytes())
 			if lidx < 0 {
 				break
 			}
 			p.buf.Truncate(lidx)
 		}
 
+		value := p.buf.String()
+		size := int64(len(value) + len(newline))
+		if p.redact != nil {
+			value = p.redactLine(value)
+
+			// Redaction may have grown the line beyond our limit.
+			if vlimit := limit - int64(len(newline)); int64(len(value)) > vlimit {
+				if len(txt.Lines) > 0 {
+					// Leave this line for the next LogEntry.
+					p.deferred = &deferredLine{raw: p.buf.String(), redacted: value}
+					break
+				}
+				value = string(truncateRunes([]byte(value), vlimit))
+			}
+		}
+
+		if !partial {
+			lineCount++
+		}
 		txt.Lines = append(txt.Lines, &logpb.Text_Line{
-			Value:     p.buf.String(),
+			Value:     value,
 			Delimiter: newline,
 		})
-		p.Consume(int64(p.buf.Len() + len(newline)))
-		limit -= int64(p.buf.Len() + len(newline))
+		p.Consume(size)
+		limit -= int64(len(value) + len(newline))
 	}
 
 	if len(txt.Lines) == 0 {
@@ -135,3 +153,47 @@ func (p *textParser) nextEntry(c *constraints) (*logpb.LogEntry, error) {
 	p.sequence += int64(lineCount)
 	return le, nil
 }
+
+// redactLine returns the redacted form of line, reusing the deferred
+// redaction if line is the one that was left for this LogEntry.
+func (p *textParser) redactLine(line string) string {
+	if d := p.deferred; d != nil {
+		p.deferred = nil
+		if d.raw == line {
+			return d.redacted
+		}
+	}
+	return p.redact.Redact(line)
+}
+
+// lastRuneBoundary returns the index immediately following the last complete
+// UTF8 rune in b, or -1 if b contains no complete runes.
+func lastRuneBoundary(b []byte) int {
+	count := 0
+	lidx := -1
+	for len(b) > 0 {
+		r, sz := utf8.DecodeRune(b)
+		count += sz
+		if r != utf8.RuneError {
+			lidx = count
+		}
+		b = b[sz:]
+	}
+	return lidx
+}
+
+// truncateRunes truncates b to at most limit bytes without splitting a UTF8
+// rune.
+func truncateRunes(b []byte, limit int64) []byte {
+	if limit <= 0 {
+		return nil
+	}
+	if int64(len(b)) <= limit {
+		return b
+	}
+	b = b[:limit]
+	if lidx := lastRuneBoundary(b); lidx >= 0 {
+		return b[:lidx]
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/client/butler/redact"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -22,6 +23,8 @@ type textTestCase struct {
 	increment  time.Duration
 	allowSplit bool
 	closed     bool
+	redact     []string
+	redactions int64
 	out        []textTestOutput
 }
 
@@ -175,6 +178,37 @@ func TestTextParser(t *testing.T) {
 					{seq: 5, lines: []string{"\r\n", "\n", "\n", "\r\n", "\n", "\n"}},
 				},
 			},
+
+			{
+				title:  `Will redact lines.`,
+				source: []string{"foo secret\nbar\n"},
+				redact: []string{"secret"},
+				out: []textTestOutput{
+					{lines: []string{"foo [REDACTED]\n", "bar\n"}},
+				},
+			},
+
+			{
+				title:      `Will defer a line whose redaction exceeds the limit.`,
+				source:     []string{"ab\nab\n"},
+				redact:     []string{"ab"},
+				redactions: 2,
+				limit:      14,
+				out: []textTestOutput{
+					{lines: []string{"[REDACTED]\n"}},
+					{seq: 1, lines: []string{"[REDACTED]\n"}},
+				},
+			},
+
+			{
+				title:  `Will truncate a single line whose redaction exceeds the limit.`,
+				source: []string{"ab\n"},
+				redact: []string{"ab"},
+				limit:  5,
+				out: []textTestOutput{
+					{lines: []string{"[RED\n"}},
+				},
+			},
 		} {
 			if tst.limit == 0 {
 				tst.limit = 1024
@@ -184,6 +218,13 @@ func TestTextParser(t *testing.T) {
 				p := &textParser{
 					baseParser: s.base(),
 				}
+				if len(tst.redact) > 0 {
+					rules := make([]redact.Rule, len(tst.redact))
+					for i, l := range tst.redact {
+						rules[i] = redact.Literal(l)
+					}
+					p.redact = redact.NewFilter(rules)
+				}
 				c := &constraints{
 					limit: tst.limit,
 				}
@@ -210,6 +251,10 @@ func TestTextParser(t *testing.T) {
 					le, err := p.nextEntry(c)
 					So(err, ShouldBeNil)
 					So(le, ShouldBeNil)
+
+					if tst.redactions > 0 {
+						So(p.redact.Redactions(), ShouldEqual, tst.redactions)
+					}
 				})
 			})
 		}
@@ -20,8 +20,10 @@ import (
 	"github.com/luci/luci-go/common/proto/google"
 	"github.com/luci/luci-go/common/runtime/paniccatcher"
 	"github.com/luci/luci-go/common/sync/parallel"
+	"github.com/luci/luci-go/logdog/api/logpb"
 	"github.com/luci/luci-go/logdog/client/butler/bundler"
 	"github.com/luci/luci-go/logdog/client/butler/output"
+	"github.com/luci/luci-go/logdog/client/butler/redact"
 	"github.com/luci/luci-go/logdog/client/butler/streamserver"
 	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
 	"github.com/luci/luci-go/logdog/common/types"
@@ -36,6 +38,10 @@ const (
 	// DefaultOutputWorkers is the default number of output workers to use.
 	DefaultOutputWorkers = 16
 
+	// DefaultOverflow is the default action to take when a stream exceeds one of
+	// its limits.
+	DefaultOverflow = streamproto.OverflowBlock
+
 	// streamBufferSize is the maximum amount of stream data to buffer in memory.
 	streamBufferSize = 1024 * 1024 * 5
 )
@@ -67,6 +73,27 @@ type Config struct {
 	// TeeStderr, if not nil, is the Writer that will be used for streams
 	// requesting STDERR tee.
 	TeeStderr io.Writer
+
+	// RateLimit, if >0, is the maximum number of bytes per second that the
+	// Butler will accept across all of its streams.
+	RateLimit int64
+	// ByteLimit, if >0, is the maximum total number of bytes that the Butler
+	// will accept across all of its streams.
+	ByteLimit int64
+	// StreamRateLimit, if >0, is the default per-stream byte/second limit. It
+	// applies to streams whose Properties do not specify a RateLimit.
+	StreamRateLimit int64
+	// StreamByteLimit, if >0, is the default per-stream byte quota. It applies
+	// to streams whose Properties do not specify a ByteLimit.
+	StreamByteLimit int64
+	// Overflow is the action to take when a stream that does not specify its own
+	// overflow policy exceeds a limit. If OverflowDefault, DefaultOverflow will
+	// be used.
+	Overflow streamproto.OverflowPolicy
+
+	// RedactionRules, if not empty, is the set of redaction rules to apply to
+	// TEXT stream lines before they are sent to Output.
+	RedactionRules []redact.Rule
 }
 
 // Validate validates that the configuration is sufficient to instantiate a
@@ -81,6 +108,12 @@ func (c *Config) Validate() error {
 	if err := c.Prefix.Validate(); err != nil {
 		return fmt.Errorf("invalid prefix: %v", err)
 	}
+	if c.RateLimit < 0 || c.StreamRateLimit < 0 {
+		return errors.New("butler: rate limits must not be negative")
+	}
+	if c.ByteLimit < 0 || c.StreamByteLimit < 0 {
+		return errors.New("butler: byte limits must not be negative")
+	}
 	return nil
 }
 
@@ -98,6 +131,12 @@ type Butler struct {
 	// been drained.
 	bundlerDrainedC chan struct{}
 
+	// limiter enforces the Butler-wide rate limit and byte quota. It is nil if
+	// the Butler is not limited.
+	limiter *byteLimiter
+	// limitStats tracks the actions taken when stream limits are exceeded.
+	limitStats limitStats
+
 	// activateC is closed when Activate() is called.
 	activateC chan struct{}
 	// activateOnce ensures we close activeC exactly once.
@@ -139,6 +178,9 @@ func New(ctx context.Context, config Config) (*Butler, error) {
 	if config.OutputWorkers <= 0 {
 		config.OutputWorkers = DefaultOutputWorkers
 	}
+	if config.Overflow == streamproto.OverflowDefault {
+		config.Overflow = DefaultOverflow
+	}
 
 	bc := bundler.Config{
 		Clock:            clock.Get(ctx),
@@ -146,6 +188,7 @@ func New(ctx context.Context, config Config) (*Butler, error) {
 		Prefix:           config.Prefix,
 		MaxBufferedBytes: streamBufferSize,
 		MaxBundleSize:    config.Output.MaxSize(),
+		RedactionRules:   config.RedactionRules,
 	}
 	if config.BufferLogs {
 		bc.MaxBufferDelay = config.MaxBufferAge
@@ -162,6 +205,8 @@ func New(ctx context.Context, config Config) (*Butler, error) {
 		bundler:         lb,
 		bundlerDrainedC: make(chan struct{}),
 
+		limiter: newByteLimiter(clock.Get(ctx), config.RateLimit, config.ByteLimit),
+
 		streamsFinishedC: make(chan struct{}),
 
 		activateC:         make(chan struct{}),
@@ -258,11 +303,35 @@ func (b *Butler) Wait() error {
 	log.Debugf(b.ctx, "Output queue has shut down.")
 
 	log.Fields{
-		"stats": b.c.Output.Stats(),
+		"stats": b.Stats(),
 	}.Infof(b.ctx, "Message output has closed")
+
+	for name, count := range b.Redactions() {
+		if count > 0 {
+			log.Fields{
+				"stream": name,
+				"count":  count,
+			}.Infof(b.ctx, "Redacted data from stream.")
+		}
+	}
 	return b.getRunErr()
 }
 
+// Redactions returns a map of stream name to the number of redactions that
+// have been made in that stream.
+func (b *Butler) Redactions() map[string]int64 {
+	return b.bundler.Redactions()
+}
+
+// Stats returns the Butler's current statistics. These are the statistics of
+// its Output, augmented with the Butler's stream limit counters.
+func (b *Butler) Stats() output.Stats {
+	var st output.StatsBase
+	st.Merge(b.c.Output.Stats())
+	b.limitStats.mergeInto(&st)
+	return &st
+}
+
 // Streams returns a sorted list of stream names that have been registered to
 // the Butler.
 func (b *Butler) Streams() []types.StreamName {
@@ -403,15 +472,56 @@ func (b *Butler) AddStream(rc io.ReadCloser, p streamproto.Properties) error {
 		return err
 	}
 
-	b.streamC <- &stream{
+	s := &stream{
 		Context: log.SetField(b.ctx, "stream", p.Name),
 		r:       reader,
 		c:       rc,
 		bs:      bs,
 	}
+	b.applyLimits(s, &p)
+	b.streamC <- s
 	return nil
 }
 
+// applyLimits configures a stream to enforce its own limits and the Butler's.
+func (b *Butler) applyLimits(s *stream, p *streamproto.Properties) {
+	rateLimit, byteLimit := p.RateLimit, p.ByteLimit
+	if rateLimit == 0 {
+		rateLimit = b.c.StreamRateLimit
+	}
+	if byteLimit == 0 {
+		byteLimit = b.c.StreamByteLimit
+	}
+
+	if l := newByteLimiter(clock.Get(b.ctx), rateLimit, byteLimit); l != nil {
+		s.limiters = append(s.limiters, l)
+	}
+	if b.limiter != nil {
+		s.limiters = append(s.limiters, b.limiter)
+	}
+	if len(s.limiters) == 0 {
+		return
+	}
+
+	s.overflow = p.Overflow
+	if s.overflow == streamproto.OverflowDefault {
+		s.overflow = b.c.Overflow
+	}
+
+	switch p.StreamType {
+	case logpb.StreamType_TEXT:
+		s.markDrops = true
+
+	case logpb.StreamType_DATAGRAM:
+		// Dropping arbitrary chunks of a DATAGRAM stream would corrupt its
+		// framing, so we truncate it instead.
+		if s.overflow == streamproto.OverflowDrop {
+			s.overflow = streamproto.OverflowTruncate
+		}
+	}
+	s.stats = &b.limitStats
+}
+
 func (b *Butler) runStreams(activateC chan struct{}) {
 	streamFinishedC := make(chan struct{})
 	streamC := b.streamC
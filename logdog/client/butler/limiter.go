@@ -0,0 +1,150 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package butler
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/logdog/client/butler/output"
+)
+
+// byteLimiter enforces a byte/second rate limit and a total byte quota.
+//
+// The rate limit is implemented as a token bucket holding up to one second's
+// worth of bytes. Consumers may take more tokens than are available, putting
+// the bucket into debt; the bucket is considered exhausted until the debt has
+// been repaid.
+//
+// A nil byteLimiter is valid and imposes no limits.
+//
+// byteLimiter is goroutine-safe.
+type byteLimiter struct {
+	sync.Mutex
+
+	// clock is the clock used to refill the token bucket.
+	clock clock.Clock
+	// rate is the number of bytes per second. If zero, there is no rate limit.
+	rate int64
+	// quota is the total number of bytes. If zero, there is no quota.
+	quota int64
+
+	// tokens is the number of bytes currently available in the bucket. It may be
+	// negative if the bucket is in debt.
+	tokens float64
+	// last is the last time that the bucket was refilled.
+	last time.Time
+	// consumed is the total number of bytes that have been consumed.
+	consumed int64
+}
+
+// newByteLimiter creates a new byteLimiter. If both rate and quota are zero,
+// newByteLimiter will return nil.
+func newByteLimiter(c clock.Clock, rate, quota int64) *byteLimiter {
+	if rate <= 0 && quota <= 0 {
+		return nil
+	}
+	return &byteLimiter{
+		clock:  c,
+		rate:   rate,
+		quota:  quota,
+		tokens: float64(rate),
+		last:   c.Now(),
+	}
+}
+
+// refillLocked adds tokens to the bucket based on the time that has elapsed
+// since it was last refilled. The limiter's lock must be held.
+func (l *byteLimiter) refillLocked() {
+	now := l.clock.Now()
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * float64(l.rate)
+		if max := float64(l.rate); l.tokens > max {
+			l.tokens = max
+		}
+	}
+	l.last = now
+}
+
+// remaining returns the number of bytes that may be consumed before the quota
+// is exhausted. If there is no quota, remaining returns -1.
+func (l *byteLimiter) remaining() int64 {
+	if l == nil || l.quota <= 0 {
+		return -1
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	if r := l.quota - l.consumed; r > 0 {
+		return r
+	}
+	return 0
+}
+
+// exhausted returns true if the rate limit's token bucket is empty or in
+// debt.
+func (l *byteLimiter) exhausted() bool {
+	if l == nil || l.rate <= 0 {
+		return false
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	l.refillLocked()
+	return l.tokens <= 0
+}
+
+// take consumes n bytes from the limiter, returning the amount of time that the
+// caller must wait for the rate limit's bucket to leave debt.
+func (l *byteLimiter) take(n int) time.Duration {
+	if l == nil {
+		return 0
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	l.consumed += int64(n)
+	if l.rate <= 0 {
+		return 0
+	}
+
+	l.refillLocked()
+	l.tokens -= float64(n)
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / float64(l.rate) * float64(time.Second))
+}
+
+// limitStats tracks the actions taken by the Butler in response to stream
+// limits being exceeded.
+//
+// limitStats' fields must be accessed atomically.
+type limitStats struct {
+	droppedBytes     int64
+	truncatedStreams int64
+	blockedReads     int64
+}
+
+func (s *limitStats) addDroppedBytes(n int) {
+	atomic.AddInt64(&s.droppedBytes, int64(n))
+}
+
+func (s *limitStats) addTruncatedStream() {
+	atomic.AddInt64(&s.truncatedStreams, 1)
+}
+
+func (s *limitStats) addBlockedRead() {
+	atomic.AddInt64(&s.blockedReads, 1)
+}
+
+// mergeInto adds the tracked counters to the supplied StatsBase.
+func (s *limitStats) mergeInto(st *output.StatsBase) {
+	st.F.DroppedBytes += atomic.LoadInt64(&s.droppedBytes)
+	st.F.TruncatedStreams += atomic.LoadInt64(&s.truncatedStreams)
+	st.F.BlockedReads += atomic.LoadInt64(&s.blockedReads)
+}
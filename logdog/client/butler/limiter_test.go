@@ -0,0 +1,68 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package butler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/clock/testclock"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestByteLimiter(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A byteLimiter`, t, func() {
+		tc := testclock.New(testclock.TestTimeUTC)
+
+		Convey(`Is nil if it has no limits.`, func() {
+			l := newByteLimiter(tc, 0, 0)
+			So(l, ShouldBeNil)
+
+			So(l.remaining(), ShouldEqual, -1)
+			So(l.exhausted(), ShouldBeFalse)
+			So(l.take(1024), ShouldEqual, 0)
+		})
+
+		Convey(`Will enforce a byte quota.`, func() {
+			l := newByteLimiter(tc, 0, 10)
+			So(l.remaining(), ShouldEqual, 10)
+
+			So(l.take(4), ShouldEqual, 0)
+			So(l.remaining(), ShouldEqual, 6)
+
+			So(l.take(8), ShouldEqual, 0)
+			So(l.remaining(), ShouldEqual, 0)
+			So(l.exhausted(), ShouldBeFalse)
+		})
+
+		Convey(`Will enforce a rate limit.`, func() {
+			l := newByteLimiter(tc, 100, 0)
+			So(l.remaining(), ShouldEqual, -1)
+			So(l.exhausted(), ShouldBeFalse)
+
+			So(l.take(50), ShouldEqual, 0)
+			So(l.exhausted(), ShouldBeFalse)
+
+			// Go into debt.
+			So(l.take(100), ShouldEqual, 500*time.Millisecond)
+			So(l.exhausted(), ShouldBeTrue)
+
+			tc.Add(500 * time.Millisecond)
+			So(l.exhausted(), ShouldBeTrue)
+
+			tc.Add(10 * time.Millisecond)
+			So(l.exhausted(), ShouldBeFalse)
+
+			Convey(`Will not accumulate more than one second of tokens.`, func() {
+				tc.Add(time.Hour)
+				So(l.take(100), ShouldEqual, 0)
+				So(l.take(100), ShouldEqual, time.Second)
+			})
+		})
+	})
+}
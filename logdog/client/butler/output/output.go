@@ -59,6 +59,16 @@ type Stats interface {
 	DiscardedMessages() int64
 	// Errors returns the number of errors encountered during operation.
 	Errors() int64
+
+	// DroppedBytes returns the number of stream bytes that were discarded
+	// because a stream exceeded its rate limit or byte quota.
+	DroppedBytes() int64
+	// TruncatedStreams returns the number of streams that were terminated
+	// because they exceeded their rate limit or byte quota.
+	TruncatedStreams() int64
+	// BlockedReads returns the number of times that a stream's reads were
+	// delayed to enforce its rate limit.
+	BlockedReads() int64
 }
 
 // StatsBase is a simple implementation of the Stats interface.
@@ -68,6 +78,10 @@ type StatsBase struct {
 		SentMessages      int64 // The number of messages sent.
 		DiscardedMessages int64 // The number of messages that have been discarded.
 		Errors            int64 // The number of errors encountered.
+
+		DroppedBytes     int64 // The number of bytes discarded due to stream limits.
+		TruncatedStreams int64 // The number of streams truncated due to stream limits.
+		BlockedReads     int64 // The number of stream reads delayed by rate limits.
 	}
 }
 
@@ -97,10 +111,28 @@ func (s *StatsBase) Errors() int64 {
 	return s.F.Errors
 }
 
+// DroppedBytes implements Stats.
+func (s *StatsBase) DroppedBytes() int64 {
+	return s.F.DroppedBytes
+}
+
+// TruncatedStreams implements Stats.
+func (s *StatsBase) TruncatedStreams() int64 {
+	return s.F.TruncatedStreams
+}
+
+// BlockedReads implements Stats.
+func (s *StatsBase) BlockedReads() int64 {
+	return s.F.BlockedReads
+}
+
 // Merge merges the values from one Stats block into another.
 func (s *StatsBase) Merge(o Stats) {
 	s.F.SentBytes += o.SentBytes()
 	s.F.SentMessages += o.SentMessages()
 	s.F.DiscardedMessages += o.DiscardedMessages()
 	s.F.Errors += o.Errors()
+	s.F.DroppedBytes += o.DroppedBytes()
+	s.F.TruncatedStreams += o.TruncatedStreams()
+	s.F.BlockedReads += o.BlockedReads()
 }
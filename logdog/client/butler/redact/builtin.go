@@ -0,0 +1,77 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package redact
+
+import (
+	"regexp"
+)
+
+var (
+	// oauthAccessTokenRE matches Google OAuth2 access tokens.
+	oauthAccessTokenRE = regexp.MustCompile(`ya29\.[0-9A-Za-z_\-\.]+`)
+	// bearerTokenRE matches bearer tokens in HTTP Authorization headers.
+	bearerTokenRE = regexp.MustCompile(`(?i)\bbearer\s+(?P<secret>[0-9A-Za-z_\-\.=~+/]{8,})`)
+	// jsonSecretRE matches credential-bearing JSON fields, such as those in
+	// OAuth2 token responses and service account JSON key files.
+	jsonSecretRE = regexp.MustCompile(
+		`"(?:access_token|refresh_token|id_token|client_secret|private_key_id|private_key)"\s*:\s*"(?P<secret>(?:[^"\\]|\\.)+)"`)
+	// inlinePEMRE matches a complete private key block on a single line, as
+	// found in escaped JSON strings.
+	inlinePEMRE = regexp.MustCompile(
+		`-----BEGIN [A-Z0-9 ]*PRIVATE KEY-----.*?-----END [A-Z0-9 ]*PRIVATE KEY-----`)
+
+	pemBeginRE = regexp.MustCompile(`-----BEGIN [A-Z0-9 ]*PRIVATE KEY-----`)
+	pemEndRE   = regexp.MustCompile(`-----END [A-Z0-9 ]*PRIVATE KEY-----`)
+)
+
+// Builtin returns the built-in set of redaction Rules. These redact:
+//   - OAuth2 access tokens and HTTP bearer tokens.
+//   - Credential fields in OAuth2 token responses and service account JSON.
+//   - PEM-encoded private keys, both inline and spanning multiple lines.
+func Builtin() []Rule {
+	return []Rule{
+		pemBlockRule{},
+		newRegexpRule(jsonSecretRE),
+		newRegexpRule(inlinePEMRE),
+		newRegexpRule(oauthAccessTokenRE),
+		newRegexpRule(bearerTokenRE),
+	}
+}
+
+// pemBlockRule is a Rule that redacts the contents of multi-line PEM private
+// key blocks.
+type pemBlockRule struct{}
+
+func (pemBlockRule) NewRedactor() Redactor { return &pemBlockRedactor{} }
+
+// pemBlockRedactor is the stateful per-stream Redactor for pemBlockRule.
+type pemBlockRedactor struct {
+	// inBlock is true if a BEGIN line has been observed without a matching END
+	// line.
+	inBlock bool
+}
+
+func (r *pemBlockRedactor) RedactLine(line string) (string, int) {
+	if !r.inBlock {
+		loc := pemBeginRE.FindStringIndex(line)
+		if loc == nil || pemEndRE.MatchString(line[loc[1]:]) {
+			// No block, or the block is entirely on this line (handled by the inline
+			// rule).
+			return line, 0
+		}
+
+		// Redact everything following the BEGIN marker.
+		r.inBlock = true
+		return line[:loc[1]] + Replacement, 1
+	}
+
+	loc := pemEndRE.FindStringIndex(line)
+	if loc == nil {
+		// Still inside of the block.
+		return Replacement, 1
+	}
+	r.inBlock = false
+	return Replacement + line[loc[0]:], 1
+}
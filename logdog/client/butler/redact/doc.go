@@ -0,0 +1,24 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package redact implements redaction of sensitive data from TEXT log stream
+// lines before they are exported by the Butler.
+//
+// Redaction is configured as a set of Rules. Each TEXT stream instantiates a
+// Filter from those Rules, which holds any per-stream state (e.g., whether the
+// stream is in the middle of a multi-line private key block) and counts the
+// number of redactions that have been made.
+//
+// The package provides the following Rule implementations:
+//   - Literal: redacts every occurrence of a fixed string.
+//   - Regexp: redacts every match of a regular expression.
+//   - PEM blocks: redacts multi-line private key blocks (see Builtin).
+//
+// Builtin returns a set of Rules that redact common credential formats,
+// including OAuth2 tokens, private keys, and service account JSON.
+//
+// Note that lines are redacted as they are emitted by the bundler. A line that
+// is split across LogEntry boundaries is redacted in fragments, so a secret
+// that spans a split may not be matched.
+package redact
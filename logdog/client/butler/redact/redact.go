@@ -0,0 +1,175 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package redact
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync/atomic"
+)
+
+// Replacement is the text that redacted data is replaced with.
+const Replacement = "[REDACTED]"
+
+// Redactor redacts the lines of a single log stream.
+//
+// Lines are supplied to a Redactor in stream order, allowing it to track
+// state across lines. A Redactor is not goroutine-safe.
+type Redactor interface {
+	// RedactLine returns the redacted form of line, along with the number of
+	// redactions that were made.
+	RedactLine(line string) (string, int)
+}
+
+// Rule is a redaction rule. It instantiates a Redactor for each stream that it
+// is applied to.
+//
+// A Rule must be goroutine-safe.
+type Rule interface {
+	// NewRedactor returns a new Redactor instance bound to a single stream.
+	NewRedactor() Redactor
+}
+
+// Literal returns a Rule that redacts all occurrences of the literal string s.
+func Literal(s string) Rule {
+	return &literalRule{s}
+}
+
+type literalRule struct {
+	value string
+}
+
+func (r *literalRule) NewRedactor() Redactor { return r }
+
+func (r *literalRule) RedactLine(line string) (string, int) {
+	if r.value == "" {
+		return line, 0
+	}
+	count := strings.Count(line, r.value)
+	if count == 0 {
+		return line, 0
+	}
+	return strings.Replace(line, r.value, Replacement, -1), count
+}
+
+// Regexp returns a Rule that redacts all matches of the supplied regular
+// expression.
+//
+// If the expression contains a capture group named "secret", only the text
+// matched by that group will be redacted. Otherwise, the entire match will be
+// redacted.
+func Regexp(expr string) (Rule, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redaction expression %q: %v", expr, err)
+	}
+	return newRegexpRule(re), nil
+}
+
+func newRegexpRule(re *regexp.Regexp) *regexpRule {
+	r := regexpRule{re: re, group: -1}
+	for i, name := range re.SubexpNames() {
+		if name == "secret" {
+			r.group = i
+			break
+		}
+	}
+	return &r
+}
+
+type regexpRule struct {
+	re *regexp.Regexp
+	// group is the index of the "secret" capture group, or -1 if there is no
+	// such group.
+	group int
+}
+
+func (r *regexpRule) NewRedactor() Redactor { return r }
+
+func (r *regexpRule) RedactLine(line string) (string, int) {
+	matches := r.re.FindAllStringSubmatchIndex(line, -1)
+	if len(matches) == 0 {
+		return line, 0
+	}
+
+	group := r.group
+	if group < 0 {
+		group = 0
+	}
+
+	count := 0
+	parts := make([]string, 0, len(matches)*2+1)
+	last := 0
+	for _, m := range matches {
+		start, end := m[2*group], m[2*group+1]
+		if start < 0 || start == end {
+			// The group did not participate in this match.
+			continue
+		}
+		parts = append(parts, line[last:start], Replacement)
+		last = end
+		count++
+	}
+	if count == 0 {
+		return line, 0
+	}
+	parts = append(parts, line[last:])
+	return strings.Join(parts, ""), count
+}
+
+// Filter applies a set of Rules to the lines of a single log stream and counts
+// the redactions that were made.
+//
+// Filter's Redact method is not goroutine-safe, but Redactions may be called
+// concurrently with it.
+type Filter struct {
+	// count is the number of redactions made.
+	//
+	// It must be the first field in the struct to ensure it's 64-bit aligned
+	// for atomic operations.
+	count int64
+
+	redactors []Redactor
+}
+
+// NewFilter instantiates a Filter for a single stream from the supplied Rules.
+//
+// If there are no Rules, NewFilter will return nil.
+func NewFilter(rules []Rule) *Filter {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	f := Filter{
+		redactors: make([]Redactor, len(rules)),
+	}
+	for i, r := range rules {
+		f.redactors[i] = r.NewRedactor()
+	}
+	return &f
+}
+
+// Redact applies the Filter's Rules, in order, to a single line.
+func (f *Filter) Redact(line string) string {
+	total := 0
+	for _, r := range f.redactors {
+		var count int
+		line, count = r.RedactLine(line)
+		total += count
+	}
+	if total > 0 {
+		atomic.AddInt64(&f.count, int64(total))
+	}
+	return line
+}
+
+// Redactions returns the number of redactions that the Filter has made.
+func (f *Filter) Redactions() int64 {
+	if f == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&f.count)
+}
@@ -0,0 +1,76 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package redact
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRules(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A Literal rule`, t, func() {
+		r := Literal("hunter2").NewRedactor()
+
+		Convey(`Will redact all occurrences.`, func() {
+			line, count := r.RedactLine("password=hunter2, again hunter2")
+			So(line, ShouldEqual, "password=[REDACTED], again [REDACTED]")
+			So(count, ShouldEqual, 2)
+		})
+
+		Convey(`Will leave unmatched lines alone.`, func() {
+			line, count := r.RedactLine("nothing to see here")
+			So(line, ShouldEqual, "nothing to see here")
+			So(count, ShouldEqual, 0)
+		})
+	})
+
+	Convey(`A Regexp rule`, t, func() {
+		Convey(`Will fail to compile an invalid expression.`, func() {
+			_, err := Regexp(`(`)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey(`Will redact entire matches.`, func() {
+			rule, err := Regexp(`key-[0-9]+`)
+			So(err, ShouldBeNil)
+
+			line, count := rule.NewRedactor().RedactLine("key-123 and key-456")
+			So(line, ShouldEqual, "[REDACTED] and [REDACTED]")
+			So(count, ShouldEqual, 2)
+		})
+
+		Convey(`Will redact only a "secret" capture group.`, func() {
+			rule, err := Regexp(`token=(?P<secret>\w+)`)
+			So(err, ShouldBeNil)
+
+			line, count := rule.NewRedactor().RedactLine("token=abc&token=def")
+			So(line, ShouldEqual, "token=[REDACTED]&token=[REDACTED]")
+			So(count, ShouldEqual, 2)
+		})
+	})
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A Filter`, t, func() {
+		Convey(`Is nil with no rules.`, func() {
+			f := NewFilter(nil)
+			So(f, ShouldBeNil)
+			So(f.Redactions(), ShouldEqual, 0)
+		})
+
+		Convey(`Will apply rules in order and count redactions.`, func() {
+			f := NewFilter([]Rule{Literal("foo"), Literal("bar")})
+
+			So(f.Redact("foo bar baz"), ShouldEqual, "[REDACTED] [REDACTED] baz")
+			So(f.Redact("qux"), ShouldEqual, "qux")
+			So(f.Redactions(), ShouldEqual, 2)
+		})
+	})
+}
@@ -6,11 +6,13 @@ package butler
 
 import (
 	"io"
+	"time"
 
 	"github.com/luci/luci-go/common/clock"
 	"github.com/luci/luci-go/common/iotools"
 	log "github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/logdog/client/butler/bundler"
+	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
 	"golang.org/x/net/context"
 )
 
@@ -20,6 +22,28 @@ type stream struct {
 	r  io.Reader
 	c  io.Closer
 	bs bundler.Stream
+
+	// limiters is the set of limiters that this stream's data is subject to.
+	// Individual entries may be nil.
+	limiters []*byteLimiter
+	// overflow is the action to take when one of the limiters is exceeded. It
+	// must not be OverflowDefault.
+	overflow streamproto.OverflowPolicy
+	// markDrops, if true, instructs the stream to insert a marker line when it
+	// begins dropping data.
+	markDrops bool
+	// stats, if not nil, is updated when the stream's limits are exceeded.
+	stats *limitStats
+
+	// dropping is true if the stream is currently discarding data.
+	dropping bool
+	// markerPending is true if a drop marker line should be appended to the
+	// stream.
+	markerPending bool
+	// quotaExceeded is true if the stream has exhausted one of its byte quotas.
+	quotaExceeded bool
+	// lastByte is the last byte forwarded to the bundler.
+	lastByte byte
 }
 
 func (s *stream) readChunk() bool {
@@ -31,7 +55,9 @@ func (s *stream) readChunk() bool {
 	}()
 
 	amount, err := s.r.Read(d.Bytes())
+	amount, proceed := s.admit(amount)
 	if amount > 0 {
+		s.lastByte = d.Bytes()[amount-1]
 		d.Bind(amount, clock.Now(s))
 
 		// Add the data to our bundler endpoint. This may block waiting for the
@@ -48,6 +74,18 @@ func (s *stream) readChunk() bool {
 		d = nil
 	}
 
+	if s.markerPending {
+		s.markerPending = false
+		if err := s.appendDropMarker(); err != nil {
+			log.WithError(err).Errorf(s, "Failed to Append drop marker to stream.")
+			return false
+		}
+	}
+	if !proceed {
+		log.Warningf(s, "Stream exceeded its limits; truncating.")
+		return false
+	}
+
 	switch err {
 	case iotools.ErrTimeout:
 		log.Debugf(s, "Encountered 'Read()' timeout; re-reading.")
@@ -71,6 +109,113 @@ func (s *stream) readChunk() bool {
 	return true
 }
 
+// admit applies the stream's limits to a chunk of n bytes that has been read.
+//
+// It returns the number of leading bytes in the chunk that should be forwarded
+// to the bundler, and false if the stream should be truncated after they have
+// been forwarded.
+func (s *stream) admit(n int) (int, bool) {
+	if n <= 0 || len(s.limiters) == 0 {
+		return n, true
+	}
+	if s.quotaExceeded {
+		// We only continue reading past an exhausted quota to drop data.
+		s.drop(n)
+		return 0, true
+	}
+
+	// Enforce our byte quotas.
+	proceed := true
+	for _, l := range s.limiters {
+		if rem := l.remaining(); rem >= 0 && int64(n) > rem {
+			s.quotaExceeded = true
+			if s.overflow == streamproto.OverflowDrop {
+				s.drop(n - int(rem))
+			} else {
+				proceed = false
+			}
+			n = int(rem)
+		}
+	}
+	if !proceed && s.stats != nil {
+		s.stats.addTruncatedStream()
+	}
+	if n == 0 {
+		return 0, proceed
+	}
+
+	// Enforce our rate limits.
+	switch s.overflow {
+	case streamproto.OverflowBlock:
+		var delay time.Duration
+		for _, l := range s.limiters {
+			if d := l.take(n); d > delay {
+				delay = d
+			}
+		}
+		if delay > 0 {
+			if s.stats != nil {
+				s.stats.addBlockedRead()
+			}
+			log.Fields{
+				"delay": delay,
+			}.Debugf(s, "Stream exceeded its rate limit; blocking.")
+			clock.Sleep(s, delay)
+		}
+
+	default:
+		for _, l := range s.limiters {
+			if !l.exhausted() {
+				continue
+			}
+
+			if s.overflow == streamproto.OverflowDrop {
+				s.drop(n)
+				return 0, proceed
+			}
+			if s.stats != nil && proceed {
+				s.stats.addTruncatedStream()
+			}
+			return 0, false
+		}
+		for _, l := range s.limiters {
+			l.take(n)
+		}
+	}
+
+	if !s.quotaExceeded {
+		s.dropping = false
+	}
+	return n, proceed
+}
+
+// drop records that n bytes of stream data have been discarded.
+func (s *stream) drop(n int) {
+	if !s.dropping {
+		log.Warningf(s, "Stream exceeded its limits; dropping data.")
+		s.dropping = true
+		s.markerPending = s.markDrops
+	}
+	if s.stats != nil {
+		s.stats.addDroppedBytes(n)
+	}
+}
+
+// appendDropMarker appends a line to the stream noting that data has been
+// dropped.
+func (s *stream) appendDropMarker() error {
+	marker := "[logdog butler: stream exceeded its limits; dropping data]\n"
+	if s.lastByte != 0 && s.lastByte != '\n' {
+		// Terminate the partial line that preceded the dropped data.
+		marker = "\n" + marker
+	}
+
+	d := s.bs.LeaseData()
+	d.Bind(copy(d.Bytes(), marker), clock.Now(s))
+	s.lastByte = '\n'
+	return s.bs.Append(d)
+}
+
 func (s *stream) closeStream() {
 	if err := s.c.Close(); err != nil {
 		log.Fields{
@@ -10,9 +10,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/luci/luci-go/common/clock"
 	"github.com/luci/luci-go/common/clock/testclock"
 	"github.com/luci/luci-go/common/iotools"
 	"github.com/luci/luci-go/logdog/client/butler/bundler"
+	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
 	. "github.com/smartystreets/goconvey/convey"
 	"golang.org/x/net/context"
 )
@@ -183,5 +185,84 @@ func TestStream(t *testing.T) {
 			s.closeStream()
 			So(bs.closedAndReleased(), ShouldBeTrue)
 		})
+
+		Convey(`With a byte quota`, func() {
+			var st limitStats
+			s.limiters = []*byteLimiter{newByteLimiter(tc, 0, 4)}
+			s.stats = &st
+
+			Convey(`Will drop data and insert a marker line.`, func() {
+				s.overflow = streamproto.OverflowDrop
+				s.markDrops = true
+
+				rc.data = []byte("foobar")
+				So(s.readChunk(), ShouldBeTrue)
+
+				rc.data = []byte("baz")
+				So(s.readChunk(), ShouldBeTrue)
+
+				s.closeStream()
+				So(string(bs.appended), ShouldEqual,
+					"foob\n[logdog butler: stream exceeded its limits; dropping data]\n")
+				So(st.droppedBytes, ShouldEqual, 5)
+				So(bs.closedAndReleased(), ShouldBeTrue)
+			})
+
+			Convey(`Will truncate the stream.`, func() {
+				s.overflow = streamproto.OverflowTruncate
+
+				rc.data = []byte("foobar")
+				So(s.readChunk(), ShouldBeFalse)
+
+				s.closeStream()
+				So(string(bs.appended), ShouldEqual, "foob")
+				So(st.truncatedStreams, ShouldEqual, 1)
+				So(bs.closedAndReleased(), ShouldBeTrue)
+			})
+		})
+
+		Convey(`With a rate limit`, func() {
+			var st limitStats
+			s.limiters = []*byteLimiter{newByteLimiter(tc, 4, 0)}
+			s.stats = &st
+
+			Convey(`Will drop data while the limit is exceeded.`, func() {
+				s.overflow = streamproto.OverflowDrop
+
+				rc.data = []byte("foobar")
+				So(s.readChunk(), ShouldBeTrue)
+
+				rc.data = []byte("baz")
+				So(s.readChunk(), ShouldBeTrue)
+
+				tc.Add(time.Second)
+				rc.data = []byte("qux")
+				So(s.readChunk(), ShouldBeTrue)
+
+				s.closeStream()
+				So(string(bs.appended), ShouldEqual, "foobarqux")
+				So(st.droppedBytes, ShouldEqual, 3)
+				So(bs.closedAndReleased(), ShouldBeTrue)
+			})
+
+			Convey(`Will block until the limit permits more data.`, func() {
+				s.overflow = streamproto.OverflowBlock
+
+				var slept time.Duration
+				tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+					slept += d
+					tc.Add(d)
+				})
+
+				rc.data = []byte("foobar")
+				So(s.readChunk(), ShouldBeTrue)
+
+				s.closeStream()
+				So(string(bs.appended), ShouldEqual, "foobar")
+				So(slept, ShouldEqual, 500*time.Millisecond)
+				So(st.blockedReads, ShouldEqual, 1)
+				So(bs.closedAndReleased(), ShouldBeTrue)
+			})
+		})
 	})
 }
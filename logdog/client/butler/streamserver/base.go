@@ -39,6 +39,10 @@ type listenerStreamServer struct {
 	l     net.Listener
 	laddr string
 
+	// authToken, if not empty, is the authentication token that each client
+	// must present before its handshake.
+	authToken []byte
+
 	streamParamsC   chan *streamParams
 	closedC         chan struct{}
 	acceptFinishedC chan struct{}
@@ -126,9 +130,10 @@ func (s *listenerStreamServer) serve() {
 		// Spawn a goroutine to handle this connection. This goroutine will take
 		// ownership of the connection, closing it as appropriate.
 		client := &streamClient{
-			closedC: s.closedC,
-			id:      nextID,
-			conn:    &iotools.DeadlineReader{conn, 0},
+			closedC:   s.closedC,
+			id:        nextID,
+			conn:      &iotools.DeadlineReader{conn, 0},
+			authToken: s.authToken,
 		}
 		client.Context = log.SetFields(s, log.Fields{
 			"id":     client.id,
@@ -191,9 +196,10 @@ func (s *listenerStreamServer) serve() {
 type streamClient struct {
 	context.Context
 
-	closedC chan struct{} // Signal channel to indicate that the server has closed.
-	id      int           // Client ID, used for debugging correlation.
-	conn    net.Conn      // The underlying client connection.
+	closedC   chan struct{} // Signal channel to indicate that the server has closed.
+	id        int           // Client ID, used for debugging correlation.
+	conn      net.Conn      // The underlying client connection.
+	authToken []byte        // If not empty, the token the client must present.
 
 	// decoupleMu is used to ensure that decoupleConn is called at most one time.
 	decoupleMu sync.Mutex
@@ -216,7 +222,7 @@ func (c *streamClient) handle() (*streamParams, error) {
 
 	// Perform our handshake. We pass the connection explicitly into this method
 	// because it can get decoupled during operation.
-	p, err := handshake(c, c.conn)
+	p, err := handshake(c, c.conn, c.authToken)
 	if err != nil {
 		return nil, err
 	}
@@ -231,7 +237,17 @@ func (c *streamClient) handle() (*streamParams, error) {
 //
 // The client connection opens with a handshake protocol. Once complete, the
 // connection itself becomes the stream.
-func handshake(ctx context.Context, conn net.Conn) (*streamproto.Properties, error) {
+//
+// If authToken is not empty, the client must present a matching
+// authentication token before the handshake.
+func handshake(ctx context.Context, conn net.Conn, authToken []byte) (*streamproto.Properties, error) {
+	if len(authToken) > 0 {
+		log.Infof(ctx, "Authenticating client.")
+		if err := authenticate(ctx, conn, authToken); err != nil {
+			return nil, err
+		}
+	}
+
 	log.Infof(ctx, "Beginning handshake.")
 	hs := handshakeProtocol{}
 	return hs.Handshake(ctx, conn)
@@ -0,0 +1,119 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package streamserver
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/luci/luci-go/common/data/recordio"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
+	"golang.org/x/net/context"
+)
+
+// authTokenBytes is the number of random bytes in a generated authentication
+// token.
+const authTokenBytes = 32
+
+// TCPServer is a StreamServer that listens for connections on a TCP socket.
+//
+// Since any local process (or, when bound to a container network, any process
+// on that network) can connect to a TCP socket, each connection must present
+// the server's authentication token before its handshake.
+type TCPServer struct {
+	*listenerStreamServer
+
+	network string
+}
+
+// NewTCPServer instantiates a new TCP stream server.
+//
+// network must be either "tcp4" or "tcp6". address is the address to bind to,
+// in the form "host:port". If the port is 0, one will be chosen when the
+// server begins listening; URI will return the bound address.
+//
+// token is the authentication token that clients must present. If it is
+// empty, a random token will be generated.
+func NewTCPServer(ctx context.Context, network, address, token string) (*TCPServer, error) {
+	switch network {
+	case "tcp4", "tcp6":
+	default:
+		return nil, fmt.Errorf("unsupported TCP network %q", network)
+	}
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		return nil, fmt.Errorf("invalid address %q: %s", address, err)
+	}
+
+	if token == "" {
+		var err error
+		if token, err = generateAuthToken(); err != nil {
+			return nil, fmt.Errorf("failed to generate authentication token: %s", err)
+		}
+	}
+	if len(token) > streamproto.MaxAuthTokenSize {
+		return nil, fmt.Errorf("authentication token exceeds maximum size (%d > %d)",
+			len(token), streamproto.MaxAuthTokenSize)
+	}
+
+	ctx = log.SetFields(ctx, log.Fields{
+		"network": network,
+		"address": address,
+	})
+	return &TCPServer{
+		listenerStreamServer: &listenerStreamServer{
+			Context: ctx,
+			gen: func() (net.Listener, error) {
+				log.Infof(ctx, "Creating TCP server socket Listener.")
+				return net.Listen(network, address)
+			},
+			authToken: []byte(token),
+		},
+		network: network,
+	}, nil
+}
+
+// URI returns the streamclient URI for this server (e.g.,
+// "tcp4:127.0.0.1:1234").
+//
+// The server must be listening.
+func (s *TCPServer) URI() string {
+	return fmt.Sprintf("%s:%s", s.network, s.laddr)
+}
+
+// Token returns the authentication token that clients must present.
+func (s *TCPServer) Token() string {
+	return string(s.authToken)
+}
+
+func generateAuthToken() (string, error) {
+	buf := make([]byte, authTokenBytes)
+	if _, err := io.ReadFull(rand.Reader, buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// authenticate reads the client's authentication token frame from r and
+// verifies that it matches token.
+func authenticate(ctx context.Context, r io.Reader, token []byte) error {
+	fr := recordio.NewReader(r, streamproto.MaxAuthTokenSize)
+	clientToken, err := fr.ReadFrameAll()
+	if err != nil {
+		log.WithError(err).Errorf(ctx, "Failed to read authentication token frame.")
+		return errors.New("handshake: failed to read authentication token")
+	}
+
+	if subtle.ConstantTimeCompare(clientToken, token) != 1 {
+		log.Errorf(ctx, "Client presented an invalid authentication token.")
+		return errors.New("handshake: invalid authentication token")
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package streamserver
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/luci/luci-go/common/data/recordio"
+	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
+	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTCPServer(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A TCP stream server`, t, func() {
+		ctx := context.Background()
+
+		Convey(`Will refuse to create a server with an invalid network or address.`, func() {
+			_, err := NewTCPServer(ctx, "udp", "127.0.0.1:0", "")
+			So(err, ShouldNotBeNil)
+
+			_, err = NewTCPServer(ctx, "tcp4", "127.0.0.1", "")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey(`Will generate a token if one is not supplied.`, func() {
+			s, err := NewTCPServer(ctx, "tcp4", "127.0.0.1:0", "")
+			So(err, ShouldBeNil)
+			So(s.Token(), ShouldHaveLength, authTokenBytes*2)
+		})
+
+		Convey(`Listening on a local port`, func() {
+			s, err := NewTCPServer(ctx, "tcp4", "127.0.0.1:0", "secret")
+			So(err, ShouldBeNil)
+			So(s.Listen(), ShouldBeNil)
+			defer s.Close()
+
+			So(s.URI(), ShouldStartWith, "tcp4:127.0.0.1:")
+			addr := strings.TrimPrefix(s.URI(), "tcp4:")
+
+			hb := handshakeBuilder{
+				magic: streamproto.ProtocolFrameHeaderMagic,
+			}
+			handshake := `{"name": "test", "contentType": "application/octet-stream"}`
+			content := bytes.Repeat([]byte("THIS IS A TEST STREAM "), 100)
+
+			connect := func(token string) error {
+				conn, err := net.Dial("tcp4", addr)
+				if err != nil {
+					return err
+				}
+				defer conn.Close()
+
+				if token != "" {
+					if _, err := recordio.WriteFrame(conn, []byte(token)); err != nil {
+						return err
+					}
+				}
+				hb.writeTo(conn, handshake, content)
+				return nil
+			}
+
+			Convey(`Accepts a client that presents the correct token.`, func() {
+				So(connect("secret"), ShouldBeNil)
+
+				stream, props := s.Next()
+				So(stream, ShouldNotBeNil)
+				defer stream.Close()
+				So(props.Name, ShouldEqual, "test")
+
+				recvData, _ := ioutil.ReadAll(stream)
+				So(recvData, ShouldResemble, content)
+			})
+
+			Convey(`Rejects clients that present an invalid or missing token.`, func() {
+				s.discardC = make(chan *streamClient)
+
+				go connect("wrong")
+				So(<-s.discardC, ShouldNotBeNil)
+
+				go connect("")
+				So(<-s.discardC, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -54,6 +54,13 @@ func getFromEnv(env environ.Environment, reg *streamclient.Registry) (*Bootstrap
 		if err != nil {
 			return nil, fmt.Errorf("bootstrap: failed to create stream client [%s]: %s", p, err)
 		}
+
+		// If the stream server requires authentication, supply its token.
+		if tok, ok := env[EnvStreamServerToken]; ok {
+			if c, err = streamclient.WithAuthToken(c, tok); err != nil {
+				return nil, fmt.Errorf("bootstrap: failed to authenticate stream client [%s]: %s", p, err)
+			}
+		}
 		bs.Client = c
 	}
 
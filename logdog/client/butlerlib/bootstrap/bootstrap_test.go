@@ -68,6 +68,12 @@ func TestBootstrap(t *testing.T) {
 					So(regSpec, ShouldEqual, "client:params")
 				})
 
+				Convey(`With a stream server token, will fail if the Client does not support authentication.`, func() {
+					env[EnvStreamServerToken] = "secret"
+					_, err := getFromEnv(env, reg)
+					So(err, ShouldErrLike, "failed to authenticate stream client")
+				})
+
 				Convey(`If Client creation fails, will fail.`, func() {
 					regErr = errors.New("testing error")
 					_, err := getFromEnv(env, reg)
@@ -14,6 +14,14 @@ const (
 	// processes.
 	EnvStreamServerPath = "LOGDOG_STREAM_SERVER_PATH"
 
+	// EnvStreamServerToken is the authentication token for the Butler's stream
+	// server.
+	//
+	// This is set when the stream server requires clients to authenticate
+	// (e.g., a TCP stream server). Like EnvStreamServerPath, it is propagated
+	// to child processes.
+	EnvStreamServerToken = "LOGDOG_STREAM_SERVER_TOKEN"
+
 	// EnvStreamProject is the environment variable set to the configured stream
 	// project name.
 	EnvStreamProject = "LOGDOG_STREAM_PROJECT"
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/luci/luci-go/common/data/recordio"
 	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
 )
 
@@ -29,6 +30,10 @@ type streamFactory func() (io.WriteCloser, error)
 type clientImpl struct {
 	// network is the connection path to the stream server.
 	factory streamFactory
+
+	// authToken, if not empty, is the authentication token to present to the
+	// stream server before the handshake.
+	authToken []byte
 }
 
 // New instantiates a new Client instance. This type of instance will be parsed
@@ -38,6 +43,9 @@ type clientImpl struct {
 // Supported protocols and their respective specs are:
 //   - unix:/path/to/socket describes a stream server listening on UNIX domain
 //     socket at "/path/to/socket".
+//   - tcp4:host:port and tcp6:host:port describe a stream server listening on
+//     a TCP socket. These servers require an authentication token, which can
+//     be supplied using WithAuthToken.
 //
 // Windows-only:
 //   - net.pipe:name describes a stream server listening on Windows named pipe
@@ -69,11 +77,16 @@ func (c *clientImpl) NewStream(f streamproto.Flags) (Stream, error) {
 		return nil, fmt.Errorf("failed to marshal properties JSON: %s", err)
 	}
 
-	// Perform the handshake: magic + size(data) + data.
+	// Perform the handshake: [token] + magic + size(data) + data.
 	s := &streamImpl{
 		Properties:  p,
 		WriteCloser: client,
 	}
+	if len(c.authToken) > 0 {
+		if _, err := recordio.WriteFrame(client, c.authToken); err != nil {
+			return nil, fmt.Errorf("failed to write authentication token: %s", err)
+		}
+	}
 	if _, err := s.writeRaw(streamproto.ProtocolFrameHeaderMagic); err != nil {
 		return nil, fmt.Errorf("failed to write magic number: %s", err)
 	}
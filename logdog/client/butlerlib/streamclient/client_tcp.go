@@ -0,0 +1,60 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package streamclient
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
+)
+
+// Register TCP protocols.
+func init() {
+	registerProtocol("tcp4", newTCPClientFactory("tcp4"))
+	registerProtocol("tcp6", newTCPClientFactory("tcp6"))
+}
+
+// newTCPClientFactory returns a ClientFactory that creates Client instances
+// bound to a TCP stream server on the specified network.
+//
+// TCP stream servers require an authentication token; use WithAuthToken to
+// supply it.
+func newTCPClientFactory(network string) ClientFactory {
+	return func(address string) (Client, error) {
+		if _, _, err := net.SplitHostPort(address); err != nil {
+			return nil, fmt.Errorf("invalid %s address [%s]: %s", network, address, err)
+		}
+
+		return &clientImpl{
+			factory: func() (io.WriteCloser, error) {
+				return net.Dial(network, address)
+			},
+		}, nil
+	}
+}
+
+// WithAuthToken returns a copy of the supplied Client that presents token to
+// the stream server before each stream's handshake.
+//
+// Only Clients that connect to a stream server (e.g., those returned by New)
+// support authentication. An error will be returned for other Clients.
+func WithAuthToken(c Client, token string) (Client, error) {
+	ci, ok := c.(*clientImpl)
+	if !ok {
+		return nil, errors.New("streamclient: client does not support authentication")
+	}
+	if len(token) > streamproto.MaxAuthTokenSize {
+		return nil, fmt.Errorf("streamclient: authentication token exceeds maximum size (%d > %d)",
+			len(token), streamproto.MaxAuthTokenSize)
+	}
+
+	return &clientImpl{
+		factory:   ci.factory,
+		authToken: []byte(token),
+	}, nil
+}
@@ -65,6 +65,11 @@ func TestClient(t *testing.T) {
 			So(func() { reg.Register("test2", nil) }, ShouldNotPanic)
 		})
 
+		Convey(`Will refuse to add an authentication token to an unsupported Client.`, func() {
+			_, err := WithAuthToken(&localClient{}, "secret")
+			So(err, ShouldNotBeNil)
+		})
+
 		Convey(`Will fail to instantiate a Client with an invalid protocol.`, func() {
 			_, err := reg.NewClient("fake:foo")
 			So(err, ShouldNotBeNil)
@@ -97,6 +102,23 @@ func TestClient(t *testing.T) {
 				})
 			})
 
+			Convey(`With an authentication token, writes the token before the stream header.`, func() {
+				client, err := WithAuthToken(client, "secret")
+				So(err, ShouldBeNil)
+
+				stream, err := client.NewStream(flags)
+				So(err, ShouldBeNil)
+				tswc := stream.(*streamImpl).WriteCloser.(*testStreamWriteCloser)
+
+				r := recordio.NewReader(tswc, -1)
+				f, err := r.ReadFrameAll()
+				So(err, ShouldBeNil)
+				So(string(f), ShouldEqual, "secret")
+
+				So(tswc.Next(len(streamproto.ProtocolFrameHeaderMagic)), ShouldResemble,
+					streamproto.ProtocolFrameHeaderMagic)
+			})
+
 			Convey(`If the stream fails to write the handshake, it will be closed.`, func() {
 				tswcErr = errors.New("test error")
 				_, err := client.NewStream(flags)
@@ -15,3 +15,14 @@ var (
 	//     a switch to something other than recordio/JSON.
 	ProtocolFrameHeaderMagic = []byte("BTLR1\x1E")
 )
+
+const (
+	// MaxAuthTokenSize is the maximum size, in bytes, of a stream server
+	// authentication token.
+	//
+	// Stream servers that require authentication (e.g., TCP stream servers)
+	// expect each connection to begin with a single recordio frame containing
+	// the server's authentication token. The standard handshake, beginning with
+	// ProtocolFrameHeaderMagic, follows it.
+	MaxAuthTokenSize = 1024
+)
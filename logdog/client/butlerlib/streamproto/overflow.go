@@ -0,0 +1,71 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package streamproto
+
+import (
+	"encoding/json"
+	"flag"
+
+	"github.com/luci/luci-go/common/flag/flagenum"
+)
+
+// OverflowPolicy is an enumeration of the actions that the Butler can take
+// when a stream exceeds its configured rate limit or byte quota.
+type OverflowPolicy uint
+
+var _ interface {
+	flag.Value
+	json.Marshaler
+	json.Unmarshaler
+} = (*OverflowPolicy)(nil)
+
+const (
+	// OverflowDefault indicates that the Butler's default overflow policy should
+	// be used.
+	OverflowDefault OverflowPolicy = iota
+	// OverflowDrop discards data that exceeds the stream's limits. For TEXT
+	// streams, a marker line is inserted in place of the discarded data.
+	OverflowDrop
+	// OverflowBlock stops reading from the stream until its rate limit permits
+	// more data, applying backpressure to the writer.
+	//
+	// A blocked stream that exhausts its byte quota can never recover, so it
+	// will be truncated instead.
+	OverflowBlock
+	// OverflowTruncate terminates the stream the first time it exceeds one of
+	// its limits.
+	OverflowTruncate
+)
+
+var (
+	// OverflowPolicyFlagEnum is a flag- and JSON-compatible enumeration mapping
+	// OverflowPolicy configuration strings to their underlying OverflowPolicy
+	// values.
+	OverflowPolicyFlagEnum = flagenum.Enum{
+		"drop":     OverflowDrop,
+		"block":    OverflowBlock,
+		"truncate": OverflowTruncate,
+	}
+)
+
+// Set implements flag.Value.
+func (p *OverflowPolicy) Set(v string) error {
+	return OverflowPolicyFlagEnum.FlagSet(p, v)
+}
+
+// String implements flag.Value.
+func (p *OverflowPolicy) String() string {
+	return OverflowPolicyFlagEnum.FlagString(p)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *OverflowPolicy) UnmarshalJSON(data []byte) error {
+	return OverflowPolicyFlagEnum.JSONUnmarshal(p, data)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p OverflowPolicy) MarshalJSON() ([]byte, error) {
+	return OverflowPolicyFlagEnum.JSONMarshal(p)
+}
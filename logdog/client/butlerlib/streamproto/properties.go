@@ -5,6 +5,7 @@
 package streamproto
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/luci/luci-go/common/clock/clockflag"
@@ -35,6 +36,17 @@ type Properties struct {
 	// Note that this value is best-effort, as it is subject to the constraints
 	// of the underlying transport medium.
 	Deadline time.Duration
+
+	// RateLimit, if >0, is the maximum number of bytes per second that the
+	// Butler will accept from this stream.
+	RateLimit int64
+	// ByteLimit, if >0, is the maximum total number of bytes that the Butler
+	// will accept from this stream.
+	ByteLimit int64
+	// Overflow is the action to take when this stream exceeds its RateLimit or
+	// ByteLimit, or the Butler's own limits. If OverflowDefault, the Butler's
+	// configured policy will be used.
+	Overflow OverflowPolicy
 }
 
 // Validate validates that the configured Properties are valid and sufficient to
@@ -46,6 +58,12 @@ func (p *Properties) Validate() error {
 	if err := p.LogStreamDescriptor.Validate(false); err != nil {
 		return err
 	}
+	if p.RateLimit < 0 {
+		return fmt.Errorf("invalid rate limit: %d", p.RateLimit)
+	}
+	if p.ByteLimit < 0 {
+		return fmt.Errorf("invalid byte limit: %d", p.ByteLimit)
+	}
 	return nil
 }
 
@@ -62,6 +80,10 @@ type Flags struct {
 	Tee      TeeType            `json:"tee,omitempty"`
 	Timeout  clockflag.Duration `json:"timeout,omitempty"`
 	Deadline clockflag.Duration `json:"deadline,omitempty"`
+
+	RateLimit int64          `json:"rateLimit,omitempty"`
+	ByteLimit int64          `json:"byteLimit,omitempty"`
+	Overflow  OverflowPolicy `json:"overflow,omitempty"`
 }
 
 // Properties converts the Flags to a standard Properties structure.
@@ -85,6 +107,10 @@ func (f *Flags) Properties() *Properties {
 		Tee:      f.Tee,
 		Timeout:  time.Duration(f.Timeout),
 		Deadline: time.Duration(f.Deadline),
+
+		RateLimit: f.RateLimit,
+		ByteLimit: f.ByteLimit,
+		Overflow:  f.Overflow,
 	}
 	return p
 }
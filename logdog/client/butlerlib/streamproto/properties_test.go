@@ -41,6 +41,14 @@ func TestProperties(t *testing.T) {
 				So(p.Validate(), ShouldNotBeNil)
 			})
 
+			Convey(`Will fail to validate with a negative limit.`, func() {
+				p.Name = "foo/bar"
+				p.ContentType = "some/mimetype"
+				p.Timestamp = google.NewTimestamp(clock.Now(ctx))
+				p.RateLimit = -1
+				So(p.Validate(), ShouldNotBeNil)
+			})
+
 			Convey(`Will validate if valid.`, func() {
 				p.Name = "foo/bar"
 				p.ContentType = "some/mimetype"
@@ -121,5 +129,27 @@ func TestFlagsJSON(t *testing.T) {
 				Tee: TeeNone,
 			})
 		})
+
+		Convey(`Will decode stream limits.`, func() {
+			t := `{"name": "my/stream", "rateLimit": 1024, "byteLimit": 4096, "overflow": "drop"}`
+			So(json.Unmarshal([]byte(t), &f), ShouldBeNil)
+
+			So(f.Properties(), ShouldResemble, &Properties{
+				LogStreamDescriptor: logpb.LogStreamDescriptor{
+					Name:        "my/stream",
+					StreamType:  logpb.StreamType_TEXT,
+					ContentType: string(types.ContentTypeText),
+				},
+				Tee:       TeeNone,
+				RateLimit: 1024,
+				ByteLimit: 4096,
+				Overflow:  OverflowDrop,
+			})
+		})
+
+		Convey(`Will fail to decode an invalid overflow policy.`, func() {
+			t := `{"name": "my/stream", "overflow": "XXX_whatisthis?"}`
+			So(json.Unmarshal([]byte(t), &f), ShouldNotBeNil)
+		})
 	})
 }
@@ -8,6 +8,7 @@ import (
 	"flag"
 
 	"github.com/luci/luci-go/common/flag/flagenum"
+	"github.com/luci/luci-go/logdog/client/annotee"
 	"github.com/luci/luci-go/logdog/client/annotee/executor"
 )
 
@@ -28,3 +29,33 @@ func (val *annotationMode) Set(v string) error {
 func (val *annotationMode) String() string {
 	return annotationFlagEnum.FlagString(val)
 }
+
+type summaryFormat annotee.SummaryFormat
+
+var summaryFormatFlagEnum = flagenum.Enum{
+	"json":      summaryFormat(annotee.SummaryJSON),
+	"proto":     summaryFormat(annotee.SummaryProto),
+	"textproto": summaryFormat(annotee.SummaryTextProto),
+}
+
+var _ flag.Value = (*summaryFormat)(nil)
+
+func (val *summaryFormat) Set(v string) error {
+	return summaryFormatFlagEnum.FlagSet(val, v)
+}
+
+func (val *summaryFormat) String() string {
+	return summaryFormatFlagEnum.FlagString(val)
+}
+
+// summaryWriter returns the annotee.SummaryWriter for the supplied summary
+// path, or nil if no path was supplied.
+func summaryWriter(path string, f summaryFormat) annotee.SummaryWriter {
+	if path == "" {
+		return nil
+	}
+	return &annotee.FileSummaryWriter{
+		Path:   path,
+		Format: annotee.SummaryFormat(f),
+	}
+}
@@ -65,6 +65,8 @@ type application struct {
 	nameBase           streamproto.StreamNameFlag
 	prefix             streamproto.StreamNameFlag
 	logdogHost         string
+	summaryPath        string
+	summaryFormat      summaryFormat
 
 	bootstrap *bootstrap.Bootstrap
 }
@@ -95,6 +97,11 @@ func (a *application) addToFlagSet(fs *flag.FlagSet) {
 	fs.Var(&a.prefix, "prefix", "The log stream prefix. If missing, one will be inferred from bootstrap.")
 	fs.StringVar(&a.logdogHost, "logdog-host", "",
 		"LogDog Coordinator host name. If supplied, log viewing links will be generated.")
+	fs.StringVar(&a.summaryPath, "summary-path", "",
+		"If supplied, a summary of the annotation step tree will be written to this file whenever a step "+
+			"changes and when execution finishes.")
+	fs.Var(&a.summaryFormat, "summary-format",
+		"The format of the -summary-path file. Options are: "+summaryFormatFlagEnum.Choices())
 }
 
 func (a *application) loadJSONArgs() ([]string, error) {
@@ -143,6 +150,10 @@ func (a *application) maybeWriteResult(r *bootstrapResult.Result) error {
 func mainImpl(args []string) int {
 	ctx := gologger.StdConfig.Use(context.Background())
 
+	if len(args) > 0 && args[0] == "replay" {
+		return replayMain(ctx, args[1:])
+	}
+
 	logFlags := log.Config{
 		Level: log.Warning,
 	}
@@ -224,6 +235,7 @@ func mainImpl(args []string) int {
 			Client:                 client,
 			MetadataUpdateInterval: time.Duration(a.annotationInterval),
 			CloseSteps:             true,
+			Summary:                summaryWriter(a.summaryPath, a.summaryFormat),
 		},
 
 		Annotate: executor.AnnotationMode(a.annotate),
@@ -0,0 +1,91 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/golang/protobuf/proto"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/logdog/client/annotee"
+	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
+	"github.com/luci/luci-go/logdog/common/types"
+	"golang.org/x/net/context"
+)
+
+// replayMain is the entry point for "replay" mode, which reconstructs the
+// annotation step state from an existing annotated log file rather than
+// executing a process.
+//
+// Usage: logdog_annotee replay [flags] <path|->
+func replayMain(c context.Context, args []string) int {
+	logFlags := log.Config{
+		Level: log.Warning,
+	}
+
+	var (
+		nameBase      streamproto.StreamNameFlag
+		summaryPath   string
+		summaryFormat summaryFormat
+		printSummary  bool
+	)
+
+	fs := &flag.FlagSet{}
+	logFlags.AddFlags(fs)
+	fs.Var(&nameBase, "name-base", "Base stream name to prepend to generated names.")
+	fs.StringVar(&summaryPath, "summary-path", "",
+		"If supplied, a summary of the annotation step tree will be written to this file.")
+	fs.Var(&summaryFormat, "summary-format",
+		"The format of the -summary-path file. Options are: "+summaryFormatFlagEnum.Choices())
+	fs.BoolVar(&printSummary, "print-summary", true,
+		"Print the reconstructed annotation protobuf at the end.")
+	if err := fs.Parse(args); err != nil {
+		log.WithError(err).Errorf(c, "Failed to parse flags.")
+		return configErrorReturnCode
+	}
+	c = logFlags.Set(c)
+
+	args = fs.Args()
+	if len(args) != 1 {
+		log.Errorf(c, "Exactly one annotated log file path (or '-' for STDIN) must be supplied.")
+		return configErrorReturnCode
+	}
+
+	var r io.Reader
+	if path := args[0]; path == "-" {
+		r = os.Stdin
+	} else {
+		fd, err := os.Open(path)
+		if err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"path":       path,
+			}.Errorf(c, "Failed to open annotated log file.")
+			return configErrorReturnCode
+		}
+		defer fd.Close()
+		r = fd
+	}
+
+	st, err := annotee.Replay(c, r, annotee.ReplayOptions{
+		Base:       types.StreamName(nameBase),
+		Summary:    summaryWriter(summaryPath, summaryFormat),
+		CloseSteps: true,
+	})
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to replay annotated log.")
+		return runtimeErrorReturnCode
+	}
+
+	if printSummary {
+		root := st.RootStep().Proto()
+		fmt.Printf("=== Annotee: %q ===\n", root.Name)
+		fmt.Println(proto.MarshalTextString(root))
+	}
+	return 0
+}
@@ -26,11 +26,14 @@ import (
 	"github.com/luci/luci-go/common/config"
 	"github.com/luci/luci-go/common/errors"
 	"github.com/luci/luci-go/common/flag/multiflag"
+	"github.com/luci/luci-go/common/flag/stringlistflag"
 	log "github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/common/logging/gologger"
 	"github.com/luci/luci-go/common/runtime/paniccatcher"
 	"github.com/luci/luci-go/logdog/client/butler"
 	"github.com/luci/luci-go/logdog/client/butler/output"
+	"github.com/luci/luci-go/logdog/client/butler/redact"
+	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
 	"github.com/luci/luci-go/logdog/common/types"
 )
 
@@ -65,6 +68,16 @@ type application struct {
 	maxBufferAge clockflag.Duration
 	noBufferLogs bool
 
+	rateLimit       int64
+	byteLimit       int64
+	streamRateLimit int64
+	streamByteLimit int64
+	overflow        streamproto.OverflowPolicy
+
+	redactBuiltin  bool
+	redactLiterals stringlistflag.Flag
+	redactRegexps  stringlistflag.Flag
+
 	cpuProfile string
 
 	client *http.Client
@@ -103,6 +116,46 @@ func (a *application) addFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&a.noBufferLogs, "output-no-buffer", false,
 		"If true, dispatch logs immediately. Setting this flag simplifies output at the expense "+
 			"of wire-format efficiency.")
+	fs.Int64Var(&a.rateLimit, "rate-limit", 0,
+		"If >0, the maximum number of bytes per second to accept across all streams.")
+	fs.Int64Var(&a.byteLimit, "byte-limit", 0,
+		"If >0, the maximum total number of bytes to accept across all streams.")
+	fs.Int64Var(&a.streamRateLimit, "stream-rate-limit", 0,
+		"If >0, the default maximum number of bytes per second to accept from a single stream.")
+	fs.Int64Var(&a.streamByteLimit, "stream-byte-limit", 0,
+		"If >0, the default maximum total number of bytes to accept from a single stream.")
+	fs.Var(&a.overflow, "overflow",
+		fmt.Sprintf("Default action to take when a stream exceeds a limit. Options are: %s",
+			streamproto.OverflowPolicyFlagEnum.Choices()))
+	fs.BoolVar(&a.redactBuiltin, "redact-builtin", false,
+		"If true, redact common credential formats (OAuth tokens, private keys, service "+
+			"account JSON) from TEXT streams.")
+	fs.Var(&a.redactLiterals, "redact-literal",
+		"Redact this literal string from TEXT streams. May be specified multiple times.")
+	fs.Var(&a.redactRegexps, "redact-regexp",
+		"Redact matches of this regular expression from TEXT streams. If the expression "+
+			"has a capture group named \"secret\", only that group is redacted. May be "+
+			"specified multiple times.")
+}
+
+// redactionRules returns the redaction rules configured via command-line
+// flags.
+func (a *application) redactionRules() ([]redact.Rule, error) {
+	var rules []redact.Rule
+	if a.redactBuiltin {
+		rules = append(rules, redact.Builtin()...)
+	}
+	for _, l := range a.redactLiterals {
+		rules = append(rules, redact.Literal(l))
+	}
+	for _, expr := range a.redactRegexps {
+		r, err := redact.Regexp(expr)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, r)
+	}
+	return rules, nil
 }
 
 func (a *application) authenticator(ctx context.Context) (*auth.Authenticator, error) {
@@ -142,6 +195,11 @@ func (a *application) runWithButler(ctx context.Context, out output.Output,
 		defer pprof.StopCPUProfile()
 	}
 
+	redactionRules, err := a.redactionRules()
+	if err != nil {
+		return err
+	}
+
 	// Instantiate our Butler.
 	butlerOpts := butler.Config{
 		Project:       a.project,
@@ -152,6 +210,14 @@ func (a *application) runWithButler(ctx context.Context, out output.Output,
 		OutputWorkers: a.outputWorkers,
 		TeeStdout:     os.Stdout,
 		TeeStderr:     os.Stderr,
+
+		RateLimit:       a.rateLimit,
+		ByteLimit:       a.byteLimit,
+		StreamRateLimit: a.streamRateLimit,
+		StreamByteLimit: a.streamByteLimit,
+		Overflow:        a.overflow,
+
+		RedactionRules: redactionRules,
 	}
 	b, err := butler.New(a, butlerOpts)
 	if err != nil {
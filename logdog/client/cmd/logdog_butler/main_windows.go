@@ -40,14 +40,8 @@ func (u streamServerURI) Parse() (string, error) {
 	return value, nil
 }
 
-// Validates that the URI is correct for Windows.
-func (u streamServerURI) Validate() error {
-	_, err := u.Parse()
-	return err
-}
-
 // Create a Windows stream server.
-func createStreamServer(ctx context.Context, uri streamServerURI) streamserver.StreamServer {
+func createNamedPipeServer(ctx context.Context, uri streamServerURI) streamserver.StreamServer {
 	name, err := uri.Parse()
 	if err != nil {
 		panic("Failed to parse stream server URI.")
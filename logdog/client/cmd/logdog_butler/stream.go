@@ -36,6 +36,13 @@ func (s *streamConfig) addFlags(fs *flag.FlagSet) {
 		fmt.Sprintf("Tee the stream through the Butler's output. Options are: %s",
 			streamproto.TeeTypeFlagEnum.Choices()))
 	fs.Var(&s.Tags, "tag", "Add a key=value tag.")
+	fs.Int64Var(&s.RateLimit, "rate-limit", 0,
+		"If >0, the maximum number of bytes per second to accept from this stream.")
+	fs.Int64Var(&s.ByteLimit, "byte-limit", 0,
+		"If >0, the maximum total number of bytes to accept from this stream.")
+	fs.Var(&s.Overflow, "overflow",
+		fmt.Sprintf("Action to take when this stream exceeds a limit. Options are: %s",
+			streamproto.OverflowPolicyFlagEnum.Choices()))
 }
 
 // Converts command-line parameters into a stream.Config.
@@ -6,7 +6,14 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"net"
+	"os"
 	"strings"
+
+	"github.com/luci/luci-go/logdog/client/butler/streamserver"
+	"github.com/luci/luci-go/logdog/client/butlerlib/bootstrap"
+	"golang.org/x/net/context"
 )
 
 var (
@@ -36,3 +43,50 @@ func parseStreamServer(v string) (string, string) {
 	}
 	return parts[0], parts[1]
 }
+
+// parseTCP parses a TCP stream server URI (tcp4:<host>:<port> or
+// tcp6:<host>:<port>). If the URI is not a TCP URI, ok will be false.
+func (u streamServerURI) parseTCP() (network, address string, ok bool) {
+	typ, value := parseStreamServer(string(u))
+	switch typ {
+	case "tcp4", "tcp6":
+		return typ, value, true
+	default:
+		return "", "", false
+	}
+}
+
+// Validate validates that the URI is correct for this platform.
+func (u streamServerURI) Validate() error {
+	if _, address, ok := u.parseTCP(); ok {
+		if _, _, err := net.SplitHostPort(address); err != nil {
+			return fmt.Errorf("invalid TCP address [%s]: %s", address, err)
+		}
+		return nil
+	}
+
+	_, err := u.Parse()
+	return err
+}
+
+// createStreamServer creates the stream server described by uri.
+//
+// TCP stream servers require an authentication token. If the Butler's own
+// environment has a stream server token, it will be used; otherwise, a random
+// token will be generated. Other stream servers ignore the inherited token.
+func createStreamServer(ctx context.Context, uri streamServerURI) (streamserver.StreamServer, error) {
+	if network, address, ok := uri.parseTCP(); ok {
+		return streamserver.NewTCPServer(ctx, network, address, os.Getenv(bootstrap.EnvStreamServerToken))
+	}
+	return createNamedPipeServer(ctx, uri), nil
+}
+
+// streamServerBootstrap returns the URI and authentication token that a
+// bootstrapped process should use to connect to a listening stream server.
+func streamServerBootstrap(s streamserver.StreamServer, uri streamServerURI) (string, string) {
+	if ts, ok := s.(*streamserver.TCPServer); ok {
+		// Use the bound address, in case the URI requested an ephemeral port.
+		return ts.URI(), ts.Token()
+	}
+	return string(uri), ""
+}
@@ -177,7 +177,10 @@ func (cmd *runCommandRun) Run(app subcommands.Application, args []string) int {
 		log.Fields{
 			"url": cmd.streamServerURI,
 		}.Infof(a, "Creating stream server.")
-		streamServer = createStreamServer(a, cmd.streamServerURI)
+		if streamServer, err = createStreamServer(a, cmd.streamServerURI); err != nil {
+			log.WithError(err).Errorf(a, "Failed to create stream server.")
+			return runtimeErrorReturnCode
+		}
 
 		if err := streamServer.Listen(); err != nil {
 			log.Errorf(log.SetError(a, err), "Failed to connect to stream server.")
@@ -189,7 +192,7 @@ func (cmd *runCommandRun) Run(app subcommands.Application, args []string) int {
 			}
 		}()
 
-		bsEnv.StreamServerURI = string(cmd.streamServerURI)
+		bsEnv.StreamServerURI, bsEnv.StreamServerToken = streamServerBootstrap(streamServer, cmd.streamServerURI)
 	}
 
 	// Build our command enviornment.
@@ -5,8 +5,13 @@
 package main
 
 import (
+	"fmt"
+	"os"
+
 	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/system/environ"
 	"github.com/luci/luci-go/logdog/client/butler"
+	"github.com/luci/luci-go/logdog/client/butler/bootstrap"
 
 	"github.com/maruel/subcommands"
 	"golang.org/x/net/context"
@@ -15,7 +20,9 @@ import (
 var subcommandServe = &subcommands.Command{
 	UsageLine: "serve",
 	ShortDesc: "Instantiates a stream server.",
-	LongDesc:  "Instantiates a stream server, accepting connections and forwarding them to output.",
+	LongDesc: "Instantiates a stream server, accepting connections and forwarding them to output. " +
+		"If the stream server requires an authentication token (e.g., a TCP stream server), the " +
+		"environment that clients should use to connect is written to STDOUT.",
 	CommandRun: func() subcommands.CommandRun {
 		cmd := &serveCommandRun{}
 
@@ -41,12 +48,34 @@ func (cmd *serveCommandRun) Run(app subcommands.Application, args []string) int
 		}.Errorf(a, "Invalid stream server URI.")
 		return configErrorReturnCode
 	}
-	streamServer := createStreamServer(a, cmd.uri)
+	streamServer, err := createStreamServer(a, cmd.uri)
+	if err != nil {
+		log.WithError(err).Errorf(a, "Failed to create stream server.")
+		return runtimeErrorReturnCode
+	}
 
 	if err := streamServer.Listen(); err != nil {
 		log.Errorf(log.SetError(a, err), "Failed to connect to stream server.")
 		return runtimeErrorReturnCode
 	}
+	uri, token := streamServerBootstrap(streamServer, cmd.uri)
+	if uri != string(cmd.uri) {
+		log.Fields{
+			"uri": uri,
+		}.Infof(a, "Stream server is listening.")
+	}
+	if token != "" {
+		// Clients can't connect without the token, so export it to them.
+		env := environ.Env{}
+		bsEnv := bootstrap.Environment{
+			StreamServerURI:   uri,
+			StreamServerToken: token,
+		}
+		bsEnv.Augment(env)
+		for _, v := range env.Sorted() {
+			fmt.Fprintln(os.Stdout, v)
+		}
+	}
 
 	// We think everything will work. Configure our Output instance.
 	output, err := a.configOutput()
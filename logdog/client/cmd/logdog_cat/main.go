@@ -97,6 +97,7 @@ func mainImpl() int {
 			Commands: []*subcommands.Command{
 				subcommands.CmdHelp,
 				newCatCommand(),
+				newTailCommand(),
 				newQueryCommand(),
 				newListCommand(),
 				authcli.SubcommandLogin(authOptions, "auth-login"),
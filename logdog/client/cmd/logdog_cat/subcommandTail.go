@@ -0,0 +1,355 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/clockflag"
+	"github.com/luci/luci-go/common/config"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/logdog/client/coordinator"
+	"github.com/luci/luci-go/logdog/common/fetcher"
+	"github.com/maruel/subcommands"
+	"golang.org/x/net/context"
+)
+
+const (
+	// defaultTailPollInterval is the default amount of time in between queries
+	// for new log streams.
+	defaultTailPollInterval = 5 * time.Second
+
+	// defaultTailReorderWindow is the default amount of time that a log entry is
+	// held so it can be ordered with entries from other streams.
+	defaultTailReorderWindow = 1 * time.Second
+)
+
+type tailCommandRun struct {
+	subcommands.CommandRunBase
+
+	follow        bool
+	poll          clockflag.Duration
+	reorderWindow clockflag.Duration
+	noColor       bool
+	fetchSize     int
+	fetchBytes    int
+}
+
+func newTailCommand() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "tail [-f] <path>...",
+		ShortDesc: "Write the text log streams matching a path query to STDOUT.",
+		LongDesc: "Write the text log streams matching one or more path queries (e.g., " +
+			"'project/prefix/+/**') to STDOUT, interleaved in timestamp order. Each line is prefixed " +
+			"with the name of its log stream.",
+		CommandRun: func() subcommands.CommandRun {
+			cmd := &tailCommandRun{
+				poll:          clockflag.Duration(defaultTailPollInterval),
+				reorderWindow: clockflag.Duration(defaultTailReorderWindow),
+			}
+
+			fs := cmd.GetFlags()
+			fs.BoolVar(&cmd.follow, "f", false,
+				"Follow: continue to query for new log streams until interrupted. Otherwise, only the log "+
+					"streams that match when the command is started will be tailed.")
+			fs.Var(&cmd.poll, "poll", "The amount of time in between queries for new log streams.")
+			fs.Var(&cmd.reorderWindow, "reorder-window",
+				"The amount of time to hold log entries so they can be ordered with entries from other "+
+					"streams. A larger window produces a more accurate ordering at the expense of latency.")
+			fs.BoolVar(&cmd.noColor, "no-color", false, "Don't colorize log stream name prefixes.")
+			fs.IntVar(&cmd.fetchSize, "fetch-size", 0, "Constrains the number of log entries to fetch per request.")
+			fs.IntVar(&cmd.fetchBytes, "fetch-bytes", 0, "Constrains the number of bytes to fetch per request.")
+			return cmd
+		},
+	}
+}
+
+// tailQuery is a single query path to tail.
+type tailQuery struct {
+	project config.ProjectName
+	path    string
+	unified bool
+}
+
+func (cmd *tailCommandRun) Run(scApp subcommands.Application, args []string) int {
+	a := scApp.(*application)
+
+	if len(args) == 0 {
+		log.Errorf(a, "At least one query path must be supplied.")
+		return 1
+	}
+	if cmd.follow && cmd.poll <= 0 {
+		log.Fields{
+			"value": cmd.poll,
+		}.Errorf(a, "Poll interval must be >0.")
+		return 1
+	}
+	if cmd.reorderWindow < 0 {
+		log.Fields{
+			"value": cmd.reorderWindow,
+		}.Errorf(a, "Reorder window must be >=0.")
+		return 1
+	}
+
+	queries := make([]*tailQuery, len(args))
+	for i, arg := range args {
+		project, path, unified, err := a.splitPath(arg)
+		if err != nil {
+			log.WithError(err).Errorf(a, "Invalid path specifier.")
+			return 1
+		}
+		queries[i] = &tailQuery{project, path, unified}
+	}
+
+	c, cancelFunc := context.WithCancel(a)
+	defer cancelFunc()
+
+	t := tailer{
+		tailCommandRun: cmd,
+		app:            a,
+		entC:           make(chan *tailEntry),
+		seen:           make(map[string]struct{}),
+	}
+
+	// Discover and fetch streams. Once discovery has finished and all of the
+	// discovered streams have been fetched, close our entry channel.
+	var discoverErr error
+	t.fetchWG.Add(1)
+	go func() {
+		defer t.fetchWG.Done()
+		discoverErr = t.discover(c, queries)
+	}()
+	go func() {
+		t.fetchWG.Wait()
+		close(t.entC)
+	}()
+
+	bw := bufio.NewWriter(os.Stdout)
+	defer bw.Flush()
+	if err := t.output(c, bw); err != nil {
+		log.WithError(err).Errorf(a, "Failed to write log output.")
+		return 1
+	}
+
+	if discoverErr != nil {
+		log.WithError(discoverErr).Errorf(a, "Failed to query for log streams.")
+		return 1
+	}
+	if t.fetchFailed {
+		return 1
+	}
+	return 0
+}
+
+// tailer discovers, fetches, and multiplexes tailed log streams.
+type tailer struct {
+	*tailCommandRun
+
+	app *application
+
+	// entC receives log entries from each of the stream fetchers.
+	entC chan *tailEntry
+	// fetchWG tracks the discovery and stream fetcher goroutines.
+	fetchWG sync.WaitGroup
+
+	// seen is the set of streams that have already been discovered. It is only
+	// accessed by the discovery goroutine.
+	seen map[string]struct{}
+	// streams is the number of streams that have been discovered. It is used to
+	// assign each stream a color.
+	streams int
+
+	fetchFailedMu sync.Mutex
+	fetchFailed   bool
+}
+
+// discover queries for log streams matching the supplied queries, starting a
+// fetcher for each new stream.
+//
+// If following, discover will continue to query for new streams until its
+// Context is cancelled. Otherwise, it will return after a single round of
+// queries.
+func (t *tailer) discover(c context.Context, queries []*tailQuery) error {
+	for {
+		for _, q := range queries {
+			qo := coordinator.QueryOptions{
+				StreamType: coordinator.Text,
+				State:      true,
+			}
+			err := t.app.coord.Query(c, q.project, q.path, qo, func(s *coordinator.LogStream) bool {
+				t.addStream(c, q, s)
+				return true
+			})
+			if err != nil {
+				if c.Err() != nil {
+					return nil
+				}
+				if !t.follow {
+					return err
+				}
+
+				// We're following, so a failed query is not fatal; we'll try again.
+				log.Fields{
+					log.ErrorKey: err,
+					"project":    q.project,
+					"path":       q.path,
+				}.Warningf(c, "Failed to query for log streams.")
+			}
+		}
+
+		if !t.follow {
+			return nil
+		}
+		if tr := <-clock.After(c, time.Duration(t.poll)); tr.Incomplete() {
+			return nil
+		}
+	}
+}
+
+// addStream begins fetching a log stream, if it has not already been
+// discovered.
+func (t *tailer) addStream(c context.Context, q *tailQuery, s *coordinator.LogStream) {
+	key := makeUnifiedPath(s.Project, s.Path)
+	if _, ok := t.seen[key]; ok {
+		return
+	}
+	t.seen[key] = struct{}{}
+
+	ts := tailStream{
+		name: string(s.Path),
+	}
+	if q.unified {
+		ts.name = key
+	}
+	if !t.noColor {
+		ts.color = tailColors[t.streams%len(tailColors)]
+	}
+	t.streams++
+
+	log.Fields{
+		"project": s.Project,
+		"path":    s.Path,
+	}.Debugf(c, "Tailing new log stream.")
+
+	t.fetchWG.Add(1)
+	go func() {
+		defer t.fetchWG.Done()
+
+		if err := t.fetchStream(c, s, &ts); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"project":    s.Project,
+				"path":       s.Path,
+			}.Errorf(c, "Failed to fetch log stream.")
+
+			t.fetchFailedMu.Lock()
+			defer t.fetchFailedMu.Unlock()
+			t.fetchFailed = true
+		}
+	}()
+}
+
+// fetchStream fetches a single log stream until it terminates, sending its log
+// entries to the multiplexer.
+func (t *tailer) fetchStream(c context.Context, s *coordinator.LogStream, ts *tailStream) error {
+	src := coordinatorSource{
+		stream: t.app.coord.Stream(s.Project, s.Path),
+	}
+	src.tidx = -1 // Must be set to probe for state.
+
+	f := fetcher.New(c, fetcher.Options{
+		Source:      &src,
+		BufferCount: t.fetchSize,
+		BufferBytes: int64(t.fetchBytes),
+	})
+
+	for {
+		le, err := f.NextLogEntry()
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			if c.Err() != nil {
+				// We've been cancelled; this is not an error.
+				return nil
+			}
+			return err
+		case le == nil:
+			continue
+		}
+
+		// Determine the entry's absolute timestamp. Prefer the descriptor that
+		// the query returned; otherwise, use the one that the source loaded.
+		desc := s.Desc
+		if desc == nil {
+			desc, _ = src.descriptor()
+		}
+		e := tailEntry{
+			stream:    ts,
+			le:        le,
+			timestamp: desc.GetTimestamp().Time().Add(le.TimeOffset.Duration()),
+		}
+
+		select {
+		case t.entC <- &e:
+		case <-c.Done():
+			return nil
+		}
+	}
+}
+
+// output receives log entries from the fetchers and writes them to w in
+// timestamp order.
+func (t *tailer) output(c context.Context, w *bufio.Writer) error {
+	mux := tailMux{
+		window: time.Duration(t.reorderWindow),
+	}
+
+	write := func(all bool) error {
+		for _, e := range mux.release(clock.Now(c), all) {
+			if err := e.write(w); err != nil {
+				return err
+			}
+		}
+		return w.Flush()
+	}
+
+	// Release entries at least this often while they are pending.
+	tick := time.Duration(t.reorderWindow) / 2
+	if tick <= 0 {
+		tick = 100 * time.Millisecond
+	}
+
+	for {
+		var tickC <-chan clock.TimerResult
+		if mux.pending.Len() > 0 {
+			tickC = clock.After(c, tick)
+		}
+
+		select {
+		case e, ok := <-t.entC:
+			if !ok {
+				// All streams have finished; write any remaining entries.
+				return write(true)
+			}
+			mux.add(e, clock.Now(c))
+
+		case tr := <-tickC:
+			if tr.Incomplete() {
+				// We've been cancelled; write what we have.
+				return write(true)
+			}
+		}
+
+		if err := write(false); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"container/heap"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/luci/luci-go/logdog/api/logpb"
+)
+
+// tailColors is the set of ANSI color codes that are cycled through when
+// assigning colors to tailed streams.
+var tailColors = []int{32, 33, 34, 35, 36, 92, 93, 94, 95, 96}
+
+// tailStream is a single log stream that is being tailed.
+type tailStream struct {
+	// name is the display name of the stream.
+	name string
+	// color is the ANSI color code for this stream's prefix, or 0 for no color.
+	color int
+}
+
+// prefix returns the prefix to write in front of each of this stream's lines.
+func (s *tailStream) prefix() string {
+	if s.color == 0 {
+		return fmt.Sprintf("[%s] ", s.name)
+	}
+	return fmt.Sprintf("\x1b[%dm[%s]\x1b[0m ", s.color, s.name)
+}
+
+// tailEntry is a single log entry fetched from a tailed stream.
+type tailEntry struct {
+	stream *tailStream
+	le     *logpb.LogEntry
+
+	// timestamp is the absolute timestamp of the log entry.
+	timestamp time.Time
+	// received is the time when the entry was received by the multiplexer.
+	received time.Time
+	// seq is the order in which the entry was received by the multiplexer. It is
+	// used to keep entries with identical timestamps in their arrival order.
+	seq int64
+}
+
+// write writes the entry's text lines to w, each with the stream's prefix.
+func (e *tailEntry) write(w io.Writer) error {
+	prefix := e.stream.prefix()
+	for _, line := range e.le.GetText().GetLines() {
+		if _, err := fmt.Fprintf(w, "%s%s\n", prefix, line.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tailEntryHeap is a heap of tailEntry, ordered by timestamp.
+type tailEntryHeap []*tailEntry
+
+func (h tailEntryHeap) Len() int { return len(h) }
+func (h tailEntryHeap) Less(i, j int) bool {
+	if !h[i].timestamp.Equal(h[j].timestamp) {
+		return h[i].timestamp.Before(h[j].timestamp)
+	}
+	return h[i].seq < h[j].seq
+}
+func (h tailEntryHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *tailEntryHeap) Push(x interface{}) { *h = append(*h, x.(*tailEntry)) }
+func (h *tailEntryHeap) Pop() interface{} {
+	old := *h
+	e := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	return e
+}
+
+// tailMux interleaves log entries from multiple streams in timestamp order.
+//
+// Since streams are fetched independently, entries from one stream may arrive
+// after later entries from another. To account for this, entries are held for
+// a reorder window before they are released, allowing entries with earlier
+// timestamps to arrive and be sorted ahead of them.
+type tailMux struct {
+	// window is the amount of time that an entry is held before it is released.
+	window time.Duration
+
+	pending tailEntryHeap
+	seq     int64
+}
+
+// add adds a log entry to the multiplexer. The supplied time is the time that
+// the entry was received.
+func (m *tailMux) add(e *tailEntry, now time.Time) {
+	e.received = now
+	e.seq = m.seq
+	m.seq++
+	heap.Push(&m.pending, e)
+}
+
+// release returns the entries that are ready to be emitted, in timestamp
+// order. An entry is ready once it has been held for the reorder window.
+//
+// If all is true, all pending entries will be returned.
+func (m *tailMux) release(now time.Time, all bool) []*tailEntry {
+	var ready []*tailEntry
+	cutoff := now.Add(-m.window)
+	for len(m.pending) > 0 {
+		if !all && m.pending[0].received.After(cutoff) {
+			break
+		}
+		ready = append(ready, heap.Pop(&m.pending).(*tailEntry))
+	}
+	return ready
+}
@@ -0,0 +1,207 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"golang.org/x/net/context"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// testTailEntry returns a tailEntry with a single text line, value.
+func testTailEntry(s *tailStream, ts time.Time, value string) *tailEntry {
+	return &tailEntry{
+		stream: s,
+		le: &logpb.LogEntry{
+			Content: &logpb.LogEntry_Text{Text: &logpb.Text{
+				Lines: []*logpb.Text_Line{{Value: value}},
+			}},
+		},
+		timestamp: ts,
+	}
+}
+
+// failWriter is an io.Writer that always fails.
+type failWriter struct{}
+
+func (failWriter) Write([]byte) (int, error) { return 0, errors.New("test error") }
+
+func TestTailMux(t *testing.T) {
+	t.Parallel()
+
+	type muxAdd struct {
+		value    string
+		ts       time.Duration
+		received time.Duration
+	}
+	type muxRelease struct {
+		at   time.Duration
+		all  bool
+		want []string
+	}
+
+	Convey(`A tailMux`, t, func() {
+		epoch := testclock.TestTimeUTC
+		s := &tailStream{name: "foo"}
+
+		for _, tc := range []struct {
+			title    string
+			window   time.Duration
+			adds     []muxAdd
+			releases []muxRelease
+		}{
+			{
+				title:  `Holds entries for the reorder window.`,
+				window: time.Second,
+				adds:   []muxAdd{{"a", 0, 0}},
+				releases: []muxRelease{
+					{at: 500 * time.Millisecond},
+					{at: time.Second, want: []string{"a"}},
+					{at: 2 * time.Second},
+				},
+			},
+
+			{
+				title:  `Orders entries that arrive within the window by timestamp.`,
+				window: time.Second,
+				adds: []muxAdd{
+					{"late", 2 * time.Second, 0},
+					{"early", time.Second, 500 * time.Millisecond},
+				},
+				releases: []muxRelease{
+					// "late" has been held long enough, but "early" sorts ahead of it
+					// and hasn't.
+					{at: time.Second},
+					{at: 1500 * time.Millisecond, want: []string{"early", "late"}},
+				},
+			},
+
+			{
+				title:  `Keeps entries with identical timestamps in arrival order.`,
+				window: time.Second,
+				adds: []muxAdd{
+					{"first", time.Second, 0},
+					{"second", time.Second, 0},
+					{"third", time.Second, 0},
+				},
+				releases: []muxRelease{
+					{at: time.Second, want: []string{"first", "second", "third"}},
+				},
+			},
+
+			{
+				title:  `Releases entries immediately with no window.`,
+				window: 0,
+				adds: []muxAdd{
+					{"b", 2 * time.Second, 0},
+					{"a", time.Second, 0},
+				},
+				releases: []muxRelease{
+					{at: 0, want: []string{"a", "b"}},
+				},
+			},
+
+			{
+				title:  `Releases all pending entries on request.`,
+				window: time.Hour,
+				adds: []muxAdd{
+					{"b", 2 * time.Second, 0},
+					{"a", time.Second, 0},
+				},
+				releases: []muxRelease{
+					{at: 0},
+					{at: 0, all: true, want: []string{"a", "b"}},
+					{at: 0, all: true},
+				},
+			},
+		} {
+			tc := tc
+
+			Convey(tc.title, func() {
+				m := tailMux{window: tc.window}
+				for _, a := range tc.adds {
+					m.add(testTailEntry(s, epoch.Add(a.ts), a.value), epoch.Add(a.received))
+				}
+
+				for _, r := range tc.releases {
+					var got []string
+					for _, e := range m.release(epoch.Add(r.at), r.all) {
+						got = append(got, e.le.GetText().Lines[0].Value)
+					}
+					So(got, ShouldResemble, r.want)
+				}
+			})
+		}
+	})
+}
+
+func TestTailerOutput(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A tailer writing output`, t, func() {
+		c, _ := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+		c, cancelFunc := context.WithCancel(c)
+		defer cancelFunc()
+
+		epoch := testclock.TestTimeUTC
+		foo := &tailStream{name: "foo"}
+		bar := &tailStream{name: "bar", color: 32}
+
+		tl := tailer{
+			tailCommandRun: &tailCommandRun{},
+			entC:           make(chan *tailEntry, 16),
+		}
+		tl.reorderWindow.Set("1h")
+
+		var buf bytes.Buffer
+		w := bufio.NewWriter(&buf)
+
+		Convey(`Writes all pending entries, in order, when the streams finish.`, func() {
+			tl.entC <- testTailEntry(foo, epoch.Add(2*time.Second), "foo 2")
+			tl.entC <- testTailEntry(bar, epoch.Add(time.Second), "bar 1")
+			tl.entC <- testTailEntry(foo, epoch.Add(3*time.Second), "foo 3")
+			close(tl.entC)
+
+			So(tl.output(c, w), ShouldBeNil)
+			So(buf.String(), ShouldEqual, fmt.Sprintf("%sbar 1\n%sfoo 2\n%sfoo 3\n",
+				bar.prefix(), foo.prefix(), foo.prefix()))
+		})
+
+		Convey(`Writes pending entries when cancelled.`, func() {
+			tl.entC <- testTailEntry(foo, epoch, "foo")
+			outputC := make(chan error)
+			go func() {
+				outputC <- tl.output(c, w)
+			}()
+
+			// Wait for the entry to be consumed, then cancel.
+			for len(tl.entC) > 0 {
+				time.Sleep(time.Millisecond)
+			}
+			cancelFunc()
+
+			So(<-outputC, ShouldBeNil)
+			So(buf.String(), ShouldEqual, foo.prefix()+"foo\n")
+		})
+
+		Convey(`Returns write errors.`, func() {
+			w = bufio.NewWriter(failWriter{})
+			tl.entC <- testTailEntry(foo, epoch, "foo")
+			close(tl.entC)
+
+			So(tl.output(c, w), ShouldErrLike, "test error")
+		})
+	})
+}
@@ -0,0 +1,120 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package blobstore
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrObjectNotExist is returned by NewReader if the object does not exist.
+var ErrObjectNotExist = errors.New("blobstore: object does not exist")
+
+// Writer is a Writer instance for a single blob store object.
+type Writer interface {
+	io.WriteCloser
+
+	// Count returns the number of bytes written by the object.
+	Count() int64
+}
+
+// Client is a generic blob store client.
+//
+// A Client's methods must be goroutine-safe.
+type Client interface {
+	io.Closer
+
+	// NewReader instantiates a new Reader instance for the named path.
+	//
+	// The supplied offset must be >= 0, or else this function will panic.
+	//
+	// If the supplied length is <0, no upper byte bound will be set.
+	NewReader(p Path, offset, length int64) (io.ReadCloser, error)
+
+	// NewWriter instantiates a new Writer instance for the named path.
+	//
+	// The object may not be visible until the Writer has been successfully
+	// closed.
+	NewWriter(p Path) (Writer, error)
+
+	// Delete deletes the object at the specified path.
+	//
+	// If the object does not exist, it is considered a success.
+	Delete(p Path) error
+
+	// Rename renames an object from one path to another.
+	//
+	// Implementations may perform this as a non-atomic copy followed by a
+	// delete, so it may occasionally fail midway.
+	Rename(src, dst Path) error
+}
+
+// SchemeClient is a Client that routes each operation to the Client registered
+// for the operation's Path scheme.
+//
+// Closing a SchemeClient closes all of its registered Clients.
+type SchemeClient map[string]Client
+
+var _ Client = SchemeClient(nil)
+
+func (sc SchemeClient) clientFor(p Path) (Client, error) {
+	scheme := p.Scheme()
+	if c := sc[scheme]; c != nil {
+		return c, nil
+	}
+	return nil, fmt.Errorf("blobstore: no client for scheme %q (path %q)", scheme, p)
+}
+
+// Close implements Client.
+func (sc SchemeClient) Close() (err error) {
+	for _, c := range sc {
+		if cerr := c.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	return
+}
+
+// NewReader implements Client.
+func (sc SchemeClient) NewReader(p Path, offset, length int64) (io.ReadCloser, error) {
+	c, err := sc.clientFor(p)
+	if err != nil {
+		return nil, err
+	}
+	return c.NewReader(p, offset, length)
+}
+
+// NewWriter implements Client.
+func (sc SchemeClient) NewWriter(p Path) (Writer, error) {
+	c, err := sc.clientFor(p)
+	if err != nil {
+		return nil, err
+	}
+	return c.NewWriter(p)
+}
+
+// Delete implements Client.
+func (sc SchemeClient) Delete(p Path) error {
+	c, err := sc.clientFor(p)
+	if err != nil {
+		return err
+	}
+	return c.Delete(p)
+}
+
+// Rename implements Client.
+//
+// Both paths must share the same scheme.
+func (sc SchemeClient) Rename(src, dst Path) error {
+	if src.Scheme() != dst.Scheme() {
+		return fmt.Errorf("blobstore: cannot rename across schemes (%q => %q)", src, dst)
+	}
+	c, err := sc.clientFor(src)
+	if err != nil {
+		return err
+	}
+	return c.Rename(src, dst)
+}
@@ -0,0 +1,121 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package blobstore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/luci/luci-go/common/gcloud/gs"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/logdog/api/config/svcconfig"
+	"golang.org/x/net/context"
+)
+
+// GSClientFactory instantiates a Google Storage client.
+type GSClientFactory func(context.Context) (gs.Client, error)
+
+// NewConfigClient returns the Client described by the supplied archive
+// configuration, along with the Path scheme that it operates on.
+//
+// If no configuration is supplied, a Google Storage client, created by gsf,
+// will be returned.
+func NewConfigClient(c context.Context, cfg *svcconfig.ArchiveConfig, gsf GSClientFactory) (Client, string, error) {
+	if cfg == nil {
+		cfg = &svcconfig.ArchiveConfig{}
+	}
+
+	switch cfg.Type {
+	case svcconfig.ArchiveConfig_GCS:
+		gsClient, err := gsf(c)
+		if err != nil {
+			return nil, "", err
+		}
+		return NewGSClient(gsClient), GSScheme, nil
+
+	case svcconfig.ArchiveConfig_LOCAL:
+		if cfg.LocalRoot == "" {
+			return nil, "", errors.New("missing required config: archivist.archive_config.local_root")
+		}
+		return NewLocalClient(cfg.LocalRoot), LocalScheme, nil
+
+	case svcconfig.ArchiveConfig_S3:
+		s3cfg := cfg.S3
+		if s3cfg == nil || s3cfg.Endpoint == "" {
+			return nil, "", errors.New("missing required config: archivist.archive_config.s3.endpoint")
+		}
+
+		o := S3Options{
+			Endpoint: s3cfg.Endpoint,
+			Region:   s3cfg.Region,
+		}
+		if s3cfg.CredentialsPath != "" {
+			if err := loadS3Credentials(s3cfg.CredentialsPath, &o); err != nil {
+				log.Fields{
+					log.ErrorKey: err,
+					"path":       s3cfg.CredentialsPath,
+				}.Errorf(c, "Failed to load S3 credentials.")
+				return nil, "", err
+			}
+		}
+
+		client, err := NewS3Client(c, o)
+		if err != nil {
+			return nil, "", err
+		}
+		return client, S3Scheme, nil
+
+	default:
+		return nil, "", fmt.Errorf("unknown archive config type: %v", cfg.Type)
+	}
+}
+
+// NewArchiveReadClient returns a SchemeClient that can read archived logs.
+//
+// Archives are always readable from Google Storage, since that is where logs
+// were archived before other blob stores were supported. If cfg selects a
+// different blob store, its Client is registered alongside.
+func NewArchiveReadClient(c context.Context, cfg *svcconfig.ArchiveConfig, gsf GSClientFactory) (SchemeClient, error) {
+	gsClient, err := gsf(c)
+	if err != nil {
+		return nil, err
+	}
+	sc := SchemeClient{GSScheme: NewGSClient(gsClient)}
+
+	if cfg != nil && cfg.Type != svcconfig.ArchiveConfig_GCS {
+		client, scheme, err := NewConfigClient(c, cfg, gsf)
+		if err != nil {
+			sc.Close()
+			return nil, err
+		}
+		sc[scheme] = client
+	}
+	return sc, nil
+}
+
+// loadS3Credentials loads S3 access keys from the JSON file at path into o.
+func loadS3Credentials(path string, o *S3Options) error {
+	fd, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	var creds struct {
+		AccessKeyID     string `json:"access_key_id"`
+		SecretAccessKey string `json:"secret_access_key"`
+	}
+	if err := json.NewDecoder(fd).Decode(&creds); err != nil {
+		return err
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return errors.New("credentials must include an access_key_id and secret_access_key")
+	}
+
+	o.AccessKeyID, o.SecretAccessKey = creds.AccessKeyID, creds.SecretAccessKey
+	return nil
+}
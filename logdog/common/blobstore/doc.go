@@ -0,0 +1,14 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package blobstore defines an abstraction over the blob stores that LogDog
+// archives log streams to, along with implementations for:
+//   - Google Storage (scheme "gs"), wrapping a gs.Client.
+//   - A local filesystem directory (scheme "file").
+//   - An S3-compatible object store accessed over HTTP (scheme "s3").
+//
+// Blobs are addressed by Path URLs of the form
+// "<scheme>://<bucket>/<filename>". A SchemeClient can be used to route a Path
+// to the Client registered for its scheme.
+package blobstore
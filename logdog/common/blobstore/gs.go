@@ -0,0 +1,72 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package blobstore
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/luci/luci-go/common/gcloud/gs"
+)
+
+// GSScheme is the Path scheme for Google Storage objects.
+const GSScheme = "gs"
+
+// gsClient is a Client implementation backed by a Google Storage client.
+type gsClient struct {
+	gs.Client
+}
+
+// NewGSClient returns a Client that operates on "gs://" Paths using the
+// supplied Google Storage client.
+//
+// The returned Client assumes ownership of c, and will close it when closed.
+func NewGSClient(c gs.Client) Client {
+	return &gsClient{c}
+}
+
+func (c *gsClient) NewReader(p Path, offset, length int64) (io.ReadCloser, error) {
+	gp, err := toGSPath(p)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.NewReader(gp, offset, length)
+}
+
+func (c *gsClient) NewWriter(p Path) (Writer, error) {
+	gp, err := toGSPath(p)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.NewWriter(gp)
+}
+
+func (c *gsClient) Delete(p Path) error {
+	gp, err := toGSPath(p)
+	if err != nil {
+		return err
+	}
+	return c.Client.Delete(gp)
+}
+
+func (c *gsClient) Rename(src, dst Path) error {
+	gsrc, err := toGSPath(src)
+	if err != nil {
+		return err
+	}
+	gdst, err := toGSPath(dst)
+	if err != nil {
+		return err
+	}
+	return c.Client.Rename(gsrc, gdst)
+}
+
+func toGSPath(p Path) (gs.Path, error) {
+	scheme, bucket, filename := p.Split()
+	if scheme != GSScheme {
+		return "", fmt.Errorf("blobstore: not a Google Storage path: %q", p)
+	}
+	return gs.MakePath(bucket, filename), nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package blobstore
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/luci/luci-go/common/iotools"
+)
+
+// LocalScheme is the Path scheme for objects stored in a local directory.
+const LocalScheme = "file"
+
+// localClient is a Client implementation that stores objects in a local
+// filesystem directory. A Path's bucket is a subdirectory of the root
+// directory, and its filename is a slash-delimited path within that bucket.
+type localClient struct {
+	root string
+}
+
+// NewLocalClient returns a Client that operates on "file://" Paths, storing
+// objects underneath the supplied root directory.
+func NewLocalClient(root string) Client {
+	return &localClient{root}
+}
+
+func (c *localClient) Close() error { return nil }
+
+func (c *localClient) NewReader(p Path, offset, length int64) (io.ReadCloser, error) {
+	if offset < 0 {
+		panic(fmt.Errorf("offset (%d) must be >= 0", offset))
+	}
+
+	fp, err := c.filePath(p)
+	if err != nil {
+		return nil, err
+	}
+	fd, err := os.Open(fp)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrObjectNotExist
+		}
+		return nil, err
+	}
+
+	if offset > 0 {
+		if _, err := fd.Seek(offset, 0); err != nil {
+			fd.Close()
+			return nil, err
+		}
+	}
+	if length < 0 {
+		return fd, nil
+	}
+	return &limitedReadCloser{io.LimitReader(fd, length), fd}, nil
+}
+
+func (c *localClient) NewWriter(p Path) (Writer, error) {
+	fp, err := c.filePath(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(fp), 0755); err != nil {
+		return nil, err
+	}
+
+	// Write to a temporary file alongside the destination, and move it into
+	// place when the Writer is closed.
+	fd, err := ioutil.TempFile(filepath.Dir(fp), ".blobstore-")
+	if err != nil {
+		return nil, err
+	}
+	return &localWriter{
+		CountingWriter: iotools.CountingWriter{Writer: fd},
+		fd:             fd,
+		path:           fp,
+	}, nil
+}
+
+func (c *localClient) Delete(p Path) error {
+	fp, err := c.filePath(p)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(fp); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (c *localClient) Rename(src, dst Path) error {
+	srcPath, err := c.filePath(src)
+	if err != nil {
+		return fmt.Errorf("invalid source path: %s", err)
+	}
+	dstPath, err := c.filePath(dst)
+	if err != nil {
+		return fmt.Errorf("invalid destination path: %s", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dstPath), 0755); err != nil {
+		return err
+	}
+	return os.Rename(srcPath, dstPath)
+}
+
+// filePath returns the local filesystem path for p.
+func (c *localClient) filePath(p Path) (string, error) {
+	scheme, bucket, filename := p.Split()
+	switch {
+	case scheme != LocalScheme:
+		return "", fmt.Errorf("blobstore: not a local path: %q", p)
+	case bucket == "" || filename == "":
+		return "", fmt.Errorf("blobstore: incomplete path: %q", p)
+	}
+
+	// Reject paths that would escape the bucket directory.
+	for _, comp := range append(strings.Split(filename, "/"), bucket) {
+		if comp == ".." {
+			return "", fmt.Errorf("blobstore: invalid path component in %q", p)
+		}
+	}
+	return filepath.Join(c.root, bucket, filepath.FromSlash(filename)), nil
+}
+
+// localWriter is a Writer that writes to a temporary file, moving it into place
+// when closed.
+type localWriter struct {
+	iotools.CountingWriter
+
+	fd   *os.File
+	path string
+}
+
+func (w *localWriter) Close() error {
+	if err := w.fd.Close(); err != nil {
+		os.Remove(w.fd.Name())
+		return err
+	}
+	if err := os.Rename(w.fd.Name(), w.path); err != nil {
+		os.Remove(w.fd.Name())
+		return err
+	}
+	return nil
+}
+
+func (w *localWriter) Count() int64 {
+	return w.CountingWriter.Count
+}
+
+// limitedReadCloser is an io.ReadCloser that reads from a limited Reader and
+// closes its underlying Closer.
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
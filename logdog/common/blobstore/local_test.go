@@ -0,0 +1,140 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package blobstore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// readAll reads the contents of an object, asserting that there was no error.
+func readAll(c Client, p Path, offset, length int64) string {
+	r, err := c.NewReader(p, offset, length)
+	So(err, ShouldBeNil)
+	defer r.Close()
+
+	d, err := ioutil.ReadAll(r)
+	So(err, ShouldBeNil)
+	return string(d)
+}
+
+func writeAll(c Client, p Path, data string) error {
+	w, err := c.NewWriter(p)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write([]byte(data)); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func TestLocalClient(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Using a local blob store`, t, func() {
+		root, err := ioutil.TempDir("", "blobstore_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(root)
+
+		c := NewLocalClient(root)
+		defer c.Close()
+
+		p := Path("file://bucket/foo/bar")
+
+		Convey(`Can write, read, rename, and delete an object.`, func() {
+			w, err := c.NewWriter(p)
+			So(err, ShouldBeNil)
+			_, err = w.Write([]byte("hello, world"))
+			So(err, ShouldBeNil)
+			So(w.Count(), ShouldEqual, 12)
+
+			// The object is not visible until the Writer is closed.
+			_, err = c.NewReader(p, 0, -1)
+			So(err, ShouldEqual, ErrObjectNotExist)
+
+			So(w.Close(), ShouldBeNil)
+			So(filepath.Join(root, "bucket", "foo", "bar"), shouldBeFile)
+
+			So(readAll(c, p, 0, -1), ShouldEqual, "hello, world")
+			So(readAll(c, p, 7, -1), ShouldEqual, "world")
+			So(readAll(c, p, 7, 3), ShouldEqual, "wor")
+
+			dst := Path("file://other/baz")
+			So(c.Rename(p, dst), ShouldBeNil)
+			So(readAll(c, dst, 0, -1), ShouldEqual, "hello, world")
+			_, err = c.NewReader(p, 0, -1)
+			So(err, ShouldEqual, ErrObjectNotExist)
+
+			So(c.Delete(dst), ShouldBeNil)
+			_, err = c.NewReader(dst, 0, -1)
+			So(err, ShouldEqual, ErrObjectNotExist)
+
+			Convey(`Deleting a missing object succeeds.`, func() {
+				So(c.Delete(dst), ShouldBeNil)
+			})
+		})
+
+		Convey(`Rejects invalid paths.`, func() {
+			for _, p := range []Path{
+				"gs://bucket/foo",
+				"file://bucket",
+				"foo/bar",
+				"file://bucket/../../etc/passwd",
+				"file://../foo",
+			} {
+				_, err := c.NewWriter(p)
+				So(err, ShouldNotBeNil)
+			}
+		})
+	})
+}
+
+func TestSchemeClient(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A SchemeClient`, t, func() {
+		root, err := ioutil.TempDir("", "blobstore_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(root)
+
+		sc := SchemeClient{
+			LocalScheme: NewLocalClient(root),
+		}
+		defer sc.Close()
+
+		Convey(`Routes operations by scheme.`, func() {
+			So(writeAll(sc, "file://bucket/foo", "ohai"), ShouldBeNil)
+			So(readAll(sc, "file://bucket/foo", 0, -1), ShouldEqual, "ohai")
+		})
+
+		Convey(`Fails for unregistered schemes.`, func() {
+			_, err := sc.NewReader("gs://bucket/foo", 0, -1)
+			So(err, ShouldErrLike, `no client for scheme "gs"`)
+		})
+
+		Convey(`Refuses to rename across schemes.`, func() {
+			So(writeAll(sc, "file://bucket/foo", "ohai"), ShouldBeNil)
+			So(sc.Rename("file://bucket/foo", "gs://bucket/foo"), ShouldNotBeNil)
+		})
+	})
+}
+
+func shouldBeFile(actual interface{}, _ ...interface{}) string {
+	st, err := os.Stat(actual.(string))
+	if err != nil {
+		return err.Error()
+	}
+	if !st.Mode().IsRegular() {
+		return "not a regular file"
+	}
+	return ""
+}
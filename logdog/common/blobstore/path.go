@@ -0,0 +1,108 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package blobstore
+
+import (
+	"strings"
+)
+
+const schemeSep = "://"
+
+// Path is a blob store path URL. It is composed of a scheme, a bucket name, and
+// a filename within that bucket:
+//
+// <scheme>://<bucket>/<filename>
+//
+// A Path with no scheme is a bucket-relative filename.
+type Path string
+
+// MakePath constructs a blob store path from its scheme, bucket, and filename
+// components.
+//
+// If scheme or bucket is empty, a bucket-relative Path will be returned.
+func MakePath(scheme, bucket, filename string) Path {
+	var carr [2]string
+
+	comps := carr[:0]
+	if b := stripTrailingSlashes(bucket); scheme != "" && b != "" {
+		comps = append(comps, scheme+schemeSep+b)
+	}
+	if filename != "" {
+		comps = append(comps, filename)
+	}
+	return Path(strings.Join(comps, "/"))
+}
+
+// Scheme returns the scheme component of the Path.
+func (p Path) Scheme() string {
+	scheme, _, _ := p.Split()
+	return scheme
+}
+
+// Bucket returns the bucket component of the Path.
+func (p Path) Bucket() string {
+	_, bucket, _ := p.Split()
+	return bucket
+}
+
+// Filename returns the filename component of the Path.
+func (p Path) Filename() string {
+	_, _, filename := p.Split()
+	return filename
+}
+
+// Split splits the Path into its scheme, bucket, and filename components.
+func (p Path) Split() (scheme, bucket, filename string) {
+	v := string(p)
+	if idx := strings.Index(v, schemeSep); idx > 0 {
+		scheme, v = v[:idx], v[idx+len(schemeSep):]
+
+		sidx := strings.IndexRune(v, '/')
+		if sidx <= 0 {
+			// Only a bucket name.
+			bucket = v
+			return
+		}
+
+		bucket = v[:sidx]
+		v = v[sidx+1:]
+	}
+	filename = v
+	return
+}
+
+// IsFullPath returns true if the Path contains a scheme, a bucket, and a
+// filename.
+func (p Path) IsFullPath() bool {
+	scheme, bucket, filename := p.Split()
+	return (scheme != "" && bucket != "" && filename != "")
+}
+
+// Concat concatenates a filename component to the end of Path.
+//
+// Multiple components may be specified. In this case, each will be added as a
+// "/"-delimited component, and will have any present trailing slashes stripped.
+func (p Path) Concat(v string, parts ...string) Path {
+	comps := make([]string, 0, len(parts)+2)
+	add := func(v string) {
+		v = stripTrailingSlashes(v)
+		if len(v) > 0 {
+			comps = append(comps, v)
+		}
+	}
+
+	// Build our components slice.
+	scheme, b, f := p.Split()
+	add(f)
+	add(v)
+	for _, p := range parts {
+		add(p)
+	}
+	return MakePath(scheme, b, strings.Join(comps, "/"))
+}
+
+func stripTrailingSlashes(v string) string {
+	return strings.TrimRight(v, "/")
+}
@@ -0,0 +1,74 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package blobstore
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPath(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Path manipulation tests`, t, func() {
+		for _, tc := range []struct {
+			scheme   string
+			bucket   string
+			filename string
+			path     Path
+		}{
+			{"", "", "", ""},
+			{"gs", "bucket/", "", "gs://bucket"},
+			{"gs", "bucket", "foo/bar", "gs://bucket/foo/bar"},
+			{"s3", "bucket", "foo/bar", "s3://bucket/foo/bar"},
+			{"file", "bucket", "foo", "file://bucket/foo"},
+			{"", "", "foo/bar", "foo/bar"},
+		} {
+			Convey(fmt.Sprintf(`Test path: scheme=%q, bucket=%q, filename=%q, path=%q`,
+				tc.scheme, tc.bucket, tc.filename, tc.path), func() {
+
+				Convey(`The components compose into the path.`, func() {
+					So(MakePath(tc.scheme, tc.bucket, tc.filename), ShouldEqual, tc.path)
+				})
+
+				Convey(`The path splits into its components.`, func() {
+					s, b, f := tc.path.Split()
+					if tc.path.Scheme() != "" {
+						So(s, ShouldEqual, tc.scheme)
+						So(b+"/", ShouldStartWith, tc.bucket)
+					}
+					So(f, ShouldEqual, tc.filename)
+				})
+			})
+		}
+
+		Convey(`A bucket-relative path is not a full path.`, func() {
+			So(Path("foo/bar").IsFullPath(), ShouldBeFalse)
+			So(Path("gs://bucket").IsFullPath(), ShouldBeFalse)
+			So(Path("gs://bucket/foo").IsFullPath(), ShouldBeTrue)
+		})
+	})
+
+	Convey(`Concat tests`, t, func() {
+		for _, tc := range []struct {
+			orig   Path
+			concat []string
+			final  Path
+		}{
+			{"gs://foo/bar", []string{"baz"}, "gs://foo/bar/baz"},
+			{"s3://foo/bar", []string{"baz"}, "s3://foo/bar/baz"},
+			{"foo/bar", []string{"baz"}, "foo/bar/baz"},
+			{"file://bucket", []string{"baz"}, "file://bucket/baz"},
+			{"gs://bucket/", []string{"baz"}, "gs://bucket/baz"},
+			{"gs://bucket/foo/", []string{"bar//", "baz"}, "gs://bucket/foo/bar/baz"},
+		} {
+			Convey(fmt.Sprintf(`Concat: %q to %q yields %q`, tc.orig, tc.concat, tc.final), func() {
+				So(tc.orig.Concat(tc.concat[0], tc.concat[1:]...), ShouldEqual, tc.final)
+			})
+		}
+	})
+}
@@ -0,0 +1,330 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package blobstore
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/retry"
+	"golang.org/x/net/context"
+	"golang.org/x/net/context/ctxhttp"
+)
+
+// S3Scheme is the Path scheme for objects in an S3-compatible object store.
+const S3Scheme = "s3"
+
+// S3Options is the set of options used to connect to an S3-compatible object
+// store.
+type S3Options struct {
+	// Endpoint is the base URL of the object store (e.g.,
+	// "https://s3.example.com"). Buckets are addressed path-style, as
+	// "<Endpoint>/<bucket>/<key>".
+	Endpoint string
+	// Region is the region to use when signing requests. If empty, "us-east-1"
+	// will be used.
+	Region string
+
+	// AccessKeyID is the access key ID used to sign requests. If empty,
+	// requests will not be signed.
+	AccessKeyID string
+	// SecretAccessKey is the secret key used to sign requests.
+	SecretAccessKey string
+
+	// Client is the HTTP client to use. If nil, http.DefaultClient will be used.
+	Client *http.Client
+}
+
+// s3Client is a Client implementation for S3-compatible object stores.
+type s3Client struct {
+	context.Context
+	*S3Options
+
+	base *url.URL
+}
+
+// NewS3Client returns a Client that operates on "s3://" Paths against an
+// S3-compatible object store.
+func NewS3Client(ctx context.Context, o S3Options) (Client, error) {
+	base, err := url.Parse(o.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid S3 endpoint %q: %v", o.Endpoint, err)
+	}
+	if base.Scheme == "" || base.Host == "" {
+		return nil, fmt.Errorf("invalid S3 endpoint %q: must be an absolute URL", o.Endpoint)
+	}
+	if o.Region == "" {
+		o.Region = "us-east-1"
+	}
+	if o.Client == nil {
+		o.Client = http.DefaultClient
+	}
+
+	return &s3Client{
+		Context:   ctx,
+		S3Options: &o,
+		base:      base,
+	}, nil
+}
+
+func (c *s3Client) Close() error { return nil }
+
+func (c *s3Client) NewReader(p Path, offset, length int64) (io.ReadCloser, error) {
+	if offset < 0 {
+		panic(fmt.Errorf("offset (%d) must be >= 0", offset))
+	}
+	if length == 0 {
+		return ioutil.NopCloser(bytes.NewReader(nil)), nil
+	}
+
+	u, err := c.objectURL(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *http.Response
+	err = c.retry(p, func() error {
+		req, err := http.NewRequest("GET", u.String(), nil)
+		if err != nil {
+			return err
+		}
+		switch {
+		case length > 0:
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+		case offset > 0:
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		}
+
+		resp, err = c.do(req)
+		return err
+	})
+	if err != nil {
+		if isS3Status(err, http.StatusRequestedRangeNotSatisfiable) {
+			// Reading past the end of the object yields no data.
+			return ioutil.NopCloser(bytes.NewReader(nil)), nil
+		}
+		if isS3Status(err, http.StatusNotFound) {
+			return nil, ErrObjectNotExist
+		}
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (c *s3Client) NewWriter(p Path) (Writer, error) {
+	if _, err := c.objectURL(p); err != nil {
+		return nil, err
+	}
+
+	// S3 requires the object size up front, so buffer the object in a temporary
+	// file and upload it when the Writer is closed.
+	fd, err := ioutil.TempFile("", "blobstore-s3-")
+	if err != nil {
+		return nil, err
+	}
+	return &s3Writer{
+		client: c,
+		path:   p,
+		fd:     fd,
+	}, nil
+}
+
+func (c *s3Client) Delete(p Path) error {
+	u, err := c.objectURL(p)
+	if err != nil {
+		return err
+	}
+
+	err = c.retry(p, func() error {
+		req, err := http.NewRequest("DELETE", u.String(), nil)
+		if err != nil {
+			return err
+		}
+		resp, err := c.do(req)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	})
+	if isS3Status(err, http.StatusNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (c *s3Client) Rename(src, dst Path) error {
+	if _, err := c.objectURL(src); err != nil {
+		return fmt.Errorf("invalid source path: %s", err)
+	}
+	u, err := c.objectURL(dst)
+	if err != nil {
+		return fmt.Errorf("invalid destination path: %s", err)
+	}
+
+	// First stage: server-side copy.
+	err = c.retry(dst, func() error {
+		req, err := http.NewRequest("PUT", u.String(), nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("x-amz-copy-source", escapeKey("/"+src.Bucket()+"/"+src.Filename()))
+
+		resp, err := c.do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		// A copy may fail after the server has returned a 200 status, in which
+		// case the failure is reported in the response body.
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return errors.WrapTransient(err)
+		}
+		if bytes.Contains(body, []byte("<Error>")) {
+			return errors.WrapTransient(fmt.Errorf("copy failed: %s", body))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Second stage: Delete. This is not fatal, since the copy has succeeded.
+	if err := c.Delete(src); err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"path":       src,
+		}.Warningf(c, "(Non-fatal) Failed to delete source during rename.")
+	}
+	return nil
+}
+
+// objectURL returns the object store URL for p.
+func (c *s3Client) objectURL(p Path) (*url.URL, error) {
+	scheme, bucket, filename := p.Split()
+	switch {
+	case scheme != S3Scheme:
+		return nil, fmt.Errorf("blobstore: not an S3 path: %q", p)
+	case bucket == "" || filename == "":
+		return nil, fmt.Errorf("blobstore: incomplete path: %q", p)
+	}
+
+	u := *c.base
+	u.Path = strings.TrimRight(u.Path, "/") + "/" + bucket + "/" + filename
+	u.RawPath = escapeKey(u.Path)
+	return &u, nil
+}
+
+// do signs and executes an HTTP request, returning an error if the response
+// status was not successful.
+//
+// Server errors are returned as transient errors.
+func (c *s3Client) do(req *http.Request) (*http.Response, error) {
+	if c.AccessKeyID != "" {
+		signS3Request(req, c.AccessKeyID, c.SecretAccessKey, c.Region, clock.Now(c))
+	}
+
+	resp, err := ctxhttp.Do(c, c.Client, req)
+	if err != nil {
+		return nil, errors.WrapTransient(err)
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+	resp.Body.Close()
+
+	err = &s3StatusError{resp.StatusCode, strings.TrimSpace(string(body))}
+	if resp.StatusCode >= 500 {
+		return nil, errors.WrapTransient(err)
+	}
+	return nil, err
+}
+
+func (c *s3Client) retry(p Path, fn func() error) error {
+	return retry.Retry(c, retry.TransientOnly(retry.Default), fn, func(err error, d time.Duration) {
+		log.Fields{
+			log.ErrorKey: err,
+			"delay":      d,
+			"path":       p,
+		}.Warningf(c, "Transient error on S3 request. Retrying...")
+	})
+}
+
+// s3StatusError is an error returned when an S3 request returns an
+// unsuccessful HTTP status.
+type s3StatusError struct {
+	status int
+	body   string
+}
+
+func (e *s3StatusError) Error() string {
+	return fmt.Sprintf("S3 request failed with HTTP %d: %s", e.status, e.body)
+}
+
+func isS3Status(err error, status int) bool {
+	if se, ok := errors.Unwrap(err).(*s3StatusError); ok {
+		return se.status == status
+	}
+	return false
+}
+
+// s3Writer is a Writer that buffers an object in a temporary file, uploading
+// it when closed.
+type s3Writer struct {
+	client *s3Client
+	path   Path
+	fd     *os.File
+	count  int64
+}
+
+func (w *s3Writer) Write(d []byte) (int, error) {
+	n, err := w.fd.Write(d)
+	w.count += int64(n)
+	return n, err
+}
+
+func (w *s3Writer) Close() error {
+	defer os.Remove(w.fd.Name())
+	defer w.fd.Close()
+
+	u, err := w.client.objectURL(w.path)
+	if err != nil {
+		return err
+	}
+	return w.client.retry(w.path, func() error {
+		if _, err := w.fd.Seek(0, 0); err != nil {
+			return err
+		}
+
+		req, err := http.NewRequest("PUT", u.String(), ioutil.NopCloser(w.fd))
+		if err != nil {
+			return err
+		}
+		req.ContentLength = w.count
+
+		resp, err := w.client.do(req)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	})
+}
+
+func (w *s3Writer) Count() int64 {
+	return w.count
+}
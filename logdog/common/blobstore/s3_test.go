@@ -0,0 +1,163 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package blobstore
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+	"golang.org/x/net/context"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeS3 is a minimal in-memory S3-compatible HTTP server.
+type fakeS3 struct {
+	sync.Mutex
+
+	objects map[string][]byte
+	auth    []string
+	fail    int
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.auth = append(f.auth, req.Header.Get("Authorization"))
+	if f.fail > 0 {
+		f.fail--
+		http.Error(w, "<Error>InternalError</Error>", http.StatusInternalServerError)
+		return
+	}
+
+	key := strings.TrimPrefix(req.URL.Path, "/")
+	switch req.Method {
+	case "GET":
+		d, ok := f.objects[key]
+		if !ok {
+			http.Error(w, "<Error>NoSuchKey</Error>", http.StatusNotFound)
+			return
+		}
+		if rng := req.Header.Get("Range"); rng != "" {
+			var start, end int
+			if n, _ := fmt.Sscanf(rng, "bytes=%d-%d", &start, &end); n == 0 {
+				http.Error(w, "bad range", http.StatusBadRequest)
+				return
+			} else if n == 1 || end >= len(d) {
+				end = len(d) - 1
+			}
+			if start >= len(d) {
+				w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+				return
+			}
+			d = d[start : end+1]
+		}
+		w.Write(d)
+
+	case "PUT":
+		if src := req.Header.Get("x-amz-copy-source"); src != "" {
+			d, ok := f.objects[strings.TrimPrefix(src, "/")]
+			if !ok {
+				http.Error(w, "<Error>NoSuchKey</Error>", http.StatusNotFound)
+				return
+			}
+			f.objects[key] = d
+			w.Write([]byte("<CopyObjectResult></CopyObjectResult>"))
+			return
+		}
+		d, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.objects[key] = d
+
+	case "DELETE":
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		http.Error(w, "unsupported method", http.StatusMethodNotAllowed)
+	}
+}
+
+func TestS3Client(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Using an S3 blob store`, t, func() {
+		c, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		tc.SetTimerCallback(func(time.Duration, clock.Timer) { tc.Add(time.Second) })
+
+		fs := fakeS3{objects: map[string][]byte{}}
+		srv := httptest.NewServer(&fs)
+		defer srv.Close()
+
+		client, err := NewS3Client(c, S3Options{
+			Endpoint:        srv.URL,
+			AccessKeyID:     "AKID",
+			SecretAccessKey: "secret",
+		})
+		So(err, ShouldBeNil)
+		defer client.Close()
+
+		p := Path("s3://bucket/foo/bar")
+
+		Convey(`Can write, read, rename, and delete an object.`, func() {
+			w, err := client.NewWriter(p)
+			So(err, ShouldBeNil)
+			_, err = w.Write([]byte("hello, world"))
+			So(err, ShouldBeNil)
+			So(w.Count(), ShouldEqual, 12)
+			So(w.Close(), ShouldBeNil)
+			So(string(fs.objects["bucket/foo/bar"]), ShouldEqual, "hello, world")
+
+			So(readAll(client, p, 0, -1), ShouldEqual, "hello, world")
+			So(readAll(client, p, 7, -1), ShouldEqual, "world")
+			So(readAll(client, p, 7, 3), ShouldEqual, "wor")
+			So(readAll(client, p, 100, -1), ShouldEqual, "")
+
+			dst := Path("s3://bucket/baz")
+			So(client.Rename(p, dst), ShouldBeNil)
+			So(fs.objects, ShouldNotContainKey, "bucket/foo/bar")
+			So(readAll(client, dst, 0, -1), ShouldEqual, "hello, world")
+
+			So(client.Delete(dst), ShouldBeNil)
+			_, err = client.NewReader(dst, 0, -1)
+			So(err, ShouldEqual, ErrObjectNotExist)
+
+			Convey(`Requests are signed.`, func() {
+				for _, a := range fs.auth {
+					So(a, ShouldStartWith, "AWS4-HMAC-SHA256 Credential=AKID/20160203/us-east-1/s3/aws4_request, ")
+				}
+			})
+		})
+
+		Convey(`Retries server errors.`, func() {
+			fs.fail = 2
+			So(writeAll(client, p, "ohai"), ShouldBeNil)
+			So(readAll(client, p, 0, -1), ShouldEqual, "ohai")
+		})
+
+		Convey(`Rejects non-S3 paths.`, func() {
+			_, err := client.NewReader("gs://bucket/foo", 0, -1)
+			So(err, ShouldErrLike, "not an S3 path")
+		})
+	})
+
+	Convey(`NewS3Client rejects invalid endpoints.`, t, func() {
+		_, err := NewS3Client(context.Background(), S3Options{Endpoint: "s3.example.com"})
+		So(err, ShouldErrLike, "must be an absolute URL")
+	})
+}
@@ -3,7 +3,7 @@
 // that can be found in the LICENSE file.
 
 // Package archive implements a storage.Storage instance that retrieves logs
-// from a Google Storage archive.
+// from a blob store archive.
 //
 // This is a special implementation of storage.Storage, and does not fully
 // conform to the API expecations. Namely:
@@ -25,10 +25,10 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/luci/luci-go/common/config"
 	"github.com/luci/luci-go/common/data/recordio"
-	"github.com/luci/luci-go/common/gcloud/gs"
 	"github.com/luci/luci-go/common/iotools"
 	log "github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/common/blobstore"
 	"github.com/luci/luci-go/logdog/common/storage"
 	"github.com/luci/luci-go/logdog/common/types"
 )
@@ -44,17 +44,18 @@ const (
 //
 // Unlike other Storage instances, this is bound to a single archived stream.
 // Project and Path parameters in requests will be ignored in favor of the
-// Google Storage URLs.
+// blob store URLs.
 type Options struct {
-	// IndexURL is the Google Storage URL for the stream's index.
+	// IndexURL is the blob store URL for the stream's index.
 	IndexURL string
-	// StreamURL is the Google Storage URL for the stream's entries.
+	// StreamURL is the blob store URL for the stream's entries.
 	StreamURL string
 
-	// Client is the HTTP client to use for authentication.
+	// Client is the blob store client to use. It must support the schemes of
+	// IndexURL and StreamURL.
 	//
 	// Closing this Storage instance does not close the underlying Client.
-	Client gs.Client
+	Client blobstore.Client
 
 	// MaxBytes, if >0, is the maximum number of bytes to fetch in any given
 	// request. This should be set for GAE fetches, as large log streams may
@@ -70,8 +71,8 @@ type storageImpl struct {
 	*Options
 	context.Context
 
-	streamPath gs.Path
-	indexPath  gs.Path
+	streamPath blobstore.Path
+	indexPath  blobstore.Path
 
 	indexMu     sync.Mutex
 	index       *logpb.LogIndex
@@ -84,8 +85,8 @@ func New(ctx context.Context, o Options) (storage.Storage, error) {
 		Options: &o,
 		Context: ctx,
 
-		streamPath: gs.Path(o.StreamURL),
-		indexPath:  gs.Path(o.IndexURL),
+		streamPath: blobstore.Path(o.StreamURL),
+		indexPath:  blobstore.Path(o.IndexURL),
 	}
 
 	if !s.streamPath.IsFullPath() {
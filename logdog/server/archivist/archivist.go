@@ -17,7 +17,6 @@ import (
 	"github.com/luci/luci-go/common/clock"
 	"github.com/luci/luci-go/common/config"
 	"github.com/luci/luci-go/common/errors"
-	"github.com/luci/luci-go/common/gcloud/gs"
 	log "github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/common/sync/parallel"
 	"github.com/luci/luci-go/common/tsmon/distribution"
@@ -27,6 +26,7 @@ import (
 	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/services/v1"
 	"github.com/luci/luci-go/logdog/api/logpb"
 	"github.com/luci/luci-go/logdog/common/archive"
+	"github.com/luci/luci-go/logdog/common/blobstore"
 	"github.com/luci/luci-go/logdog/common/storage"
 	"github.com/luci/luci-go/logdog/common/types"
 )
@@ -120,18 +120,18 @@ type Task interface {
 //
 // In practice, this will be formed from service and project settings.
 type Settings struct {
-	// GSBase is the base Google Storage path. This includes the bucket name
-	// and any associated path.
+	// Base is the base blob store path. This includes the scheme, the bucket
+	// name, and any associated path.
 	//
 	// This must be unique to this archival project. In practice, it will be
 	// composed of the project's archival bucket and project ID.
-	GSBase gs.Path
-	// GSStagingBase is the base Google Storage path for archive staging. This
-	// includes the bucket name and any associated path.
+	Base blobstore.Path
+	// StagingBase is the base blob store path for archive staging. This
+	// includes the scheme, the bucket name, and any associated path.
 	//
 	// This must be unique to this archival project. In practice, it will be
 	// composed of the project's staging archival bucket and project ID.
-	GSStagingBase gs.Path
+	StagingBase blobstore.Path
 
 	// AlwaysRender, if true, means that a binary should be archived
 	// regardless of whether a specific binary file extension has been supplied
@@ -164,8 +164,9 @@ type Archivist struct {
 
 	// Storage is the archival source Storage instance.
 	Storage storage.Storage
-	// GSClient is the Google Storage client to for archive generation.
-	GSClient gs.Client
+	// Client is the blob store client to use for archive generation. It must
+	// support the schemes of the Settings' base paths.
+	Client blobstore.Client
 }
 
 // storageBufferSize is the size, in bytes, of the LogEntry buffer that is used
@@ -373,7 +374,7 @@ func (a *Archivist) archiveTaskImpl(c context.Context, task Task) error {
 		}
 
 		// Finalize the archival.
-		if err := staged.finalize(c, a.GSClient, &ar); err != nil {
+		if err := staged.finalize(c, a.Client, &ar); err != nil {
 			log.WithError(err).Errorf(c, "Failed to finalize archival.")
 			return err
 		}
@@ -427,17 +428,17 @@ func (a *Archivist) loadSettings(c context.Context, project config.ProjectName)
 	case err != nil:
 		return nil, err
 
-	case st.GSBase.Bucket() == "":
+	case st.Base.Bucket() == "":
 		log.Fields{
 			log.ErrorKey: err,
-			"gsBase":     st.GSBase,
+			"base":       st.Base,
 		}.Errorf(c, "Invalid storage base.")
 		return nil, errors.New("invalid storage base")
 
-	case st.GSStagingBase.Bucket() == "":
+	case st.StagingBase.Bucket() == "":
 		log.Fields{
-			log.ErrorKey:    err,
-			"gsStagingBase": st.GSStagingBase,
+			log.ErrorKey:  err,
+			"stagingBase": st.StagingBase,
 		}.Errorf(c, "Invalid storage staging base.")
 		return nil, errors.New("invalid storage staging base")
 
@@ -506,8 +507,8 @@ func (sa *stagedArchival) makeStagingPaths(name, uid string) stagingPaths {
 	// an absence of conflicts, we will insert the project name as part of the
 	// path.
 	return stagingPaths{
-		staged: sa.GSStagingBase.Concat(proj, string(sa.path), uid, name),
-		final:  sa.GSBase.Concat(proj, string(sa.path), name),
+		staged: sa.StagingBase.Concat(proj, string(sa.path), uid, name),
+		final:  sa.Base.Concat(proj, string(sa.path), name),
 	}
 }
 
@@ -572,7 +573,7 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 
 	// Close our writers on exit. If any of them fail to close, mark the archival
 	// as a transient failure.
-	closeWriter := func(closer io.Closer, path gs.Path) {
+	closeWriter := func(closer io.Closer, path blobstore.Path) {
 		// Close the Writer. If this results in an error, append it to our transient
 		// error MultiError.
 		if ierr := closer.Close(); ierr != nil {
@@ -583,7 +584,7 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 		// stream. This is a non-fatal failure, since we've already hit a fatal
 		// one.
 		if err != nil || len(terr) > 0 {
-			if ierr := sa.GSClient.Delete(path); ierr != nil {
+			if ierr := sa.Client.Delete(path); ierr != nil {
 				log.Fields{
 					log.ErrorKey: ierr,
 					"path":       path,
@@ -594,8 +595,8 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 
 	// createWriter is a shorthand function for creating a writer to a path and
 	// reporting an error if it failed.
-	createWriter := func(p gs.Path) (gs.Writer, error) {
-		w, ierr := sa.GSClient.NewWriter(p)
+	createWriter := func(p blobstore.Path) (blobstore.Writer, error) {
+		w, ierr := sa.Client.NewWriter(p)
 		if ierr != nil {
 			log.Fields{
 				log.ErrorKey: ierr,
@@ -606,7 +607,7 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 		return w, nil
 	}
 
-	var streamWriter, indexWriter, dataWriter gs.Writer
+	var streamWriter, indexWriter, dataWriter blobstore.Writer
 	if streamWriter, err = createWriter(sa.stream.staged); err != nil {
 		return
 	}
@@ -672,8 +673,8 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 }
 
 type stagingPaths struct {
-	staged       gs.Path
-	final        gs.Path
+	staged       blobstore.Path
+	final        blobstore.Path
 	bytesWritten int64
 }
 
@@ -686,7 +687,7 @@ func (d *stagingPaths) addMetrics(c context.Context, archiveField, streamField s
 	tsTotalBytes.Add(c, d.bytesWritten, archiveField, streamField)
 }
 
-func (sa *stagedArchival) finalize(c context.Context, client gs.Client, ar *logdog.ArchiveStreamRequest) error {
+func (sa *stagedArchival) finalize(c context.Context, client blobstore.Client, ar *logdog.ArchiveStreamRequest) error {
 	err := parallel.FanOutIn(func(taskC chan<- func() error) {
 		for _, d := range sa.getStagingPaths() {
 			d := d
@@ -702,7 +703,7 @@ func (sa *stagedArchival) finalize(c context.Context, client gs.Client, ar *logd
 						log.ErrorKey: err,
 						"stagedPath": d.staged,
 						"finalPath":  d.final,
-					}.Errorf(c, "Failed to rename GS object.")
+					}.Errorf(c, "Failed to rename staged object.")
 					return err
 				}
 
@@ -733,7 +734,7 @@ func (sa *stagedArchival) cleanup(c context.Context) {
 			continue
 		}
 
-		if err := sa.GSClient.Delete(d.staged); err != nil {
+		if err := sa.Client.Delete(d.staged); err != nil {
 			log.Fields{
 				log.ErrorKey: err,
 				"path":       d.staged,
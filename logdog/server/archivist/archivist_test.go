@@ -15,10 +15,10 @@ import (
 	"github.com/luci/luci-go/common/clock/testclock"
 	"github.com/luci/luci-go/common/config"
 	"github.com/luci/luci-go/common/errors"
-	"github.com/luci/luci-go/common/gcloud/gs"
 	"github.com/luci/luci-go/common/proto/google"
 	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/services/v1"
 	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/common/blobstore"
 	"github.com/luci/luci-go/logdog/common/storage"
 	"github.com/luci/luci-go/logdog/common/storage/memory"
 	"github.com/luci/luci-go/logdog/common/types"
@@ -84,27 +84,28 @@ func (sc *testServicesClient) ArchiveStream(c context.Context, req *logdog.Archi
 	return &google.Empty{}, nil
 }
 
-// testGSClient is a testing implementation of the gsClient interface.
+// testBlobClient is a testing implementation of the blobstore.Client
+// interface.
 //
 // It does not actually retain any of the written data, since that level of
 // testing is done in the archive package.
-type testGSClient struct {
+type testBlobClient struct {
 	sync.Mutex
-	gs.Client
+	blobstore.Client
 
 	// objs is a map of filename to "write amount". The write amount is the
-	// cumulative amount of data written to the Writer for a given GS path.
-	objs   map[gs.Path]int64
+	// cumulative amount of data written to the Writer for a given path.
+	objs   map[blobstore.Path]int64
 	closed bool
 
 	closeErr     error
-	newWriterErr func(w *testGSWriter) error
-	deleteErr    func(gs.Path) error
-	renameErr    func(gs.Path, gs.Path) error
+	newWriterErr func(w *testBlobWriter) error
+	deleteErr    func(blobstore.Path) error
+	renameErr    func(blobstore.Path, blobstore.Path) error
 }
 
-func (c *testGSClient) NewWriter(p gs.Path) (gs.Writer, error) {
-	w := testGSWriter{
+func (c *testBlobClient) NewWriter(p blobstore.Path) (blobstore.Writer, error) {
+	w := testBlobWriter{
 		client: c,
 		path:   p,
 	}
@@ -116,7 +117,7 @@ func (c *testGSClient) NewWriter(p gs.Path) (gs.Writer, error) {
 	return &w, nil
 }
 
-func (c *testGSClient) Close() error {
+func (c *testBlobClient) Close() error {
 	if c.closed {
 		panic("double close")
 	}
@@ -127,7 +128,7 @@ func (c *testGSClient) Close() error {
 	return nil
 }
 
-func (c *testGSClient) Delete(p gs.Path) error {
+func (c *testBlobClient) Delete(p blobstore.Path) error {
 	if c.deleteErr != nil {
 		if err := c.deleteErr(p); err != nil {
 			return err
@@ -141,7 +142,7 @@ func (c *testGSClient) Delete(p gs.Path) error {
 	return nil
 }
 
-func (c *testGSClient) Rename(src, dst gs.Path) error {
+func (c *testBlobClient) Rename(src, dst blobstore.Path) error {
 	if c.renameErr != nil {
 		if err := c.renameErr(src, dst); err != nil {
 			return err
@@ -156,10 +157,10 @@ func (c *testGSClient) Rename(src, dst gs.Path) error {
 	return nil
 }
 
-type testGSWriter struct {
-	client *testGSClient
+type testBlobWriter struct {
+	client *testBlobClient
 
-	path       gs.Path
+	path       blobstore.Path
 	closed     bool
 	writeCount int64
 
@@ -167,7 +168,7 @@ type testGSWriter struct {
 	closeErr error
 }
 
-func (w *testGSWriter) Write(d []byte) (int, error) {
+func (w *testBlobWriter) Write(d []byte) (int, error) {
 	if err := w.writeErr; err != nil {
 		return 0, err
 	}
@@ -176,14 +177,14 @@ func (w *testGSWriter) Write(d []byte) (int, error) {
 	defer w.client.Unlock()
 
 	if w.client.objs == nil {
-		w.client.objs = make(map[gs.Path]int64)
+		w.client.objs = make(map[blobstore.Path]int64)
 	}
 	w.client.objs[w.path] += int64(len(d))
 	w.writeCount += int64(len(d))
 	return len(d), nil
 }
 
-func (w *testGSWriter) Close() error {
+func (w *testBlobWriter) Close() error {
 	if w.closed {
 		panic("double close")
 	}
@@ -194,7 +195,7 @@ func (w *testGSWriter) Close() error {
 	return nil
 }
 
-func (w *testGSWriter) Count() int64 {
+func (w *testBlobWriter) Count() int64 {
 	return w.writeCount
 }
 
@@ -205,7 +206,7 @@ func TestHandleArchive(t *testing.T) {
 		c, tc := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
 
 		st := memory.Storage{}
-		gsc := testGSClient{}
+		gsc := testBlobClient{}
 
 		// Set up our test log stream.
 		project := "test-project"
@@ -306,12 +307,12 @@ func TestHandleArchive(t *testing.T) {
 			SettingsLoader: func(c context.Context, proj config.ProjectName) (*Settings, error) {
 				// Extra slashes to test concatenation,.
 				st := stBase
-				st.GSBase = gs.Path(fmt.Sprintf("gs://archival/%s/path/to/archive/", proj))
-				st.GSStagingBase = gs.Path(fmt.Sprintf("gs://archival-staging/%s/path/to/archive/", proj))
+				st.Base = blobstore.Path(fmt.Sprintf("gs://archival/%s/path/to/archive/", proj))
+				st.StagingBase = blobstore.Path(fmt.Sprintf("gs://archival-staging/%s/path/to/archive/", proj))
 				return &st, nil
 			},
-			Storage:  &st,
-			GSClient: &gsc,
+			Storage: &st,
+			Client:  &gsc,
 		}
 
 		gsURL := func(project, name string) string {
@@ -462,7 +463,7 @@ func TestHandleArchive(t *testing.T) {
 
 			Convey(`When a transient archival error occurs, will not consume the task.`, func() {
 				addTestEntry(project, 0, 1, 2, 3, 4)
-				gsc.newWriterErr = func(*testGSWriter) error { return errors.WrapTransient(errors.New("test error")) }
+				gsc.newWriterErr = func(*testBlobWriter) error { return errors.WrapTransient(errors.New("test error")) }
 
 				So(ar.archiveTaskImpl(c, task), ShouldErrLike, "test error")
 				So(task.consumed, ShouldBeFalse)
@@ -471,7 +472,7 @@ func TestHandleArchive(t *testing.T) {
 			Convey(`When a non-transient archival error occurs`, func() {
 				addTestEntry(project, 0, 1, 2, 3, 4)
 				archiveErr := errors.New("archive failure error")
-				gsc.newWriterErr = func(*testGSWriter) error { return archiveErr }
+				gsc.newWriterErr = func(*testBlobWriter) error { return archiveErr }
 
 				Convey(`If remote report returns an error, do not consume the task.`, func() {
 					archiveStreamErr = errors.New("test error")
@@ -617,7 +618,7 @@ func TestHandleArchive(t *testing.T) {
 					setup func()
 				}{
 					{"writer create failure", func() {
-						gsc.newWriterErr = func(w *testGSWriter) error {
+						gsc.newWriterErr = func(w *testBlobWriter) error {
 							if strings.HasSuffix(string(w.path), failName) {
 								return errors.WrapTransient(errors.New("test error"))
 							}
@@ -626,7 +627,7 @@ func TestHandleArchive(t *testing.T) {
 					}},
 
 					{"write failure", func() {
-						gsc.newWriterErr = func(w *testGSWriter) error {
+						gsc.newWriterErr = func(w *testBlobWriter) error {
 							if strings.HasSuffix(string(w.path), failName) {
 								w.writeErr = errors.WrapTransient(errors.New("test error"))
 							}
@@ -635,7 +636,7 @@ func TestHandleArchive(t *testing.T) {
 					}},
 
 					{"rename failure", func() {
-						gsc.renameErr = func(src, dst gs.Path) error {
+						gsc.renameErr = func(src, dst blobstore.Path) error {
 							if strings.HasSuffix(string(src), failName) {
 								return errors.WrapTransient(errors.New("test error"))
 							}
@@ -644,7 +645,7 @@ func TestHandleArchive(t *testing.T) {
 					}},
 
 					{"close failure", func() {
-						gsc.newWriterErr = func(w *testGSWriter) error {
+						gsc.newWriterErr = func(w *testBlobWriter) error {
 							if strings.HasSuffix(string(w.path), failName) {
 								w.closeErr = errors.WrapTransient(errors.New("test error"))
 							}
@@ -655,7 +656,7 @@ func TestHandleArchive(t *testing.T) {
 					{"delete failure after other failure", func() {
 						// Simulate a write failure. This is the error that will actually
 						// be returned.
-						gsc.newWriterErr = func(w *testGSWriter) error {
+						gsc.newWriterErr = func(w *testBlobWriter) error {
 							if strings.HasSuffix(string(w.path), failName) {
 								w.writeErr = errors.WrapTransient(errors.New("test error"))
 							}
@@ -664,7 +665,7 @@ func TestHandleArchive(t *testing.T) {
 
 						// This will trigger whe NewWriter fails from the above
 						// instrumentation.
-						gsc.deleteErr = func(p gs.Path) error {
+						gsc.deleteErr = func(p blobstore.Path) error {
 							if strings.HasSuffix(string(p), failName) {
 								return errors.New("other error")
 							}
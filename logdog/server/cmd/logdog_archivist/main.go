@@ -11,7 +11,6 @@ import (
 	"github.com/luci/luci-go/common/clock"
 	"github.com/luci/luci-go/common/config"
 	"github.com/luci/luci-go/common/errors"
-	"github.com/luci/luci-go/common/gcloud/gs"
 	gcps "github.com/luci/luci-go/common/gcloud/pubsub"
 	log "github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/common/sync/parallel"
@@ -20,6 +19,7 @@ import (
 	"github.com/luci/luci-go/common/tsmon/metric"
 	"github.com/luci/luci-go/common/tsmon/types"
 	"github.com/luci/luci-go/logdog/api/config/svcconfig"
+	"github.com/luci/luci-go/logdog/common/blobstore"
 	"github.com/luci/luci-go/logdog/server/archivist"
 	"github.com/luci/luci-go/logdog/server/service"
 	"golang.org/x/net/context"
@@ -115,19 +115,19 @@ func (a *application) runArchivist(c context.Context) error {
 	}
 	defer st.Close()
 
-	// Initialize our Google Storage client.
-	gsClient, err := a.GSClient(c)
+	// Initialize our blob store client.
+	client, scheme, err := blobstore.NewConfigClient(c, acfg.ArchiveConfig, a.GSClient)
 	if err != nil {
-		log.WithError(err).Errorf(c, "Failed to get Google Storage client.")
+		log.WithError(err).Errorf(c, "Failed to get blob store client.")
 		return err
 	}
-	defer gsClient.Close()
+	defer client.Close()
 
 	ar := archivist.Archivist{
 		Service:        a.Coordinator(),
-		SettingsLoader: a.GetSettingsLoader(acfg),
+		SettingsLoader: a.GetSettingsLoader(acfg, scheme),
 		Storage:        st,
-		GSClient:       gsClient,
+		Client:         client,
 	}
 
 	tasks := int(acfg.Tasks)
@@ -222,8 +222,10 @@ func (a *application) runArchivist(c context.Context) error {
 // GetSettingsLoader is an archivist.SettingsLoader implementation that merges
 // global and project-specific settings.
 //
+// The supplied scheme is the blob store Path scheme of the archival buckets.
+//
 // The resulting settings object will be verified by the Archivist.
-func (a *application) GetSettingsLoader(acfg *svcconfig.Archivist) archivist.SettingsLoader {
+func (a *application) GetSettingsLoader(acfg *svcconfig.Archivist, scheme string) archivist.SettingsLoader {
 	serviceID := a.ServiceID()
 
 	return func(c context.Context, proj config.ProjectName) (*archivist.Settings, error) {
@@ -256,11 +258,11 @@ func (a *application) GetSettingsLoader(acfg *svcconfig.Archivist) archivist.Set
 		// Load our base settings.
 		//
 		// Archival bases are:
-		// Staging: gs://<services:gs_staging_bucket>/<project-id>/...
-		// Archive: gs://<project:archive_gs_bucket>/<project-id>/...
+		// Staging: <scheme>://<services:gs_staging_bucket>/<project-id>/...
+		// Archive: <scheme>://<project:archive_gs_bucket>/<project-id>/...
 		st := archivist.Settings{
-			GSBase:        gs.MakePath(pcfg.ArchiveGsBucket, "").Concat(serviceID),
-			GSStagingBase: gs.MakePath(acfg.GsStagingBucket, "").Concat(serviceID),
+			Base:        blobstore.MakePath(scheme, pcfg.ArchiveGsBucket, "").Concat(serviceID),
+			StagingBase: blobstore.MakePath(scheme, acfg.GsStagingBucket, "").Concat(serviceID),
 
 			IndexStreamRange: indexParam(func(ic *svcconfig.ArchiveIndexConfig) int32 { return ic.StreamRange }),
 			IndexPrefixRange: indexParam(func(ic *svcconfig.ArchiveIndexConfig) int32 { return ic.PrefixRange }),
@@ -0,0 +1,142 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package filedb
+
+import (
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/identity"
+	"github.com/luci/luci-go/server/auth/service/protocol"
+)
+
+// DB implements auth.DB on top of an AuthDB proto message.
+//
+// It is auth.SnapshotDB with faster group membership checks: each group is
+// expanded into a flat set of members and globs (including all nested groups)
+// the first time it is used, and the expansion is reused by all following
+// checks.
+//
+// Use NewDB to create new instances. Don't touch public fields of existing
+// instances.
+type DB struct {
+	*auth.SnapshotDB
+
+	groups map[string]*protocol.AuthGroup // all groups in AuthDB
+
+	lock     sync.RWMutex
+	expanded map[string]*expandedGroup // group name -> its expansion
+}
+
+// expandedGroup is a group with all nested groups merged into it.
+type expandedGroup struct {
+	members map[identity.Identity]struct{} // set of all members
+	globs   []identity.Glob                // list of all unique globs
+}
+
+var _ auth.DB = &DB{}
+
+// NewDB creates new instance of DB.
+//
+// Returns errors if AuthDB has inconsistencies.
+func NewDB(authDB *protocol.AuthDB, authServiceURL string, rev int64) (*DB, error) {
+	snap, err := auth.NewSnapshotDB(authDB, authServiceURL, rev)
+	if err != nil {
+		return nil, err
+	}
+	db := &DB{
+		SnapshotDB: snap,
+		groups:     make(map[string]*protocol.AuthGroup, len(authDB.GetGroups())),
+		expanded:   map[string]*expandedGroup{},
+	}
+	for _, g := range authDB.GetGroups() {
+		db.groups[g.GetName()] = g
+	}
+	return db, nil
+}
+
+// IsMember returns true if the given identity belongs to the given group.
+//
+// Unknown groups are considered empty.
+func (db *DB) IsMember(c context.Context, id identity.Identity, groupName string) (bool, error) {
+	gr := db.expand(groupName)
+	if gr == nil {
+		return false, nil
+	}
+	if _, ok := gr.members[id]; ok {
+		return true, nil
+	}
+	for _, glob := range gr.globs {
+		if glob.Match(id) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// expand returns the expansion of the given group, building it if necessary.
+//
+// Returns nil for unknown groups.
+func (db *DB) expand(groupName string) *expandedGroup {
+	if db.groups[groupName] == nil {
+		return nil
+	}
+
+	db.lock.RLock()
+	gr := db.expanded[groupName]
+	db.lock.RUnlock()
+	if gr != nil {
+		return gr
+	}
+
+	// Two goroutines may build the same expansion concurrently. It's fine, they
+	// are identical.
+	gr = db.build(groupName)
+
+	db.lock.Lock()
+	db.expanded[groupName] = gr
+	db.lock.Unlock()
+	return gr
+}
+
+// build traverses the group graph starting at the given group and merges all
+// members and globs it finds.
+//
+// Each group is visited once, so diamond-like graphs and (unexpected) cycles
+// are handled. Unknown nested groups are considered empty.
+func (db *DB) build(groupName string) *expandedGroup {
+	gr := &expandedGroup{members: map[identity.Identity]struct{}{}}
+	seenGlobs := map[string]struct{}{}
+	visited := map[string]struct{}{}
+
+	queue := []string{groupName}
+	for len(queue) != 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if _, ok := visited[name]; ok {
+			continue
+		}
+		visited[name] = struct{}{}
+
+		g := db.groups[name]
+		if g == nil {
+			continue
+		}
+		for _, ident := range g.GetMembers() {
+			gr.members[identity.Identity(ident)] = struct{}{}
+		}
+		for _, glob := range g.GetGlobs() {
+			if _, ok := seenGlobs[glob]; !ok {
+				seenGlobs[glob] = struct{}{}
+				gr.globs = append(gr.globs, identity.Glob(glob))
+			}
+		}
+		queue = append(queue, g.GetNested()...)
+	}
+
+	return gr
+}
@@ -0,0 +1,94 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package filedb
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/server/auth/identity"
+	"github.com/luci/luci-go/server/auth/service/protocol"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDB(t *testing.T) {
+	Convey("IsMember works", t, func() {
+		c := context.Background()
+		db, err := NewDB(&protocol.AuthDB{
+			Groups: []*protocol.AuthGroup{
+				makeGroup("direct", []string{"user:abc@example.com"}, nil, nil),
+				makeGroup("via glob", nil, []string{"user:*@example.com"}, nil),
+				makeGroup("via nested", nil, nil, []string{"direct"}),
+				makeGroup("diamond", nil, nil, []string{"via nested", "direct", "via glob"}),
+				makeGroup("cycle", nil, nil, []string{"cycle 2"}),
+				makeGroup("cycle 2", []string{"user:cycle@example.com"}, nil, []string{"cycle"}),
+				makeGroup("unknown nested", nil, nil, []string{"unknown"}),
+			},
+		}, "http://auth-service", 1234)
+		So(err, ShouldBeNil)
+
+		call := func(ident, group string) bool {
+			res, err := db.IsMember(c, identity.Identity(ident), group)
+			So(err, ShouldBeNil)
+			return res
+		}
+
+		So(call("user:abc@example.com", "direct"), ShouldBeTrue)
+		So(call("user:another@example.com", "direct"), ShouldBeFalse)
+
+		So(call("user:abc@example.com", "via glob"), ShouldBeTrue)
+		So(call("user:abc@another.com", "via glob"), ShouldBeFalse)
+
+		So(call("user:abc@example.com", "via nested"), ShouldBeTrue)
+		So(call("user:another@example.com", "via nested"), ShouldBeFalse)
+
+		So(call("user:abc@example.com", "diamond"), ShouldBeTrue)
+		So(call("user:another@example.com", "diamond"), ShouldBeTrue)
+		So(call("user:abc@another.com", "diamond"), ShouldBeFalse)
+
+		So(call("user:cycle@example.com", "cycle"), ShouldBeTrue)
+		So(call("user:abc@example.com", "cycle"), ShouldBeFalse)
+
+		So(call("user:abc@example.com", "unknown nested"), ShouldBeFalse)
+		So(call("user:abc@example.com", "unknown"), ShouldBeFalse)
+
+		Convey("expansions are reused", func() {
+			So(db.expanded, ShouldContainKey, "diamond")
+			So(db.expanded, ShouldNotContainKey, "unknown")
+			So(db.expand("diamond"), ShouldEqual, db.expanded["diamond"])
+			So(db.expanded["diamond"].globs, ShouldResemble, []identity.Glob{"user:*@example.com"})
+		})
+	})
+
+	Convey("Other methods are inherited from SnapshotDB", t, func() {
+		c := context.Background()
+		db, err := NewDB(&protocol.AuthDB{
+			OauthClientId: proto.String("primary-client-id"),
+		}, "http://auth-service", 1234)
+		So(err, ShouldBeNil)
+		So(db.Rev, ShouldEqual, 1234)
+
+		ok, err := db.IsAllowedOAuthClientID(c, "dude@example.com", "primary-client-id")
+		So(err, ShouldBeNil)
+		So(ok, ShouldBeTrue)
+	})
+}
+
+func makeGroup(name string, members, globs, nested []string) *protocol.AuthGroup {
+	return &protocol.AuthGroup{
+		Name:        proto.String(name),
+		Members:     members,
+		Globs:       globs,
+		Nested:      nested,
+		Description: proto.String(""),
+		CreatedTs:   proto.Int64(0),
+		CreatedBy:   proto.String(""),
+		ModifiedTs:  proto.Int64(0),
+		ModifiedBy:  proto.String(""),
+	}
+}
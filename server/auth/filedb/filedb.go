@@ -0,0 +1,306 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package filedb implements auth.DB backed by an AuthDB snapshot loaded from
+// a local file or a URL.
+//
+// It allows to run server/auth based services outside of App Engine:
+//
+//   p, err := filedb.New(c, filedb.Options{Source: "/etc/service/authdb.pb"})
+//   if err != nil {
+//     return err
+//   }
+//   go p.Run(c)
+//   auth.SetConfig(auth.Config{DBProvider: p.DB, ...})
+package filedb
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/transport"
+
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/service"
+	"github.com/luci/luci-go/server/auth/service/protocol"
+)
+
+// DefaultRefreshInterval is how often Provider.Run reloads the snapshot if
+// Options.RefreshInterval is not set.
+const DefaultRefreshInterval = time.Minute
+
+// Options describe where to load AuthDB snapshot from.
+type Options struct {
+	// Source is a path to a local file or http(s):// URL with AuthDB snapshot.
+	//
+	// URLs are fetched using the transport in the context (see
+	// github.com/luci/luci-go/common/transport).
+	Source string
+
+	// AuthServiceURL is URL (with protocol) of auth_service that produced the
+	// snapshot.
+	//
+	// It is used to fetch certificates of the service (to validate tokens it
+	// signs). Optional if no such tokens are used.
+	AuthServiceURL string
+
+	// RefreshInterval is how often Run reloads the snapshot.
+	//
+	// Default is DefaultRefreshInterval.
+	RefreshInterval time.Duration
+}
+
+// Provider holds the most recent DB loaded from Options.Source.
+//
+// Its DB method can be used as auth.DBProvider. The DB is replaced atomically
+// when Refresh loads a new revision. If the source is broken or unavailable,
+// the previous DB is kept.
+type Provider struct {
+	opts Options
+
+	refreshLock sync.Mutex // held by Refresh
+	version     string     // version of the source the current DB was built from
+
+	lock sync.RWMutex
+	db   *DB
+}
+
+// New loads the initial DB and returns a Provider that holds it.
+func New(c context.Context, opts Options) (*Provider, error) {
+	if opts.Source == "" {
+		return nil, fmt.Errorf("auth: AuthDB snapshot source is not set")
+	}
+	if opts.RefreshInterval == 0 {
+		opts.RefreshInterval = DefaultRefreshInterval
+	}
+	p := &Provider{opts: opts}
+	if err := p.Refresh(c); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// DB returns the most recent DB.
+//
+// It has auth.DBProvider signature.
+func (p *Provider) DB(c context.Context) (auth.DB, error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.db, nil
+}
+
+// Refresh reloads the snapshot from the source.
+//
+// It doesn't rebuild the DB if the source or the revision hasn't changed. On
+// errors the current DB is kept.
+func (p *Provider) Refresh(c context.Context) error {
+	p.refreshLock.Lock()
+	defer p.refreshLock.Unlock()
+
+	blob, version, err := p.fetch(c)
+	switch {
+	case err != nil:
+		return err
+	case blob == nil:
+		return nil // not modified
+	}
+
+	msg, err := parseSnapshot(blob)
+	if err != nil {
+		return fmt.Errorf("auth: bad AuthDB snapshot at %q - %s", p.opts.Source, err)
+	}
+	rev := msg.GetRevision().GetAuthDbRev()
+
+	p.lock.RLock()
+	cur := p.db
+	p.lock.RUnlock()
+	if cur != nil && rev != 0 && cur.Rev == rev {
+		p.version = version
+		return nil
+	}
+
+	if err := service.ValidateAuthDB(msg.GetAuthDb()); err != nil {
+		return err
+	}
+	db, err := NewDB(msg.GetAuthDb(), p.opts.AuthServiceURL, rev)
+	if err != nil {
+		return err
+	}
+
+	p.lock.Lock()
+	p.db = db
+	p.lock.Unlock()
+	p.version = version
+
+	logging.Infof(c, "auth: loaded AuthDB snapshot at rev %d from %q", rev, p.opts.Source)
+	return nil
+}
+
+// Run calls Refresh every RefreshInterval until the context is canceled.
+//
+// Errors are logged.
+func (p *Provider) Run(c context.Context) {
+	for {
+		if tr := <-clock.After(c, p.opts.RefreshInterval); tr.Incomplete() {
+			return
+		}
+		if err := p.Refresh(c); err != nil {
+			logging.WithError(err).Errorf(c, "auth: failed to refresh AuthDB snapshot, using the previous one")
+		}
+	}
+}
+
+// fetch reads the source if it has changed since the current DB was loaded.
+//
+// Returns nil blob if it hasn't changed, and an opaque version string of the
+// source otherwise.
+func (p *Provider) fetch(c context.Context) ([]byte, string, error) {
+	if strings.HasPrefix(p.opts.Source, "http://") || strings.HasPrefix(p.opts.Source, "https://") {
+		return p.fetchURL(c)
+	}
+	return p.fetchFile(c)
+}
+
+func (p *Provider) fetchFile(c context.Context) ([]byte, string, error) {
+	fi, err := os.Stat(p.opts.Source)
+	if err != nil {
+		return nil, "", err
+	}
+	version := fmt.Sprintf("%d:%d", fi.ModTime().UnixNano(), fi.Size())
+	if version == p.version {
+		return nil, "", nil
+	}
+	blob, err := ioutil.ReadFile(p.opts.Source)
+	if err != nil {
+		return nil, "", err
+	}
+	return blob, version, nil
+}
+
+func (p *Provider) fetchURL(c context.Context) ([]byte, string, error) {
+	req, err := http.NewRequest("GET", p.opts.Source, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if p.version != "" {
+		req.Header.Set("If-None-Match", p.version)
+	}
+	resp, err := transport.GetClient(c).Do(req)
+	if err != nil {
+		return nil, "", errors.WrapTransient(err)
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotModified:
+		return nil, "", nil
+	case resp.StatusCode >= 300:
+		err := fmt.Errorf("auth: HTTP code (%d) when fetching %s", resp.StatusCode, p.opts.Source)
+		if resp.StatusCode >= 500 {
+			return nil, "", errors.WrapTransient(err)
+		}
+		return nil, "", err
+	}
+	blob, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", errors.WrapTransient(err)
+	}
+	return blob, resp.Header.Get("ETag"), nil
+}
+
+// parseSnapshot decodes ReplicationPushRequest in any of the supported
+// formats:
+//   * JSON response of auth_service's /auth_service/api/v1/authdb/revisions/
+//     endpoint (base64-encoded zlib-compressed binary proto).
+//   * zlib-compressed binary proto.
+//   * text proto.
+//   * binary proto.
+func parseSnapshot(blob []byte) (*protocol.ReplicationPushRequest, error) {
+	var err error
+	switch trimmed := bytes.TrimSpace(blob); {
+	case len(trimmed) != 0 && trimmed[0] == '{':
+		if blob, err = decodeJSONSnapshot(trimmed); err != nil {
+			return nil, err
+		}
+	case isZlib(blob):
+		if blob, err = inflate(blob); err != nil {
+			return nil, err
+		}
+	default:
+		msg := &protocol.ReplicationPushRequest{}
+		if proto.UnmarshalText(string(blob), msg) == nil {
+			return msg, checkSnapshot(msg)
+		}
+	}
+	msg := &protocol.ReplicationPushRequest{}
+	if err := proto.Unmarshal(blob, msg); err != nil {
+		return nil, err
+	}
+	return msg, checkSnapshot(msg)
+}
+
+// decodeJSONSnapshot extracts serialized ReplicationPushRequest from
+// auth_service JSON response and verifies its digest.
+func decodeJSONSnapshot(blob []byte) ([]byte, error) {
+	var out struct {
+		Snapshot struct {
+			SHA256       string `json:"sha256"`
+			DeflatedBody string `json:"deflated_body"`
+		} `json:"snapshot"`
+	}
+	if err := json.Unmarshal(blob, &out); err != nil {
+		return nil, err
+	}
+	deflated, err := base64.StdEncoding.DecodeString(out.Snapshot.DeflatedBody)
+	if err != nil {
+		return nil, err
+	}
+	inflated, err := inflate(deflated)
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(inflated)
+	if hex.EncodeToString(digest[:]) != out.Snapshot.SHA256 {
+		return nil, fmt.Errorf("wrong SHA256 digest")
+	}
+	return inflated, nil
+}
+
+// checkSnapshot verifies required fields are set.
+func checkSnapshot(msg *protocol.ReplicationPushRequest) error {
+	if msg.GetAuthDb() == nil {
+		return fmt.Errorf("'auth_db' field is missing")
+	}
+	return nil
+}
+
+// isZlib returns true if blob starts with a zlib header.
+func isZlib(blob []byte) bool {
+	return len(blob) >= 2 && blob[0]&0x0f == 8 && (uint16(blob[0])<<8|uint16(blob[1]))%31 == 0
+}
+
+func inflate(blob []byte) ([]byte, error) {
+	r, err := zlib.NewReader(bytes.NewReader(blob))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
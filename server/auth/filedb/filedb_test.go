@@ -0,0 +1,235 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package filedb
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/server/auth/identity"
+	"github.com/luci/luci-go/server/auth/service/protocol"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestProvider(t *testing.T) {
+	Convey("With a snapshot file", t, func() {
+		c := context.Background()
+
+		tmp, err := ioutil.TempDir("", "filedb")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tmp)
+		path := filepath.Join(tmp, "authdb.pb")
+
+		mtime := time.Unix(1442540000, 0)
+		write := func(blob []byte) {
+			So(ioutil.WriteFile(path, blob, 0600), ShouldBeNil)
+			So(os.Chtimes(path, mtime, mtime), ShouldBeNil)
+			mtime = mtime.Add(time.Second)
+		}
+		isMember := func(p *Provider, ident string) bool {
+			db, err := p.DB(c)
+			So(err, ShouldBeNil)
+			res, err := db.IsMember(c, identity.Identity(ident), "group")
+			So(err, ShouldBeNil)
+			return res
+		}
+		rev := func(p *Provider) int64 {
+			db, err := p.DB(c)
+			So(err, ShouldBeNil)
+			return db.(*DB).Rev
+		}
+
+		write(marshal(snapshot(1, "user:a@example.com")))
+		p, err := New(c, Options{Source: path})
+		So(err, ShouldBeNil)
+		So(rev(p), ShouldEqual, 1)
+		So(isMember(p, "user:a@example.com"), ShouldBeTrue)
+
+		Convey("Picks up new revisions", func() {
+			write(marshal(snapshot(2, "user:b@example.com")))
+			So(p.Refresh(c), ShouldBeNil)
+			So(rev(p), ShouldEqual, 2)
+			So(isMember(p, "user:a@example.com"), ShouldBeFalse)
+			So(isMember(p, "user:b@example.com"), ShouldBeTrue)
+		})
+
+		Convey("Keeps the DB if the file is unchanged", func() {
+			db, _ := p.DB(c)
+			So(p.Refresh(c), ShouldBeNil)
+			again, _ := p.DB(c)
+			So(again, ShouldEqual, db)
+		})
+
+		Convey("Keeps the DB if the revision is unchanged", func() {
+			db, _ := p.DB(c)
+			write(marshal(snapshot(1, "user:b@example.com")))
+			So(p.Refresh(c), ShouldBeNil)
+			again, _ := p.DB(c)
+			So(again, ShouldEqual, db)
+		})
+
+		Convey("Keeps the DB if the file is broken", func() {
+			write([]byte("garbage"))
+			So(p.Refresh(c), ShouldErrLike, "bad AuthDB snapshot")
+			So(rev(p), ShouldEqual, 1)
+
+			write(marshal(snapshot(2, "user:b@example.com", "group")))
+			So(p.Refresh(c), ShouldErrLike, "dependency cycle")
+			So(rev(p), ShouldEqual, 1)
+
+			So(os.Remove(path), ShouldBeNil)
+			So(p.Refresh(c), ShouldNotBeNil)
+			So(rev(p), ShouldEqual, 1)
+		})
+	})
+
+	Convey("With a snapshot URL", t, func() {
+		c := context.Background()
+
+		blob := marshal(snapshot(1, "user:a@example.com"))
+		fetches := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fetches++
+			etag := `"` + hex.EncodeToString(sha256Sum(blob)) + `"`
+			if r.Header.Get("If-None-Match") == etag {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", etag)
+			w.Write(blob)
+		}))
+		defer ts.Close()
+
+		p, err := New(c, Options{Source: ts.URL})
+		So(err, ShouldBeNil)
+		db, _ := p.DB(c)
+		So(db.(*DB).Rev, ShouldEqual, 1)
+
+		So(p.Refresh(c), ShouldBeNil)
+		again, _ := p.DB(c)
+		So(again, ShouldEqual, db)
+		So(fetches, ShouldEqual, 2)
+
+		blob = marshal(snapshot(2, "user:a@example.com"))
+		So(p.Refresh(c), ShouldBeNil)
+		db, _ = p.DB(c)
+		So(db.(*DB).Rev, ShouldEqual, 2)
+	})
+
+	Convey("Requires a source", t, func() {
+		_, err := New(context.Background(), Options{})
+		So(err, ShouldErrLike, "source is not set")
+	})
+}
+
+func TestParseSnapshot(t *testing.T) {
+	Convey("parseSnapshot works", t, func() {
+		msg := snapshot(5, "user:a@example.com")
+		blob := marshal(msg)
+
+		check := func(blob []byte) {
+			out, err := parseSnapshot(blob)
+			So(err, ShouldBeNil)
+			So(out, ShouldResemble, msg)
+		}
+
+		Convey("binary", func() {
+			check(blob)
+		})
+
+		Convey("deflated", func() {
+			check(deflate(blob))
+		})
+
+		Convey("text", func() {
+			check([]byte(proto.MarshalTextString(msg)))
+		})
+
+		Convey("auth_service JSON", func() {
+			var out struct {
+				Snapshot struct {
+					Rev          int64  `json:"auth_db_rev"`
+					SHA256       string `json:"sha256"`
+					DeflatedBody string `json:"deflated_body"`
+				} `json:"snapshot"`
+			}
+			out.Snapshot.Rev = 5
+			out.Snapshot.SHA256 = hex.EncodeToString(sha256Sum(blob))
+			out.Snapshot.DeflatedBody = base64.StdEncoding.EncodeToString(deflate(blob))
+			js, err := json.Marshal(&out)
+			So(err, ShouldBeNil)
+			check(js)
+
+			out.Snapshot.SHA256 = "bad"
+			js, err = json.Marshal(&out)
+			So(err, ShouldBeNil)
+			_, err = parseSnapshot(js)
+			So(err, ShouldErrLike, "wrong SHA256 digest")
+		})
+
+		Convey("missing auth_db", func() {
+			_, err := parseSnapshot(marshal(&protocol.ReplicationPushRequest{}))
+			So(err, ShouldErrLike, "'auth_db' field is missing")
+		})
+	})
+}
+
+///
+
+func snapshot(rev int64, member string, nested ...string) *protocol.ReplicationPushRequest {
+	return &protocol.ReplicationPushRequest{
+		Revision: &protocol.AuthDBRevision{
+			PrimaryId:  proto.String("primaryId"),
+			AuthDbRev:  proto.Int64(rev),
+			ModifiedTs: proto.Int64(1446599918304238),
+		},
+		AuthDb: &protocol.AuthDB{
+			OauthClientId:     proto.String(""),
+			OauthClientSecret: proto.String(""),
+			Groups: []*protocol.AuthGroup{
+				makeGroup("group", []string{member}, nil, nested),
+			},
+		},
+	}
+}
+
+func marshal(msg proto.Message) []byte {
+	blob, err := proto.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return blob
+}
+
+func deflate(blob []byte) []byte {
+	buf := bytes.Buffer{}
+	w := zlib.NewWriter(&buf)
+	if _, err := w.Write(blob); err != nil {
+		panic(err)
+	}
+	w.Close()
+	return buf.Bytes()
+}
+
+func sha256Sum(blob []byte) []byte {
+	h := sha256.Sum256(blob)
+	return h[:]
+}
@@ -0,0 +1,14 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package idtoken implements auth.Method that authenticates requests using
+// OpenID Connect ID tokens (signed JWTs) passed via Authorization header.
+//
+// Tokens are verified using public keys of trusted issuers, fetched from their
+// JWKS endpoints (discovered via OpenID Connect discovery document, if not
+// given explicitly) and cached in local memory.
+//
+// See https://openid.net/specs/openid-connect-core-1_0.html#IDToken and
+// https://tools.ietf.org/html/rfc7519.
+package idtoken
@@ -0,0 +1,212 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package idtoken
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/data/caching/proccache"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/server/auth/internal"
+)
+
+const (
+	// KeysCacheExpiration defines how long to cache fetched issuer keys in local
+	// memory.
+	KeysCacheExpiration = time.Hour
+
+	// MinKeysRefreshInterval is how often the keys may be refetched when a token
+	// is signed by an unknown key (e.g. the issuer has rotated its keys).
+	MinKeysRefreshInterval = time.Minute
+
+	// discoveryDocExpiration defines how long to cache OpenID discovery
+	// documents in local memory.
+	discoveryDocExpiration = 24 * time.Hour
+)
+
+// discoveryDoc describes subset of OpenID Discovery JSON document.
+type discoveryDoc struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+// jwks is JSON Web Key Set document.
+//
+// See https://tools.ietf.org/html/rfc7517#section-5.
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+// jwk is a single JSON Web Key. Only RSA and EC public keys are supported.
+//
+// See https://tools.ietf.org/html/rfc7518#section-6.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+
+	// RSA keys.
+	N string `json:"n"`
+	E string `json:"e"`
+
+	// EC keys.
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// keySet is a set of parsed public keys of some issuer.
+type keySet struct {
+	keys    map[string]crypto.PublicKey // kid -> *rsa.PublicKey or *ecdsa.PublicKey
+	fetched time.Time                   // when it was fetched
+}
+
+type proccacheKey string
+
+// discoverJWKSURL fetches OpenID discovery document of the issuer and returns
+// its JWKS URL. The document is cached in local memory for 24 hours.
+//
+// The document must name the issuer exactly as it is configured.
+func discoverJWKSURL(c context.Context, issuer string) (string, error) {
+	url := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	doc, err := proccache.GetOrMake(c, proccacheKey("discovery:"+url), func() (interface{}, time.Duration, error) {
+		doc := &discoveryDoc{}
+		err := internal.FetchJSON(c, doc, func() (*http.Request, error) {
+			return http.NewRequest("GET", url, nil)
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		// See https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderConfigurationValidation.
+		if doc.Issuer != issuer {
+			return nil, 0, fmt.Errorf("idtoken: discovery document at %s is for issuer %q, not %q", url, doc.Issuer, issuer)
+		}
+		return doc, discoveryDocExpiration, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if doc.(*discoveryDoc).JWKSURI == "" {
+		return "", fmt.Errorf("idtoken: no jwks_uri in discovery document at %s", url)
+	}
+	return doc.(*discoveryDoc).JWKSURI, nil
+}
+
+// getKey returns public key with the given ID from JWKS at the given URL.
+//
+// Keys are cached in local memory for KeysCacheExpiration. If the key is not
+// in the cache, refetches the keys (but not more often than once per
+// MinKeysRefreshInterval). Returns (nil, nil) if there's no such key.
+func getKey(c context.Context, url, kid string) (crypto.PublicKey, error) {
+	cacheKey := proccacheKey("jwks:" + url)
+
+	if cached, ok := proccache.Get(c, cacheKey); ok {
+		ks := cached.(*keySet)
+		if key := ks.keys[kid]; key != nil {
+			return key, nil
+		}
+		if clock.Now(c).Sub(ks.fetched) < MinKeysRefreshInterval {
+			return nil, nil
+		}
+	}
+
+	ks, err := fetchKeySet(c, url)
+	if err != nil {
+		return nil, err
+	}
+	proccache.Put(c, cacheKey, ks, KeysCacheExpiration)
+	return ks.keys[kid], nil
+}
+
+// fetchKeySet fetches and parses JWKS document.
+//
+// Keys of unsupported types are skipped.
+func fetchKeySet(c context.Context, url string) (*keySet, error) {
+	doc := jwks{}
+	err := internal.FetchJSON(c, &doc, func() (*http.Request, error) {
+		return http.NewRequest("GET", url, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	ks := &keySet{
+		keys:    make(map[string]crypto.PublicKey, len(doc.Keys)),
+		fetched: clock.Now(c),
+	}
+	for _, k := range doc.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			logging.Warningf(c, "idtoken: skipping key %q in %s - %s", k.Kid, url, err)
+			continue
+		}
+		ks.keys[k.Kid] = key
+	}
+	return ks, nil
+}
+
+// publicKey parses the key.
+func (k *jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("bad modulus - %s", err)
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("bad exponent - %s", err)
+		}
+		if e.BitLen() > 31 {
+			return nil, fmt.Errorf("exponent is too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("bad x - %s", err)
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("bad y - %s", err)
+		}
+		if !elliptic.P256().IsOnCurve(x, y) {
+			return nil, fmt.Errorf("the point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	blob, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(blob) == 0 {
+		return nil, fmt.Errorf("empty value")
+	}
+	return new(big.Int).SetBytes(blob), nil
+}
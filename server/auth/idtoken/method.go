@@ -0,0 +1,344 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package idtoken
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/identity"
+)
+
+// DefaultClockSkew is how much clock difference between the issuer and us is
+// tolerated when checking token's expiration, if AuthMethod.ClockSkew is not
+// set.
+const DefaultClockSkew = time.Minute
+
+var (
+	// ErrNotConfigured is returned by Authenticate if AuthMethod has no issuers.
+	ErrNotConfigured = errors.New("idtoken: not configured")
+
+	// ErrMalformedIDToken is returned when ID token cannot be deserialized.
+	ErrMalformedIDToken = errors.New("auth: malformed ID token")
+
+	// ErrUnsignedIDToken is returned if token's signature cannot be verified.
+	ErrUnsignedIDToken = errors.New("auth: unsigned ID token")
+
+	// ErrForbiddenIDToken is returned if token is structurally correct and
+	// properly signed, but some of its claims prevent it from being used. For
+	// example, it is expired or it was minted for some other audience. See logs
+	// for details.
+	ErrForbiddenIDToken = errors.New("auth: forbidden ID token")
+)
+
+// IdentityFunc maps claims of a valid ID token to an identity.
+type IdentityFunc func(c context.Context, claims *Claims) (identity.Identity, error)
+
+// Issuer describes a trusted issuer of ID tokens.
+type Issuer struct {
+	// Issuer is the expected value of "iss" claim, e.g.
+	// "https://accounts.google.com".
+	Issuer string
+
+	// JWKSURL is URL of the issuer's JSON Web Key Set.
+	//
+	// If empty, it is discovered via the issuer's OpenID discovery document at
+	// <Issuer>/.well-known/openid-configuration.
+	JWKSURL string
+
+	// Audiences is a list of accepted values of "aud" claim. Must not be empty.
+	Audiences []string
+
+	// Identity maps claims to an identity.
+	//
+	// Default is EmailIdentity.
+	Identity IdentityFunc
+}
+
+// Claims is a subset of ID token claims.
+type Claims struct {
+	Issuer        string      `json:"iss"`
+	Subject       string      `json:"sub"`
+	Audience      Audience    `json:"aud"`
+	Expiry        NumericDate `json:"exp"`
+	IssuedAt      NumericDate `json:"iat,omitempty"`
+	NotBefore     NumericDate `json:"nbf,omitempty"`
+	Email         string      `json:"email,omitempty"`
+	EmailVerified *bool       `json:"email_verified,omitempty"`
+	Name          string      `json:"name,omitempty"`
+	Picture       string      `json:"picture,omitempty"`
+}
+
+// NumericDate is a JWT timestamp: seconds since epoch, possibly fractional.
+//
+// See https://tools.ietf.org/html/rfc7519#section-2.
+type NumericDate float64
+
+// NewNumericDate converts time.Time to NumericDate.
+func NewNumericDate(t time.Time) NumericDate {
+	return NumericDate(float64(t.UnixNano()) / 1e9)
+}
+
+// Time converts NumericDate to time.Time.
+func (d NumericDate) Time() time.Time {
+	sec := math.Floor(float64(d))
+	return time.Unix(int64(sec), int64((float64(d)-sec)*1e9))
+}
+
+// Audience is "aud" claim. It can be either a string or a list of strings.
+type Audience []string
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *Audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = Audience{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*a = Audience(list)
+	return nil
+}
+
+// EmailIdentity is the default IdentityFunc. It returns "user:<email>" if
+// the token has "email" claim that is not marked as unverified.
+func EmailIdentity(c context.Context, claims *Claims) (identity.Identity, error) {
+	if claims.Email == "" {
+		return "", fmt.Errorf("no 'email' claim")
+	}
+	if claims.EmailVerified != nil && !*claims.EmailVerified {
+		return "", fmt.Errorf("email %q is not verified", claims.Email)
+	}
+	return identity.MakeIdentity("user:" + claims.Email)
+}
+
+// AuthMethod implements auth.Method by checking ID tokens passed via
+// "Authorization: Bearer <token>" header.
+//
+// Bearer tokens that are not JWTs (e.g. OAuth2 access tokens) or issued by
+// unknown issuers are ignored, so AuthMethod can be combined with other
+// methods in auth.Authenticator.
+type AuthMethod struct {
+	// Issuers is a list of trusted issuers.
+	Issuers []Issuer
+
+	// ClockSkew is how much clock difference is tolerated when checking "exp",
+	// "nbf" and "iat" claims.
+	//
+	// Default is DefaultClockSkew.
+	ClockSkew time.Duration
+}
+
+// Authenticate extracts peer's identity from the incoming request. It is part
+// of auth.Method interface.
+func (m *AuthMethod) Authenticate(c context.Context, r *http.Request) (*auth.User, error) {
+	if len(m.Issuers) == 0 {
+		return nil, ErrNotConfigured
+	}
+
+	header := r.Header.Get("Authorization")
+	chunks := strings.SplitN(header, " ", 2)
+	if len(chunks) != 2 || strings.ToLower(chunks[0]) != "bearer" {
+		return nil, nil
+	}
+	tok := strings.TrimSpace(chunks[1])
+	if strings.Count(tok, ".") != 2 {
+		return nil, nil // not a JWT
+	}
+
+	claims, err := m.CheckToken(c, tok)
+	if err != nil || claims == nil {
+		return nil, err
+	}
+
+	iss := m.issuer(claims.Issuer)
+	identityFn := iss.Identity
+	if identityFn == nil {
+		identityFn = EmailIdentity
+	}
+	id, err := identityFn(c, claims)
+	if err != nil {
+		logging.Warningf(c, "auth: Can't derive identity from ID token of %q - %s", claims.Subject, err)
+		return nil, ErrForbiddenIDToken
+	}
+
+	return &auth.User{
+		Identity: id,
+		Email:    claims.Email,
+		Name:     claims.Name,
+		Picture:  claims.Picture,
+	}, nil
+}
+
+// CheckToken verifies the ID token and returns its claims.
+//
+// Returns (nil, nil) if the token is issued by an unknown issuer.
+func (m *AuthMethod) CheckToken(c context.Context, tok string) (*Claims, error) {
+	chunks := strings.Split(tok, ".")
+	if len(chunks) != 3 {
+		logging.Warningf(c, "auth: ID token is not a JWT")
+		return nil, ErrMalformedIDToken
+	}
+
+	var hdr struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(chunks[0], &hdr); err != nil {
+		logging.Warningf(c, "auth: Failed to deserialize ID token header - %s", err)
+		return nil, ErrMalformedIDToken
+	}
+	claims := &Claims{}
+	if err := decodeSegment(chunks[1], claims); err != nil {
+		logging.Warningf(c, "auth: Failed to deserialize ID token claims - %s", err)
+		return nil, ErrMalformedIDToken
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(chunks[2])
+	if err != nil {
+		logging.Warningf(c, "auth: Failed to deserialize ID token signature - %s", err)
+		return nil, ErrMalformedIDToken
+	}
+
+	iss := m.issuer(claims.Issuer)
+	if iss == nil {
+		logging.Debugf(c, "auth: Ignoring ID token from unknown issuer %q", claims.Issuer)
+		return nil, nil
+	}
+
+	// Check the signature before looking at other claims.
+	if err := iss.checkSignature(c, hdr.Alg, hdr.Kid, chunks[0]+"."+chunks[1], sig); err != nil {
+		if errors.IsTransient(err) {
+			logging.Warningf(c, "auth: Transient error when checking ID token signature - %s", err)
+			return nil, err
+		}
+		logging.Warningf(c, "auth: Failed to check ID token signature - %s", err)
+		return nil, ErrUnsignedIDToken
+	}
+
+	if err := m.checkClaims(c, iss, claims); err != nil {
+		logging.Warningf(c, "auth: Bad ID token of %q from %q - %s", claims.Subject, claims.Issuer, err)
+		return nil, ErrForbiddenIDToken
+	}
+	return claims, nil
+}
+
+// issuer returns the trusted issuer with the given name or nil.
+func (m *AuthMethod) issuer(name string) *Issuer {
+	for i := range m.Issuers {
+		if m.Issuers[i].Issuer == name {
+			return &m.Issuers[i]
+		}
+	}
+	return nil
+}
+
+// checkClaims checks expiration and audience of the token.
+func (m *AuthMethod) checkClaims(c context.Context, iss *Issuer, claims *Claims) error {
+	skew := m.ClockSkew
+	if skew == 0 {
+		skew = DefaultClockSkew
+	}
+	now := clock.Now(c)
+
+	if claims.Expiry == 0 {
+		return fmt.Errorf("no 'exp' claim")
+	}
+	if exp := claims.Expiry.Time(); now.After(exp.Add(skew)) {
+		return fmt.Errorf("expired %s ago", now.Sub(exp))
+	}
+	if claims.NotBefore != 0 {
+		if nbf := claims.NotBefore.Time(); now.Before(nbf.Add(-skew)) {
+			return fmt.Errorf("not valid for another %s", nbf.Sub(now))
+		}
+	}
+	if claims.IssuedAt != 0 {
+		if iat := claims.IssuedAt.Time(); now.Before(iat.Add(-skew)) {
+			return fmt.Errorf("issued %s in the future", iat.Sub(now))
+		}
+	}
+
+	for _, aud := range claims.Audience {
+		for _, allowed := range iss.Audiences {
+			if aud == allowed {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("unexpected audience %q", []string(claims.Audience))
+}
+
+// checkSignature verifies the signature of the token using the issuer's key.
+func (iss *Issuer) checkSignature(c context.Context, alg, kid, signed string, sig []byte) error {
+	url := iss.JWKSURL
+	if url == "" {
+		var err error
+		if url, err = discoverJWKSURL(c, iss.Issuer); err != nil {
+			return err
+		}
+	}
+	key, err := getKey(c, url, kid)
+	switch {
+	case err != nil:
+		return err
+	case key == nil:
+		return fmt.Errorf("unknown key %q", kid)
+	}
+
+	digest := sha256.Sum256([]byte(signed))
+	switch alg {
+	case "RS256":
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("key %q is not an RSA key", kid)
+		}
+		return rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], sig)
+
+	case "ES256":
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("key %q is not an EC key", kid)
+		}
+		if len(sig) != 64 {
+			return fmt.Errorf("bad ES256 signature length %d", len(sig))
+		}
+		r := new(big.Int).SetBytes(sig[:32])
+		s := new(big.Int).SetBytes(sig[32:])
+		if !ecdsa.Verify(pub, digest[:], r, s) {
+			return fmt.Errorf("ecdsa: verification error")
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported signing algorithm %q", alg)
+	}
+}
+
+// decodeSegment decodes base64url-encoded JSON.
+func decodeSegment(seg string, out interface{}) error {
+	blob, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(blob, out)
+}
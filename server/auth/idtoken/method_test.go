@@ -0,0 +1,371 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package idtoken
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/data/caching/proccache"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/identity"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAuthMethod(t *testing.T) {
+	Convey("With fake issuer", t, func() {
+		c := proccache.Use(context.Background(), &proccache.Cache{})
+		c, tc := testclock.UseTime(c, time.Unix(1442540000, 0))
+
+		iss := newFakeIssuer()
+		defer iss.Close()
+
+		method := AuthMethod{
+			Issuers: []Issuer{
+				{
+					Issuer:    iss.URL,
+					Audiences: []string{"my-service"},
+				},
+			},
+		}
+
+		call := func(header string) (*auth.User, error) {
+			req, _ := http.NewRequest("GET", "http://example.com", nil)
+			if header != "" {
+				req.Header.Set("Authorization", header)
+			}
+			return method.Authenticate(c, req)
+		}
+
+		claims := func() *Claims {
+			return &Claims{
+				Issuer:   iss.URL,
+				Subject:  "12345",
+				Audience: Audience{"my-service"},
+				Expiry:   NewNumericDate(tc.Now().Add(time.Hour)),
+				IssuedAt: NewNumericDate(tc.Now()),
+				Email:    "robot@example.com",
+				Name:     "Robot",
+			}
+		}
+
+		Convey("Accepts valid RS256 token", func() {
+			user, err := call("Bearer " + iss.signRS256(claims()))
+			So(err, ShouldBeNil)
+			So(user, ShouldResemble, &auth.User{
+				Identity: "user:robot@example.com",
+				Email:    "robot@example.com",
+				Name:     "Robot",
+			})
+			So(iss.discoveryFetches, ShouldEqual, 1)
+			So(iss.keysFetches, ShouldEqual, 1)
+
+			Convey("Caches keys", func() {
+				_, err := call("Bearer " + iss.signRS256(claims()))
+				So(err, ShouldBeNil)
+				So(iss.discoveryFetches, ShouldEqual, 1)
+				So(iss.keysFetches, ShouldEqual, 1)
+			})
+		})
+
+		Convey("Accepts valid ES256 token", func() {
+			user, err := call("Bearer " + iss.signES256(claims()))
+			So(err, ShouldBeNil)
+			So(user.Identity, ShouldEqual, identity.Identity("user:robot@example.com"))
+		})
+
+		Convey("Accepts a list of audiences", func() {
+			cl := claims()
+			cl.Audience = Audience{"another", "my-service"}
+			user, err := call("Bearer " + iss.signRS256(cl))
+			So(err, ShouldBeNil)
+			So(user, ShouldNotBeNil)
+		})
+
+		Convey("Uses explicit JWKS URL", func() {
+			method.Issuers[0].JWKSURL = iss.URL + "/jwks"
+			_, err := call("Bearer " + iss.signRS256(claims()))
+			So(err, ShouldBeNil)
+			So(iss.discoveryFetches, ShouldEqual, 0)
+		})
+
+		Convey("Uses custom identity mapping", func() {
+			method.Issuers[0].Identity = func(c context.Context, claims *Claims) (identity.Identity, error) {
+				return identity.MakeIdentity("user:" + claims.Subject + "@k8s.example.com")
+			}
+			user, err := call("Bearer " + iss.signRS256(claims()))
+			So(err, ShouldBeNil)
+			So(user.Identity, ShouldEqual, identity.Identity("user:12345@k8s.example.com"))
+		})
+
+		Convey("Picks up rotated keys", func() {
+			_, err := call("Bearer " + iss.signRS256(claims()))
+			So(err, ShouldBeNil)
+
+			iss.rotate()
+
+			// Too soon to refetch.
+			_, err = call("Bearer " + iss.signRS256(claims()))
+			So(err, ShouldEqual, ErrUnsignedIDToken)
+			So(iss.keysFetches, ShouldEqual, 1)
+
+			tc.Add(MinKeysRefreshInterval)
+			_, err = call("Bearer " + iss.signRS256(claims()))
+			So(err, ShouldBeNil)
+			So(iss.keysFetches, ShouldEqual, 2)
+		})
+
+		Convey("Skips non-applicable requests", func() {
+			user, err := call("")
+			So(err, ShouldBeNil)
+			So(user, ShouldBeNil)
+
+			user, err = call("Bearer ya29.access-token")
+			So(err, ShouldBeNil)
+			So(user, ShouldBeNil)
+
+			cl := claims()
+			cl.Issuer = "https://unknown.example.com"
+			user, err = call("Bearer " + iss.signRS256(cl))
+			So(err, ShouldBeNil)
+			So(user, ShouldBeNil)
+		})
+
+		Convey("Rejects malformed tokens", func() {
+			_, err := call("Bearer a.b.c")
+			So(err, ShouldEqual, ErrMalformedIDToken)
+		})
+
+		Convey("Rejects bad signatures", func() {
+			tok := iss.signRS256(claims())
+			_, err := call("Bearer " + tok[:len(tok)-4] + "AAAA")
+			So(err, ShouldEqual, ErrUnsignedIDToken)
+
+			_, err = call("Bearer " + sign(claims(), "none", "", nil))
+			So(err, ShouldEqual, ErrUnsignedIDToken)
+		})
+
+		Convey("Rejects expired tokens", func() {
+			cl := claims()
+			cl.Expiry = NewNumericDate(tc.Now().Add(-DefaultClockSkew / 2))
+			_, err := call("Bearer " + iss.signRS256(cl))
+			So(err, ShouldBeNil)
+
+			cl.Expiry = NewNumericDate(tc.Now().Add(-2 * DefaultClockSkew))
+			_, err = call("Bearer " + iss.signRS256(cl))
+			So(err, ShouldEqual, ErrForbiddenIDToken)
+		})
+
+		Convey("Accepts fractional timestamps", func() {
+			cl := claims()
+			cl.Expiry = NewNumericDate(tc.Now().Add(1500 * time.Millisecond))
+			cl.NotBefore = NewNumericDate(tc.Now().Add(-500 * time.Millisecond))
+			tok := iss.signRS256(cl)
+			So(decodedClaims(tok), ShouldContainSubstring, `"exp":1442540001.5,`)
+			_, err := call("Bearer " + tok)
+			So(err, ShouldBeNil)
+
+			tc.Add(DefaultClockSkew + time.Second)
+			_, err = call("Bearer " + tok)
+			So(err, ShouldBeNil)
+
+			tc.Add(time.Second)
+			_, err = call("Bearer " + tok)
+			So(err, ShouldEqual, ErrForbiddenIDToken)
+		})
+
+		Convey("Rejects tokens from the future", func() {
+			cl := claims()
+			cl.NotBefore = NewNumericDate(tc.Now().Add(2 * DefaultClockSkew))
+			_, err := call("Bearer " + iss.signRS256(cl))
+			So(err, ShouldEqual, ErrForbiddenIDToken)
+		})
+
+		Convey("Rejects discovery documents of other issuers", func() {
+			iss.docIssuer = "https://evil.example.com"
+			_, err := call("Bearer " + iss.signRS256(claims()))
+			So(err, ShouldEqual, ErrUnsignedIDToken)
+			So(iss.keysFetches, ShouldEqual, 0)
+		})
+
+		Convey("Rejects wrong audience", func() {
+			cl := claims()
+			cl.Audience = Audience{"another-service"}
+			_, err := call("Bearer " + iss.signRS256(cl))
+			So(err, ShouldEqual, ErrForbiddenIDToken)
+		})
+
+		Convey("Rejects unverified emails", func() {
+			cl := claims()
+			cl.EmailVerified = new(bool)
+			_, err := call("Bearer " + iss.signRS256(cl))
+			So(err, ShouldEqual, ErrForbiddenIDToken)
+		})
+
+		Convey("Requires issuers", func() {
+			method.Issuers = nil
+			_, err := call("")
+			So(err, ShouldEqual, ErrNotConfigured)
+		})
+	})
+}
+
+func TestAudience(t *testing.T) {
+	Convey("Audience unmarshals from string and list", t, func() {
+		var a Audience
+		So(json.Unmarshal([]byte(`"abc"`), &a), ShouldBeNil)
+		So(a, ShouldResemble, Audience{"abc"})
+		So(json.Unmarshal([]byte(`["abc", "def"]`), &a), ShouldBeNil)
+		So(a, ShouldResemble, Audience{"abc", "def"})
+		So(json.Unmarshal([]byte(`123`), &a), ShouldNotBeNil)
+	})
+}
+
+func TestNumericDate(t *testing.T) {
+	Convey("NumericDate unmarshals from integers and fractions", t, func() {
+		var d NumericDate
+		So(json.Unmarshal([]byte(`1442540000`), &d), ShouldBeNil)
+		So(d.Time(), ShouldResemble, time.Unix(1442540000, 0))
+		So(json.Unmarshal([]byte(`1442540000.25`), &d), ShouldBeNil)
+		So(d.Time(), ShouldResemble, time.Unix(1442540000, 250000000))
+		So(json.Unmarshal([]byte(`1.4425400005e9`), &d), ShouldBeNil)
+		So(d.Time(), ShouldResemble, time.Unix(1442540000, 500000000))
+		So(json.Unmarshal([]byte(`"1442540000"`), &d), ShouldNotBeNil)
+	})
+}
+
+///
+
+// fakeIssuer serves OpenID discovery document and JWKS.
+type fakeIssuer struct {
+	*httptest.Server
+
+	rsaKey *rsa.PrivateKey
+	ecKey  *ecdsa.PrivateKey
+	kid    string
+
+	docIssuer string // if set, overrides "issuer" in the discovery document
+
+	discoveryFetches int
+	keysFetches      int
+}
+
+func newFakeIssuer() *fakeIssuer {
+	iss := &fakeIssuer{}
+	iss.rotate()
+	iss.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			iss.discoveryFetches++
+			docIssuer := iss.docIssuer
+			if docIssuer == "" {
+				docIssuer = iss.URL
+			}
+			json.NewEncoder(w).Encode(&discoveryDoc{
+				Issuer:  docIssuer,
+				JWKSURI: iss.URL + "/jwks",
+			})
+		case "/jwks":
+			iss.keysFetches++
+			json.NewEncoder(w).Encode(&jwks{
+				Keys: []jwk{
+					{
+						Kty: "RSA",
+						Kid: "rsa-" + iss.kid,
+						Use: "sig",
+						N:   base64.RawURLEncoding.EncodeToString(iss.rsaKey.N.Bytes()),
+						E:   base64.RawURLEncoding.EncodeToString([]byte{1, 0, 1}),
+					},
+					{
+						Kty: "EC",
+						Kid: "ec-" + iss.kid,
+						Crv: "P-256",
+						X:   base64.RawURLEncoding.EncodeToString(iss.ecKey.X.Bytes()),
+						Y:   base64.RawURLEncoding.EncodeToString(iss.ecKey.Y.Bytes()),
+					},
+					{
+						Kty: "oct",
+						Kid: "unsupported",
+					},
+				},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	return iss
+}
+
+// rotate generates new keys.
+func (iss *fakeIssuer) rotate() {
+	var err error
+	if iss.rsaKey, err = rsa.GenerateKey(rand.Reader, 1024); err != nil {
+		panic(err)
+	}
+	if iss.ecKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader); err != nil {
+		panic(err)
+	}
+	iss.kid += "1"
+}
+
+func (iss *fakeIssuer) signRS256(claims *Claims) string {
+	return sign(claims, "RS256", "rsa-"+iss.kid, func(digest []byte) []byte {
+		sig, err := rsa.SignPKCS1v15(rand.Reader, iss.rsaKey, crypto.SHA256, digest)
+		if err != nil {
+			panic(err)
+		}
+		return sig
+	})
+}
+
+func (iss *fakeIssuer) signES256(claims *Claims) string {
+	return sign(claims, "ES256", "ec-"+iss.kid, func(digest []byte) []byte {
+		r, s, err := ecdsa.Sign(rand.Reader, iss.ecKey, digest)
+		if err != nil {
+			panic(err)
+		}
+		sig := make([]byte, 64)
+		rb, sb := r.Bytes(), s.Bytes()
+		copy(sig[32-len(rb):32], rb)
+		copy(sig[64-len(sb):], sb)
+		return sig
+	})
+}
+
+func sign(claims *Claims, alg, kid string, signer func(digest []byte) []byte) string {
+	hdr, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(hdr) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	var sig []byte
+	if signer != nil {
+		digest := sha256.Sum256([]byte(signed))
+		sig = signer(digest[:])
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+// decodedClaims returns JSON of claims of the token.
+func decodedClaims(tok string) string {
+	payload, err := base64.RawURLEncoding.DecodeString(strings.Split(tok, ".")[1])
+	if err != nil {
+		panic(err)
+	}
+	return string(payload)
+}
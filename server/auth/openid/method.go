@@ -42,6 +42,9 @@ var errBadDestinationURL = errors.New("openid: dest URL in LoginURL or LogoutURL
 type AuthMethod struct {
 	// SessionStore keeps user sessions in some permanent storage. Must be set,
 	// otherwise all methods return ErrNotConfigured.
+	//
+	// See server/auth/sessionstore for implementations that don't need
+	// the datastore.
 	SessionStore auth.SessionStore
 
 	// Insecure is true to allow http:// URLs and non-https cookies. Useful for
@@ -12,6 +12,14 @@ import (
 	"github.com/luci/luci-go/server/auth/service/protocol"
 )
 
+// ValidateAuthDB returns nil if AuthDB looks correct.
+//
+// It checks identity names, globs, nested group references, group dependency
+// cycles and IP whitelist subnets.
+func ValidateAuthDB(db *protocol.AuthDB) error {
+	return validateAuthDB(db)
+}
+
 // validateAuthDB returns nil if AuthDB looks correct.
 func validateAuthDB(db *protocol.AuthDB) error {
 	groups := make(map[string]*protocol.AuthGroup, len(db.GetGroups()))
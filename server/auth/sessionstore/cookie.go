@@ -0,0 +1,184 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sessionstore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/secrets"
+)
+
+// DefaultCookieSecretKey is the name of the secret used by CookieStore if
+// CookieStore.SecretKey is not set.
+const DefaultCookieSecretKey = secrets.Key("session_store_cookie")
+
+// cookieSessionVersion is a prefix of session IDs produced by CookieStore.
+const cookieSessionVersion = "v1"
+
+// CookieStore is stateless auth.SessionStore.
+//
+// The session (user profile and expiration time) is encrypted with AES-GCM
+// using a key derived from a secret in server/secrets and returned as the
+// session ID. Previous values of the secret are still accepted, so the secret
+// can be rotated.
+//
+// Since there's nothing to delete, CloseSession records the session in
+// Revoked. Without it closed sessions stay valid until they expire.
+type CookieStore struct {
+	// SecretKey is a name of the secret to derive encryption keys from.
+	//
+	// Default is DefaultCookieSecretKey.
+	SecretKey secrets.Key
+
+	// Revoked keeps closed sessions. Optional.
+	Revoked RevocationList
+}
+
+// OpenSession create a new session for a user with given expiration time.
+// It returns unique session ID.
+func (s *CookieStore) OpenSession(c context.Context, userID string, u *auth.User, exp time.Time) (string, error) {
+	data, err := newSessionData(userID, u, exp)
+	if err != nil {
+		return "", err
+	}
+	blob, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+
+	secret, err := s.secret(c)
+	if err != nil {
+		return "", err
+	}
+	aead, err := newAEAD(secret.Current.Blob)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errors.WrapTransient(err)
+	}
+	sealed := aead.Seal(nonce, nonce, blob, []byte(cookieSessionVersion))
+	return cookieSessionVersion + "." + base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// CloseSession closes a session given its ID. Does nothing if session is
+// already closed or doesn't exist. Returns only transient errors.
+func (s *CookieStore) CloseSession(c context.Context, sessionID string) error {
+	data, err := s.decode(c, sessionID)
+	if data == nil {
+		return err
+	}
+	if s.Revoked == nil {
+		logging.Warningf(c, "sessionstore: no RevocationList, session %q of %q can't be closed", data.ID, data.UserID)
+		return nil
+	}
+	return errors.WrapTransient(s.Revoked.Revoke(c, data.ID, data.Exp))
+}
+
+// GetSession returns existing non-expired session given its ID. Returns nil
+// if session doesn't exist, closed or expired. Returns only transient errors.
+func (s *CookieStore) GetSession(c context.Context, sessionID string) (*auth.Session, error) {
+	data, err := s.decode(c, sessionID)
+	if data == nil {
+		return nil, err
+	}
+	session := data.session(c, sessionID)
+	if session == nil {
+		return nil, nil
+	}
+	if s.Revoked != nil {
+		switch revoked, err := s.Revoked.IsRevoked(c, data.ID); {
+		case err != nil:
+			return nil, errors.WrapTransient(err)
+		case revoked:
+			return nil, nil
+		}
+	}
+	return session, nil
+}
+
+// secret returns the secret to derive encryption keys from.
+func (s *CookieStore) secret(c context.Context) (secrets.Secret, error) {
+	key := s.SecretKey
+	if key == "" {
+		key = DefaultCookieSecretKey
+	}
+	secret, err := secrets.GetSecret(c, key)
+	if err != nil {
+		return secrets.Secret{}, errors.WrapTransient(err)
+	}
+	return secret, nil
+}
+
+// decode decrypts the session ID.
+//
+// Returns (nil, nil) if it is malformed or wasn't produced using any of
+// the known values of the secret.
+func (s *CookieStore) decode(c context.Context, sessionID string) (*sessionData, error) {
+	if !strings.HasPrefix(sessionID, cookieSessionVersion+".") {
+		logging.Warningf(c, "sessionstore: unrecognized session ID format")
+		return nil, nil
+	}
+	sealed, err := base64.RawURLEncoding.DecodeString(sessionID[len(cookieSessionVersion)+1:])
+	if err != nil {
+		logging.Warningf(c, "sessionstore: bad session ID encoding - %s", err)
+		return nil, nil
+	}
+
+	secret, err := s.secret(c)
+	if err != nil {
+		return nil, err
+	}
+	for _, blob := range secret.Blobs() {
+		aead, err := newAEAD(blob.Blob)
+		if err != nil {
+			return nil, err
+		}
+		if len(sealed) < aead.NonceSize() {
+			break
+		}
+		nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+		plain, err := aead.Open(nil, nonce, ciphertext, []byte(cookieSessionVersion))
+		if err != nil {
+			continue // try the previous secret
+		}
+		data := &sessionData{}
+		if err := json.Unmarshal(plain, data); err != nil {
+			logging.Warningf(c, "sessionstore: broken session - %s", err)
+			return nil, nil
+		}
+		return data, nil
+	}
+
+	logging.Warningf(c, "sessionstore: session ID can't be decrypted with known secrets")
+	return nil, nil
+}
+
+// newAEAD returns AES-256-GCM cipher with a key derived from the secret.
+func newAEAD(secret []byte) (cipher.AEAD, error) {
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("sessionstore: empty secret")
+	}
+	key := sha256.Sum256(secret)
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
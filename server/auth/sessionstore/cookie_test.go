@@ -0,0 +1,114 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sessionstore
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/secrets"
+	"github.com/luci/luci-go/server/secrets/testsecrets"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCookieStore(t *testing.T) {
+	Convey("Works", t, func() {
+		c, tc := testclock.UseTime(context.Background(), time.Unix(1442540000, 0))
+		store := &testsecrets.Store{}
+		c = secrets.Set(c, store)
+
+		s := CookieStore{
+			Revoked: &KVRevocationList{KV: &MemoryKV{}, Prefix: "revoked/"},
+		}
+
+		user := &auth.User{
+			Identity: "user:abc@example.com",
+			Email:    "abc@example.com",
+			Name:     "dude",
+		}
+		exp := clock.Now(c).Add(time.Hour)
+		sid, err := s.OpenSession(c, "uid", user, exp)
+		So(err, ShouldBeNil)
+
+		expected := &auth.Session{
+			SessionID: sid,
+			UserID:    "uid",
+			User:      *user,
+			Exp:       exp.UTC(),
+		}
+
+		ss, err := s.GetSession(c, sid)
+		So(err, ShouldBeNil)
+		So(ss, ShouldResemble, expected)
+
+		Convey("Closes sessions", func() {
+			So(s.CloseSession(c, sid), ShouldBeNil)
+			ss, err := s.GetSession(c, sid)
+			So(err, ShouldBeNil)
+			So(ss, ShouldBeNil)
+
+			// Other sessions are still valid.
+			another, err := s.OpenSession(c, "uid", user, exp)
+			So(err, ShouldBeNil)
+			ss, err = s.GetSession(c, another)
+			So(err, ShouldBeNil)
+			So(ss, ShouldNotBeNil)
+		})
+
+		Convey("Can't close sessions without revocation list", func() {
+			s.Revoked = nil
+			So(s.CloseSession(c, sid), ShouldBeNil)
+			ss, err := s.GetSession(c, sid)
+			So(err, ShouldBeNil)
+			So(ss, ShouldNotBeNil)
+		})
+
+		Convey("Expires sessions", func() {
+			tc.Add(2 * time.Hour)
+			ss, err := s.GetSession(c, sid)
+			So(err, ShouldBeNil)
+			So(ss, ShouldBeNil)
+		})
+
+		Convey("Survives secret rotation", func() {
+			old := store.Secrets[DefaultCookieSecretKey]
+			store.Secrets[DefaultCookieSecretKey] = secrets.Secret{
+				Current:  secrets.NamedBlob{ID: "new", Blob: []byte("new secret")},
+				Previous: []secrets.NamedBlob{old.Current},
+			}
+			ss, err := s.GetSession(c, sid)
+			So(err, ShouldBeNil)
+			So(ss, ShouldResemble, expected)
+
+			Convey("but not its removal", func() {
+				store.Secrets[DefaultCookieSecretKey] = secrets.Secret{
+					Current: secrets.NamedBlob{ID: "new", Blob: []byte("new secret")},
+				}
+				ss, err := s.GetSession(c, sid)
+				So(err, ShouldBeNil)
+				So(ss, ShouldBeNil)
+			})
+		})
+
+		Convey("Rejects tampered sessions", func() {
+			for _, bad := range []string{"", "garbage", "v1.garbage", "v1.", sid[:len(sid)-2] + "AA"} {
+				ss, err := s.GetSession(c, bad)
+				So(err, ShouldBeNil)
+				So(ss, ShouldBeNil)
+				So(s.CloseSession(c, bad), ShouldBeNil)
+			}
+		})
+
+		Convey("Doesn't leak the profile", func() {
+			So(sid, ShouldNotContainSubstring, "abc@example.com")
+		})
+	})
+}
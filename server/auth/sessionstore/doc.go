@@ -0,0 +1,14 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package sessionstore implements auth.SessionStore without the datastore.
+//
+// CookieStore is stateless: the session itself (encrypted and authenticated
+// with a key from server/secrets) is used as the session ID, which
+// openid.AuthMethod puts into the session cookie. Closed sessions are recorded
+// in an optional RevocationList until they expire.
+//
+// KVStore keeps sessions in a pluggable key-value storage (see KV), e.g.
+// memcached or Redis.
+package sessionstore
@@ -0,0 +1,84 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sessionstore
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+)
+
+// KV is a key-value storage with expiring items.
+//
+// It is used by KVStore and KVRevocationList. Implementations must be safe for
+// concurrent use.
+type KV interface {
+	// Get returns a value given its key or nil if there's no such item or it
+	// has expired.
+	Get(c context.Context, key string) ([]byte, error)
+
+	// Put stores a value. It may be forgotten after exp. Zero exp means the item
+	// never expires.
+	Put(c context.Context, key string, value []byte, exp time.Time) error
+
+	// Delete removes the item. Does nothing if there's no such item.
+	Delete(c context.Context, key string) error
+}
+
+// MemoryKV implements KV in process memory.
+//
+// Useful for tests and single-process deployments. Expired items are removed
+// lazily, when they are accessed.
+type MemoryKV struct {
+	lock  sync.Mutex
+	items map[string]memoryItem
+}
+
+type memoryItem struct {
+	value []byte
+	exp   time.Time
+}
+
+// Get returns a value given its key or nil if there's no such item or it
+// has expired.
+func (kv *MemoryKV) Get(c context.Context, key string) ([]byte, error) {
+	kv.lock.Lock()
+	defer kv.lock.Unlock()
+	item, ok := kv.items[key]
+	if !ok {
+		return nil, nil
+	}
+	if !item.exp.IsZero() && !clock.Now(c).Before(item.exp) {
+		delete(kv.items, key)
+		return nil, nil
+	}
+	return item.value, nil
+}
+
+// Put stores a value. It may be forgotten after exp. Zero exp means the item
+// never expires.
+func (kv *MemoryKV) Put(c context.Context, key string, value []byte, exp time.Time) error {
+	kv.lock.Lock()
+	defer kv.lock.Unlock()
+	if kv.items == nil {
+		kv.items = map[string]memoryItem{}
+	}
+	kv.items[key] = memoryItem{
+		value: append([]byte(nil), value...),
+		exp:   exp,
+	}
+	return nil
+}
+
+// Delete removes the item. Does nothing if there's no such item.
+func (kv *MemoryKV) Delete(c context.Context, key string) error {
+	kv.lock.Lock()
+	defer kv.lock.Unlock()
+	delete(kv.items, key)
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sessionstore
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/identity"
+)
+
+// KVStore stores auth sessions in a KV. It implements auth.SessionStore.
+type KVStore struct {
+	KV     KV     // where to store sessions, required
+	Prefix string // used as prefix for keys
+}
+
+// OpenSession create a new session for a user with given expiration time.
+// It returns unique session ID.
+func (s *KVStore) OpenSession(c context.Context, userID string, u *auth.User, exp time.Time) (string, error) {
+	if strings.IndexByte(userID, '/') != -1 {
+		return "", fmt.Errorf("sessionstore: bad userID (%q), cannot have '/' inside", userID)
+	}
+	data, err := newSessionData(userID, u, exp)
+	if err != nil {
+		return "", err
+	}
+	blob, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	sessionID := userID + "/" + data.ID
+	if err := s.KV.Put(c, s.Prefix+sessionID, blob, exp); err != nil {
+		return "", errors.WrapTransient(err)
+	}
+	return sessionID, nil
+}
+
+// CloseSession closes a session given its ID. Does nothing if session is
+// already closed or doesn't exist. Returns only transient errors.
+func (s *KVStore) CloseSession(c context.Context, sessionID string) error {
+	return errors.WrapTransient(s.KV.Delete(c, s.Prefix+sessionID))
+}
+
+// GetSession returns existing non-expired session given its ID. Returns nil
+// if session doesn't exist, closed or expired. Returns only transient errors.
+func (s *KVStore) GetSession(c context.Context, sessionID string) (*auth.Session, error) {
+	blob, err := s.KV.Get(c, s.Prefix+sessionID)
+	if err != nil {
+		return nil, errors.WrapTransient(err)
+	}
+	if blob == nil {
+		return nil, nil
+	}
+	data := sessionData{}
+	if err := json.Unmarshal(blob, &data); err != nil {
+		logging.Warningf(c, "sessionstore: broken session %q - %s", sessionID, err)
+		return nil, nil
+	}
+	return data.session(c, sessionID), nil
+}
+
+////
+
+// sessionData is serialized session.
+type sessionData struct {
+	ID        string    `json:"id"`  // random ID of the session
+	UserID    string    `json:"uid"` // authentication provider specific user id
+	Identity  string    `json:"identity"`
+	Superuser bool      `json:"superuser,omitempty"`
+	Email     string    `json:"email,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	Picture   string    `json:"picture,omitempty"`
+	Exp       time.Time `json:"exp"`
+}
+
+// newSessionData validates the user and makes new sessionData with random ID.
+func newSessionData(userID string, u *auth.User, exp time.Time) (*sessionData, error) {
+	if err := u.Identity.Validate(); err != nil {
+		return nil, fmt.Errorf("sessionstore: bad identity string (%q) - %s", u.Identity, err)
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, errors.WrapTransient(err)
+	}
+	return &sessionData{
+		ID:        hex.EncodeToString(id),
+		UserID:    userID,
+		Identity:  string(u.Identity),
+		Superuser: u.Superuser,
+		Email:     u.Email,
+		Name:      u.Name,
+		Picture:   u.Picture,
+		Exp:       exp.UTC(),
+	}, nil
+}
+
+// session returns auth.Session or nil if it has expired.
+func (d *sessionData) session(c context.Context, sessionID string) *auth.Session {
+	if !clock.Now(c).Before(d.Exp) {
+		return nil
+	}
+	return &auth.Session{
+		SessionID: sessionID,
+		UserID:    d.UserID,
+		User: auth.User{
+			Identity:  identity.Identity(d.Identity),
+			Superuser: d.Superuser,
+			Email:     d.Email,
+			Name:      d.Name,
+			Picture:   d.Picture,
+		},
+		Exp: d.Exp,
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sessionstore
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/server/auth"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestKVStore(t *testing.T) {
+	Convey("Works", t, func() {
+		c, tc := testclock.UseTime(context.Background(), time.Unix(1442540000, 0))
+		kv := &MemoryKV{}
+		s := KVStore{KV: kv, Prefix: "sessions/"}
+
+		ss, err := s.GetSession(c, "missing")
+		So(err, ShouldBeNil)
+		So(ss, ShouldBeNil)
+
+		user := &auth.User{Identity: "user:abc@example.com", Name: "dude"}
+		exp := clock.Now(c).Add(time.Hour)
+		sid, err := s.OpenSession(c, "uid", user, exp)
+		So(err, ShouldBeNil)
+		So(strings.HasPrefix(sid, "uid/"), ShouldBeTrue)
+
+		blob, err := kv.Get(c, "sessions/"+sid)
+		So(err, ShouldBeNil)
+		So(blob, ShouldNotBeNil)
+
+		ss, err = s.GetSession(c, sid)
+		So(err, ShouldBeNil)
+		So(ss, ShouldResemble, &auth.Session{
+			SessionID: sid,
+			UserID:    "uid",
+			User:      *user,
+			Exp:       exp.UTC(),
+		})
+
+		Convey("Sessions are unique", func() {
+			another, err := s.OpenSession(c, "uid", user, exp)
+			So(err, ShouldBeNil)
+			So(another, ShouldNotEqual, sid)
+		})
+
+		Convey("Closes sessions", func() {
+			So(s.CloseSession(c, sid), ShouldBeNil)
+			ss, err := s.GetSession(c, sid)
+			So(err, ShouldBeNil)
+			So(ss, ShouldBeNil)
+
+			// Closing again is fine.
+			So(s.CloseSession(c, sid), ShouldBeNil)
+		})
+
+		Convey("Expires sessions", func() {
+			tc.Add(2 * time.Hour)
+			ss, err := s.GetSession(c, sid)
+			So(err, ShouldBeNil)
+			So(ss, ShouldBeNil)
+		})
+
+		Convey("Validates input", func() {
+			_, err := s.OpenSession(c, "a/b", user, exp)
+			So(err, ShouldErrLike, "bad userID")
+
+			_, err = s.OpenSession(c, "uid", &auth.User{Identity: "bad"}, exp)
+			So(err, ShouldErrLike, "bad identity string")
+		})
+	})
+}
+
+func TestMemoryKV(t *testing.T) {
+	Convey("Works", t, func() {
+		c, tc := testclock.UseTime(context.Background(), time.Unix(1442540000, 0))
+		kv := &MemoryKV{}
+
+		val, err := kv.Get(c, "k")
+		So(err, ShouldBeNil)
+		So(val, ShouldBeNil)
+
+		So(kv.Put(c, "k", []byte("v"), clock.Now(c).Add(time.Minute)), ShouldBeNil)
+		So(kv.Put(c, "forever", []byte("v"), time.Time{}), ShouldBeNil)
+		val, err = kv.Get(c, "k")
+		So(err, ShouldBeNil)
+		So(val, ShouldResemble, []byte("v"))
+
+		tc.Add(time.Minute)
+		val, err = kv.Get(c, "k")
+		So(err, ShouldBeNil)
+		So(val, ShouldBeNil)
+		val, err = kv.Get(c, "forever")
+		So(err, ShouldBeNil)
+		So(val, ShouldResemble, []byte("v"))
+
+		So(kv.Delete(c, "forever"), ShouldBeNil)
+		val, err = kv.Get(c, "forever")
+		So(err, ShouldBeNil)
+		So(val, ShouldBeNil)
+	})
+}
@@ -0,0 +1,41 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package sessionstore
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// RevocationList keeps IDs of revoked sessions until they expire.
+//
+// It is used by CookieStore, since stateless sessions can't be deleted.
+type RevocationList interface {
+	// Revoke marks the session as revoked. The record may be forgotten after
+	// exp, when the session expires anyway.
+	Revoke(c context.Context, id string, exp time.Time) error
+
+	// IsRevoked returns true if the session was revoked.
+	IsRevoked(c context.Context, id string) (bool, error)
+}
+
+// KVRevocationList implements RevocationList on top of KV.
+type KVRevocationList struct {
+	KV     KV     // where to store revoked IDs, required
+	Prefix string // used as prefix for keys
+}
+
+// Revoke marks the session as revoked. The record may be forgotten after
+// exp, when the session expires anyway.
+func (l *KVRevocationList) Revoke(c context.Context, id string, exp time.Time) error {
+	return l.KV.Put(c, l.Prefix+id, []byte{1}, exp)
+}
+
+// IsRevoked returns true if the session was revoked.
+func (l *KVRevocationList) IsRevoked(c context.Context, id string) (bool, error) {
+	blob, err := l.KV.Get(c, l.Prefix+id)
+	return blob != nil, err
+}
@@ -0,0 +1,55 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/luci/luci-go/common/trace"
+	"github.com/luci/luci-go/server/router"
+)
+
+// WithTracing is a middleware that wraps the request in a server trace span.
+//
+// The span is a child of the span in the request's traceparent header, if
+// any. It is recorded by the exporter installed in the context (see
+// trace.SetExporter).
+func WithTracing(c *router.Context, next router.Handler) {
+	ctx := trace.Extract(c.Context, c.Request.Header)
+	ctx, span := trace.StartSpan(ctx, fmt.Sprintf("%s %s", c.Request.Method, c.Request.URL.Path), trace.SpanKindServer)
+	defer span.End()
+
+	span.SetAttribute("http.method", c.Request.Method)
+	span.SetAttribute("http.target", c.Request.URL.RequestURI())
+
+	w := &statusRecorder{ResponseWriter: c.Writer, status: http.StatusOK}
+	c.Context, c.Writer = ctx, w
+	next(c)
+
+	span.SetAttribute("http.status_code", w.status)
+	if w.status >= 500 {
+		span.SetStatus(trace.StatusError, http.StatusText(w.status))
+	}
+}
+
+// statusRecorder remembers the HTTP status written to the response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusRecorder) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Flush implements http.Flusher, so that streaming handlers keep working. It
+// does nothing if the wrapped ResponseWriter doesn't support flushing.
+func (w *statusRecorder) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
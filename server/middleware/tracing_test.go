@@ -0,0 +1,114 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/trace"
+	"github.com/luci/luci-go/server/router"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// spanRecorder is a trace.Exporter that remembers exported spans.
+type spanRecorder struct {
+	lock  sync.Mutex
+	spans []*trace.SpanData
+}
+
+func (r *spanRecorder) ExportSpan(c context.Context, s *trace.SpanData) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.spans = append(r.spans, s)
+}
+
+func (r *spanRecorder) Flush(c context.Context) error { return nil }
+
+func TestWithTracing(t *testing.T) {
+	t.Parallel()
+
+	Convey("With an exporter", t, func() {
+		exp := &spanRecorder{}
+		c := trace.SetExporter(context.Background(), exp)
+
+		call := func(req *http.Request, h router.Handler) *httptest.ResponseRecorder {
+			rec := httptest.NewRecorder()
+			router.RunMiddleware(&router.Context{
+				Context: c,
+				Writer:  rec,
+				Request: req,
+			}, router.NewMiddlewareChain(WithTracing), h)
+			return rec
+		}
+
+		Convey("Records a server span", func() {
+			var inner *trace.Span
+			req, _ := http.NewRequest("GET", "http://example.com/some/path?q=1", nil)
+			call(req, func(c *router.Context) {
+				inner = trace.SpanFromContext(c.Context)
+				c.Writer.WriteHeader(http.StatusNotFound)
+			})
+
+			So(exp.spans, ShouldHaveLength, 1)
+			span := exp.spans[0]
+			So(span.SpanContext, ShouldResemble, inner.SpanContext())
+			So(span.Name, ShouldEqual, "GET /some/path")
+			So(span.Kind, ShouldEqual, trace.SpanKindServer)
+			So(span.ParentSpanID.IsValid(), ShouldBeFalse)
+			So(span.Attributes, ShouldResemble, map[string]interface{}{
+				"http.method":      "GET",
+				"http.target":      "/some/path?q=1",
+				"http.status_code": http.StatusNotFound,
+			})
+			So(span.StatusCode, ShouldNotEqual, trace.StatusError)
+		})
+
+		Convey("Continues the trace from the traceparent header", func() {
+			parent := trace.SpanContext{
+				TraceID: trace.TraceID{1, 2, 3},
+				SpanID:  trace.SpanID{4, 5, 6},
+				Sampled: true,
+			}
+			req, _ := http.NewRequest("POST", "http://example.com/rpc", nil)
+			req.Header.Set("traceparent", trace.FormatTraceparent(parent))
+			call(req, func(c *router.Context) {})
+
+			So(exp.spans, ShouldHaveLength, 1)
+			So(exp.spans[0].TraceID, ShouldResemble, parent.TraceID)
+			So(exp.spans[0].ParentSpanID, ShouldResemble, parent.SpanID)
+			So(exp.spans[0].Attributes["http.status_code"], ShouldEqual, http.StatusOK)
+		})
+
+		Convey("Marks server errors", func() {
+			req, _ := http.NewRequest("GET", "http://example.com/", nil)
+			call(req, func(c *router.Context) {
+				http.Error(c.Writer, "boom", http.StatusInternalServerError)
+			})
+
+			So(exp.spans, ShouldHaveLength, 1)
+			So(exp.spans[0].StatusCode, ShouldEqual, trace.StatusError)
+			So(exp.spans[0].StatusMessage, ShouldEqual, "Internal Server Error")
+		})
+
+		Convey("Passes through http.Flusher", func() {
+			req, _ := http.NewRequest("GET", "http://example.com/stream", nil)
+			rec := call(req, func(c *router.Context) {
+				f, ok := c.Writer.(http.Flusher)
+				So(ok, ShouldBeTrue)
+				c.Writer.Write([]byte("chunk"))
+				f.Flush()
+			})
+
+			So(rec.Flushed, ShouldBeTrue)
+			So(rec.Body.String(), ShouldEqual, "chunk")
+		})
+	})
+}
@@ -0,0 +1,471 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tumble
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/server/auth/xsrf"
+	"github.com/luci/luci-go/server/router"
+	"github.com/luci/luci-go/server/templates"
+	"golang.org/x/net/context"
+)
+
+const (
+	adminBacklogURL          = adminURL + "/backlog"
+	adminTraceURL            = adminURL + "/trace"
+	adminDeadMutationsURL    = adminURL + "/dead_mutations"
+	adminAPIBacklogURL       = adminURL + "/api/backlog"
+	adminAPITraceURL         = adminURL + "/api/trace"
+	adminAPIDeadMutationsURL = adminURL + "/api/dead_mutations"
+
+	// defaultDeadMutationsLimit is the default maximum number of dead mutations
+	// to list.
+	defaultDeadMutationsLimit = 100
+)
+
+// adminTemplates are the templates used by the tumble admin pages.
+var adminTemplates = map[string]string{
+	"includes/base.html": `{{define "base"}}<!DOCTYPE html>
+<html>
+<head>
+<title>Tumble - {{template "title" .}}</title>
+<style>
+body { font-family: sans-serif; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; vertical-align: top; }
+pre { margin: 0; white-space: pre-wrap; }
+.error { color: #c00; }
+</style>
+</head>
+<body>
+<h1>Tumble - {{template "title" .}}</h1>
+<p><a href="{{.BacklogURL}}">Backlog</a> | <a href="{{.DeadMutationsURL}}">Dead mutations</a></p>
+{{template "content" .}}
+</body>
+</html>{{end}}`,
+
+	"pages/backlog.html": `{{define "title"}}Backlog{{end}}
+{{define "content"}}
+<p>{{.Backlog.Total}} pending mutation(s){{if .Backlog.Truncated}} (truncated; only
+the first {{.Backlog.Total}} were examined){{end}}.</p>
+
+<h2>Shards</h2>
+{{if .Backlog.Shards}}
+<table>
+<tr><th>Shard</th><th>Pending</th><th>Oldest (s)</th></tr>
+{{range .Backlog.Shards}}
+<tr><td>{{.Shard}}</td><td>{{.Pending}}</td><td>{{printf "%.0f" .OldestAgeSecs}}</td></tr>
+{{end}}
+</table>
+{{else}}
+<p>There are no pending mutations.</p>
+{{end}}
+
+<h2>Ages</h2>
+<table>
+<tr><th>Age</th><th>Pending</th></tr>
+{{range .Backlog.Ages}}
+<tr><td>{{.Label}}</td><td>{{.Count}}</td></tr>
+{{end}}
+</table>
+
+<h2>Types</h2>
+<table>
+<tr><th>Type</th><th>Pending</th></tr>
+{{range .Backlog.Types}}
+<tr><td>{{.Type}}</td><td>{{.Pending}}</td></tr>
+{{end}}
+</table>
+
+<h2>Oldest mutations</h2>
+<table>
+<tr><th>Age (s)</th><th>Type</th><th>Root</th><th>Shard</th><th>Attempts</th><th></th></tr>
+{{range .Backlog.Oldest}}
+<tr>
+<td>{{printf "%.0f" .AgeSecs}}</td>
+<td>{{.Type}}</td>
+<td>{{.TargetRoot}}</td>
+<td>{{.Shard}}</td>
+<td>{{.Attempts}}</td>
+<td><a href="{{$.TraceURL}}?key={{.Key}}">trace</a></td>
+</tr>
+{{end}}
+</table>
+{{end}}`,
+
+	"pages/trace.html": `{{define "title"}}Mutation trace{{end}}
+{{define "mutation"}}
+<li>{{if .Processed}}<i>processed</i>{{else if .Dead}}<b class="error">dead</b>{{else}}pending{{end}}
+<b>{{.Type}}</b>
+{{if not .Processed}}on {{.TargetRoot}}, created {{.Created}} (shard {{.Shard}}, {{.Attempts}} failed attempt(s))
+{{if .LastError}}<pre class="error">{{.LastError}}</pre>{{end}}{{end}}
+<br><small>{{.Key}}</small>
+{{if .Children}}<ul>{{range .Children}}{{template "mutation" .}}{{end}}</ul>{{end}}
+</li>
+{{end}}
+{{define "content"}}
+<form method="GET" action="{{.TraceURL}}">
+<input type="text" name="key" size="80" value="{{.Key}}"><input type="submit" value="Trace">
+</form>
+{{if .Error}}<p class="error">{{.Error}}</p>{{end}}
+{{with .Trace}}
+{{if .Ancestors}}
+<h2>Ancestors</h2>
+<ol>
+{{range .Ancestors}}
+<li>{{if .Processed}}<i>processed</i>{{else}}<a href="{{$.TraceURL}}?key={{.Key}}">{{if .Dead}}dead{{else}}pending{{end}}</a>{{end}}
+<b>{{.Type}}</b> <small>{{.Key}}</small></li>
+{{end}}
+</ol>
+{{else if .Mutation.ParentType}}
+<p>Created by <b>{{.Mutation.ParentType}}</b>, which was not a stored mutation.</p>
+{{end}}
+<h2>Mutation and pending descendants</h2>
+<ul>{{template "mutation" .Mutation}}</ul>
+{{end}}
+{{end}}`,
+
+	"pages/dead_mutations.html": `{{define "title"}}Dead mutations{{end}}
+{{define "content"}}
+{{if .Error}}<p class="error">{{.Error}}</p>{{end}}
+<p>These mutations failed too many times and are no longer being processed.
+Retrying a mutation returns it to the processing queue with its attempt counter
+reset; discarding it deletes it permanently.</p>
+{{if .Mutations}}
+<table>
+<tr><th>Died</th><th>Type</th><th>Root</th><th>Attempts</th><th>Last error</th><th></th></tr>
+{{range .Mutations}}
+<tr>
+<td>{{.Died}}</td>
+<td>{{.Type}}</td>
+<td>{{.TargetRoot}}</td>
+<td>{{.Attempts}}</td>
+<td><pre>{{.LastError}}</pre></td>
+<td>
+<form method="POST" action="{{$.BaseURL}}/retry">{{$.XsrfTokenField}}
+<input type="hidden" name="key" value="{{.Key}}"><input type="submit" value="Retry"></form>
+<form method="POST" action="{{$.BaseURL}}/discard">{{$.XsrfTokenField}}
+<input type="hidden" name="key" value="{{.Key}}"><input type="submit" value="Discard"></form>
+</td>
+</tr>
+{{end}}
+</table>
+{{else}}
+<p>There are no dead mutations.</p>
+{{end}}
+{{end}}`,
+}
+
+// deadMutationInfo is the JSON and template representation of a
+// DeadMutation.
+type deadMutationInfo struct {
+	Key        string    `json:"key"`
+	TargetRoot string    `json:"targetRoot"`
+	Type       string    `json:"type"`
+	Died       time.Time `json:"died"`
+	Attempts   int32     `json:"attempts"`
+	LastError  string    `json:"lastError"`
+}
+
+// deadMutationsRequest is the JSON body of a retry or discard API request.
+type deadMutationsRequest struct {
+	// XsrfToken is the XSRF token returned by the dead mutations list API.
+	XsrfToken string   `json:"xsrf_token"`
+	Keys      []string `json:"keys"`
+}
+
+// InstallAdminHandlers installs HTTP handlers for tumble's admin pages and
+// JSON API.
+//
+// The admin pages are served under "/admin/tumble/":
+//   - "backlog" shows the pending mutations' per-shard backlog, age histogram,
+//     per-type counts, and the oldest pending mutations.
+//   - "trace?key=..." shows the provenance of a single mutation: its known
+//     ancestors and its pending descendants.
+//   - "dead_mutations" lists dead mutations, and allows them to be retried or
+//     discarded.
+//
+// The JSON API is served under "/admin/tumble/api/":
+//   - GET "backlog" returns the backlog summary.
+//   - GET "trace?key=..." returns the provenance of a single mutation.
+//   - GET "dead_mutations" lists dead mutations, along with an XSRF token. The
+//     optional "limit" parameter limits the number of mutations returned.
+//   - POST to "dead_mutations/retry" or "dead_mutations/discard" with a JSON
+//     body of the form {"xsrf_token": "...", "keys": [...]} retries or
+//     discards the specified dead mutations.
+//
+// Computing the backlog also updates tumble's backlog gauges (see
+// UpdateMetrics).
+//
+// 'base' must install authentication and ensure that only administrators can
+// access these handlers. The admin page uses XSRF tokens, so 'base' must also
+// install an auth state (see server/auth). The same applies to the JSON API's
+// POST requests, which carry the XSRF token in their body.
+func (s *Service) InstallAdminHandlers(r *router.Router, base router.MiddlewareChain) {
+	tmpl := &templates.Bundle{
+		Loader:          templates.AssetsLoader(adminTemplates),
+		DefaultTemplate: "base",
+	}
+	page := base.Extend(templates.WithTemplates(tmpl))
+
+	r.GET(adminBacklogURL, page, s.backlogPage)
+	r.GET(adminTraceURL, page, s.tracePage)
+	r.GET(adminDeadMutationsURL, page, s.deadMutationsPage)
+	r.POST(adminDeadMutationsURL+"/:action", page.Extend(xsrf.WithTokenCheck), s.deadMutationsPageAction)
+
+	r.GET(adminAPIBacklogURL, base, s.backlogAPI)
+	r.GET(adminAPITraceURL, base, s.traceAPI)
+	r.GET(adminAPIDeadMutationsURL, base, s.listDeadMutationsAPI)
+	r.POST(adminAPIDeadMutationsURL+"/:action", base, s.deadMutationsAPIAction)
+}
+
+// renderAdminPage renders an admin page template, adding the arguments shared
+// by all admin pages.
+func renderAdminPage(c *router.Context, name string, args templates.Args) {
+	args["BacklogURL"] = adminBacklogURL
+	args["TraceURL"] = adminTraceURL
+	args["DeadMutationsURL"] = adminDeadMutationsURL
+	templates.MustRender(c.Context, c.Writer, name, args)
+}
+
+func (s *Service) backlogPage(c *router.Context) {
+	b, err := s.getMutationBacklog(c.Context)
+	if err != nil {
+		c.Writer.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(c.Writer, "failed to compute backlog: %s", err)
+		return
+	}
+	renderAdminPage(c, "pages/backlog.html", templates.Args{
+		"Backlog": b,
+	})
+}
+
+func (s *Service) backlogAPI(c *router.Context) {
+	b, err := s.getMutationBacklog(c.Context)
+	if err != nil {
+		adminReplyError(c, http.StatusInternalServerError, err)
+		return
+	}
+	adminReply(c, http.StatusOK, b)
+}
+
+func (s *Service) tracePage(c *router.Context) {
+	args := templates.Args{}
+	if ks := c.Request.FormValue("key"); ks != "" {
+		args["Key"] = ks
+		if t, err := getMutationTrace(c.Context, ks); err != nil {
+			args["Error"] = err.Error()
+		} else {
+			args["Trace"] = t
+		}
+	}
+	renderAdminPage(c, "pages/trace.html", args)
+}
+
+func (s *Service) traceAPI(c *router.Context) {
+	t, err := getMutationTrace(c.Context, c.Request.FormValue("key"))
+	switch {
+	case err == nil:
+		adminReply(c, http.StatusOK, t)
+	case err == datastore.ErrNoSuchEntity:
+		adminReplyError(c, http.StatusNotFound, err)
+	case errors.IsTransient(err):
+		adminReplyError(c, http.StatusInternalServerError, err)
+	default:
+		adminReplyError(c, http.StatusBadRequest, err)
+	}
+}
+
+// getMutationTrace loads the trace of the mutation with the supplied encoded
+// key.
+func getMutationTrace(c context.Context, ks string) (*mutationTrace, error) {
+	if ks == "" {
+		return nil, fmt.Errorf("a mutation key is required")
+	}
+	k, err := datastore.NewKeyEncoded(ks)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key %q: %s", ks, err)
+	}
+
+	t, err := traceMutation(c, getConfig(c), k)
+	if err != nil {
+		logging.Fields{
+			logging.ErrorKey: err,
+			"key":            k,
+		}.Errorf(c, "Failed to trace mutation.")
+		return nil, err
+	}
+	return t, nil
+}
+
+func (s *Service) deadMutationsPage(c *router.Context) {
+	s.renderDeadMutationsPage(c, "")
+}
+
+func (s *Service) renderDeadMutationsPage(c *router.Context, errMsg string) {
+	dms, err := listDeadMutationInfos(c, defaultDeadMutationsLimit)
+	if err != nil {
+		logging.WithError(err).Errorf(c.Context, "Failed to list dead mutations.")
+		c.Writer.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(c.Writer, "failed to list dead mutations: %s", err)
+		return
+	}
+
+	renderAdminPage(c, "pages/dead_mutations.html", templates.Args{
+		"BaseURL":        adminDeadMutationsURL,
+		"Mutations":      dms,
+		"Error":          errMsg,
+		"XsrfTokenField": xsrf.TokenField(c.Context),
+	})
+}
+
+func (s *Service) deadMutationsPageAction(c *router.Context) {
+	err := applyDeadMutationAction(c, c.Params.ByName("action"), []string{c.Request.PostFormValue("key")})
+	if err != nil {
+		s.renderDeadMutationsPage(c, err.Error())
+		return
+	}
+	http.Redirect(c.Writer, c.Request, adminDeadMutationsURL, http.StatusSeeOther)
+}
+
+func (s *Service) listDeadMutationsAPI(c *router.Context) {
+	limit := int32(defaultDeadMutationsLimit)
+	if v := c.Request.FormValue("limit"); v != "" {
+		l, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			adminReplyError(c, http.StatusBadRequest, fmt.Errorf("invalid limit %q: %s", v, err))
+			return
+		}
+		limit = int32(l)
+	}
+
+	dms, err := listDeadMutationInfos(c, limit)
+	if err != nil {
+		adminReplyError(c, http.StatusInternalServerError, err)
+		return
+	}
+	tok, err := xsrf.Token(c.Context)
+	if err != nil {
+		adminReplyError(c, http.StatusInternalServerError, err)
+		return
+	}
+	adminReply(c, http.StatusOK, map[string]interface{}{
+		"mutations":  dms,
+		"xsrf_token": tok,
+	})
+}
+
+func (s *Service) deadMutationsAPIAction(c *router.Context) {
+	var req deadMutationsRequest
+	if err := json.NewDecoder(c.Request.Body).Decode(&req); err != nil {
+		adminReplyError(c, http.StatusBadRequest, fmt.Errorf("invalid request: %s", err))
+		return
+	}
+	if req.XsrfToken == "" {
+		adminReplyError(c, http.StatusForbidden, fmt.Errorf("XSRF token is missing"))
+		return
+	}
+	switch err := xsrf.Check(c.Context, req.XsrfToken); {
+	case errors.IsTransient(err):
+		adminReplyError(c, http.StatusInternalServerError, err)
+		return
+	case err != nil:
+		adminReplyError(c, http.StatusForbidden, fmt.Errorf("bad XSRF token: %s", err))
+		return
+	}
+
+	if err := applyDeadMutationAction(c, c.Params.ByName("action"), req.Keys); err != nil {
+		code := http.StatusBadRequest
+		if errors.IsTransient(err) {
+			code = http.StatusInternalServerError
+		}
+		adminReplyError(c, code, err)
+		return
+	}
+	adminReply(c, http.StatusOK, map[string]interface{}{})
+}
+
+func listDeadMutationInfos(c *router.Context, limit int32) ([]*deadMutationInfo, error) {
+	dms, err := ListDeadMutations(c.Context, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	ds := datastore.Get(c.Context)
+	infos := make([]*deadMutationInfo, len(dms))
+	for i, dm := range dms {
+		infos[i] = &deadMutationInfo{
+			Key:        ds.KeyForObj(dm).Encode(),
+			TargetRoot: dm.TargetRoot.String(),
+			Type:       dm.Type,
+			Died:       dm.Died,
+			Attempts:   dm.Attempts,
+			LastError:  dm.LastError,
+		}
+	}
+	return infos, nil
+}
+
+// applyDeadMutationAction retries or discards the dead mutations identified
+// by the supplied encoded keys.
+func applyDeadMutationAction(c *router.Context, action string, keys []string) error {
+	var fn func(*datastore.Key) error
+	switch action {
+	case "retry":
+		fn = func(k *datastore.Key) error { return RetryDeadMutation(c.Context, k) }
+	case "discard":
+		fn = func(k *datastore.Key) error { return DiscardDeadMutation(c.Context, k) }
+	default:
+		return fmt.Errorf("unknown action %q", action)
+	}
+
+	for _, ks := range keys {
+		k, err := datastore.NewKeyEncoded(ks)
+		if err != nil {
+			return fmt.Errorf("invalid key %q: %s", ks, err)
+		}
+
+		if err := fn(k); err != nil {
+			logging.Fields{
+				logging.ErrorKey: err,
+				"key":            k,
+				"action":         action,
+			}.Errorf(c.Context, "Failed to apply action to dead mutation.")
+
+			ferr := fmt.Errorf("failed to %s %s: %s", action, k, err)
+			if errors.IsTransient(err) {
+				ferr = errors.WrapTransient(ferr)
+			}
+			return ferr
+		}
+		logging.Fields{
+			"key":    k,
+			"action": action,
+		}.Infof(c.Context, "Applied action to dead mutation.")
+	}
+	return nil
+}
+
+func adminReply(c *router.Context, code int, out interface{}) {
+	c.Writer.Header().Set("Content-Type", "application/json")
+	c.Writer.WriteHeader(code)
+	if err := json.NewEncoder(c.Writer).Encode(out); err != nil {
+		logging.WithError(err).Errorf(c.Context, "Failed to JSON encode output.")
+	}
+}
+
+func adminReplyError(c *router.Context, code int, err error) {
+	adminReply(c, code, map[string]string{
+		"error": err.Error(),
+	})
+}
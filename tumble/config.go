@@ -21,7 +21,10 @@ const (
 
 	baseURL             = "/internal/" + baseName
 	fireAllTasksURL     = baseURL + "/fire_all_tasks"
+	updateMetricsURL    = baseURL + "/update_metrics"
 	processShardPattern = baseURL + "/process_shard/:shard_id/at/:timestamp"
+
+	adminURL = "/admin/" + baseName
 )
 
 // Config is the set of tweakable things for tumble. If you use something other
@@ -60,6 +63,15 @@ type Config struct {
 	// It defaults to 128. A negative value means no limit.
 	ProcessMaxBatchSize int32 `json:"processMaxBatchSize,omitempty"`
 
+	// MaxMutationAttempts is the maximum number of times that a mutation will
+	// be attempted before it is moved to the dead-letter queue (see
+	// DeadMutation). A mutation's attempt is counted when its RollForward
+	// returns an error, or when it cannot be decoded.
+	//
+	// It defaults to 16. A negative value means that failing mutations will be
+	// retried indefinitely.
+	MaxMutationAttempts int32 `json:"maxMutationAttempts,omitempty"`
+
 	// DelayedMutations enables the 'DelayedMutation' mutation subtype.
 	//
 	// If you set this to true, you MUST also add the second index mentioned
@@ -79,6 +91,7 @@ var defaultConfig = Config{
 	TemporalRoundFactor: clockflag.Duration(4 * time.Second),
 	NumGoroutines:       16,
 	ProcessMaxBatchSize: 128,
+	MaxMutationAttempts: 16,
 	DustSettleTimeout:   clockflag.Duration(2 * time.Second),
 }
 
@@ -86,11 +99,15 @@ var defaultConfig = Config{
 //
 // It first tries to load it from settings. If no settings is installed, or if
 // there is no configuration in settings, defaultConfig is returned.
+//
+// Stored settings that leave MaxMutationAttempts unset get its default value.
 func getConfig(c context.Context) *Config {
 	cfg := Config{}
 	switch err := settings.Get(c, baseName, &cfg); err {
 	case nil:
-		break
+		if cfg.MaxMutationAttempts == 0 {
+			cfg.MaxMutationAttempts = defaultConfig.MaxMutationAttempts
+		}
 	case settings.ErrNoSettings:
 		// Defaults.
 		cfg = defaultConfig
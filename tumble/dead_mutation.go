@@ -0,0 +1,243 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tumble
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/data/stringset"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"golang.org/x/net/context"
+)
+
+// DeadMutation is a mutation that has failed MaxMutationAttempts times and has
+// been removed from tumble's processing queue.
+//
+// A DeadMutation shares its ID and parent with the mutation that it replaces,
+// so it lives in the same entity group. It can be retried (see
+// RetryDeadMutation), which restores the original mutation, or discarded (see
+// DiscardDeadMutation).
+type DeadMutation struct {
+	_kind  string         `gae:"$kind,tumble.DeadMutation"`
+	ID     string         `gae:"$id"`
+	Parent *datastore.Key `gae:"$parent"`
+
+	// TargetRoot is the root entity that the mutation operates on.
+	TargetRoot *datastore.Key
+	// Type is the registered Go type of the mutation.
+	Type string
+	// Died is the time when the mutation was dead-lettered.
+	Died time.Time
+
+	// Attempts is the number of times that the mutation failed.
+	Attempts int32 `gae:",noindex"`
+	// LastError is the error from the mutation's final attempt.
+	LastError string `gae:",noindex"`
+
+	// The remaining fields are copied from the original mutation so that it can
+	// be restored.
+	ExpandedShard int64     `gae:",noindex"`
+	ProcessAfter  time.Time `gae:",noindex"`
+	Version       string    `gae:",noindex"`
+	Data          []byte    `gae:",noindex"`
+	Created       time.Time `gae:",noindex"`
+	ParentKey     string    `gae:",noindex"`
+	ParentType    string    `gae:",noindex"`
+}
+
+func newDeadMutation(rm *realMutation, now time.Time) *DeadMutation {
+	return &DeadMutation{
+		ID:     rm.ID,
+		Parent: rm.Parent,
+
+		TargetRoot: rm.TargetRoot,
+		Type:       rm.Type,
+		Died:       now,
+
+		Attempts:  rm.Attempts,
+		LastError: rm.LastError,
+
+		ExpandedShard: rm.ExpandedShard,
+		ProcessAfter:  rm.ProcessAfter,
+		Version:       rm.Version,
+		Data:          rm.Data,
+		Created:       rm.Created,
+		ParentKey:     rm.ParentKey,
+		ParentType:    rm.ParentType,
+	}
+}
+
+// realMutation reconstructs the original mutation. Its attempt counter is
+// reset, and it will be processed no earlier than now.
+func (dm *DeadMutation) realMutation(now time.Time) *realMutation {
+	processAfter := dm.ProcessAfter
+	if processAfter.Before(now) {
+		processAfter = now
+	}
+
+	return &realMutation{
+		ID:     dm.ID,
+		Parent: dm.Parent,
+
+		ExpandedShard: dm.ExpandedShard,
+		ProcessAfter:  processAfter,
+		TargetRoot:    dm.TargetRoot,
+
+		Version: dm.Version,
+		Type:    dm.Type,
+		Data:    dm.Data,
+
+		Created:    dm.Created,
+		ParentKey:  dm.ParentKey,
+		ParentType: dm.ParentType,
+	}
+}
+
+// mutationFailure is a single failed mutation attempt.
+type mutationFailure struct {
+	key *datastore.Key
+	typ string
+	err error
+}
+
+// recordMutationFailures increments the attempt counter of each failed
+// mutation. Mutations that have reached cfg.MaxMutationAttempts are moved to
+// the dead-letter queue and added to banSet.
+//
+// Failures to record are logged, but are otherwise ignored: the mutation will
+// simply fail again on a later pass.
+func recordMutationFailures(c context.Context, cfg *Config, banSet stringset.Set, failures []*mutationFailure) {
+	for _, f := range failures {
+		metricMutationFailures.Add(c, 1, f.typ)
+
+		dead, err := recordMutationFailure(c, cfg, f)
+		if err != nil {
+			logging.Fields{
+				logging.ErrorKey: err,
+				"key":            f.key,
+			}.Warningf(c, "Failed to record mutation failure.")
+			continue
+		}
+		if dead {
+			metricMutationsDead.Add(c, 1, f.typ)
+			banSet.Add(f.key.Encode())
+		}
+	}
+}
+
+func recordMutationFailure(c context.Context, cfg *Config, f *mutationFailure) (dead bool, err error) {
+	err = datastore.Get(c).RunInTransaction(func(c context.Context) error {
+		ds := datastore.Get(c)
+		dead = false
+
+		rm := realMutation{ID: f.key.StringID(), Parent: f.key.Parent()}
+		switch err := ds.Get(&rm); err {
+		case nil:
+			break
+		case datastore.ErrNoSuchEntity:
+			// The mutation was deleted out from under us; nothing to record.
+			return nil
+		default:
+			return err
+		}
+
+		rm.Attempts++
+		rm.LastError = f.err.Error()
+		if cfg.MaxMutationAttempts < 0 || rm.Attempts < cfg.MaxMutationAttempts {
+			return ds.Put(&rm)
+		}
+
+		logging.Fields{
+			"key":       f.key,
+			"type":      rm.Type,
+			"attempts":  rm.Attempts,
+			"lastError": rm.LastError,
+		}.Errorf(c, "Mutation exceeded maximum attempts; moving to dead-letter queue.")
+		if err := ds.Put(newDeadMutation(&rm, clock.Now(c).UTC())); err != nil {
+			return err
+		}
+		if err := ds.Delete(f.key); err != nil {
+			return err
+		}
+		dead = true
+		return nil
+	}, nil)
+	return
+}
+
+// ListDeadMutations returns up to limit dead mutations, most recently
+// dead-lettered first. If limit <= 0, all dead mutations will be returned.
+func ListDeadMutations(c context.Context, limit int32) ([]*DeadMutation, error) {
+	q := datastore.NewQuery("tumble.DeadMutation").Order("-Died")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	var dms []*DeadMutation
+	if err := datastore.Get(c).GetAll(q, &dms); err != nil {
+		return nil, err
+	}
+	return dms, nil
+}
+
+// RetryDeadMutation restores the dead mutation with the supplied key to
+// tumble's processing queue, resetting its attempt counter.
+func RetryDeadMutation(c context.Context, key *datastore.Key) error {
+	cfg := getConfig(c)
+
+	var shard taskShard
+	err := datastore.Get(c).RunInTransaction(func(c context.Context) error {
+		ds := datastore.Get(c)
+
+		dm, err := getDeadMutation(c, key)
+		if err != nil {
+			return err
+		}
+
+		rm := dm.realMutation(clock.Now(c).UTC())
+		if err := ds.Put(rm); err != nil {
+			return err
+		}
+		shard = rm.shard(cfg)
+		return ds.Delete(key)
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	fireTasks(c, cfg, map[taskShard]struct{}{shard: {}})
+	return nil
+}
+
+// DiscardDeadMutation permanently deletes the dead mutation with the supplied
+// key.
+func DiscardDeadMutation(c context.Context, key *datastore.Key) error {
+	return datastore.Get(c).RunInTransaction(func(c context.Context) error {
+		if _, err := getDeadMutation(c, key); err != nil {
+			return err
+		}
+		return datastore.Get(c).Delete(key)
+	}, nil)
+}
+
+func getDeadMutation(c context.Context, key *datastore.Key) (*DeadMutation, error) {
+	if key.Kind() != "tumble.DeadMutation" {
+		return nil, fmt.Errorf("key %s is not a dead mutation key", key)
+	}
+
+	dm := DeadMutation{ID: key.StringID(), Parent: key.Parent()}
+	switch err := datastore.Get(c).Get(&dm); err {
+	case nil:
+		return &dm, nil
+	case datastore.ErrNoSuchEntity:
+		return nil, err
+	default:
+		return nil, errors.WrapTransient(err)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tumble
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/luci/gae/service/datastore"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+type FailingMutation struct {
+	Name string
+}
+
+func (f *FailingMutation) Root(c context.Context) *datastore.Key {
+	return datastore.Get(c).MakeKey("FailingRoot", f.Name)
+}
+
+func (f *FailingMutation) RollForward(c context.Context) ([]Mutation, error) {
+	return nil, errors.New("always fails")
+}
+
+func init() {
+	Register((*FailingMutation)(nil))
+}
+
+func TestDeadMutations(t *testing.T) {
+	t.Parallel()
+
+	Convey("Dead mutations", t, func() {
+		testing := &Testing{}
+		c := testing.Context()
+		ds := datastore.Get(c)
+
+		cfg := testing.GetConfig(c)
+		cfg.MaxMutationAttempts = 2
+		testing.UpdateSettings(c, cfg)
+
+		run := func() {
+			testing.FireAllTasks(c)
+			testing.Drain(c)
+		}
+		countMutations := func() int {
+			var rms []*realMutation
+			So(ds.GetAll(datastore.NewQuery("tumble.Mutation"), &rms), ShouldBeNil)
+			return len(rms)
+		}
+
+		So(RunMutation(c, &FailingMutation{"foo"}), ShouldBeNil)
+		So(countMutations(), ShouldEqual, 1)
+
+		Convey("are retained until they fail MaxMutationAttempts times", func() {
+			run()
+			So(countMutations(), ShouldEqual, 1)
+
+			dms, err := ListDeadMutations(c, 0)
+			So(err, ShouldBeNil)
+			So(dms, ShouldHaveLength, 0)
+
+			run()
+			So(countMutations(), ShouldEqual, 0)
+
+			dms, err = ListDeadMutations(c, 0)
+			So(err, ShouldBeNil)
+			So(dms, ShouldHaveLength, 1)
+
+			dm := dms[0]
+			So(dm.Type, ShouldEqual, "*tumble.FailingMutation")
+			So(dm.TargetRoot, ShouldResemble, ds.MakeKey("FailingRoot", "foo"))
+			So(dm.Attempts, ShouldEqual, 2)
+			So(dm.LastError, ShouldEqual, "always fails")
+
+			Convey("can be retried", func() {
+				So(RetryDeadMutation(c, ds.KeyForObj(dm)), ShouldBeNil)
+				So(countMutations(), ShouldEqual, 1)
+
+				dms, err := ListDeadMutations(c, 0)
+				So(err, ShouldBeNil)
+				So(dms, ShouldHaveLength, 0)
+
+				// The retried mutation has a fresh set of attempts.
+				run()
+				So(countMutations(), ShouldEqual, 1)
+				run()
+				So(countMutations(), ShouldEqual, 0)
+			})
+
+			Convey("can be discarded", func() {
+				So(DiscardDeadMutation(c, ds.KeyForObj(dm)), ShouldBeNil)
+				So(countMutations(), ShouldEqual, 0)
+
+				dms, err := ListDeadMutations(c, 0)
+				So(err, ShouldBeNil)
+				So(dms, ShouldHaveLength, 0)
+			})
+
+			Convey("cannot be retried if they do not exist", func() {
+				So(RetryDeadMutation(c, ds.MakeKey("tumble.DeadMutation", "missing")),
+					ShouldEqual, datastore.ErrNoSuchEntity)
+			})
+		})
+
+		Convey("use the default MaxMutationAttempts if it is unset", func() {
+			cfg.MaxMutationAttempts = 0
+			testing.UpdateSettings(c, cfg)
+			So(testing.GetConfig(c).MaxMutationAttempts, ShouldEqual, defaultConfig.MaxMutationAttempts)
+		})
+
+		Convey("are never dead-lettered if MaxMutationAttempts is unlimited", func() {
+			cfg.MaxMutationAttempts = -1
+			testing.UpdateSettings(c, cfg)
+
+			for i := 0; i < 5; i++ {
+				run()
+			}
+			So(countMutations(), ShouldEqual, 1)
+		})
+	})
+}
@@ -106,4 +106,39 @@
 //   - description: tumble fire_all_tasks invocation
 //     url: /internal/tumble/fire_all_tasks  # NOTE: must match tumble.Config.FireAllTasksURL()
 //     schedule: every 5 minutes             # maximium task latency you can tolerate.
+//
+// To export tumble's backlog gauges (pending mutations and oldest mutation age
+// per shard, and pending mutations per type) to tsmon, add another cron entry:
+//
+//   - description: tumble update_metrics invocation
+//     url: /internal/tumble/update_metrics
+//     schedule: every 5 minutes
+//
+// Introspection
+//
+// InstallAdminHandlers installs admin pages and a JSON API that show the
+// pending mutation backlog, trace the provenance of individual mutations, and
+// manage dead mutations:
+//
+//   tumbleService.InstallAdminHandlers(router, adminMiddleware)
+//
+// Each mutation records the mutation whose RollForward created it, so a trace
+// shows a mutation's known ancestors as well as its pending descendants.
+//
+// Running Without App Engine
+//
+// Instead of task queues and cron, tumble can be driven by a Runner in a
+// regular binary, against any luci/gae implementation:
+//
+//   r := &tumble.Runner{}
+//   c = r.Use(c)  // Route mutations run with c to the Runner.
+//   go r.Run(c)   // Process them until c is cancelled.
+//
+// Dead Mutations
+//
+// A mutation that fails Config.MaxMutationAttempts times is moved out of the
+// processing queue into a tumble.DeadMutation entity, so that it doesn't block
+// the rest of its shard forever. Dead mutations can be inspected, retried, and
+// discarded with ListDeadMutations, RetryDeadMutation, and
+// DiscardDeadMutation, or through the admin pages.
 package tumble
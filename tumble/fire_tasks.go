@@ -40,11 +40,30 @@ type taskShard struct {
 	time  timestamp
 }
 
+// shardETA returns the time at which the supplied shard should be processed,
+// given the next available time slot.
+func shardETA(cfg *Config, nextSlot timestamp, shard taskShard) timestamp {
+	if cfg.DelayedMutations && shard.time > nextSlot {
+		return shard.time
+	}
+	return nextSlot
+}
+
 func fireTasks(c context.Context, cfg *Config, shards map[taskShard]struct{}) bool {
 	if len(shards) == 0 {
 		return true
 	}
 
+	// If a Runner is driving tumble, hand the shards to it instead of the task
+	// queue.
+	if sink := getShardSink(c); sink != nil {
+		nextSlot := mkTimestamp(cfg, clock.Now(c).UTC())
+		for shard := range shards {
+			sink(taskShard{shard.shard, shardETA(cfg, nextSlot, shard)})
+		}
+		return true
+	}
+
 	// If namespacing is enabled, Tumble will fire tasks into the Tumble task
 	// namespace.
 	if cfg.Namespaced {
@@ -60,10 +79,7 @@ func fireTasks(c context.Context, cfg *Config, shards map[taskShard]struct{}) bo
 	tasks := make([]*taskqueue.Task, 0, len(shards))
 
 	for shard := range shards {
-		eta := nextSlot
-		if cfg.DelayedMutations && shard.time > eta {
-			eta = shard.time
-		}
+		eta := shardETA(cfg, nextSlot, shard)
 		tsk := &taskqueue.Task{
 			Name: fmt.Sprintf("%d_%d", eta, shard.shard),
 
@@ -0,0 +1,385 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tumble
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/gae/service/info"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"golang.org/x/net/context"
+)
+
+const (
+	// maxBacklogScan is the maximum number of pending mutations that will be
+	// examined when computing backlog statistics.
+	maxBacklogScan = 10000
+
+	// numOldestMutations is the number of oldest pending mutations to include in
+	// backlog statistics.
+	numOldestMutations = 20
+
+	// maxTraceDepth is the maximum number of generations of descendants that a
+	// trace will follow.
+	maxTraceDepth = 8
+
+	// maxTraceChildren is the maximum number of children that a trace will
+	// load for any single mutation.
+	maxTraceChildren = 100
+)
+
+// backlogAgeBuckets are the upper bounds of the backlog age histogram buckets.
+// A final, unbounded bucket follows them.
+var backlogAgeBuckets = []time.Duration{
+	time.Minute,
+	10 * time.Minute,
+	time.Hour,
+	6 * time.Hour,
+	24 * time.Hour,
+}
+
+// mutationBacklog is a summary of tumble's pending mutations.
+type mutationBacklog struct {
+	// Total is the total number of pending mutations that were examined.
+	Total int `json:"total"`
+	// Truncated is true if there were more than maxBacklogScan pending
+	// mutations, in which case only the first maxBacklogScan were examined.
+	Truncated bool `json:"truncated,omitempty"`
+
+	// Shards is the backlog of each shard that has pending mutations.
+	Shards []*shardBacklog `json:"shards"`
+	// Types is the number of pending mutations of each type.
+	Types []*typeBacklog `json:"types"`
+	// Ages is a histogram of pending mutation ages.
+	Ages []*ageBucket `json:"ages"`
+	// Oldest are the oldest pending mutations, oldest first.
+	Oldest []*mutationInfo `json:"oldest"`
+}
+
+// shardBacklog is the backlog of a single shard.
+type shardBacklog struct {
+	Shard         uint64  `json:"shard"`
+	Pending       int     `json:"pending"`
+	OldestAgeSecs float64 `json:"oldestAgeSecs"`
+}
+
+// typeBacklog is the number of pending mutations of a single type.
+type typeBacklog struct {
+	Type    string `json:"type"`
+	Pending int    `json:"pending"`
+}
+
+// ageBucket is a single backlog age histogram bucket.
+type ageBucket struct {
+	// Label is a human-readable description of the bucket's range.
+	Label string `json:"label"`
+	// MaxAgeSecs is the bucket's exclusive upper bound. It is zero for the final,
+	// unbounded bucket.
+	MaxAgeSecs float64 `json:"maxAgeSecs,omitempty"`
+	Count      int     `json:"count"`
+}
+
+// mutationInfo is the JSON and template representation of a single mutation.
+type mutationInfo struct {
+	Key          string    `json:"key"`
+	Type         string    `json:"type,omitempty"`
+	TargetRoot   string    `json:"targetRoot,omitempty"`
+	Shard        uint64    `json:"shard"`
+	Created      time.Time `json:"created,omitempty"`
+	ProcessAfter time.Time `json:"processAfter,omitempty"`
+	AgeSecs      float64   `json:"ageSecs"`
+	Attempts     int32     `json:"attempts,omitempty"`
+	LastError    string    `json:"lastError,omitempty"`
+
+	ParentKey  string `json:"parentKey,omitempty"`
+	ParentType string `json:"parentType,omitempty"`
+
+	// Dead is true if this mutation has been dead-lettered.
+	Dead bool `json:"dead,omitempty"`
+	// Processed is true if this mutation no longer exists, presumably because
+	// it was processed. Only its Key and Type are known.
+	Processed bool `json:"processed,omitempty"`
+
+	// Children are the mutations that this mutation created which are still
+	// pending. It is only populated in traces.
+	Children []*mutationInfo `json:"children,omitempty"`
+}
+
+func newMutationInfo(cfg *Config, key *datastore.Key, rm *realMutation, now time.Time) *mutationInfo {
+	created := rm.createdTime()
+	return &mutationInfo{
+		Key:          key.Encode(),
+		Type:         rm.Type,
+		TargetRoot:   rm.TargetRoot.String(),
+		Shard:        rm.shard(cfg).shard,
+		Created:      created,
+		ProcessAfter: rm.ProcessAfter,
+		AgeSecs:      now.Sub(created).Seconds(),
+		Attempts:     rm.Attempts,
+		LastError:    rm.LastError,
+		ParentKey:    rm.ParentKey,
+		ParentType:   rm.ParentType,
+	}
+}
+
+// createdTime returns the time when the mutation was created. Mutations that
+// predate the recording of creation times use their ProcessAfter time.
+func (r *realMutation) createdTime() time.Time {
+	if !r.Created.IsZero() {
+		return r.Created
+	}
+	return r.ProcessAfter
+}
+
+// getMutationBacklog scans the pending mutations in all of the supplied
+// namespaces and summarizes them.
+func getMutationBacklog(c context.Context, cfg *Config, namespaces []string) (*mutationBacklog, error) {
+	now := clock.Now(c).UTC()
+
+	shards := map[uint64]*shardBacklog{}
+	types := map[string]*typeBacklog{}
+	ages := make([]*ageBucket, len(backlogAgeBuckets)+1)
+	for i, d := range backlogAgeBuckets {
+		ages[i] = &ageBucket{Label: "< " + d.String(), MaxAgeSecs: d.Seconds()}
+	}
+	ages[len(backlogAgeBuckets)] = &ageBucket{
+		Label: ">= " + backlogAgeBuckets[len(backlogAgeBuckets)-1].String(),
+	}
+
+	b := mutationBacklog{}
+	for _, ns := range namespaces {
+		c := c
+		if ns != "" {
+			c = info.Get(c).MustNamespace(ns)
+		}
+		ds := datastore.Get(c)
+
+		q := datastore.NewQuery("tumble.Mutation")
+		err := ds.Run(q, func(rm *realMutation) error {
+			if b.Total >= maxBacklogScan {
+				b.Truncated = true
+				return datastore.Stop
+			}
+			b.Total++
+
+			mi := newMutationInfo(cfg, ds.KeyForObj(rm), rm, now)
+			age := now.Sub(mi.Created)
+
+			sb := shards[mi.Shard]
+			if sb == nil {
+				sb = &shardBacklog{Shard: mi.Shard}
+				shards[mi.Shard] = sb
+			}
+			sb.Pending++
+			if mi.AgeSecs > sb.OldestAgeSecs {
+				sb.OldestAgeSecs = mi.AgeSecs
+			}
+
+			tb := types[rm.Type]
+			if tb == nil {
+				tb = &typeBacklog{Type: rm.Type}
+				types[rm.Type] = tb
+			}
+			tb.Pending++
+
+			bucket := len(backlogAgeBuckets)
+			for i, d := range backlogAgeBuckets {
+				if age < d {
+					bucket = i
+					break
+				}
+			}
+			ages[bucket].Count++
+
+			b.addOldest(mi)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if b.Truncated {
+			break
+		}
+	}
+
+	b.Shards = make([]*shardBacklog, 0, len(shards))
+	for _, sb := range shards {
+		b.Shards = append(b.Shards, sb)
+	}
+	sort.Sort(shardBacklogs(b.Shards))
+
+	b.Types = make([]*typeBacklog, 0, len(types))
+	for _, tb := range types {
+		b.Types = append(b.Types, tb)
+	}
+	sort.Sort(typeBacklogs(b.Types))
+
+	b.Ages = ages
+	return &b, nil
+}
+
+// addOldest adds mi to b's Oldest list if it is one of the numOldestMutations
+// oldest mutations seen so far.
+func (b *mutationBacklog) addOldest(mi *mutationInfo) {
+	idx := sort.Search(len(b.Oldest), func(i int) bool {
+		return b.Oldest[i].AgeSecs < mi.AgeSecs
+	})
+	if idx >= numOldestMutations {
+		return
+	}
+
+	b.Oldest = append(b.Oldest, nil)
+	copy(b.Oldest[idx+1:], b.Oldest[idx:])
+	b.Oldest[idx] = mi
+	if len(b.Oldest) > numOldestMutations {
+		b.Oldest = b.Oldest[:numOldestMutations]
+	}
+}
+
+// shardBacklogs sorts shardBacklog by shard number.
+type shardBacklogs []*shardBacklog
+
+func (s shardBacklogs) Len() int           { return len(s) }
+func (s shardBacklogs) Less(i, j int) bool { return s[i].Shard < s[j].Shard }
+func (s shardBacklogs) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// typeBacklogs sorts typeBacklog by decreasing pending count, then by type.
+type typeBacklogs []*typeBacklog
+
+func (s typeBacklogs) Len() int { return len(s) }
+func (s typeBacklogs) Less(i, j int) bool {
+	if s[i].Pending != s[j].Pending {
+		return s[i].Pending > s[j].Pending
+	}
+	return s[i].Type < s[j].Type
+}
+func (s typeBacklogs) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+// mutationTrace is the recorded provenance of a single mutation.
+type mutationTrace struct {
+	// Ancestors are the mutation's known ancestors, starting with the most
+	// distant and ending with its parent. Ancestors which have already been
+	// processed are known only by the key and type recorded in their child, so
+	// the chain ends at the first processed ancestor.
+	Ancestors []*mutationInfo `json:"ancestors"`
+	// Mutation is the traced mutation, along with its pending descendants.
+	Mutation *mutationInfo `json:"mutation"`
+}
+
+// traceMutation loads the provenance of the mutation with the supplied key.
+//
+// The key may be either a pending (tumble.Mutation) or dead
+// (tumble.DeadMutation) mutation key. Datastore failures are returned as
+// transient errors.
+func traceMutation(c context.Context, cfg *Config, key *datastore.Key) (*mutationTrace, error) {
+	switch key.Kind() {
+	case "tumble.Mutation", "tumble.DeadMutation":
+	default:
+		return nil, fmt.Errorf("key %s is not a mutation key", key)
+	}
+	if ns := key.Namespace(); ns != "" {
+		c = info.Get(c).MustNamespace(ns)
+	}
+	now := clock.Now(c).UTC()
+
+	mi, err := loadMutationInfo(c, cfg, key, now)
+	switch {
+	case err != nil:
+		return nil, err
+	case mi.Processed:
+		return nil, datastore.ErrNoSuchEntity
+	}
+
+	t := mutationTrace{Mutation: mi}
+	for cur := mi; cur.ParentKey != ""; {
+		pk, err := datastore.NewKeyEncoded(cur.ParentKey)
+		if err != nil {
+			return nil, err
+		}
+
+		parent, err := loadMutationInfo(c, cfg, pk, now)
+		if err != nil {
+			return nil, err
+		}
+		if parent.Processed {
+			parent.Type = cur.ParentType
+		}
+		t.Ancestors = append(t.Ancestors, parent)
+		if parent.Processed || len(t.Ancestors) >= maxTraceDepth {
+			break
+		}
+		cur = parent
+	}
+
+	// Reverse so that the most distant ancestor is first.
+	for i, j := 0, len(t.Ancestors)-1; i < j; i, j = i+1, j-1 {
+		t.Ancestors[i], t.Ancestors[j] = t.Ancestors[j], t.Ancestors[i]
+	}
+
+	if err := loadTraceChildren(c, cfg, mi, now, maxTraceDepth); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// loadMutationInfo loads the pending or dead mutation with the supplied key.
+//
+// If neither exists, the returned mutationInfo will be marked Processed.
+func loadMutationInfo(c context.Context, cfg *Config, key *datastore.Key, now time.Time) (*mutationInfo, error) {
+	ds := datastore.Get(c)
+
+	rm := realMutation{ID: key.StringID(), Parent: key.Parent()}
+	switch err := ds.Get(&rm); err {
+	case nil:
+		return newMutationInfo(cfg, ds.KeyForObj(&rm), &rm, now), nil
+	case datastore.ErrNoSuchEntity:
+		break
+	default:
+		return nil, errors.WrapTransient(err)
+	}
+
+	dm := DeadMutation{ID: key.StringID(), Parent: key.Parent()}
+	switch err := ds.Get(&dm); err {
+	case nil:
+		mi := newMutationInfo(cfg, ds.KeyForObj(&dm), dm.realMutation(dm.ProcessAfter), now)
+		mi.Attempts = dm.Attempts
+		mi.LastError = dm.LastError
+		mi.Dead = true
+		return mi, nil
+	case datastore.ErrNoSuchEntity:
+		return &mutationInfo{Key: key.Encode(), Processed: true}, nil
+	default:
+		return nil, errors.WrapTransient(err)
+	}
+}
+
+// loadTraceChildren loads the pending children of mi, recursing up to depth
+// generations.
+func loadTraceChildren(c context.Context, cfg *Config, mi *mutationInfo, now time.Time, depth int) error {
+	if depth <= 0 {
+		return nil
+	}
+
+	ds := datastore.Get(c)
+	q := datastore.NewQuery("tumble.Mutation").Eq("ParentKey", mi.Key).Limit(maxTraceChildren)
+	err := ds.Run(q, func(rm *realMutation) error {
+		mi.Children = append(mi.Children, newMutationInfo(cfg, ds.KeyForObj(rm), rm, now))
+		return nil
+	})
+	if err != nil {
+		return errors.WrapTransient(err)
+	}
+
+	for _, child := range mi.Children {
+		if err := loadTraceChildren(c, cfg, child, now, depth-1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tumble
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/common/clock"
+	. "github.com/luci/luci-go/common/testing/assertions"
+	"github.com/luci/luci-go/common/tsmon"
+	"github.com/luci/luci-go/common/tsmon/metric"
+	"github.com/luci/luci-go/common/tsmon/monitor"
+	"github.com/luci/luci-go/common/tsmon/store"
+	"github.com/luci/luci-go/common/tsmon/target"
+	"github.com/luci/luci-go/common/tsmon/types"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+type TraceMutation struct {
+	Name string
+}
+
+func (t *TraceMutation) Root(c context.Context) *datastore.Key {
+	return datastore.Get(c).MakeKey("TraceRoot", t.Name)
+}
+
+func (t *TraceMutation) RollForward(c context.Context) ([]Mutation, error) {
+	return nil, nil
+}
+
+func init() {
+	Register((*TraceMutation)(nil))
+}
+
+func TestIntrospection(t *testing.T) {
+	t.Parallel()
+
+	Convey("Tumble introspection", t, func() {
+		testing := &Testing{}
+		c := testing.Context()
+		cfg := testing.GetConfig(c)
+		ds := datastore.Get(c)
+		now := clock.Now(c).UTC()
+
+		const typ = "*tumble.TraceMutation"
+
+		// "a" was processed, creating "b", which in turn created "c".
+		put := func(name string, prov *provenance, age time.Duration) *datastore.Key {
+			rm, err := newRealMutation(c, cfg, name, ds.MakeKey("TraceRoot", name), prov,
+				&TraceMutation{name}, now.Add(-age))
+			So(err, ShouldBeNil)
+			So(ds.Put(rm), ShouldBeNil)
+			return ds.KeyForObj(rm)
+		}
+		aKey := ds.NewKey("tumble.Mutation", "a", 0, ds.MakeKey("TraceRoot", "a"))
+		bKey := put("b", &provenance{aKey, typ}, 2*time.Hour)
+		cKey := put("c", &provenance{bKey, typ}, 30*time.Second)
+
+		Convey("Can summarize the backlog.", func() {
+			b, err := getMutationBacklog(c, cfg, []string{""})
+			So(err, ShouldBeNil)
+
+			So(b.Total, ShouldEqual, 2)
+			So(b.Truncated, ShouldBeFalse)
+			So(b.Types, ShouldResemble, []*typeBacklog{{Type: typ, Pending: 2}})
+
+			counts := make([]int, len(b.Ages))
+			for i, a := range b.Ages {
+				counts[i] = a.Count
+			}
+			So(counts, ShouldResemble, []int{1, 0, 0, 1, 0, 0})
+
+			So(b.Oldest, ShouldHaveLength, 2)
+			So(b.Oldest[0].Key, ShouldEqual, bKey.Encode())
+			So(b.Oldest[1].Key, ShouldEqual, cKey.Encode())
+
+			pending := 0
+			for _, sb := range b.Shards {
+				pending += sb.Pending
+			}
+			So(pending, ShouldEqual, 2)
+		})
+
+		Convey("Reports partial backlog metrics when the scan is truncated.", func() {
+			c := tsmon.WithState(c, &tsmon.State{
+				S:                 store.NewInMemory(&target.Task{ServiceName: proto.String("tumble")}),
+				M:                 monitor.NewNilMonitor(),
+				RegisteredMetrics: map[string]types.Metric{},
+			})
+			getInt := func(m metric.Int, fv ...interface{}) int64 {
+				v, err := m.Get(c, fv...)
+				So(err, ShouldBeNil)
+				return v
+			}
+
+			updateBacklogMetrics(c, cfg, &mutationBacklog{
+				Shards: []*shardBacklog{{Shard: 0, Pending: 3}, {Shard: 1, Pending: 2}},
+				Types:  []*typeBacklog{{Type: typ, Pending: 5}},
+			})
+			truncated, err := metricBacklogTruncated.Get(c)
+			So(err, ShouldBeNil)
+			So(truncated, ShouldBeFalse)
+
+			updateBacklogMetrics(c, cfg, &mutationBacklog{
+				Truncated: true,
+				Shards:    []*shardBacklog{{Shard: 0, Pending: 4}},
+			})
+			truncated, err = metricBacklogTruncated.Get(c)
+			So(err, ShouldBeNil)
+			So(truncated, ShouldBeTrue)
+
+			// Shard 0 was scanned; shard 1 and the type weren't, so they keep
+			// their previous values rather than dropping to zero.
+			So(getInt(metricPendingMutations, 0), ShouldEqual, 4)
+			So(getInt(metricPendingMutations, 1), ShouldEqual, 2)
+			So(getInt(metricPendingMutationsByType, typ), ShouldEqual, 5)
+		})
+
+		Convey("Can trace a mutation with a pending child.", func() {
+			t, err := traceMutation(c, cfg, bKey)
+			So(err, ShouldBeNil)
+
+			So(t.Ancestors, ShouldHaveLength, 1)
+			So(t.Ancestors[0].Key, ShouldEqual, aKey.Encode())
+			So(t.Ancestors[0].Type, ShouldEqual, typ)
+			So(t.Ancestors[0].Processed, ShouldBeTrue)
+
+			So(t.Mutation.Key, ShouldEqual, bKey.Encode())
+			So(t.Mutation.Children, ShouldHaveLength, 1)
+			So(t.Mutation.Children[0].Key, ShouldEqual, cKey.Encode())
+		})
+
+		Convey("Can trace a mutation with pending ancestors.", func() {
+			t, err := traceMutation(c, cfg, cKey)
+			So(err, ShouldBeNil)
+
+			So(t.Ancestors, ShouldHaveLength, 2)
+			So(t.Ancestors[0].Key, ShouldEqual, aKey.Encode())
+			So(t.Ancestors[0].Processed, ShouldBeTrue)
+			So(t.Ancestors[1].Key, ShouldEqual, bKey.Encode())
+			So(t.Ancestors[1].Processed, ShouldBeFalse)
+			So(t.Mutation.Children, ShouldHaveLength, 0)
+		})
+
+		Convey("Will not trace a processed mutation or a non-mutation key.", func() {
+			_, err := traceMutation(c, cfg, aKey)
+			So(err, ShouldEqual, datastore.ErrNoSuchEntity)
+
+			_, err = traceMutation(c, cfg, ds.MakeKey("TraceRoot", "b"))
+			So(err, ShouldErrLike, "not a mutation key")
+		})
+	})
+}
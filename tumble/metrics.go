@@ -0,0 +1,120 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tumble
+
+import (
+	"sync"
+
+	"github.com/luci/luci-go/common/data/stringset"
+	"github.com/luci/luci-go/common/tsmon/field"
+	"github.com/luci/luci-go/common/tsmon/metric"
+	"github.com/luci/luci-go/common/tsmon/types"
+	"golang.org/x/net/context"
+)
+
+var (
+	// metricMutationFailures tracks the number of failed mutation attempts.
+	//
+	// The "type" field is the Go type of the failed mutation.
+	metricMutationFailures = metric.NewCounter("luci/tumble/mutation/failures",
+		"The number of mutation attempts that failed.",
+		types.MetricMetadata{},
+		field.String("type"))
+
+	// metricMutationsDead tracks the number of mutations that have been moved
+	// to the dead-letter queue.
+	//
+	// The "type" field is the Go type of the dead mutation.
+	metricMutationsDead = metric.NewCounter("luci/tumble/mutation/dead",
+		"The number of mutations that exceeded their maximum attempts and were dead-lettered.",
+		types.MetricMetadata{},
+		field.String("type"))
+
+	// metricPendingMutations tracks the number of pending mutations in each
+	// shard.
+	metricPendingMutations = metric.NewInt("luci/tumble/mutation/pending",
+		"The number of pending mutations in a shard.",
+		types.MetricMetadata{},
+		field.Int("shard"))
+
+	// metricOldestMutationAge tracks the age of the oldest pending mutation in
+	// each shard.
+	metricOldestMutationAge = metric.NewFloat("luci/tumble/mutation/oldest_age",
+		"The age of the oldest pending mutation in a shard.",
+		types.MetricMetadata{Units: types.Seconds},
+		field.Int("shard"))
+
+	// metricPendingMutationsByType tracks the number of pending mutations of
+	// each type.
+	metricPendingMutationsByType = metric.NewInt("luci/tumble/mutation/pending_by_type",
+		"The number of pending mutations of a type.",
+		types.MetricMetadata{},
+		field.String("type"))
+
+	// metricBacklogTruncated is true if the last backlog scan stopped before
+	// it examined every pending mutation. If so, the backlog gauges are lower
+	// bounds.
+	metricBacklogTruncated = metric.NewBool("luci/tumble/mutation/backlog_truncated",
+		"Whether the last backlog scan was truncated, making the backlog gauges lower bounds.",
+		types.MetricMetadata{})
+)
+
+// reportedTypes is the set of mutation types that have been reported to
+// metricPendingMutationsByType, so that they can be reset to zero once they
+// have no more pending mutations.
+var reportedTypes = struct {
+	sync.Mutex
+	types stringset.Set
+}{types: stringset.New(0)}
+
+// updateBacklogMetrics sets the backlog gauges from b.
+//
+// Every configured shard is reported, so that shards whose backlog has
+// drained report zero rather than their last non-zero value.
+//
+// If b is truncated, only the shards and types that the scan saw are reported,
+// with their partial counts. The others keep their last values, since the scan
+// can't tell whether they have drained.
+func updateBacklogMetrics(c context.Context, cfg *Config, b *mutationBacklog) {
+	metricBacklogTruncated.Set(c, b.Truncated)
+
+	shards := make(map[uint64]*shardBacklog, len(b.Shards))
+	for _, sb := range b.Shards {
+		shards[sb.Shard] = sb
+	}
+
+	for i := uint64(0); i < cfg.NumShards; i++ {
+		var (
+			pending int64
+			oldest  float64
+		)
+		if sb := shards[i]; sb != nil {
+			pending, oldest = int64(sb.Pending), sb.OldestAgeSecs
+		} else if b.Truncated {
+			continue
+		}
+		metricPendingMutations.Set(c, pending, int(i))
+		metricOldestMutationAge.Set(c, oldest, int(i))
+	}
+
+	reportedTypes.Lock()
+	defer reportedTypes.Unlock()
+
+	current := stringset.New(len(b.Types))
+	for _, tb := range b.Types {
+		metricPendingMutationsByType.Set(c, int64(tb.Pending), tb.Type)
+		current.Add(tb.Type)
+		reportedTypes.types.Add(tb.Type)
+	}
+	if b.Truncated {
+		return
+	}
+	reportedTypes.types.Iter(func(t string) bool {
+		if !current.Has(t) {
+			metricPendingMutationsByType.Set(c, 0, t)
+		}
+		return true
+	})
+}
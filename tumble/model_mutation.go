@@ -119,6 +119,21 @@ type realMutation struct {
 	Version string
 	Type    string
 	Data    []byte `gae:",noindex"`
+
+	// Created is the time when this mutation was created.
+	Created time.Time `gae:",noindex"`
+	// ParentKey is the encoded key of the mutation whose RollForward created
+	// this mutation. It is empty if this mutation was not created by a stored
+	// mutation (e.g., by RunMutation or PutNamedMutations).
+	ParentKey string
+	// ParentType is the Go type of the mutation whose RollForward created this
+	// mutation, if any.
+	ParentType string `gae:",noindex"`
+
+	// Attempts is the number of times that this mutation has failed.
+	Attempts int32 `gae:",noindex"`
+	// LastError is the error from this mutation's most recent failure.
+	LastError string `gae:",noindex"`
 }
 
 func (r *realMutation) shard(cfg *Config) taskShard {
@@ -131,7 +146,16 @@ func (r *realMutation) shard(cfg *Config) taskShard {
 	return taskShard{ret, mkTimestamp(cfg, r.ProcessAfter)}
 }
 
-func putMutations(c context.Context, cfg *Config, fromRoot *datastore.Key, muts []Mutation, round uint64) (
+// provenance identifies the mutation that created a set of new mutations.
+type provenance struct {
+	// key is the key of the creating mutation. It is nil if the creating
+	// mutation was not stored (e.g., it was run by RunMutation).
+	key *datastore.Key
+	// typ is the Go type of the creating mutation.
+	typ string
+}
+
+func putMutations(c context.Context, cfg *Config, fromRoot *datastore.Key, prov *provenance, muts []Mutation, round uint64) (
 	shardSet map[taskShard]struct{}, mutKeys []*datastore.Key, err error) {
 	if len(muts) == 0 {
 		return
@@ -151,7 +175,7 @@ func putMutations(c context.Context, cfg *Config, fromRoot *datastore.Key, muts
 	mutKeys = make([]*datastore.Key, len(muts))
 	for i, m := range muts {
 		id := fmt.Sprintf("%016x_%08x_%08x", version, round, i)
-		toPut[i], err = newRealMutation(c, cfg, id, fromRoot, m, now)
+		toPut[i], err = newRealMutation(c, cfg, id, fromRoot, prov, m, now)
 		if err != nil {
 			logging.Errorf(c, "error creating real mutation for %v: %s", m, err)
 			return
@@ -188,7 +212,7 @@ func getAppVersion(c context.Context) string {
 	return appVersion.version
 }
 
-func newRealMutation(c context.Context, cfg *Config, id string, parent *datastore.Key, m Mutation, now time.Time) (*realMutation, error) {
+func newRealMutation(c context.Context, cfg *Config, id string, parent *datastore.Key, prov *provenance, m Mutation, now time.Time) (*realMutation, error) {
 	when := now
 	if cfg.DelayedMutations {
 		if dm, ok := m.(DelayedMutation); ok {
@@ -215,7 +239,7 @@ func newRealMutation(c context.Context, cfg *Config, id string, parent *datastor
 	hash := sha1.Sum([]byte(root.Encode()))
 	eshard := int64(binary.BigEndian.Uint64(hash[:]))
 
-	return &realMutation{
+	rm := &realMutation{
 		ID:     id,
 		Parent: parent,
 
@@ -226,7 +250,16 @@ func newRealMutation(c context.Context, cfg *Config, id string, parent *datastor
 		Version: getAppVersion(c),
 		Type:    t,
 		Data:    buf.Bytes(),
-	}, nil
+
+		Created: now,
+	}
+	if prov != nil {
+		if prov.key != nil {
+			rm.ParentKey = prov.key.Encode()
+		}
+		rm.ParentType = prov.typ
+	}
+	return rm, nil
 }
 
 func (r *realMutation) GetMutation() (Mutation, error) {
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"fmt"
 	"math"
+	"reflect"
 	"sync/atomic"
 	"time"
 
@@ -277,15 +278,18 @@ func getBatchByRoot(c context.Context, cfg *Config, root *datastore.Key, banSet
 	return toFetch, err
 }
 
-func loadFilteredMutations(c context.Context, rms []*realMutation) ([]*datastore.Key, []Mutation, error) {
+// loadFilteredMutations loads the supplied mutations. Mutations that could not
+// be decoded are returned as failures.
+func loadFilteredMutations(c context.Context, rms []*realMutation) ([]*datastore.Key, []Mutation, []*mutationFailure, error) {
 	ds := datastore.Get(c)
 
 	mutKeys := make([]*datastore.Key, 0, len(rms))
 	muts := make([]Mutation, 0, len(rms))
+	var failures []*mutationFailure
 	err := ds.Get(rms)
 	me, ok := err.(errors.MultiError)
 	if !ok && err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	for i, rm := range rms {
@@ -303,16 +307,17 @@ func loadFilteredMutations(c context.Context, rms []*realMutation) ([]*datastore
 			m, err := rm.GetMutation()
 			if err != nil {
 				logging.Errorf(c, "couldn't load mutation: %s", err)
+				failures = append(failures, &mutationFailure{ds.KeyForObj(rm), rm.Type, err})
 				continue
 			}
 			muts = append(muts, m)
 			mutKeys = append(mutKeys, ds.KeyForObj(rm))
 		} else if err != datastore.ErrNoSuchEntity {
-			return nil, nil, me
+			return nil, nil, nil, me
 		}
 	}
 
-	return mutKeys, muts, nil
+	return mutKeys, muts, failures, nil
 }
 
 type overrideRoot struct {
@@ -333,10 +338,13 @@ func processRoot(c context.Context, cfg *Config, root *datastore.Key, banSet str
 		return err
 	}
 
-	mutKeys, muts, err := loadFilteredMutations(c, toFetch)
+	mutKeys, muts, failures, err := loadFilteredMutations(c, toFetch)
 	if err != nil {
 		return err
 	}
+	if len(failures) > 0 {
+		recordMutationFailures(c, cfg, banSet, failures)
+	}
 
 	if c.Err() != nil {
 		l.Warningf("Lost lock during processRoot")
@@ -349,11 +357,13 @@ func processRoot(c context.Context, cfg *Config, root *datastore.Key, banSet str
 	numMuts := uint64(0)
 	deletedMuts := uint64(0)
 	processedMuts := uint64(0)
+	var failed []*mutationFailure
 	err = datastore.Get(txnBuf.FilterRDS(c)).RunInTransaction(func(c context.Context) error {
 		toDel = toDel[:0]
 		numMuts = 0
 		deletedMuts = 0
 		processedMuts = 0
+		failed = failed[:0]
 
 		iterMuts := muts
 		iterMutKeys := mutKeys
@@ -362,9 +372,11 @@ func processRoot(c context.Context, cfg *Config, root *datastore.Key, banSet str
 			m := iterMuts[i]
 
 			logging.Fields{"m": m}.Infof(c, "running RollForward")
-			shards, newMuts, newMutKeys, err := enterTransactionInternal(c, cfg, overrideRoot{m, root}, uint64(i))
+			prov := &provenance{iterMutKeys[i], reflect.TypeOf(m).String()}
+			shards, newMuts, newMutKeys, err := enterTransactionInternal(c, cfg, overrideRoot{m, root}, prov, uint64(i))
 			if err != nil {
 				l.Errorf("Executing decoded gob(%T) failed: %q: %+v", m, err, m)
+				failed = append(failed, &mutationFailure{prov.key, prov.typ, err})
 				continue
 			}
 			processedMuts++
@@ -408,6 +420,12 @@ func processRoot(c context.Context, cfg *Config, root *datastore.Key, banSet str
 	}
 	numMuts -= deletedMuts
 
+	// Count the failed attempts, dead-lettering mutations that have failed too
+	// many times.
+	if len(failed) > 0 {
+		recordMutationFailures(c, cfg, banSet, failed)
+	}
+
 	fireTasks(c, cfg, allShards)
 	l.Infof("successfully processed %d mutations (%d tail-call), adding %d more", processedMuts, deletedMuts, numMuts)
 
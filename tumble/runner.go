@@ -0,0 +1,311 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tumble
+
+import (
+	"sync"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"golang.org/x/net/context"
+)
+
+const (
+	// DefaultRunnerPollInterval is the default Runner PollInterval.
+	DefaultRunnerPollInterval = time.Minute
+
+	// runnerRetryDelay is the amount of time that a Runner waits before retrying
+	// a shard that failed to process.
+	runnerRetryDelay = 2 * time.Second
+)
+
+// shardSink receives shards that are ready to be processed. If one is
+// installed in the Context, fireTasks will use it instead of the task queue.
+type shardSink func(taskShard)
+
+var shardSinkKey = "holds the tumble shardSink"
+
+func withShardSink(c context.Context, sink shardSink) context.Context {
+	return context.WithValue(c, &shardSinkKey, sink)
+}
+
+func getShardSink(c context.Context) shardSink {
+	if sink, ok := c.Value(&shardSinkKey).(shardSink); ok {
+		return sink
+	}
+	return nil
+}
+
+// Runner processes tumble mutations in-process, without App Engine task
+// queues or cron.
+//
+// The Runner takes the place of the task queue: shards that would have been
+// fired as task queue tasks are instead queued in memory and processed in
+// goroutines once their scheduled time arrives. A shard is never processed by
+// more than one goroutine at a time, so the Runner offers the same ordering
+// guarantees as the task queue. The Runner also periodically scans all shards
+// for outstanding work, taking the place of the "fire_all_tasks" cron job.
+//
+// The Runner works against any luci/gae implementation. The Context passed to
+// Run must have the datastore, memcache and info services installed. Contexts
+// used to run mutations (e.g., RunMutation) should be passed through Use so
+// that the resulting work is routed to the Runner.
+type Runner struct {
+	// Service is the tumble Service configuration to use. Only its Namespaces
+	// function is used; its handlers are not installed.
+	Service Service
+
+	// PollInterval is the amount of time in between scans for outstanding work.
+	// If zero, DefaultRunnerPollInterval will be used. If negative, the Runner
+	// will not scan for outstanding work after its initial scan.
+	PollInterval time.Duration
+
+	// MaxConcurrentShards is the maximum number of shards that will be processed
+	// in parallel. If <= 0, all configured shards may be processed in parallel.
+	MaxConcurrentShards int
+
+	// ShutdownTimeout is the maximum amount of time that Run will wait for
+	// in-flight shards to finish processing once its Context is cancelled.
+	// After this time elapses, the in-flight shards' Contexts are cancelled.
+	//
+	// If zero, Run will wait for in-flight shards indefinitely.
+	ShutdownTimeout time.Duration
+
+	initOnce sync.Once
+
+	mu sync.Mutex
+	// pending maps scheduled shard tasks to the time that they may be processed.
+	pending map[taskShard]time.Time
+	// active is the set of shards that are currently being processed.
+	active map[uint64]struct{}
+	// wakeC is signalled when pending or active changes.
+	wakeC chan struct{}
+}
+
+func (r *Runner) init() {
+	r.initOnce.Do(func() {
+		r.pending = make(map[taskShard]time.Time)
+		r.active = make(map[uint64]struct{})
+		r.wakeC = make(chan struct{}, 1)
+	})
+}
+
+// Use returns a Context that routes tumble work to this Runner.
+//
+// Mutations run with the returned Context (e.g., via RunMutation) will be
+// processed by the Runner instead of being dispatched to the task queue.
+func (r *Runner) Use(c context.Context) context.Context {
+	r.init()
+	return withShardSink(c, func(ts taskShard) {
+		r.schedule(ts, ts.time.Unix())
+	})
+}
+
+// schedule adds ts to the set of pending shard tasks. It will be processed no
+// earlier than at.
+func (r *Runner) schedule(ts taskShard, at time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if cur, ok := r.pending[ts]; !ok || at.Before(cur) {
+		r.pending[ts] = at
+	}
+	r.wakeLocked()
+}
+
+func (r *Runner) wakeLocked() {
+	select {
+	case r.wakeC <- struct{}{}:
+	default:
+	}
+}
+
+// Run processes tumble work until c is cancelled.
+//
+// Once c is cancelled, no further shards will be processed, and Run will wait
+// for in-flight shards to finish (see ShutdownTimeout) before returning.
+// Shards that have not been processed are left in the datastore, and will be
+// picked up by the next Runner's initial scan.
+func (r *Runner) Run(c context.Context) error {
+	r.init()
+
+	// In-flight shards should not be interrupted by c's cancellation, so they
+	// use a Context that inherits c's values but is cancelled separately.
+	pc, cancelFunc := context.WithCancel(detachedContext{c})
+	defer cancelFunc()
+	pc = r.Use(pc)
+
+	var wg sync.WaitGroup
+
+	// Periodically scan for outstanding work.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		r.poll(r.Use(c))
+	}()
+
+	dispatchTimer := clock.NewTimer(c)
+	defer dispatchTimer.Stop()
+
+	for {
+		next, ok := r.dispatch(pc, &wg)
+
+		// Reset our timer to the next pending shard task, draining any stale
+		// result from its previous run.
+		if !dispatchTimer.Stop() {
+			select {
+			case <-dispatchTimer.GetC():
+			default:
+			}
+		}
+		if ok {
+			dispatchTimer.Reset(next)
+		}
+
+		select {
+		case <-c.Done():
+			return r.shutdown(pc, &wg, cancelFunc)
+		case <-r.wakeC:
+		case <-dispatchTimer.GetC():
+		}
+	}
+}
+
+// poll scans for outstanding work until c is cancelled.
+func (r *Runner) poll(c context.Context) {
+	interval := r.PollInterval
+	if interval == 0 {
+		interval = DefaultRunnerPollInterval
+	}
+
+	for {
+		if err := r.Service.FireAllTasks(c); err != nil && c.Err() == nil {
+			logging.WithError(err).Warningf(c, "Failed to scan for outstanding tumble work.")
+		}
+
+		if interval < 0 {
+			return
+		}
+		if tr := clock.Sleep(c, interval); tr.Incomplete() {
+			return
+		}
+	}
+}
+
+// dispatch starts processing all pending shard tasks that are due and whose
+// shards are not already being processed.
+//
+// It returns the amount of time until the next pending shard task is due. If
+// there are no pending shard tasks that can be dispatched later, ok will be
+// false.
+func (r *Runner) dispatch(c context.Context, wg *sync.WaitGroup) (next time.Duration, ok bool) {
+	now := clock.Now(c)
+	maxActive := r.MaxConcurrentShards
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for ts, at := range r.pending {
+		if _, busy := r.active[ts.shard]; busy {
+			// This shard task will be reconsidered once the shard finishes.
+			continue
+		}
+		if maxActive > 0 && len(r.active) >= maxActive {
+			break
+		}
+
+		if d := at.Sub(now); d > 0 {
+			if !ok || d < next {
+				next, ok = d, true
+			}
+			continue
+		}
+
+		// Collapse all of this shard's due tasks into the one with the latest
+		// timestamp, which covers the work of the others.
+		for other, otherAt := range r.pending {
+			if other.shard == ts.shard && !otherAt.After(now) {
+				if other.time > ts.time {
+					ts = other
+				}
+				delete(r.pending, other)
+			}
+		}
+
+		r.active[ts.shard] = struct{}{}
+		wg.Add(1)
+		go func(ts taskShard) {
+			defer wg.Done()
+			r.process(c, ts)
+		}(ts)
+	}
+	return
+}
+
+// process processes a single shard task.
+func (r *Runner) process(c context.Context, ts taskShard) {
+	defer func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(r.active, ts.shard)
+		r.wakeLocked()
+	}()
+
+	cfg := getConfig(c)
+	err := func() error {
+		namespaces, err := r.Service.getNamespaces(c, cfg)
+		if err != nil {
+			return err
+		}
+		return processShard(c, cfg, namespaces, ts.time.Unix(), ts.shard)
+	}()
+	if err == nil || c.Err() != nil {
+		return
+	}
+
+	// Like the task queue, retry failed shard tasks after a delay.
+	logging.Fields{
+		logging.ErrorKey: err,
+		"shard":          ts.shard,
+		"transient":      errors.IsTransient(err),
+	}.Warningf(c, "Failed to process shard; retrying.")
+	r.schedule(ts, clock.Now(c).Add(runnerRetryDelay))
+}
+
+// shutdown waits for in-flight shards to finish, cancelling them after
+// ShutdownTimeout. c is the in-flight shards' Context.
+func (r *Runner) shutdown(c context.Context, wg *sync.WaitGroup, cancelFunc context.CancelFunc) error {
+	logging.Infof(c, "Shutting down tumble Runner.")
+
+	doneC := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(doneC)
+	}()
+
+	if r.ShutdownTimeout > 0 {
+		select {
+		case <-doneC:
+			return nil
+		case <-clock.After(c, r.ShutdownTimeout):
+			logging.Warningf(c, "Timed out waiting for in-flight shards; cancelling.")
+			cancelFunc()
+		}
+	}
+	<-doneC
+	return nil
+}
+
+// detachedContext is a Context that inherits its parent's values, but not its
+// deadline or cancellation.
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
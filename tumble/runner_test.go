@@ -0,0 +1,75 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package tumble
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestRunner(t *testing.T) {
+	t.Parallel()
+
+	Convey("A tumble Runner", t, func() {
+		testing := &Testing{}
+		c := testing.Context()
+		ds := datastore.Get(c)
+
+		// Advance the test clock whenever anything waits on it, so the Runner
+		// doesn't have to wait for its shards' scheduled times.
+		clk := clock.Get(c).(testclock.TestClock)
+		clk.SetTimerCallback(func(d time.Duration, _ clock.Timer) {
+			clk.Add(d)
+		})
+
+		r := &Runner{PollInterval: -1}
+		rc, cancelFunc := context.WithCancel(c)
+		defer cancelFunc()
+
+		doneC := make(chan error, 1)
+		go func() {
+			doneC <- r.Run(rc)
+		}()
+
+		waitForCount := func(count int64) int64 {
+			bog := &BigObjectGroup{}
+			for deadline := time.Now().Add(10 * time.Second); time.Now().Before(deadline); {
+				switch err := ds.Get(bog); err {
+				case nil:
+					if bog.Count >= count {
+						return bog.Count
+					}
+				case datastore.ErrNoSuchEntity:
+				default:
+					So(err, ShouldBeNil)
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+			return bog.Count
+		}
+
+		Convey("Will process mutations that are routed to it.", func() {
+			So(RunMutation(r.Use(c), &SlowMutation{3}), ShouldBeNil)
+			So(waitForCount(3), ShouldEqual, 3)
+
+			// No mutations should have been dispatched to the task queue.
+			So(testing.Iterate(c), ShouldEqual, 0)
+
+			cancelFunc()
+			So(<-doneC, ShouldBeNil)
+		})
+
+		Convey("Will shut down when its Context is cancelled.", func() {
+			cancelFunc()
+			So(<-doneC, ShouldBeNil)
+		})
+	})
+}
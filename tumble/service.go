@@ -41,6 +41,7 @@ type Service struct {
 func (s *Service) InstallHandlers(r *router.Router, base router.MiddlewareChain) {
 	// GET so that this can be invoked from cron
 	r.GET(fireAllTasksURL, base.Extend(gaemiddleware.RequireCron), s.FireAllTasksHandler)
+	r.GET(updateMetricsURL, base.Extend(gaemiddleware.RequireCron), s.UpdateMetricsHandler)
 	r.POST(processShardPattern, base.Extend(gaemiddleware.RequireTaskQueue(baseName)),
 		s.ProcessShardHandler)
 }
@@ -151,6 +152,46 @@ func (s *Service) FireAllTasks(c context.Context) error {
 	return err
 }
 
+// UpdateMetricsHandler is an HTTP handler that expects `logging` and
+// `luci/gae` services to be installed into the context.
+//
+// UpdateMetricsHandler verifies that it was called within an Appengine Cron
+// request, and then invokes the UpdateMetrics function.
+func (s *Service) UpdateMetricsHandler(c *router.Context) {
+	if err := s.UpdateMetrics(c.Context); err != nil {
+		c.Writer.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(c.Writer, "update_metrics failed: %s", err)
+	} else {
+		c.Writer.Write([]byte("ok"))
+	}
+}
+
+// UpdateMetrics scans the pending mutations in all namespaces and updates
+// tumble's backlog gauges (pending mutations and oldest mutation age per
+// shard, and pending mutations per type).
+func (s *Service) UpdateMetrics(c context.Context) error {
+	_, err := s.getMutationBacklog(c)
+	return err
+}
+
+// getMutationBacklog computes the current mutation backlog, updating the
+// backlog gauges as a side effect.
+func (s *Service) getMutationBacklog(c context.Context) (*mutationBacklog, error) {
+	cfg := getConfig(c)
+	nspaces, err := s.getNamespaces(c, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := getMutationBacklog(c, cfg, nspaces)
+	if err != nil {
+		logging.WithError(err).Errorf(c, "Failed to compute mutation backlog.")
+		return nil, err
+	}
+	updateBacklogMetrics(c, cfg, b)
+	return b, nil
+}
+
 func (s *Service) getNamespaces(c context.Context, cfg *Config) (namespaces []string, err error) {
 	// Get the set of namespaces to handle.
 	if cfg.Namespaced {
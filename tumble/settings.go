@@ -81,6 +81,13 @@ func (settingsUIPage) Fields(c context.Context) ([]settings.UIField, error) {
 			Placeholder: strconv.Itoa(int(defaultConfig.ProcessMaxBatchSize)),
 			Validator:   intValidator(false),
 		},
+		{
+			ID:          "MaxMutationAttempts",
+			Title:       "Number of attempts before a failing mutation is dead-lettered (< 0 for unlimited)",
+			Type:        settings.UIFieldText,
+			Placeholder: strconv.Itoa(int(defaultConfig.MaxMutationAttempts)),
+			Validator:   intValidator(false),
+		},
 		{
 			ID:             "DelayedMutations",
 			Title:          "Delayed mutations (index MUST be present)",
@@ -128,6 +135,9 @@ func (settingsUIPage) ReadSettings(c context.Context) (map[string]string, error)
 	if cfg.ProcessMaxBatchSize != 0 {
 		values["ProcessMaxBatchSize"] = strconv.FormatInt(int64(cfg.ProcessMaxBatchSize), 10)
 	}
+	if cfg.MaxMutationAttempts != 0 {
+		values["MaxMutationAttempts"] = strconv.FormatInt(int64(cfg.MaxMutationAttempts), 10)
+	}
 
 	values["DelayedMutations"] = getToggleSetting(cfg.DelayedMutations)
 	values["Namespaced"] = getToggleSetting(cfg.Namespaced)
@@ -176,6 +186,13 @@ func (settingsUIPage) WriteSettings(c context.Context, values map[string]string,
 		}
 		cfg.ProcessMaxBatchSize = int32(val)
 	}
+	if v := values["MaxMutationAttempts"]; v != "" {
+		val, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return fmt.Errorf("could not parse MaxMutationAttempts: %v", err)
+		}
+		cfg.MaxMutationAttempts = int32(val)
+	}
 	cfg.DelayedMutations = values["DelayedMutations"] == settingEnabled
 	cfg.Namespaced = values["Namespaced"] == settingEnabled
 
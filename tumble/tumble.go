@@ -6,6 +6,7 @@ package tumble
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/luci/gae/filter/txnBuf"
 	"github.com/luci/gae/service/datastore"
@@ -24,7 +25,8 @@ import (
 // state machine as a result of some API interaction.
 func RunMutation(c context.Context, m Mutation) error {
 	cfg := getConfig(c)
-	shardSet, _, _, err := enterTransactionInternal(txnBuf.FilterRDS(c), cfg, m, 0)
+	prov := &provenance{typ: reflect.TypeOf(m).String()}
+	shardSet, _, _, err := enterTransactionInternal(txnBuf.FilterRDS(c), cfg, m, prov, 0)
 	if err != nil {
 		return err
 	}
@@ -32,7 +34,7 @@ func RunMutation(c context.Context, m Mutation) error {
 	return nil
 }
 
-func enterTransactionInternal(c context.Context, cfg *Config, m Mutation, round uint64) (map[taskShard]struct{}, []Mutation, []*datastore.Key, error) {
+func enterTransactionInternal(c context.Context, cfg *Config, m Mutation, prov *provenance, round uint64) (map[taskShard]struct{}, []Mutation, []*datastore.Key, error) {
 	fromRoot := m.Root(c)
 
 	if fromRoot == nil {
@@ -54,7 +56,7 @@ func enterTransactionInternal(c context.Context, cfg *Config, m Mutation, round
 		}
 
 		retMuts = muts
-		shardSet, retMutKeys, err = putMutations(c, cfg, fromRoot, muts, round)
+		shardSet, retMutKeys, err = putMutations(c, cfg, fromRoot, prov, muts, round)
 
 		return err
 	}, nil)
@@ -95,7 +97,7 @@ func PutNamedMutations(c context.Context, parent *datastore.Key, muts map[string
 	shardSet := map[taskShard]struct{}{}
 	toPut := make([]*realMutation, 0, len(muts))
 	for name, m := range muts {
-		realMut, err := newRealMutation(c, cfg, "n:"+name, parent, m, now)
+		realMut, err := newRealMutation(c, cfg, "n:"+name, parent, nil, m, now)
 		if err != nil {
 			logging.WithError(err).Errorf(c, "error creating real mutation for %v", m)
 			return err